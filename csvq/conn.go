@@ -0,0 +1,145 @@
+package csvq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type Conn struct {
+	proc *query.Processor
+	tx   *Tx
+}
+
+func NewConn(ctx context.Context, dsn string, waitTimeout time.Duration, retryDelay time.Duration) (*Conn, error) {
+	d, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	sess := query.NewSession()
+	sess.SetStdout(query.NewDiscard())
+	sess.SetStderr(query.NewDiscard())
+	if err = sess.SetStdinContext(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	tx, err := query.NewTransaction(ctx, waitTimeout, retryDelay, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Flags.SetRepository(d.Repository); err != nil {
+		return nil, err
+	}
+	if 0 < len(d.Timezone) {
+		if err = tx.Flags.SetLocation(d.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	tx.Flags.SetDatetimeFormat(d.DatetimeFormat)
+	tx.Flags.SetAnsiQuotes(d.AnsiQuotes)
+	tx.Flags.SetQuiet(true)
+	tx.AutoCommit = true
+
+	return &Conn{
+		proc: query.NewProcessor(tx),
+	}, nil
+}
+
+func (c *Conn) Processor() *query.Processor {
+	return c.proc
+}
+
+func (c *Conn) Prepare(queryString string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), queryString)
+}
+
+func (c *Conn) PrepareContext(ctx context.Context, queryString string) (driver.Stmt, error) {
+	return NewStmt(ctx, c, queryString)
+}
+
+func (c *Conn) Close() error {
+	var err error
+	if c.tx != nil {
+		err = c.tx.Rollback()
+	}
+	if e := c.proc.ReleaseResourcesWithErrors(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func (c *Conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *Conn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.tx != nil {
+		return nil, errors.New("transaction has already been started")
+	}
+	if sql.IsolationLevel(opts.Isolation) != sql.LevelDefault {
+		return nil, errors.New("isolation level is not supported")
+	}
+
+	c.proc.Tx.AutoCommit = false
+	c.tx = &Tx{conn: c}
+	return c.tx, nil
+}
+
+func (c *Conn) ExecContext(ctx context.Context, queryString string, args []driver.NamedValue) (driver.Result, error) {
+	stmt, err := NewStmt(ctx, c, queryString)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.ExecContext(ctx, args)
+}
+
+func (c *Conn) QueryContext(ctx context.Context, queryString string, args []driver.NamedValue) (driver.Rows, error) {
+	stmt, err := NewStmt(ctx, c, queryString)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.QueryContext(ctx, args)
+}
+
+func (c *Conn) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (c *Conn) execute(ctx context.Context, statements []parser.Statement, args []driver.NamedValue) error {
+	replaceValues, err := NewReplaceValues(args)
+	if err != nil {
+		return err
+	}
+
+	ctx = query.ContextForStoringResults(ctx)
+	ctx = query.ContextForPreparedStatement(ctx, replaceValues)
+
+	if _, err = c.proc.Execute(ctx, statements); err != nil {
+		if c.proc.Tx.AutoCommit {
+			if e := c.proc.AutoRollback(); e != nil {
+				return e
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Conn) commit(ctx context.Context) error {
+	c.tx = nil
+	c.proc.Tx.AutoCommit = true
+	return c.proc.Commit(ctx, nil)
+}
+
+func (c *Conn) rollback() error {
+	c.tx = nil
+	c.proc.Tx.AutoCommit = true
+	return c.proc.Rollback(nil)
+}
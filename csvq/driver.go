@@ -0,0 +1,59 @@
+// Package csvq provides an API to execute csvq queries in Go programs,
+// and registers a driver for database/sql under the name "csvq".
+//
+//   db, err := sql.Open("csvq", "/path/to/repository?Timezone=UTC")
+package csvq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/file"
+)
+
+const DriverName = "csvq"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+type Driver struct{}
+
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	return NewConn(context.Background(), dsn, file.DefaultWaitTimeout, file.DefaultRetryDelay)
+}
+
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	return NewConnector(d, dsn)
+}
+
+type Connector struct {
+	driver *Driver
+	dsn    string
+
+	WaitTimeout time.Duration
+	RetryDelay  time.Duration
+}
+
+func NewConnector(d *Driver, dsn string) (*Connector, error) {
+	if _, err := ParseDSN(dsn); err != nil {
+		return nil, err
+	}
+
+	return &Connector{
+		driver:      d,
+		dsn:         dsn,
+		WaitTimeout: file.DefaultWaitTimeout,
+		RetryDelay:  file.DefaultRetryDelay,
+	}, nil
+}
+
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return NewConn(ctx, c.dsn, c.WaitTimeout, c.RetryDelay)
+}
+
+func (c *Connector) Driver() driver.Driver {
+	return c.driver
+}
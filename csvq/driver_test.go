@@ -0,0 +1,198 @@
+package csvq
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func openTestDB(t *testing.T, repository string) *sql.DB {
+	db, err := sql.Open(DriverName, repository+"?Timezone=UTC")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	db.SetMaxOpenConns(1)
+	return db
+}
+
+type queryResult struct {
+	Columns []string
+	Rows    [][]interface{}
+}
+
+func fetchAll(rows *sql.Rows) (queryResult, error) {
+	defer func() { _ = rows.Close() }()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return queryResult{}, err
+	}
+
+	result := queryResult{Columns: columns, Rows: [][]interface{}{}}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return queryResult{}, err
+		}
+		result.Rows = append(result.Rows, values)
+	}
+	return result, rows.Err()
+}
+
+var dbQueryTests = []struct {
+	Name   string
+	Query  string
+	Args   []interface{}
+	Result queryResult
+	Error  string
+}{
+	{
+		Name:  "Select",
+		Query: "SELECT * FROM table1",
+		Result: queryResult{
+			Columns: []string{"column1", "column2"},
+			Rows: [][]interface{}{
+				{"1", "str1"},
+				{"2", "str2"},
+				{"3", "str3"},
+			},
+		},
+	},
+	{
+		Name:  "Select with Typed Values",
+		Query: "SELECT 1 AS i, 1.5 AS f, TRUE AS b, UNKNOWN AS t, NULL AS n, DATETIME('2012-02-03 09:18:15') AS d",
+		Result: queryResult{
+			Columns: []string{"i", "f", "b", "t", "n", "d"},
+			Rows: [][]interface{}{
+				{int64(1), 1.5, true, nil, nil, time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+			},
+		},
+	},
+	{
+		Name:  "Positional Placeholders",
+		Query: "SELECT column2 FROM table1 WHERE column1 = ? OR column2 = ?",
+		Args:  []interface{}{1, "str3"},
+		Result: queryResult{
+			Columns: []string{"column2"},
+			Rows: [][]interface{}{
+				{"str1"},
+				{"str3"},
+			},
+		},
+	},
+	{
+		Name:  "Named Placeholders",
+		Query: "SELECT column2 FROM table1 WHERE column1 = :id OR column1 = :id + 1",
+		Args:  []interface{}{sql.Named("id", 2)},
+		Result: queryResult{
+			Columns: []string{"column2"},
+			Rows: [][]interface{}{
+				{"str2"},
+				{"str3"},
+			},
+		},
+	},
+	{
+		Name:  "Placeholder Value Not Specified",
+		Query: "SELECT column2 FROM table1 WHERE column1 = :id",
+		Args:  []interface{}{sql.Named("num", 2)},
+		Error: "[L:1 C:44] replace value for :id is not specified",
+	},
+	{
+		Name:  "Syntax Error",
+		Query: "SELECT FROM table1",
+		Error: "[L:1 C:8] syntax error: unexpected token \"FROM\"",
+	},
+	{
+		Name:  "Table Not Found",
+		Query: "SELECT * FROM notexist",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+}
+
+func TestDB_Query(t *testing.T) {
+	db := openTestDB(t, TestDataDir)
+	defer func() { _ = db.Close() }()
+
+	for _, v := range dbQueryTests {
+		rows, err := db.Query(v.Query, v.Args...)
+		if err == nil {
+			var result queryResult
+			result, err = fetchAll(rows)
+			if err == nil {
+				if 0 < len(v.Error) {
+					t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+				} else if !reflect.DeepEqual(result, v.Result) {
+					t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+				}
+				continue
+			}
+		}
+
+		if len(v.Error) < 1 {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+		} else if err.Error() != v.Error {
+			t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+		}
+	}
+}
+
+func countRows(t *testing.T, db *sql.DB) int {
+	var cnt int
+	if err := db.QueryRow("SELECT COUNT(*) FROM table1").Scan(&cnt); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return cnt
+}
+
+func TestDB_Exec(t *testing.T) {
+	db := openTestDB(t, TestDir)
+	defer func() { _ = db.Close() }()
+
+	result, err := db.Exec("INSERT INTO table1 VALUES (?, ?), (?, ?)", 4, "str4", 5, "str5")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if n, _ := result.RowsAffected(); n != 2 {
+		t.Errorf("rows affected = %d, want %d", n, 2)
+	}
+	if _, err = result.LastInsertId(); err == nil {
+		t.Errorf("no error, want error for LastInsertId")
+	}
+	if cnt := countRows(t, db); cnt != 5 {
+		t.Errorf("count = %d, want %d", cnt, 5)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("DELETE FROM table1 WHERE column1 > :num", sql.Named("num", 3)); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt := countRows(t, db); cnt != 5 {
+		t.Errorf("count after rollback = %d, want %d", cnt, 5)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("DELETE FROM table1 WHERE column1 > :num", sql.Named("num", 3)); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt := countRows(t, db); cnt != 3 {
+		t.Errorf("count after commit = %d, want %d", cnt, 3)
+	}
+}
@@ -0,0 +1,75 @@
+package csvq
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type DSN struct {
+	Repository     string
+	Timezone       string
+	DatetimeFormat string
+	AnsiQuotes     bool
+}
+
+func ParseDSN(dsn string) (*DSN, error) {
+	d := &DSN{}
+
+	repository := dsn
+	params := ""
+	if i := strings.IndexByte(dsn, '?'); -1 < i {
+		repository = dsn[:i]
+		params = dsn[i+1:]
+	}
+	d.Repository = repository
+
+	values, err := url.ParseQuery(params)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid dsn: %s", err.Error()))
+	}
+
+	for key, list := range values {
+		if len(list) < 1 {
+			continue
+		}
+		v := list[len(list)-1]
+
+		switch strings.ToUpper(key) {
+		case "TIMEZONE":
+			d.Timezone = v
+		case "DATETIMEFORMAT":
+			d.DatetimeFormat = v
+		case "ANSIQUOTES":
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("invalid dsn: %q is not a boolean value for %s", v, key))
+			}
+			d.AnsiQuotes = b
+		default:
+			return nil, errors.New(fmt.Sprintf("invalid dsn: %s is an unknown parameter", key))
+		}
+	}
+
+	return d, nil
+}
+
+func (d *DSN) String() string {
+	values := url.Values{}
+	if 0 < len(d.Timezone) {
+		values.Set("Timezone", d.Timezone)
+	}
+	if 0 < len(d.DatetimeFormat) {
+		values.Set("DatetimeFormat", d.DatetimeFormat)
+	}
+	if d.AnsiQuotes {
+		values.Set("AnsiQuotes", "true")
+	}
+
+	if len(values) < 1 {
+		return d.Repository
+	}
+	return d.Repository + "?" + values.Encode()
+}
@@ -0,0 +1,67 @@
+package csvq
+
+import (
+	"reflect"
+	"testing"
+)
+
+var parseDSNTests = []struct {
+	DSN    string
+	Result *DSN
+	String string
+	Error  string
+}{
+	{
+		DSN: "/path/to/repository",
+		Result: &DSN{
+			Repository: "/path/to/repository",
+		},
+		String: "/path/to/repository",
+	},
+	{
+		DSN: "/path/to/repository?timezone=UTC&DatetimeFormat=%25Y%25m%25d&AnsiQuotes=true",
+		Result: &DSN{
+			Repository:     "/path/to/repository",
+			Timezone:       "UTC",
+			DatetimeFormat: "%Y%m%d",
+			AnsiQuotes:     true,
+		},
+		String: "/path/to/repository?AnsiQuotes=true&DatetimeFormat=%25Y%25m%25d&Timezone=UTC",
+	},
+	{
+		DSN:   "/path/to/repository?AnsiQuotes=yes",
+		Error: "invalid dsn: \"yes\" is not a boolean value for AnsiQuotes",
+	},
+	{
+		DSN:   "/path/to/repository?Delimiter=%2C",
+		Error: "invalid dsn: Delimiter is an unknown parameter",
+	},
+	{
+		DSN:   "/path/to/repository?Timezone=%ZZ",
+		Error: "invalid dsn: invalid URL escape \"%ZZ\"",
+	},
+}
+
+func TestParseDSN(t *testing.T) {
+	for _, v := range parseDSNTests {
+		result, err := ParseDSN(v.DSN)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.DSN, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.DSN, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.DSN, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.DSN, result, v.Result)
+		}
+		if result.String() != v.String {
+			t.Errorf("%s: string = %q, want %q", v.DSN, result.String(), v.String)
+		}
+	}
+}
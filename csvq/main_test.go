@@ -0,0 +1,44 @@
+package csvq
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var TestDir = filepath.Join(os.TempDir(), "csvq_driver_test")
+var TestDataDir string
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	defer teardown()
+
+	setup()
+	return m.Run()
+}
+
+func setup() {
+	if _, err := os.Stat(TestDir); err == nil {
+		_ = os.RemoveAll(TestDir)
+	}
+
+	wdir, _ := os.Getwd()
+	TestDataDir = filepath.Join(wdir, "..", "testdata", "csv")
+
+	if _, err := os.Stat(TestDir); os.IsNotExist(err) {
+		_ = os.Mkdir(TestDir, 0755)
+	}
+
+	b, _ := ioutil.ReadFile(filepath.Join(TestDataDir, "table1.csv"))
+	_ = ioutil.WriteFile(filepath.Join(TestDir, "table1.csv"), b, 0644)
+}
+
+func teardown() {
+	if _, err := os.Stat(TestDir); err == nil {
+		_ = os.RemoveAll(TestDir)
+	}
+}
@@ -0,0 +1,23 @@
+package csvq
+
+import (
+	"errors"
+)
+
+type Result struct {
+	affectedRows int64
+}
+
+func NewResult(affectedRows int64) *Result {
+	return &Result{
+		affectedRows: affectedRows,
+	}
+}
+
+func (r *Result) LastInsertId() (int64, error) {
+	return 0, errors.New("LastInsertId is not supported")
+}
+
+func (r *Result) RowsAffected() (int64, error) {
+	return r.affectedRows, nil
+}
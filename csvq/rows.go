@@ -0,0 +1,49 @@
+package csvq
+
+import (
+	"database/sql/driver"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type Rows struct {
+	view    *query.View
+	columns []string
+	idx     int
+}
+
+func NewRows(view *query.View) *Rows {
+	var columns []string
+	if view != nil {
+		columns = view.Header.TableColumnNames()
+	}
+
+	return &Rows{
+		view:    view,
+		columns: columns,
+		idx:     0,
+	}
+}
+
+func (r *Rows) Columns() []string {
+	return r.columns
+}
+
+func (r *Rows) Close() error {
+	r.view = nil
+	return nil
+}
+
+func (r *Rows) Next(dest []driver.Value) error {
+	if r.view == nil || r.view.RecordLen() <= r.idx {
+		return io.EOF
+	}
+
+	record := r.view.RecordSet[r.idx]
+	for i := range dest {
+		dest[i] = ConvertToDriverValue(record[i][0])
+	}
+	r.idx++
+	return nil
+}
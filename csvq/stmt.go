@@ -0,0 +1,79 @@
+package csvq
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type Stmt struct {
+	conn         *Conn
+	statements   []parser.Statement
+	holderNumber int
+}
+
+func NewStmt(ctx context.Context, conn *Conn, queryString string) (*Stmt, error) {
+	if ctx.Err() != nil {
+		return nil, query.ConvertContextError(ctx.Err())
+	}
+
+	flags := conn.proc.Tx.Flags
+	statements, holderNumber, err := parser.Parse(queryString, "", flags.DatetimeFormat, true, flags.AnsiQuotes)
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	return &Stmt{
+		conn:         conn,
+		statements:   statements,
+		holderNumber: holderNumber,
+	}, nil
+}
+
+func (stmt *Stmt) Close() error {
+	return nil
+}
+
+func (stmt *Stmt) NumInput() int {
+	return stmt.holderNumber
+}
+
+func (stmt *Stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return stmt.ExecContext(context.Background(), namedValues(args))
+}
+
+func (stmt *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if err := stmt.conn.execute(ctx, stmt.statements, args); err != nil {
+		return nil, err
+	}
+	return NewResult(int64(stmt.conn.proc.Tx.AffectedRows)), nil
+}
+
+func (stmt *Stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return stmt.QueryContext(context.Background(), namedValues(args))
+}
+
+func (stmt *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := stmt.conn.execute(ctx, stmt.statements, args); err != nil {
+		return nil, err
+	}
+
+	var view *query.View
+	if views := stmt.conn.proc.Tx.SelectedViews; 0 < len(views) {
+		view = views[len(views)-1]
+	}
+	return NewRows(view), nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	values := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		values[i] = driver.NamedValue{
+			Ordinal: i + 1,
+			Value:   v,
+		}
+	}
+	return values
+}
@@ -0,0 +1,24 @@
+package csvq
+
+import (
+	"context"
+	"errors"
+)
+
+type Tx struct {
+	conn *Conn
+}
+
+func (tx *Tx) Commit() error {
+	if tx.conn.tx != tx {
+		return errors.New("transaction has already been committed or rolled back")
+	}
+	return tx.conn.commit(context.Background())
+}
+
+func (tx *Tx) Rollback() error {
+	if tx.conn.tx != tx {
+		return errors.New("transaction has already been committed or rolled back")
+	}
+	return tx.conn.rollback()
+}
@@ -0,0 +1,76 @@
+package csvq
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func ConvertToDriverValue(p value.Primary) driver.Value {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw()
+	case *value.Integer:
+		return p.(*value.Integer).Raw()
+	case *value.Float:
+		return p.(*value.Float).Raw()
+	case *value.Decimal:
+		return p.(*value.Decimal).String()
+	case *value.Boolean:
+		return p.(*value.Boolean).Raw()
+	case *value.Ternary:
+		switch p.(*value.Ternary).Ternary() {
+		case ternary.TRUE:
+			return true
+		case ternary.FALSE:
+			return false
+		}
+	case *value.Datetime:
+		return p.(*value.Datetime).Raw()
+	case *value.Interval:
+		return p.(*value.Interval).Literal()
+	}
+	return nil
+}
+
+func ConvertFromDriverValue(v driver.Value) (value.Primary, error) {
+	switch v.(type) {
+	case nil:
+		return value.NewNull(), nil
+	case int64:
+		return value.NewInteger(v.(int64)), nil
+	case float64:
+		return value.NewFloat(v.(float64)), nil
+	case bool:
+		return value.NewBoolean(v.(bool)), nil
+	case []byte:
+		return value.NewString(string(v.([]byte))), nil
+	case string:
+		return value.NewString(v.(string)), nil
+	case time.Time:
+		return value.NewDatetime(v.(time.Time)), nil
+	}
+	return nil, errors.New(fmt.Sprintf("type %T is not supported", v))
+}
+
+func NewReplaceValues(args []driver.NamedValue) (*query.ReplaceValues, error) {
+	replace := make([]parser.ReplaceValue, 0, len(args))
+	for _, arg := range args {
+		p, err := ConvertFromDriverValue(arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		replace = append(replace, parser.ReplaceValue{
+			Value: parser.PrimitiveType{Value: p},
+			Name:  parser.Identifier{Literal: arg.Name},
+		})
+	}
+	return query.NewReplaceValues(replace), nil
+}
@@ -0,0 +1,78 @@
+package csvq
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var convertToDriverValueTests = []struct {
+	Name   string
+	Value  value.Primary
+	Result driver.Value
+}{
+	{
+		Name:   "String",
+		Value:  value.NewString("str"),
+		Result: "str",
+	},
+	{
+		Name:   "Integer",
+		Value:  value.NewInteger(1),
+		Result: int64(1),
+	},
+	{
+		Name:   "Float",
+		Value:  value.NewFloat(1.5),
+		Result: float64(1.5),
+	},
+	{
+		Name:   "Decimal",
+		Value:  value.NewDecimalFromString("12345678901234567890.123456789"),
+		Result: "12345678901234567890.123456789",
+	},
+	{
+		Name:   "Boolean",
+		Value:  value.NewBoolean(true),
+		Result: true,
+	},
+	{
+		Name:   "Ternary",
+		Value:  value.NewTernary(ternary.FALSE),
+		Result: false,
+	},
+	{
+		Name:   "Unknown Ternary",
+		Value:  value.NewTernary(ternary.UNKNOWN),
+		Result: nil,
+	},
+	{
+		Name:   "Datetime",
+		Value:  value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		Result: time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+	},
+	{
+		Name:   "Interval",
+		Value:  value.NewInterval(14, 3, int64(4*time.Hour+5*time.Minute+6*time.Second+500*time.Millisecond)),
+		Result: "1 year 2 months 3 days 04:05:06.5",
+	},
+	{
+		Name:   "Null",
+		Value:  value.NewNull(),
+		Result: nil,
+	},
+}
+
+func TestConvertToDriverValue(t *testing.T) {
+	for _, v := range convertToDriverValueTests {
+		result := ConvertToDriverValue(v.Value)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 module github.com/mithrandie/csvq
 
 require (
+	github.com/klauspost/compress v1.18.0
 	github.com/mitchellh/go-homedir v1.0.0
 	github.com/mithrandie/go-file/v2 v2.0.2
 	github.com/mithrandie/go-text v1.3.1
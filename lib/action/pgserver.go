@@ -0,0 +1,1157 @@
+package action
+
+import (
+	"bufio"
+	"context"
+	crand "crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/rand"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/pgwire"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const DefaultPGServerListen = "127.0.0.1:5432"
+
+const pgServerVersion = "14.0"
+
+const (
+	pgNoControl = iota
+	pgBegin
+	pgCommit
+	pgRollback
+)
+
+var (
+	pgBeginExp    = regexp.MustCompile(`(?i)^\s*(BEGIN|START\s+TRANSACTION)(\s+(WORK|TRANSACTION))?\s*;?\s*$`)
+	pgCommitExp   = regexp.MustCompile(`(?i)^\s*(COMMIT|END)(\s+(WORK|TRANSACTION))?\s*;?\s*$`)
+	pgRollbackExp = regexp.MustCompile(`(?i)^\s*(ROLLBACK|ABORT)(\s+(WORK|TRANSACTION))?\s*;?\s*$`)
+)
+
+// SQLSTATE codes
+const (
+	pgSyntaxError                      = "42601"
+	pgUndefinedColumn                  = "42703"
+	pgAmbiguousColumn                  = "42702"
+	pgUndefinedTable                   = "42P01"
+	pgUndefinedFunction                = "42883"
+	pgUndefinedParameter               = "42P02"
+	pgDuplicatePreparedStatement       = "42P05"
+	pgInvalidSQLStatementName          = "26000"
+	pgInvalidCursorName                = "34000"
+	pgQueryCanceled                    = "57014"
+	pgLockNotAvailable                 = "55P03"
+	pgInFailedSQLTransaction           = "25P02"
+	pgProtocolViolation                = "08P01"
+	pgInvalidPassword                  = "28P01"
+	pgInsufficientPrivilege            = "42501"
+	pgFeatureNotSupported              = "0A000"
+	pgRaiseException                   = "P0001"
+	pgIOError                          = "58030"
+	pgInternalError                    = "XX000"
+	pgSyntaxErrorOrAccessRuleViolation = "42000"
+)
+
+// ServePG listens on the address and serves clients of the PostgreSQL frontend/backend protocol until the context is done.
+// If password is not empty, clients are authenticated with the password by MD5 authentication.
+// The password is required to listen on addresses other than loopback addresses.
+func ServePG(ctx context.Context, proc *query.Processor, listen string, password string) error {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return query.NewIOError(nil, err.Error())
+	}
+
+	if addr, ok := listener.Addr().(*net.TCPAddr); len(password) < 1 && (!ok || !addr.IP.IsLoopback()) {
+		_ = listener.Close()
+		return query.NewIncorrectCommandUsageError(fmt.Sprintf("password is required to listen on %s", listener.Addr().String()))
+	}
+
+	server := newPGServer(ctx, proc.Tx, password)
+
+	ch := make(chan error, 1)
+	go func() {
+		ch <- server.Serve(listener)
+	}()
+
+	proc.Log(fmt.Sprintf("csvq pgserver is listening on %s", listener.Addr().String()), proc.Tx.Flags.Quiet)
+
+	select {
+	case err = <-ch:
+		server.Close()
+		return query.NewIOError(nil, err.Error())
+	case <-ctx.Done():
+		_ = listener.Close()
+		server.Close()
+		return query.ConvertContextError(ctx.Err())
+	}
+}
+
+type pgServer struct {
+	ctx      context.Context
+	tx       *query.Transaction
+	password string
+
+	conns         map[int32]*pgConn
+	lastProcessID int32
+	closed        bool
+	mtx           sync.Mutex
+	wg            sync.WaitGroup
+}
+
+func newPGServer(ctx context.Context, tx *query.Transaction, password string) *pgServer {
+	return &pgServer{
+		ctx:      ctx,
+		tx:       tx,
+		password: password,
+		conns:    make(map[int32]*pgConn),
+	}
+}
+
+func (s *pgServer) Serve(listener net.Listener) error {
+	for {
+		c, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(c)
+		}()
+	}
+}
+
+// Close cancels the running queries, closes all connections and waits for them to finish.
+func (s *pgServer) Close() {
+	s.mtx.Lock()
+	s.closed = true
+	for _, conn := range s.conns {
+		conn.Cancel()
+		_ = conn.conn.Close()
+	}
+	s.mtx.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *pgServer) handle(c net.Conn) {
+	defer func() {
+		_ = c.Close()
+	}()
+
+	r := bufio.NewReader(c)
+	w := pgwire.NewWriter(c)
+
+	var startup *pgwire.StartupMessage
+	for startup == nil {
+		msg, err := pgwire.ReadStartupMessage(r)
+		if err != nil {
+			_ = w.WriteErrorResponse(pgwire.ErrorResponseMessage, "FATAL", pgProtocolViolation, err.Error())
+			_ = w.Flush()
+			return
+		}
+
+		switch msg.Version {
+		case pgwire.SSLRequestCode, pgwire.GSSENCRequestCode:
+			if w.WriteRaw([]byte{'N'}) != nil {
+				return
+			}
+		case pgwire.CancelRequestCode:
+			s.cancel(msg.ProcessID, msg.SecretKey)
+			return
+		default:
+			startup = msg
+		}
+	}
+
+	if err := s.authenticate(r, w, startup.Parameters["user"]); err != nil {
+		_ = w.WriteErrorResponse(pgwire.ErrorResponseMessage, "FATAL", pgErrorCode(err), err.Error())
+		_ = w.Flush()
+		return
+	}
+
+	conn, err := s.register(c, r, w)
+	if err != nil {
+		_ = w.WriteErrorResponse(pgwire.ErrorResponseMessage, "FATAL", pgErrorCode(err), err.Error())
+		_ = w.Flush()
+		return
+	}
+	defer s.unregister(conn)
+
+	if err = conn.Start(); err != nil {
+		return
+	}
+	if err = conn.Run(); err != nil {
+		s.tx.LogError(err.Error())
+	}
+}
+
+// authenticate requests the password to the client by MD5 authentication if the server has a password.
+func (s *pgServer) authenticate(r *bufio.Reader, w *pgwire.Writer, user string) error {
+	if len(s.password) < 1 {
+		return nil
+	}
+
+	var salt [4]byte
+	if _, err := crand.Read(salt[:]); err != nil {
+		return query.NewSystemError(err.Error())
+	}
+	if err := w.WriteAuthenticationMD5Password(salt); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	t, body, err := pgwire.ReadMessage(r)
+	if err != nil {
+		return err
+	}
+	if t != pgwire.PasswordMessage {
+		return pgwire.ErrInvalidMessage
+	}
+	password, err := pgwire.NewBuffer(body).String()
+	if err != nil {
+		return pgwire.ErrInvalidMessage
+	}
+
+	if subtle.ConstantTimeCompare([]byte(password), []byte(pgwire.MD5Password(user, s.password, salt))) != 1 {
+		return newPGError(pgInvalidPassword, fmt.Sprintf("password authentication failed for user %q", user))
+	}
+	return nil
+}
+
+func (s *pgServer) register(c net.Conn, r *bufio.Reader, w *pgwire.Writer) (*pgConn, error) {
+	session := query.NewSession()
+	_ = session.SetStdin(nil)
+	session.SetStdout(query.NewDiscard())
+	session.SetStderr(query.NewDiscard())
+
+	tx, err := s.tx.NewSessionTransaction(session)
+	if err != nil {
+		return nil, err
+	}
+	tx.AutoCommit = false
+	tx.Sandboxed = true
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return nil, query.NewContextCanceled("the server is shutting down")
+	}
+
+	s.lastProcessID++
+	conn := &pgConn{
+		ctx:        s.ctx,
+		conn:       c,
+		r:          r,
+		w:          w,
+		proc:       query.NewProcessor(tx),
+		processID:  s.lastProcessID,
+		secretKey:  rand.Int31(),
+		status:     pgwire.TransactionStatusIdle,
+		statements: make(map[string]*pgStatement),
+		portals:    make(map[string]*pgPortal),
+	}
+	s.conns[conn.processID] = conn
+	return conn, nil
+}
+
+func (s *pgServer) unregister(conn *pgConn) {
+	if err := conn.proc.AutoRollback(); err != nil {
+		s.tx.LogError(err.Error())
+	}
+	if err := conn.proc.ReleaseResourcesWithErrors(); err != nil {
+		s.tx.LogError(err.Error())
+	}
+
+	s.mtx.Lock()
+	delete(s.conns, conn.processID)
+	s.mtx.Unlock()
+}
+
+func (s *pgServer) cancel(processID int32, secretKey int32) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if conn, ok := s.conns[processID]; ok && conn.secretKey == secretKey {
+		conn.Cancel()
+	}
+}
+
+type pgStatement struct {
+	Name       string
+	Control    int
+	Empty      bool
+	Statement  parser.Statement
+	ParamOIDs  []int32
+	ParamCount int
+	Fields     []pgwire.FieldDescription
+}
+
+type pgPortal struct {
+	Statement *pgStatement
+	Values    []parser.ReplaceValue
+	Formats   []int16
+
+	result *pgResult
+	pos    int
+}
+
+type pgResult struct {
+	View   *query.View
+	Fields []pgwire.FieldDescription
+	Tag    string
+}
+
+type pgConn struct {
+	ctx  context.Context
+	conn net.Conn
+	r    *bufio.Reader
+	w    *pgwire.Writer
+	proc *query.Processor
+
+	processID int32
+	secretKey int32
+	status    byte
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+
+	cancelFunc context.CancelFunc
+	mtx        sync.Mutex
+}
+
+// Start sends the messages that complete the startup phase.
+// The client has already been authenticated if the server has a password.
+func (c *pgConn) Start() error {
+	_ = c.w.WriteAuthenticationOk()
+
+	params := [][2]string{
+		{"server_version", pgServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"TimeZone", pgTimeZone()},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"application_name", ""},
+	}
+	for _, p := range params {
+		_ = c.w.WriteParameterStatus(p[0], p[1])
+	}
+
+	_ = c.w.WriteBackendKeyData(c.processID, c.secretKey)
+	_ = c.w.WriteReadyForQuery(c.status)
+	return c.w.Flush()
+}
+
+// Run reads and processes messages until the client terminates the connection.
+func (c *pgConn) Run() error {
+	ignoreUntilSync := false
+
+	for {
+		t, body, err := pgwire.ReadMessage(c.r)
+		if err != nil {
+			if err == pgwire.ErrInvalidMessage {
+				_ = c.w.WriteErrorResponse(pgwire.ErrorResponseMessage, "FATAL", pgProtocolViolation, err.Error())
+				_ = c.w.Flush()
+			}
+			return nil
+		}
+
+		if ignoreUntilSync && t != pgwire.SyncMessage && t != pgwire.TerminateMessage {
+			continue
+		}
+
+		buf := pgwire.NewBuffer(body)
+		switch t {
+		case pgwire.QueryMessage:
+			err = c.query(buf)
+		case pgwire.ParseMessage:
+			err = c.parse(buf)
+		case pgwire.BindMessage:
+			err = c.bind(buf)
+		case pgwire.DescribeMessage:
+			err = c.describe(buf)
+		case pgwire.ExecuteMessage:
+			err = c.execute(buf)
+		case pgwire.CloseMessage:
+			err = c.close(buf)
+		case pgwire.SyncMessage:
+			ignoreUntilSync = false
+			err = c.sync()
+		case pgwire.FlushMessage:
+			err = c.w.Flush()
+		case pgwire.TerminateMessage:
+			return nil
+		default:
+			_ = c.w.WriteErrorResponse(pgwire.ErrorResponseMessage, "FATAL", pgProtocolViolation, fmt.Sprintf("invalid frontend message type %d", t))
+			return c.w.Flush()
+		}
+
+		if err != nil {
+			if e := c.w.Err(); e != nil {
+				return e
+			}
+
+			c.writeError(err)
+			if t == pgwire.QueryMessage {
+				_ = c.w.WriteReadyForQuery(c.status)
+				err = c.w.Flush()
+			} else {
+				ignoreUntilSync = true
+			}
+		}
+
+		if e := c.w.Err(); e != nil {
+			return e
+		}
+	}
+}
+
+// Cancel cancels the running query.
+func (c *pgConn) Cancel() {
+	c.mtx.Lock()
+	if c.cancelFunc != nil {
+		c.cancelFunc()
+	}
+	c.mtx.Unlock()
+}
+
+func (c *pgConn) begin() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.mtx.Lock()
+	c.cancelFunc = cancel
+	c.mtx.Unlock()
+
+	return ctx, func() {
+		c.mtx.Lock()
+		c.cancelFunc = nil
+		c.mtx.Unlock()
+		cancel()
+	}
+}
+
+// fail is called when an error occurred in a statement.
+// Changes in an implicit transaction are rolled back, and an explicit transaction block becomes failed.
+func (c *pgConn) fail() {
+	if c.status == pgwire.TransactionStatusIdle {
+		if err := c.proc.AutoRollback(); err != nil {
+			c.proc.LogError(err.Error())
+		}
+	} else {
+		c.status = pgwire.TransactionStatusFailedBlock
+	}
+}
+
+func (c *pgConn) writeError(err error) {
+	_ = c.w.WriteErrorResponse(pgwire.ErrorResponseMessage, "ERROR", pgErrorCode(err), err.Error())
+}
+
+func (c *pgConn) query(buf *pgwire.Buffer) error {
+	queryString, err := buf.String()
+	if err != nil {
+		return err
+	}
+
+	if control := pgControl(queryString); control != pgNoControl {
+		tag, err := c.control(control)
+		if err != nil {
+			return err
+		}
+		_ = c.w.WriteCommandComplete(tag)
+		_ = c.w.WriteReadyForQuery(c.status)
+		return c.w.Flush()
+	}
+
+	if c.status == pgwire.TransactionStatusFailedBlock {
+		return newPGError(pgInFailedSQLTransaction, "current transaction is aborted, commands ignored until end of transaction block")
+	}
+
+	statements, _, err := parser.Parse(queryString, "", c.proc.Tx.Flags.DatetimeFormat, false, c.proc.Tx.Flags.AnsiQuotes)
+	if err != nil {
+		c.fail()
+		return query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	if len(statements) < 1 {
+		_ = c.w.WriteMessage(pgwire.EmptyQueryResponseMessage)
+		_ = c.w.WriteReadyForQuery(c.status)
+		return c.w.Flush()
+	}
+
+	ctx, cancel := c.begin()
+	defer cancel()
+
+	for _, stmt := range statements {
+		results, err := c.executeStatement(ctx, stmt, nil)
+		if err != nil {
+			c.fail()
+			return err
+		}
+
+		for _, result := range results {
+			if result.View != nil {
+				_ = c.w.WriteRowDescription(result.Fields)
+				if _, err = c.writeRows(result, 0, 0, nil); err != nil {
+					c.fail()
+					return err
+				}
+			}
+			_ = c.w.WriteCommandComplete(result.Tag)
+		}
+	}
+
+	if c.status == pgwire.TransactionStatusIdle {
+		if err = c.proc.AutoCommit(ctx); err != nil {
+			c.fail()
+			return err
+		}
+	}
+
+	_ = c.w.WriteReadyForQuery(c.status)
+	return c.w.Flush()
+}
+
+// executeStatement executes a statement and returns its results.
+// A statement such as IF or EXECUTE can return multiple result sets.
+func (c *pgConn) executeStatement(ctx context.Context, stmt parser.Statement, fields []pgwire.FieldDescription) ([]*pgResult, error) {
+	if _, err := c.proc.Execute(query.ContextForStoringResults(ctx), []parser.Statement{stmt}); err != nil {
+		if ex, ok := err.(*query.ForcedExit); !ok || ex.Code() != 0 {
+			return nil, err
+		}
+	}
+
+	if tc, ok := stmt.(parser.TransactionControl); ok && (tc.Token == parser.COMMIT || tc.Token == parser.ROLLBACK) {
+		c.status = pgwire.TransactionStatusIdle
+	}
+
+	if len(c.proc.Tx.SelectedViews) < 1 {
+		return []*pgResult{{Tag: pgCommandTag(c.proc.Tx, stmt)}}, nil
+	}
+
+	results := make([]*pgResult, 0, len(c.proc.Tx.SelectedViews))
+	for _, view := range c.proc.Tx.SelectedViews {
+		f := fields
+		if len(f) != view.FieldLen() {
+			f = pgFieldDescriptions(view)
+		}
+		results = append(results, &pgResult{
+			View:   view,
+			Fields: f,
+			Tag:    fmt.Sprintf("SELECT %d", view.RecordLen()),
+		})
+	}
+	return results, nil
+}
+
+// writeRows writes the records of the result from pos. If limit is greater than 0, then at most limit records are written.
+func (c *pgConn) writeRows(result *pgResult, pos int, limit int, formats []int16) (int, error) {
+	view := result.View
+	end := view.RecordLen()
+	if 0 < limit && pos+limit < end {
+		end = pos + limit
+	}
+
+	values := make([][]byte, view.FieldLen())
+	for i := pos; i < end; i++ {
+		for j := range values {
+			b, err := pgwire.EncodeValue(view.RecordSet[i][j][0], result.Fields[j].TypeOID, pgFormat(formats, j))
+			if err != nil {
+				return i, newPGError(pgInternalError, err.Error())
+			}
+			values[j] = b
+		}
+		if err := c.w.WriteDataRow(values); err != nil {
+			return i, err
+		}
+	}
+	return end, nil
+}
+
+func (c *pgConn) control(control int) (string, error) {
+	switch control {
+	case pgBegin:
+		if c.status == pgwire.TransactionStatusIdle {
+			c.status = pgwire.TransactionStatusInBlock
+		}
+		return "BEGIN", nil
+	case pgCommit:
+		if c.status != pgwire.TransactionStatusFailedBlock {
+			ctx, cancel := c.begin()
+			defer cancel()
+
+			c.status = pgwire.TransactionStatusIdle
+			if err := c.proc.Commit(ctx, nil); err != nil {
+				_ = c.proc.AutoRollback()
+				return "", err
+			}
+			return "COMMIT", nil
+		}
+	}
+
+	c.status = pgwire.TransactionStatusIdle
+	return "ROLLBACK", c.proc.AutoRollback()
+}
+
+func (c *pgConn) parse(buf *pgwire.Buffer) error {
+	name, err := buf.String()
+	if err != nil {
+		return err
+	}
+	queryString, err := buf.String()
+	if err != nil {
+		return err
+	}
+	n, err := buf.Int16()
+	if err != nil {
+		return err
+	}
+	oids := make([]int32, n)
+	for i := range oids {
+		if oids[i], err = buf.Int32(); err != nil {
+			return err
+		}
+	}
+
+	if 0 < len(name) {
+		if _, ok := c.statements[name]; ok {
+			return newPGError(pgDuplicatePreparedStatement, fmt.Sprintf("prepared statement %q already exists", name))
+		}
+	} else if err = c.closeStatement(name); err != nil {
+		return err
+	}
+
+	stmt := &pgStatement{
+		Name:      name,
+		Control:   pgControl(queryString),
+		ParamOIDs: oids,
+	}
+
+	if stmt.Control == pgNoControl {
+		if len(strings.TrimSpace(queryString)) < 1 {
+			stmt.Empty = true
+		} else {
+			if err = c.prepare(stmt, queryString); err != nil {
+				return err
+			}
+		}
+	}
+
+	if stmt.ParamCount < len(oids) {
+		stmt.ParamCount = len(oids)
+	}
+
+	c.statements[name] = stmt
+	return c.w.WriteMessage(pgwire.ParseCompleteMessage)
+}
+
+// prepare prepares the query with a StatementPreparation statement named same as the protocol-level statement.
+func (c *pgConn) prepare(stmt *pgStatement, queryString string) error {
+	identifier := parser.Identifier{Literal: stmt.Name}
+	if _, err := c.proc.ExecuteStatement(c.ctx, parser.StatementPreparation{Name: identifier, Statement: value.NewString(queryString)}); err != nil {
+		return err
+	}
+
+	prepared, err := c.proc.Tx.PreparedStatements.Get(identifier)
+	if err != nil {
+		return err
+	}
+
+	switch len(prepared.Statements) {
+	case 0:
+		stmt.Empty = true
+	case 1:
+		stmt.Statement = prepared.Statements[0]
+	default:
+		c.proc.Tx.PreparedStatements.Delete(stmt.Name)
+		return newPGError(pgSyntaxError, "cannot insert multiple commands into a prepared statement")
+	}
+	stmt.ParamCount = prepared.HolderNumber
+	return nil
+}
+
+func (c *pgConn) closeStatement(name string) error {
+	if _, ok := c.statements[name]; !ok {
+		return nil
+	}
+
+	delete(c.statements, name)
+	if c.proc.Tx.PreparedStatements.Exists(name) {
+		return c.proc.Tx.PreparedStatements.Dispose(parser.DisposeStatement{Name: parser.Identifier{Literal: name}})
+	}
+	return nil
+}
+
+func (c *pgConn) bind(buf *pgwire.Buffer) error {
+	portalName, err := buf.String()
+	if err != nil {
+		return err
+	}
+	statementName, err := buf.String()
+	if err != nil {
+		return err
+	}
+
+	paramFormats, err := readFormats(buf)
+	if err != nil {
+		return err
+	}
+
+	n, err := buf.Int16()
+	if err != nil {
+		return err
+	}
+	params := make([][]byte, n)
+	for i := range params {
+		length, err := buf.Int32()
+		if err != nil {
+			return err
+		}
+		if length < 0 {
+			continue
+		}
+		if params[i], err = buf.Bytes(int(length)); err != nil {
+			return err
+		}
+	}
+
+	resultFormats, err := readFormats(buf)
+	if err != nil {
+		return err
+	}
+
+	stmt, ok := c.statements[statementName]
+	if !ok {
+		return newPGError(pgInvalidSQLStatementName, fmt.Sprintf("prepared statement %q does not exist", statementName))
+	}
+	if len(params) != stmt.ParamCount {
+		return newPGError(pgProtocolViolation, fmt.Sprintf("bind message supplies %d parameters, but prepared statement %q requires %d", len(params), statementName, stmt.ParamCount))
+	}
+
+	values := make([]parser.ReplaceValue, len(params))
+	for i := range params {
+		oid := int32(pgwire.UnspecifiedOID)
+		if i < len(stmt.ParamOIDs) {
+			oid = stmt.ParamOIDs[i]
+		}
+
+		p, err := pgwire.DecodeValue(params[i], oid, pgFormat(paramFormats, i))
+		if err != nil {
+			return newPGError(pgProtocolViolation, err.Error())
+		}
+		values[i] = parser.ReplaceValue{Value: parser.PrimitiveType{Value: p}}
+	}
+
+	c.portals[portalName] = &pgPortal{
+		Statement: stmt,
+		Values:    values,
+		Formats:   resultFormats,
+	}
+	return c.w.WriteMessage(pgwire.BindCompleteMessage)
+}
+
+func (c *pgConn) describe(buf *pgwire.Buffer) error {
+	t, err := buf.Byte()
+	if err != nil {
+		return err
+	}
+	name, err := buf.String()
+	if err != nil {
+		return err
+	}
+
+	switch t {
+	case 'S':
+		stmt, ok := c.statements[name]
+		if !ok {
+			return newPGError(pgInvalidSQLStatementName, fmt.Sprintf("prepared statement %q does not exist", name))
+		}
+
+		oids := make([]int32, stmt.ParamCount)
+		for i := range oids {
+			oids[i] = pgwire.TextOID
+			if i < len(stmt.ParamOIDs) && stmt.ParamOIDs[i] != pgwire.UnspecifiedOID {
+				oids[i] = stmt.ParamOIDs[i]
+			}
+		}
+		_ = c.w.WriteParameterDescription(oids)
+
+		if stmt.Fields == nil && isPGRowStatement(stmt) {
+			stmt.Fields = c.describeStatement(stmt)
+		}
+		if stmt.Fields == nil {
+			return c.w.WriteMessage(pgwire.NoDataMessage)
+		}
+		return c.w.WriteRowDescription(stmt.Fields)
+	case 'P':
+		portal, ok := c.portals[name]
+		if !ok {
+			return newPGError(pgInvalidCursorName, fmt.Sprintf("portal %q does not exist", name))
+		}
+
+		if portal.result == nil && isPGRowStatement(portal.Statement) {
+			if err = c.executePortal(portal); err != nil {
+				return err
+			}
+		}
+		if portal.result == nil || portal.result.View == nil {
+			return c.w.WriteMessage(pgwire.NoDataMessage)
+		}
+
+		fields := make([]pgwire.FieldDescription, len(portal.result.Fields))
+		for i := range fields {
+			fields[i] = portal.result.Fields[i]
+			fields[i].Format = pgFormat(portal.Formats, i)
+		}
+		return c.w.WriteRowDescription(fields)
+	}
+	return newPGError(pgProtocolViolation, fmt.Sprintf("invalid DESCRIBE message subtype %d", t))
+}
+
+// describeStatement returns the fields of the result set of the statement.
+// The fields are retrieved by executing the statement with null parameters, and their types are reported as text.
+func (c *pgConn) describeStatement(stmt *pgStatement) []pgwire.FieldDescription {
+	values := make([]parser.ReplaceValue, stmt.ParamCount)
+	for i := range values {
+		values[i] = parser.ReplaceValue{Value: parser.PrimitiveType{Value: value.NewNull()}}
+	}
+
+	ctx, cancel := c.begin()
+	defer cancel()
+
+	results, err := c.executeStatement(ctx, parser.ExecuteStatement{Name: parser.Identifier{Literal: stmt.Name}, Values: values}, nil)
+	if err != nil || len(results) != 1 || results[0].View == nil {
+		return nil
+	}
+
+	fields := pgFieldDescriptions(results[0].View)
+	for i := range fields {
+		fields[i].TypeOID = pgwire.TextOID
+		fields[i].TypeSize = pgwire.TypeSize(pgwire.TextOID)
+	}
+	return fields
+}
+
+func (c *pgConn) executePortal(portal *pgPortal) error {
+	stmt := portal.Statement
+
+	if c.status == pgwire.TransactionStatusFailedBlock && stmt.Control != pgCommit && stmt.Control != pgRollback {
+		return newPGError(pgInFailedSQLTransaction, "current transaction is aborted, commands ignored until end of transaction block")
+	}
+
+	if stmt.Control != pgNoControl {
+		tag, err := c.control(stmt.Control)
+		if err != nil {
+			return err
+		}
+		portal.result = &pgResult{Tag: tag}
+		return nil
+	}
+
+	ctx, cancel := c.begin()
+	defer cancel()
+
+	results, err := c.executeStatement(ctx, parser.ExecuteStatement{Name: parser.Identifier{Literal: stmt.Name}, Values: portal.Values}, stmt.Fields)
+	if err != nil {
+		c.fail()
+		return err
+	}
+	if 1 < len(results) {
+		c.fail()
+		return newPGError(pgFeatureNotSupported, "statement returning multiple result sets cannot be executed by the extended query protocol")
+	}
+	portal.result = results[0]
+	return nil
+}
+
+func (c *pgConn) execute(buf *pgwire.Buffer) error {
+	name, err := buf.String()
+	if err != nil {
+		return err
+	}
+	maxRows, err := buf.Int32()
+	if err != nil {
+		return err
+	}
+
+	portal, ok := c.portals[name]
+	if !ok {
+		return newPGError(pgInvalidCursorName, fmt.Sprintf("portal %q does not exist", name))
+	}
+
+	if portal.Statement.Empty {
+		return c.w.WriteMessage(pgwire.EmptyQueryResponseMessage)
+	}
+
+	if portal.result == nil {
+		if err = c.executePortal(portal); err != nil {
+			return err
+		}
+	}
+
+	result := portal.result
+	if result.View != nil {
+		if portal.pos, err = c.writeRows(result, portal.pos, int(maxRows), portal.Formats); err != nil {
+			c.fail()
+			return err
+		}
+		if portal.pos < result.View.RecordLen() {
+			return c.w.WriteMessage(pgwire.PortalSuspendedMessage)
+		}
+	}
+	return c.w.WriteCommandComplete(result.Tag)
+}
+
+func (c *pgConn) close(buf *pgwire.Buffer) error {
+	t, err := buf.Byte()
+	if err != nil {
+		return err
+	}
+	name, err := buf.String()
+	if err != nil {
+		return err
+	}
+
+	switch t {
+	case 'S':
+		if err = c.closeStatement(name); err != nil {
+			return err
+		}
+	case 'P':
+		delete(c.portals, name)
+	default:
+		return newPGError(pgProtocolViolation, fmt.Sprintf("invalid CLOSE message subtype %d", t))
+	}
+	return c.w.WriteMessage(pgwire.CloseCompleteMessage)
+}
+
+func (c *pgConn) sync() error {
+	if c.status == pgwire.TransactionStatusIdle {
+		c.portals = make(map[string]*pgPortal)
+
+		ctx, cancel := c.begin()
+		err := c.proc.AutoCommit(ctx)
+		cancel()
+		if err != nil {
+			c.fail()
+			c.writeError(err)
+		}
+	}
+
+	_ = c.w.WriteReadyForQuery(c.status)
+	return c.w.Flush()
+}
+
+type pgError struct {
+	code    string
+	message string
+}
+
+func newPGError(code string, message string) error {
+	return &pgError{
+		code:    code,
+		message: message,
+	}
+}
+
+func (e *pgError) Error() string {
+	return e.message
+}
+
+func pgErrorCode(err error) string {
+	switch e := err.(type) {
+	case *pgError:
+		return e.code
+	case *query.SyntaxError, *query.PreparedStatementSyntaxError:
+		return pgSyntaxError
+	case *query.ContextCanceled, *query.ContextDone:
+		return pgQueryCanceled
+	case *query.FieldNotExistError:
+		return pgUndefinedColumn
+	case *query.FieldAmbiguousError:
+		return pgAmbiguousColumn
+	case *query.FileNotExistError, *query.TableNotLoadedError:
+		return pgUndefinedTable
+	case *query.FunctionNotExistError:
+		return pgUndefinedFunction
+	case *query.DuplicateStatementNameError:
+		return pgDuplicatePreparedStatement
+	case *query.StatementNotExistError:
+		return pgInvalidSQLStatementName
+	case *query.StatementReplaceValueNotSpecifiedError:
+		return pgUndefinedParameter
+	case *query.FileLockTimeoutError:
+		return pgLockNotAvailable
+	case *query.UserTriggeredError:
+		return pgRaiseException
+	case *query.OperationNotAllowedError:
+		return pgInsufficientPrivilege
+	case *query.IOError:
+		return pgIOError
+	case *query.SystemError:
+		return pgInternalError
+	}
+	if err == pgwire.ErrInvalidMessage {
+		return pgProtocolViolation
+	}
+	return pgSyntaxErrorOrAccessRuleViolation
+}
+
+func pgControl(queryString string) int {
+	switch {
+	case pgBeginExp.MatchString(queryString):
+		return pgBegin
+	case pgCommitExp.MatchString(queryString):
+		return pgCommit
+	case pgRollbackExp.MatchString(queryString):
+		return pgRollback
+	}
+	return pgNoControl
+}
+
+func isPGRowStatement(stmt *pgStatement) bool {
+	if s, ok := stmt.Statement.(parser.SelectQuery); ok {
+		if e, ok := s.SelectEntity.(parser.SelectEntity); !ok || e.IntoClause == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pgFieldDescriptions returns the fields of the view.
+// The type of a field is the type of the values in the field if they are all the same type, otherwise text.
+func pgFieldDescriptions(view *query.View) []pgwire.FieldDescription {
+	fields := make([]pgwire.FieldDescription, view.FieldLen())
+	for i := range fields {
+		oid := int32(pgwire.UnspecifiedOID)
+		for j := 0; j < view.RecordLen(); j++ {
+			p := view.RecordSet[j][i][0]
+			if value.IsNull(p) {
+				continue
+			}
+			if t, ok := p.(*value.Ternary); ok && t.Ternary() == ternary.UNKNOWN {
+				continue
+			}
+
+			o := pgwire.TypeOID(p)
+			if oid == pgwire.UnspecifiedOID {
+				oid = o
+			} else if oid != o {
+				oid = pgwire.TextOID
+				break
+			}
+		}
+		if oid == pgwire.UnspecifiedOID {
+			oid = pgwire.TextOID
+		}
+
+		fields[i] = pgwire.FieldDescription{
+			Name:         view.Header[i].Column,
+			TypeOID:      oid,
+			TypeSize:     pgwire.TypeSize(oid),
+			TypeModifier: -1,
+		}
+	}
+	return fields
+}
+
+func pgCommandTag(tx *query.Transaction, stmt parser.Statement) string {
+	switch s := stmt.(type) {
+	case parser.SelectQuery:
+		return "SELECT 0"
+	case parser.InsertQuery, parser.ReplaceQuery:
+		return fmt.Sprintf("INSERT 0 %d", tx.AffectedRows)
+	case parser.UpdateQuery:
+		return fmt.Sprintf("UPDATE %d", tx.AffectedRows)
+	case parser.DeleteQuery:
+		return fmt.Sprintf("DELETE %d", tx.AffectedRows)
+	case parser.MergeQuery:
+		return fmt.Sprintf("MERGE %d", tx.AffectedRows)
+	case parser.CreateTable:
+		return "CREATE TABLE"
+	case parser.CreateIndex:
+		return "CREATE INDEX"
+	case parser.DropIndex:
+		return "DROP INDEX"
+	case parser.AddColumns, parser.DropColumns, parser.RenameColumn, parser.SetTableAttribute:
+		return "ALTER TABLE"
+	case parser.TransactionControl:
+		if s.Token == parser.COMMIT {
+			return "COMMIT"
+		}
+		return "ROLLBACK"
+	case parser.Savepoint:
+		return "SAVEPOINT"
+	case parser.RollbackToSavepoint:
+		return "ROLLBACK"
+	case parser.ReleaseSavepoint:
+		return "RELEASE"
+	case parser.StatementPreparation:
+		return "PREPARE"
+	case parser.ExecuteStatement:
+		if prepared, ok := tx.PreparedStatements.Load(s.Name.Literal); ok && 0 < len(prepared.Statements) {
+			return pgCommandTag(tx, prepared.Statements[len(prepared.Statements)-1])
+		}
+		return "EXECUTE"
+	case parser.DisposeStatement:
+		return "DEALLOCATE"
+	case parser.SetFlag, parser.AddFlagElement, parser.RemoveFlagElement, parser.SetEnvVar, parser.UnsetEnvVar:
+		return "SET"
+	case parser.VariableDeclaration, parser.CursorDeclaration, parser.ViewDeclaration, parser.FunctionDeclaration, parser.AggregateDeclaration:
+		return "DECLARE"
+	}
+	return "OK"
+}
+
+func pgTimeZone() string {
+	loc := cmd.GetLocation()
+	if loc == time.Local {
+		name, _ := time.Now().In(loc).Zone()
+		return name
+	}
+	return loc.String()
+}
+
+func readFormats(buf *pgwire.Buffer) ([]int16, error) {
+	n, err := buf.Int16()
+	if err != nil {
+		return nil, err
+	}
+	formats := make([]int16, n)
+	for i := range formats {
+		if formats[i], err = buf.Int16(); err != nil {
+			return nil, err
+		}
+	}
+	return formats, nil
+}
+
+// pgFormat returns the format of the i-th column.
+// No format means text, and a single format is applied to all columns.
+func pgFormat(formats []int16, i int) int16 {
+	switch {
+	case len(formats) < 1:
+		return pgwire.TextFormat
+	case len(formats) == 1:
+		return formats[0]
+	case i < len(formats):
+		return formats[i]
+	}
+	return pgwire.TextFormat
+}
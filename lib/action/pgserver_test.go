@@ -0,0 +1,630 @@
+package action
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/pgwire"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type pgTestClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+	w    *pgwire.Writer
+}
+
+func connectPGTestServer(addr string) (*pgTestClient, error) {
+	return connectPGTestServerWithPassword(addr, "")
+}
+
+func connectPGTestServerWithPassword(addr string, password string) (*pgTestClient, error) {
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	c := &pgTestClient{
+		conn: conn,
+		r:    bufio.NewReader(conn),
+		w:    pgwire.NewWriter(conn),
+	}
+
+	b := []byte{0, 0, 0, 0}
+	b = append(b, 0, 3, 0, 0)
+	b = append(b, "user\x00csvq\x00\x00"...)
+	b[3] = byte(len(b))
+	if err = c.w.WriteRaw(b); err != nil {
+		return nil, err
+	}
+
+	if 0 < len(password) {
+		t, body, err := pgwire.ReadMessage(c.r)
+		if err != nil {
+			return nil, err
+		}
+		buf := pgwire.NewBuffer(body)
+		if i, _ := buf.Int32(); t != pgwire.AuthenticationMessage || i != 5 {
+			return nil, fmt.Errorf("unexpected authentication request %c %q", t, body)
+		}
+		var salt [4]byte
+		b, _ := buf.Bytes(4)
+		copy(salt[:], b)
+
+		c.w.Begin(pgwire.PasswordMessage)
+		c.w.WriteString(pgwire.MD5Password("csvq", password, salt))
+		_ = c.w.End()
+		if err = c.w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+
+	msgs, err := c.receive()
+	if err != nil {
+		if 0 < len(msgs) {
+			return nil, fmt.Errorf("unexpected startup response %v", msgs)
+		}
+		return nil, err
+	}
+	if msgs[0] != "R 0" || msgs[len(msgs)-1] != "Z I" {
+		return nil, fmt.Errorf("unexpected startup response %v", msgs)
+	}
+	return c, nil
+}
+
+func (c *pgTestClient) close() {
+	c.w.Begin(pgwire.TerminateMessage)
+	_ = c.w.End()
+	_ = c.w.Flush()
+	_ = c.conn.Close()
+}
+
+// receive reads messages until ReadyForQuery and returns their summaries.
+func (c *pgTestClient) receive() ([]string, error) {
+	var msgs []string
+	for {
+		t, body, err := pgwire.ReadMessage(c.r)
+		if err != nil {
+			return msgs, err
+		}
+		buf := pgwire.NewBuffer(body)
+
+		var s string
+		switch t {
+		case pgwire.AuthenticationMessage:
+			i, _ := buf.Int32()
+			s = fmt.Sprintf("R %d", i)
+		case pgwire.ParameterStatusMessage, pgwire.BackendKeyDataMessage:
+			continue
+		case pgwire.RowDescriptionMessage:
+			n, _ := buf.Int16()
+			fields := make([]string, n)
+			for i := range fields {
+				name, _ := buf.String()
+				_, _ = buf.Bytes(6)
+				oid, _ := buf.Int32()
+				_, _ = buf.Bytes(6)
+				format, _ := buf.Int16()
+				fields[i] = fmt.Sprintf("%s:%d", name, oid)
+				if format == pgwire.BinaryFormat {
+					fields[i] += ":b"
+				}
+			}
+			s = "T " + strings.Join(fields, ",")
+		case pgwire.DataRowMessage:
+			n, _ := buf.Int16()
+			values := make([]string, n)
+			for i := range values {
+				length, _ := buf.Int32()
+				if length < 0 {
+					values[i] = "NULL"
+					continue
+				}
+				b, _ := buf.Bytes(int(length))
+				values[i] = string(b)
+			}
+			s = "D " + strings.Join(values, "|")
+		case pgwire.ParameterDescriptionMessage:
+			n, _ := buf.Int16()
+			oids := make([]string, n)
+			for i := range oids {
+				oid, _ := buf.Int32()
+				oids[i] = fmt.Sprint(oid)
+			}
+			s = "t " + strings.Join(oids, ",")
+		case pgwire.CommandCompleteMessage:
+			tag, _ := buf.String()
+			s = "C " + tag
+		case pgwire.ErrorResponseMessage:
+			var code string
+			for {
+				f, _ := buf.Byte()
+				if f == 0 {
+					break
+				}
+				v, _ := buf.String()
+				if f == 'C' {
+					code = v
+				}
+			}
+			s = "E " + code
+		case pgwire.ReadyForQueryMessage:
+			status, _ := buf.Byte()
+			s = "Z " + string(status)
+		default:
+			s = string(t)
+		}
+
+		msgs = append(msgs, s)
+		if t == pgwire.ReadyForQueryMessage {
+			return msgs, nil
+		}
+	}
+}
+
+func (c *pgTestClient) query(q string) {
+	c.w.Begin(pgwire.QueryMessage)
+	c.w.WriteString(q)
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) parse(name string, q string, oids ...int32) {
+	c.w.Begin(pgwire.ParseMessage)
+	c.w.WriteString(name)
+	c.w.WriteString(q)
+	c.w.WriteInt16(int16(len(oids)))
+	for _, oid := range oids {
+		c.w.WriteInt32(oid)
+	}
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) bind(portal string, statement string, resultFormat int16, params ...interface{}) {
+	c.w.Begin(pgwire.BindMessage)
+	c.w.WriteString(portal)
+	c.w.WriteString(statement)
+	c.w.WriteInt16(0)
+	c.w.WriteInt16(int16(len(params)))
+	for _, p := range params {
+		if p == nil {
+			c.w.WriteInt32(-1)
+			continue
+		}
+		s := p.(string)
+		c.w.WriteInt32(int32(len(s)))
+		c.w.WriteBytes([]byte(s))
+	}
+	c.w.WriteInt16(1)
+	c.w.WriteInt16(resultFormat)
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) describe(t byte, name string) {
+	c.w.Begin(pgwire.DescribeMessage)
+	_ = c.w.WriteByte(t)
+	c.w.WriteString(name)
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) execute(portal string, maxRows int32) {
+	c.w.Begin(pgwire.ExecuteMessage)
+	c.w.WriteString(portal)
+	c.w.WriteInt32(maxRows)
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) closeStatement(name string) {
+	c.w.Begin(pgwire.CloseMessage)
+	_ = c.w.WriteByte('S')
+	c.w.WriteString(name)
+	_ = c.w.End()
+}
+
+func (c *pgTestClient) sync() {
+	c.w.Begin(pgwire.SyncMessage)
+	_ = c.w.End()
+}
+
+type pgServerTestStep struct {
+	Send   func(c *pgTestClient)
+	Expect []string
+}
+
+var pgServerTests = []struct {
+	Name  string
+	Steps []pgServerTestStep
+}{
+	{
+		Name: "Simple Query",
+		Steps: []pgServerTestStep{
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select * from table1 where column1 < 3")
+				},
+				Expect: []string{"T column1:25,column2:25", "D 1|str1", "D 2|str2", "C SELECT 2", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select 1 as a, 1.5 as b, true as c, null as d, datetime('2012-02-03T09:18:15.123+09:00') as e;")
+				},
+				Expect: []string{"T a:20,b:701,c:16,d:25,e:1184", "D 1|1.5|t|NULL|2012-02-03 09:18:15.123+09:00", "C SELECT 1", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("var @a := 1; select @a + 1 as b; select @a + 2 as c;")
+				},
+				Expect: []string{"C DECLARE", "T b:20", "D 2", "C SELECT 1", "T c:20", "D 3", "C SELECT 1", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query(" ")
+				},
+				Expect: []string{"I", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select from")
+				},
+				Expect: []string{"E 42601", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("$ echo foo")
+				},
+				Expect: []string{"E 42501", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("source 'statements.sql'")
+				},
+				Expect: []string{"E 42501", "Z I"},
+			},
+		},
+	},
+	{
+		Name: "Transaction Block",
+		Steps: []pgServerTestStep{
+			{
+				Send: func(c *pgTestClient) {
+					c.query("begin")
+				},
+				Expect: []string{"C BEGIN", "Z T"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select column2 from table1 where column1 = 1")
+				},
+				Expect: []string{"T column2:25", "D str1", "C SELECT 1", "Z T"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select * from notexist")
+				},
+				Expect: []string{"E 42P01", "Z E"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("select 1")
+				},
+				Expect: []string{"E 25P02", "Z E"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("commit")
+				},
+				Expect: []string{"C ROLLBACK", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("start transaction; ")
+				},
+				Expect: []string{"C BEGIN", "Z T"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("COMMIT")
+				},
+				Expect: []string{"C COMMIT", "Z I"},
+			},
+		},
+	},
+	{
+		Name: "Extended Query",
+		Steps: []pgServerTestStep{
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "select column2 from table1 where column1 = $1", pgwire.Int4OID)
+					c.bind("", "", pgwire.TextFormat, "2")
+					c.describe('P', "")
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"1", "2", "T column2:25", "D str2", "C SELECT 1", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("s1", "select $1 || $2 as s, $1 as t")
+					c.describe('S', "s1")
+					c.sync()
+				},
+				Expect: []string{"1", "t 25,25", "T s:25,t:25", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.bind("", "s1", pgwire.TextFormat, "a", "b")
+					c.execute("", 0)
+					c.bind("", "s1", pgwire.TextFormat, "c", nil)
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"2", "D ab|a", "C SELECT 1", "2", "D NULL|c", "C SELECT 1", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.query("execute s1 using 'x', 'y'")
+				},
+				Expect: []string{"T s:25,t:25", "D xy|x", "C SELECT 1", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("s1", "select 1")
+					c.bind("", "s1", pgwire.TextFormat)
+					c.sync()
+				},
+				Expect: []string{"E 42P05", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.bind("", "s1", pgwire.TextFormat, "a")
+					c.sync()
+				},
+				Expect: []string{"E 08P01", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.closeStatement("s1")
+					c.bind("", "s1", pgwire.TextFormat, "a", "b")
+					c.sync()
+				},
+				Expect: []string{"3", "E 26000", "Z I"},
+			},
+		},
+	},
+	{
+		Name: "Extended Query with Row Limit and Binary Format",
+		Steps: []pgServerTestStep{
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "select column1 from table1")
+					c.bind("p", "", pgwire.TextFormat)
+					c.execute("p", 2)
+					c.execute("p", 2)
+					c.sync()
+				},
+				Expect: []string{"1", "2", "D 1", "D 2", "s", "D 3", "C SELECT 3", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "select true as a, 'x' as b")
+					c.bind("", "", pgwire.BinaryFormat)
+					c.describe('P', "")
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"1", "2", "T a:16:b,b:25:b", "D \x01|x", "C SELECT 1", "Z I"},
+			},
+		},
+	},
+	{
+		Name: "Extended Query Errors",
+		Steps: []pgServerTestStep{
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "select 1; select 2")
+					c.bind("", "", pgwire.TextFormat)
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"E 42601", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "select * from notexist")
+					c.bind("", "", pgwire.TextFormat)
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"1", "2", "E 42P01", "Z I"},
+			},
+			{
+				Send: func(c *pgTestClient) {
+					c.parse("", "")
+					c.bind("", "", pgwire.TextFormat)
+					c.execute("", 0)
+					c.sync()
+				},
+				Expect: []string{"1", "2", "I", "Z I"},
+			},
+		},
+	},
+}
+
+func startPGTestServer(t *testing.T, repository string, password string) (string, func()) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	tx.Session.SetStderr(query.NewDiscard())
+	tx.Flags.Repository = repository
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	server := newPGServer(context.Background(), tx, password)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return listener.Addr().String(), func() {
+		_ = listener.Close()
+		server.Close()
+	}
+}
+
+func TestPGServer(t *testing.T) {
+	addr, shutdown := startPGTestServer(t, TestDataDir, "")
+	defer shutdown()
+
+	for _, v := range pgServerTests {
+		c, err := connectPGTestServer(addr)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		for i, step := range v.Steps {
+			step.Send(c)
+			_ = c.w.Flush()
+
+			msgs, err := c.receive()
+			if err != nil {
+				t.Errorf("%s: step %d: unexpected error %q", v.Name, i, err)
+				break
+			}
+			if !reflect.DeepEqual(msgs, step.Expect) {
+				t.Errorf("%s: step %d: messages = %q, want %q", v.Name, i, msgs, step.Expect)
+			}
+		}
+
+		c.close()
+	}
+}
+
+func TestPGServer_Transaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvq_pgserver")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	addr, shutdown := startPGTestServer(t, dir, "")
+	defer shutdown()
+
+	c, err := connectPGTestServer(addr)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer c.close()
+
+	steps := []pgServerTestStep{
+		{
+			Send: func(c *pgTestClient) {
+				c.query("begin")
+			},
+			Expect: []string{"C BEGIN", "Z T"},
+		},
+		{
+			Send: func(c *pgTestClient) {
+				c.query("create table `t1.csv` (a, b); insert into `t1.csv` values (1, 'x'), (2, 'y');")
+			},
+			Expect: []string{"C CREATE TABLE", "C INSERT 0 2", "Z T"},
+		},
+		{
+			Send: func(c *pgTestClient) {
+				c.parse("", "update `t1.csv` set b = $1 where a = $2")
+				c.bind("", "", pgwire.TextFormat, "z", "2")
+				c.execute("", 0)
+				c.sync()
+			},
+			Expect: []string{"1", "2", "C UPDATE 1", "Z T"},
+		},
+		{
+			Send: func(c *pgTestClient) {
+				c.query("select b from `t1.csv`")
+			},
+			Expect: []string{"T b:25", "D x", "D z", "C SELECT 2", "Z T"},
+		},
+		{
+			Send: func(c *pgTestClient) {
+				c.query("rollback")
+			},
+			Expect: []string{"C ROLLBACK", "Z I"},
+		},
+		{
+			Send: func(c *pgTestClient) {
+				c.query("create table `t2.csv` (a); insert into `t2.csv` values (1);")
+			},
+			Expect: []string{"C CREATE TABLE", "C INSERT 0 1", "Z I"},
+		},
+	}
+
+	for i, step := range steps {
+		step.Send(c)
+		_ = c.w.Flush()
+
+		msgs, err := c.receive()
+		if err != nil {
+			t.Fatalf("step %d: unexpected error %q", i, err)
+		}
+		if !reflect.DeepEqual(msgs, step.Expect) {
+			t.Errorf("step %d: messages = %q, want %q", i, msgs, step.Expect)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "t1.csv")); !os.IsNotExist(err) {
+		t.Errorf("t1.csv is created, want to be rolled back")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "t2.csv")); err != nil {
+		t.Errorf("t2.csv is not created, want to be committed")
+	}
+}
+
+func TestPGServer_Authentication(t *testing.T) {
+	addr, shutdown := startPGTestServer(t, TestDataDir, "secret")
+	defer shutdown()
+
+	c, err := connectPGTestServerWithPassword(addr, "secret")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	c.query("select 1 as a")
+	_ = c.w.Flush()
+	msgs, err := c.receive()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []string{"T a:20", "D 1", "C SELECT 1", "Z I"}
+	if !reflect.DeepEqual(msgs, expect) {
+		t.Errorf("messages = %q, want %q", msgs, expect)
+	}
+	c.close()
+
+	expectErr := "unexpected startup response [E 28P01]"
+	if _, err = connectPGTestServerWithPassword(addr, "wrong"); err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestServePG(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	tx.Session.SetStderr(query.NewDiscard())
+
+	err := ServePG(context.Background(), query.NewProcessor(tx), "0.0.0.0:0", "")
+	if _, ok := err.(*query.IncorrectCommandUsageError); !ok {
+		t.Errorf("error = %#v, want IncorrectCommandUsageError", err)
+	}
+}
@@ -42,7 +42,18 @@ func Run(ctx context.Context, proc *query.Processor, input string, sourceFile st
 		if err != nil {
 			return query.NewIOError(nil, err.Error())
 		}
+		compression, _ := cmd.CompressionOfPath(outfile)
+		w, err := query.NewCompressionWriter(fp, compression)
+		if err != nil {
+			return query.NewIOError(nil, err.Error())
+		}
+		out := &countingWriter{w: w}
 		defer func() {
+			if 0 < out.n {
+				if err = w.Close(); err != nil {
+					proc.LogError(err.Error())
+				}
+			}
 			if info, err := fp.Stat(); err == nil && info.Size() < 1 {
 				if err = os.Remove(outfile); err != nil {
 					proc.LogError(err.Error())
@@ -52,7 +63,7 @@ func Run(ctx context.Context, proc *query.Processor, input string, sourceFile st
 				proc.LogError(err.Error())
 			}
 		}()
-		proc.Tx.Session.SetOutFile(fp)
+		proc.Tx.Session.SetOutFile(out)
 	}
 
 	proc.Tx.AutoCommit = true
@@ -220,3 +231,14 @@ func showStats(ctx context.Context, proc *query.Processor, start time.Time) {
 
 	proc.Log("\n"+w.String(), false)
 }
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,269 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+const DefaultServerListen = "127.0.0.1:8080"
+
+const DefaultServerTimeout = 30 * time.Second
+
+const serverShutdownTimeout = 5 * time.Second
+
+const serverMaxRequestSize = 1 << 20
+
+var serverContentTypes = map[cmd.Format]string{
+	cmd.CSV:     "text/csv",
+	cmd.TSV:     "text/tab-separated-values",
+	cmd.JSON:    "application/json",
+	cmd.JSONL:   "application/x-ndjson",
+	cmd.XLSX:    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	cmd.PARQUET: "application/vnd.apache.parquet",
+}
+
+type serverRequest struct {
+	Query  string `json:"query"`
+	Format string `json:"format"`
+}
+
+type serverError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve listens on the address and executes queries posted over HTTP until the context is done.
+// Statements that write files are refused unless allowWrite is true.
+func Serve(ctx context.Context, proc *query.Processor, listen string, timeout time.Duration, allowWrite bool) error {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return query.NewIOError(nil, err.Error())
+	}
+
+	server := &http.Server{
+		Handler: NewQueryHandler(proc.Tx, timeout, allowWrite),
+	}
+
+	ch := make(chan error, 1)
+	go func() {
+		ch <- server.Serve(listener)
+	}()
+
+	proc.Log(fmt.Sprintf("csvq server is listening on %s", listener.Addr().String()), proc.Tx.Flags.Quiet)
+
+	select {
+	case err = <-ch:
+		return query.NewIOError(nil, err.Error())
+	case <-ctx.Done():
+		sctx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+		if err = server.Shutdown(sctx); err != nil {
+			return query.NewSystemError(err.Error())
+		}
+		return query.ConvertContextError(ctx.Err())
+	}
+}
+
+type queryHandler struct {
+	tx         *query.Transaction
+	timeout    time.Duration
+	allowWrite bool
+}
+
+// NewQueryHandler returns a handler that executes a query posted in the request body.
+// Each request is executed in its own sandboxed session and transaction derived from tx.
+// Unless allowWrite is true, the transactions are read-only and share the views loaded from files.
+func NewQueryHandler(tx *query.Transaction, timeout time.Duration, allowWrite bool) http.Handler {
+	return &queryHandler{
+		tx:         tx,
+		timeout:    timeout,
+		allowWrite: allowWrite,
+	}
+}
+
+func (h *queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeServerError(w, http.StatusMethodNotAllowed, query.NewIncorrectCommandUsageError(fmt.Sprintf("method %s is not allowed", r.Method)))
+		return
+	}
+
+	req, status, err := readServerRequest(w, r)
+	if err != nil {
+		writeServerError(w, status, err)
+		return
+	}
+
+	ctx := r.Context()
+	if 0 < h.timeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+
+	body, format, err := h.execute(ctx, req)
+	if err != nil {
+		writeServerError(w, serverErrorStatus(err), err)
+		return
+	}
+
+	if body == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	contentType, ok := serverContentTypes[format]
+	if !ok {
+		contentType = "text/plain"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(body); err != nil {
+		h.tx.LogError(err.Error())
+	}
+}
+
+func (h *queryHandler) execute(ctx context.Context, req serverRequest) (body []byte, format cmd.Format, err error) {
+	session := query.NewSession()
+	_ = session.SetStdin(nil)
+	session.SetStdout(query.NewDiscard())
+	session.SetStderr(query.NewDiscard())
+
+	var tx *query.Transaction
+	if h.allowWrite {
+		if tx, err = h.tx.NewSessionTransaction(session); err == nil {
+			tx.Sandboxed = true
+		}
+	} else {
+		tx, err = h.tx.NewReadOnlySessionTransaction(session)
+	}
+	if err != nil {
+		return nil, format, err
+	}
+
+	proc := query.NewProcessor(tx)
+	defer func() {
+		if e := proc.AutoRollback(); e != nil {
+			h.tx.LogError(e.Error())
+		}
+		if e := proc.ReleaseResourcesWithErrors(); e != nil {
+			h.tx.LogError(e.Error())
+		}
+	}()
+
+	if 0 < len(req.Format) {
+		if err = tx.SetFormatFlag(req.Format, ""); err != nil {
+			return nil, format, query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	format = tx.Flags.Format
+
+	statements, _, err := parser.Parse(req.Query, "", tx.Flags.DatetimeFormat, false, tx.Flags.AnsiQuotes)
+	if err != nil {
+		return nil, format, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	tx.AutoCommit = true
+	if _, err = proc.Execute(query.ContextForStoringResults(ctx), statements); err != nil {
+		if ex, ok := err.(*query.ForcedExit); !ok || ex.Code() != 0 {
+			return nil, format, err
+		}
+	}
+
+	if len(tx.SelectedViews) < 1 {
+		return nil, format, nil
+	}
+
+	fileInfo := &query.FileInfo{
+		Format:             tx.Flags.Format,
+		Delimiter:          tx.Flags.WriteDelimiter,
+		DelimiterPositions: tx.Flags.WriteDelimiterPositions,
+		Encoding:           tx.Flags.WriteEncoding,
+		LineBreak:          tx.Flags.LineBreak,
+		NoHeader:           tx.Flags.WithoutHeader,
+		EncloseAll:         tx.Flags.EncloseAll,
+		JsonEscape:         tx.Flags.JsonEscape,
+		PrettyPrint:        tx.Flags.PrettyPrint,
+		SingleLine:         tx.Flags.WriteAsSingleLine,
+	}
+
+	buf := &bytes.Buffer{}
+	for _, view := range tx.SelectedViews {
+		if _, e := query.EncodeView(ctx, buf, view, fileInfo, tx); e != nil {
+			if _, ok := e.(*query.EmptyResultSetError); !ok {
+				return nil, format, e
+			}
+			continue
+		}
+		if format != cmd.XLSX && format != cmd.PARQUET {
+			buf.WriteString(tx.Flags.LineBreak.Value())
+		}
+	}
+	return buf.Bytes(), format, nil
+}
+
+func readServerRequest(w http.ResponseWriter, r *http.Request) (serverRequest, int, error) {
+	req := serverRequest{
+		Format: r.URL.Query().Get("format"),
+	}
+
+	tooLargeErr := query.NewIncorrectCommandUsageError(fmt.Sprintf("request body exceeds %d bytes", serverMaxRequestSize))
+	if serverMaxRequestSize < r.ContentLength {
+		return req, http.StatusRequestEntityTooLarge, tooLargeErr
+	}
+
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, serverMaxRequestSize))
+	if err != nil {
+		if serverMaxRequestSize <= len(b) {
+			return req, http.StatusRequestEntityTooLarge, tooLargeErr
+		}
+		return req, http.StatusBadRequest, query.NewIOError(nil, err.Error())
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+		if err = json.Unmarshal(b, &req); err != nil {
+			return req, http.StatusBadRequest, query.NewIncorrectCommandUsageError(fmt.Sprintf("invalid request body: %s", err.Error()))
+		}
+	} else {
+		req.Query = string(b)
+	}
+
+	if len(strings.TrimSpace(req.Query)) < 1 {
+		return req, http.StatusBadRequest, query.NewIncorrectCommandUsageError("query is empty")
+	}
+	return req, http.StatusOK, nil
+}
+
+func serverErrorStatus(err error) int {
+	switch err.(type) {
+	case *query.ContextDone, *query.ContextCanceled:
+		return http.StatusServiceUnavailable
+	case *query.SystemError:
+		return http.StatusInternalServerError
+	}
+	return http.StatusBadRequest
+}
+
+func writeServerError(w http.ResponseWriter, status int, err error) {
+	code := query.ReturnCodeApplicationError
+	if apperr, ok := err.(query.Error); ok {
+		code = apperr.Code()
+	}
+
+	b, _ := json.Marshal(serverError{Code: code, Message: err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(b, '\n'))
+}
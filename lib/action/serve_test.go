@@ -0,0 +1,251 @@
+package action
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var queryHandlerTests = []struct {
+	Name        string
+	Method      string
+	URL         string
+	ContentType string
+	Body        string
+	Status      int
+	Type        string
+	Response    string
+}{
+	{
+		Name:   "Select Query in JSON",
+		Method: http.MethodPost,
+		URL:    "/?format=json",
+		Body:   "select * from table1 where column1 < 3",
+		Status: http.StatusOK,
+		Type:   "application/json",
+		Response: "[\n" +
+			"  {\n" +
+			"    \"column1\": \"1\",\n" +
+			"    \"column2\": \"str1\"\n" +
+			"  },\n" +
+			"  {\n" +
+			"    \"column1\": \"2\",\n" +
+			"    \"column2\": \"str2\"\n" +
+			"  }\n" +
+			"]\n",
+	},
+	{
+		Name:        "Select Query in JSON Request",
+		Method:      http.MethodPost,
+		URL:         "/",
+		ContentType: "application/json; charset=utf-8",
+		Body:        "{\"query\": \"select column2 from table1 where column1 = 3\", \"format\": \"csv\"}",
+		Status:      http.StatusOK,
+		Type:        "text/csv",
+		Response: "column2\n" +
+			"str3\n",
+	},
+	{
+		Name:   "Multiple Select Queries",
+		Method: http.MethodPost,
+		URL:    "/?format=csv",
+		Body:   "var @a := 1; select @a as a; select @a + 1 as b;",
+		Status: http.StatusOK,
+		Type:   "text/csv",
+		Response: "a\n" +
+			"1\n" +
+			"b\n" +
+			"2\n",
+	},
+	{
+		Name:   "No Result Set",
+		Method: http.MethodPost,
+		URL:    "/",
+		Body:   "var @a := 1;",
+		Status: http.StatusNoContent,
+	},
+	{
+		Name:     "Method Not Allowed",
+		Method:   http.MethodGet,
+		URL:      "/",
+		Status:   http.StatusMethodNotAllowed,
+		Type:     "application/json",
+		Response: "{\"code\":2,\"message\":\"incorrect usage: method GET is not allowed\"}\n",
+	},
+	{
+		Name:     "Empty Query",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     " ",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":2,\"message\":\"incorrect usage: query is empty\"}\n",
+	},
+	{
+		Name:        "Invalid JSON Request",
+		Method:      http.MethodPost,
+		URL:         "/",
+		ContentType: "application/json",
+		Body:        "{\"query\": ",
+		Status:      http.StatusBadRequest,
+		Type:        "application/json",
+		Response:    "{\"code\":2,\"message\":\"incorrect usage: invalid request body: unexpected end of JSON input\"}\n",
+	},
+	{
+		Name:     "Invalid Format",
+		Method:   http.MethodPost,
+		URL:      "/?format=foo",
+		Body:     "select 1",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":2,\"message\":\"incorrect usage: format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|GFM|ORG|TEXT\"}\n",
+	},
+	{
+		Name:     "Syntax Error",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "select from",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":4,\"message\":\"[L:1 C:8] syntax error: unexpected token \\\"from\\\"\"}\n",
+	},
+	{
+		Name:     "Query Execution Error",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "select * from notexist",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":16,\"message\":\"[L:1 C:15] file notexist does not exist\"}\n",
+	},
+	{
+		Name:     "External Command Not Allowed",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "$ echo foo",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":1,\"message\":\"[L:1 C:1] external command is not allowed in this session\"}\n",
+	},
+	{
+		Name:     "Call Function Not Allowed",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "select call('echo', 'foo')",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":1,\"message\":\"[L:1 C:8] CALL function is not allowed in this session\"}\n",
+	},
+	{
+		Name:     "Insert Query Not Allowed",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "insert into table1 values (4, 'str4')",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":1,\"message\":\"[L:1 C:13] updating table1 is not allowed in this session\"}\n",
+	},
+	{
+		Name:     "Create Table Not Allowed",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "create table newtable (column1)",
+		Status:   http.StatusBadRequest,
+		Type:     "application/json",
+		Response: "{\"code\":1,\"message\":\"[L:1 C:14] CREATE TABLE is not allowed in this session\"}\n",
+	},
+	{
+		Name:     "Request Body Too Large",
+		Method:   http.MethodPost,
+		URL:      "/",
+		Body:     "select 1" + strings.Repeat(" ", serverMaxRequestSize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Type:     "application/json",
+		Response: "{\"code\":2,\"message\":\"incorrect usage: request body exceeds 1048576 bytes\"}\n",
+	},
+}
+
+func TestQueryHandler_ServeHTTP(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	tx.Session.SetStderr(query.NewDiscard())
+	tx.Flags.Repository = TestDataDir
+	tx.Flags.PrettyPrint = true
+	handler := NewQueryHandler(tx, time.Minute, false)
+
+	for _, v := range queryHandlerTests {
+		r := httptest.NewRequest(v.Method, v.URL, strings.NewReader(v.Body))
+		if 0 < len(v.ContentType) {
+			r.Header.Set("Content-Type", v.ContentType)
+		}
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		if w.Code != v.Status {
+			t.Errorf("%s: status = %d, want %d (body %q)", v.Name, w.Code, v.Status, w.Body.String())
+			continue
+		}
+		if ct := w.Header().Get("Content-Type"); 0 < len(v.Type) && ct != v.Type {
+			t.Errorf("%s: content type = %q, want %q", v.Name, ct, v.Type)
+		}
+		if w.Body.String() != v.Response {
+			t.Errorf("%s: response = %q, want %q", v.Name, w.Body.String(), v.Response)
+		}
+	}
+
+	if tx.Flags.Format != cmd.TEXT {
+		t.Errorf("format = %s, want %s for the server transaction", tx.Flags.Format, cmd.TEXT)
+	}
+}
+
+func TestQueryHandler_ServeHTTPWithSharedViews(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	tx.Session.SetStderr(query.NewDiscard())
+	tx.Flags.Repository = TestDir
+	defer func() {
+		_ = tx.ReleaseResources()
+	}()
+
+	fpath := GetTestFilePath("shared_views.csv")
+	if err := ioutil.WriteFile(fpath, []byte("c1\n1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	serve := func(handler http.Handler, body string) string {
+		r := httptest.NewRequest(http.MethodPost, "/?format=csv", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Body.String()
+	}
+
+	readOnly := NewQueryHandler(tx, time.Minute, false)
+	if s := serve(readOnly, "select * from shared_views"); s != "c1\n1\n" {
+		t.Fatalf("response = %q, want %q", s, "c1\n1\n")
+	}
+
+	if err := ioutil.WriteFile(fpath, []byte("c1\n1\n2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if s := serve(readOnly, "select * from shared_views"); s != "c1\n1\n2\n" {
+		t.Errorf("response = %q, want %q after the file is modified", s, "c1\n1\n2\n")
+	}
+
+	writable := NewQueryHandler(tx, time.Minute, true)
+	_ = serve(writable, "insert into shared_views values (3)")
+	if s := serve(readOnly, "select * from shared_views"); s != "c1\n1\n2\n3\n" {
+		t.Errorf("response = %q, want %q after the file is updated", s, "c1\n1\n2\n3\n")
+	}
+
+	expect := "{\"code\":1,\"message\":\"[L:1 C:1] external command is not allowed in this session\"}\n"
+	if s := serve(writable, "$ echo foo"); s != expect {
+		t.Errorf("response = %q, want %q", s, expect)
+	}
+}
@@ -0,0 +1,40 @@
+package bzip2
+
+import (
+	"bufio"
+	"io"
+)
+
+type bitWriter struct {
+	w     *bufio.Writer
+	bits  uint64
+	nbits uint
+	err   error
+}
+
+func newBitWriter(w io.Writer) *bitWriter {
+	return &bitWriter{
+		w: bufio.NewWriter(w),
+	}
+}
+
+func (bw *bitWriter) writeBits(bits uint64, n uint) {
+	bw.bits = bw.bits<<n | bits&(1<<n-1)
+	bw.nbits += n
+	for 8 <= bw.nbits {
+		bw.nbits -= 8
+		if bw.err == nil {
+			bw.err = bw.w.WriteByte(byte(bw.bits >> bw.nbits))
+		}
+	}
+}
+
+func (bw *bitWriter) flush() error {
+	if 0 < bw.nbits {
+		bw.writeBits(0, 8-bw.nbits)
+	}
+	if bw.err == nil {
+		bw.err = bw.w.Flush()
+	}
+	return bw.err
+}
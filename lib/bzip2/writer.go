@@ -0,0 +1,467 @@
+// Package bzip2 implements a bzip2 compressor.
+//
+// The standard library provides only a decompressor for the bzip2 format,
+// so this package complements compress/bzip2 for writing files.
+package bzip2
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	blockSizeLevel = 9
+	maxBlockLen    = blockSizeLevel*100000 - 19
+
+	maxRunLen = 255
+
+	maxCodeLen = 17
+	groupSize  = 50
+	numTables  = 2
+
+	runA = 0
+	runB = 1
+)
+
+var (
+	blockMagic = []uint64{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+	endMagic   = []uint64{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+)
+
+var errWriterClosed = errors.New("bzip2: writer is closed")
+
+// Writer compresses data written to it and writes the bzip2 stream to the underlying writer.
+type Writer struct {
+	bw *bitWriter
+
+	block    []byte
+	blockCRC uint32
+	crc      uint32
+
+	runByte byte
+	runLen  int
+
+	wroteHeader bool
+	closed      bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		bw:       newBitWriter(w),
+		block:    make([]byte, 0, maxBlockLen),
+		blockCRC: 0xffffffff,
+	}
+}
+
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.closed {
+		return 0, errWriterClosed
+	}
+
+	for _, b := range p {
+		if 0 < z.runLen && (b != z.runByte || z.runLen == maxRunLen) {
+			if err := z.flushRun(); err != nil {
+				return 0, err
+			}
+		}
+		z.runByte = b
+		z.runLen++
+	}
+	return len(p), nil
+}
+
+func (z *Writer) Close() error {
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+
+	if 0 < z.runLen {
+		if err := z.flushRun(); err != nil {
+			return err
+		}
+	}
+	if 0 < len(z.block) {
+		if err := z.writeBlock(); err != nil {
+			return err
+		}
+	}
+
+	z.writeHeader()
+	for _, b := range endMagic {
+		z.bw.writeBits(b, 8)
+	}
+	z.bw.writeBits(uint64(z.crc), 32)
+	return z.bw.flush()
+}
+
+func (z *Writer) writeHeader() {
+	if !z.wroteHeader {
+		z.bw.writeBits(uint64('B'), 8)
+		z.bw.writeBits(uint64('Z'), 8)
+		z.bw.writeBits(uint64('h'), 8)
+		z.bw.writeBits(uint64('0'+blockSizeLevel), 8)
+		z.wroteHeader = true
+	}
+}
+
+// flushRun appends the current run of identical bytes to the block with the initial run-length encoding.
+func (z *Writer) flushRun() error {
+	encodedLen := z.runLen
+	if 4 <= z.runLen {
+		encodedLen = 5
+	}
+	if maxBlockLen < len(z.block)+encodedLen {
+		if err := z.writeBlock(); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < z.runLen; i++ {
+		z.blockCRC = updateCRC(z.blockCRC, z.runByte)
+	}
+
+	if z.runLen < 4 {
+		for i := 0; i < z.runLen; i++ {
+			z.block = append(z.block, z.runByte)
+		}
+	} else {
+		z.block = append(z.block, z.runByte, z.runByte, z.runByte, z.runByte, byte(z.runLen-4))
+	}
+	z.runLen = 0
+	return nil
+}
+
+func (z *Writer) writeBlock() error {
+	z.writeHeader()
+
+	blockCRC := ^z.blockCRC
+	z.crc = (z.crc<<1 | z.crc>>31) ^ blockCRC
+
+	bwt, origPtr := transform(z.block)
+
+	var inUse [256]bool
+	for _, b := range z.block {
+		inUse[b] = true
+	}
+	var seqToUnseq [256]byte
+	var unseqToSeq [256]byte
+	numInUse := 0
+	for i := 0; i < 256; i++ {
+		if inUse[i] {
+			seqToUnseq[numInUse] = byte(i)
+			unseqToSeq[i] = byte(numInUse)
+			numInUse++
+		}
+	}
+	alphaSize := numInUse + 2
+
+	symbols := encodeMTF(bwt, unseqToSeq, seqToUnseq[:numInUse])
+	eob := uint16(numInUse + 1)
+	symbols = append(symbols, eob)
+
+	freqs := make([]int, alphaSize)
+	for _, s := range symbols {
+		freqs[s]++
+	}
+	lengths := huffmanCodeLengths(freqs, maxCodeLen)
+	codes := canonicalCodes(lengths)
+
+	for _, b := range blockMagic {
+		z.bw.writeBits(b, 8)
+	}
+	z.bw.writeBits(uint64(blockCRC), 32)
+	z.bw.writeBits(0, 1)
+	z.bw.writeBits(uint64(origPtr), 24)
+
+	var usedRanges uint64
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				usedRanges |= 1 << uint(15-i)
+				break
+			}
+		}
+	}
+	z.bw.writeBits(usedRanges, 16)
+	for i := 0; i < 16; i++ {
+		if usedRanges&(1<<uint(15-i)) == 0 {
+			continue
+		}
+		var bits uint64
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				bits |= 1 << uint(15-j)
+			}
+		}
+		z.bw.writeBits(bits, 16)
+	}
+
+	numSelectors := (len(symbols) + groupSize - 1) / groupSize
+	z.bw.writeBits(numTables, 3)
+	z.bw.writeBits(uint64(numSelectors), 15)
+	for i := 0; i < numSelectors; i++ {
+		z.bw.writeBits(0, 1)
+	}
+
+	for t := 0; t < numTables; t++ {
+		current := lengths[0]
+		z.bw.writeBits(uint64(current), 5)
+		for _, l := range lengths {
+			for current < l {
+				z.bw.writeBits(2, 2)
+				current++
+			}
+			for l < current {
+				z.bw.writeBits(3, 2)
+				current--
+			}
+			z.bw.writeBits(0, 1)
+		}
+	}
+
+	for _, s := range symbols {
+		z.bw.writeBits(uint64(codes[s]), uint(lengths[s]))
+	}
+
+	z.block = z.block[:0]
+	z.blockCRC = 0xffffffff
+	return z.bw.err
+}
+
+// transform applies the Burrows-Wheeler transform to the block.
+// It returns the last column of the sorted rotations and the index of the original string.
+func transform(block []byte) ([]byte, int) {
+	n := len(block)
+	sa := sortRotations(block)
+
+	bwt := make([]byte, n)
+	origPtr := 0
+	for i, p := range sa {
+		if p == 0 {
+			origPtr = i
+			bwt[i] = block[n-1]
+		} else {
+			bwt[i] = block[p-1]
+		}
+	}
+	return bwt, origPtr
+}
+
+// sortRotations returns the starting positions of the cyclic rotations of s in sorted order
+// by using prefix doubling with counting sort.
+func sortRotations(s []byte) []int {
+	n := len(s)
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+
+	numClasses := 256
+	if numClasses < n {
+		numClasses = n
+	}
+	count := make([]int, numClasses)
+
+	for i := 0; i < n; i++ {
+		count[s[i]]++
+	}
+	for i := 1; i < 256; i++ {
+		count[i] += count[i-1]
+	}
+	for i := n - 1; 0 <= i; i-- {
+		count[s[i]]--
+		sa[count[s[i]]] = i
+	}
+	classes := 1
+	rank[sa[0]] = 0
+	for i := 1; i < n; i++ {
+		if s[sa[i]] != s[sa[i-1]] {
+			classes++
+		}
+		rank[sa[i]] = classes - 1
+	}
+
+	for k := 1; k < n && classes < n; k <<= 1 {
+		for i := 0; i < n; i++ {
+			tmp[i] = sa[i] - k
+			if tmp[i] < 0 {
+				tmp[i] += n
+			}
+		}
+
+		for i := 0; i < classes; i++ {
+			count[i] = 0
+		}
+		for i := 0; i < n; i++ {
+			count[rank[tmp[i]]]++
+		}
+		for i := 1; i < classes; i++ {
+			count[i] += count[i-1]
+		}
+		for i := n - 1; 0 <= i; i-- {
+			count[rank[tmp[i]]]--
+			sa[count[rank[tmp[i]]]] = tmp[i]
+		}
+
+		tmp[sa[0]] = 0
+		classes = 1
+		for i := 1; i < n; i++ {
+			cur, prev := sa[i], sa[i-1]
+			curNext, prevNext := cur+k, prev+k
+			if n <= curNext {
+				curNext -= n
+			}
+			if n <= prevNext {
+				prevNext -= n
+			}
+			if rank[cur] != rank[prev] || rank[curNext] != rank[prevNext] {
+				classes++
+			}
+			tmp[cur] = classes - 1
+		}
+		rank, tmp = tmp, rank
+	}
+	return sa
+}
+
+// encodeMTF applies the move-to-front transform and the run-length encoding of zeros to the data.
+func encodeMTF(data []byte, unseqToSeq [256]byte, alphabet []byte) []uint16 {
+	list := make([]byte, len(alphabet))
+	for i := range list {
+		list[i] = byte(i)
+	}
+
+	symbols := make([]uint16, 0, len(data)+1)
+	zeros := 0
+
+	var flushZeros = func() {
+		if zeros < 1 {
+			return
+		}
+		zeros--
+		for {
+			if zeros&1 == 1 {
+				symbols = append(symbols, runB)
+			} else {
+				symbols = append(symbols, runA)
+			}
+			if zeros < 2 {
+				break
+			}
+			zeros = (zeros - 2) / 2
+		}
+		zeros = 0
+	}
+
+	for _, b := range data {
+		seq := unseqToSeq[b]
+		if list[0] == seq {
+			zeros++
+			continue
+		}
+		flushZeros()
+
+		idx := 1
+		for list[idx] != seq {
+			idx++
+		}
+		copy(list[1:idx+1], list[:idx])
+		list[0] = seq
+		symbols = append(symbols, uint16(idx+1))
+	}
+	flushZeros()
+	return symbols
+}
+
+// huffmanCodeLengths calculates the code length for each symbol not exceeding maxLen.
+// Every symbol is assigned a code even if its frequency is zero.
+func huffmanCodeLengths(freqs []int, maxLen int) []int {
+	n := len(freqs)
+	weights := make([]int, n)
+	for i, f := range freqs {
+		weights[i] = f
+		if weights[i] < 1 {
+			weights[i] = 1
+		}
+	}
+
+	lengths := make([]int, n)
+	for {
+		type node struct {
+			weight int
+			parent int
+		}
+		nodes := make([]node, n, 2*n)
+		active := make([]int, n)
+		for i := 0; i < n; i++ {
+			nodes[i] = node{weight: weights[i], parent: -1}
+			active[i] = i
+		}
+
+		for 1 < len(active) {
+			a, b := pickTwoLightest(active, func(i int) int { return nodes[i].weight })
+			nodes = append(nodes, node{weight: nodes[active[a]].weight + nodes[active[b]].weight, parent: -1})
+			parent := len(nodes) - 1
+			nodes[active[a]].parent = parent
+			nodes[active[b]].parent = parent
+
+			if a < b {
+				a, b = b, a
+			}
+			active[a] = active[len(active)-1]
+			active = active[:len(active)-1]
+			active[b] = parent
+		}
+
+		tooLong := false
+		for i := 0; i < n; i++ {
+			l := 0
+			for p := i; nodes[p].parent != -1; p = nodes[p].parent {
+				l++
+			}
+			if l < 1 {
+				l = 1
+			}
+			lengths[i] = l
+			if maxLen < l {
+				tooLong = true
+			}
+		}
+		if !tooLong {
+			return lengths
+		}
+
+		for i := range weights {
+			weights[i] = 1 + weights[i]/2
+		}
+	}
+}
+
+func pickTwoLightest(indices []int, weight func(int) int) (int, int) {
+	first, second := -1, -1
+	for i, idx := range indices {
+		switch {
+		case first < 0 || weight(idx) < weight(indices[first]):
+			first, second = i, first
+		case second < 0 || weight(idx) < weight(indices[second]):
+			second = i
+		}
+	}
+	return first, second
+}
+
+// canonicalCodes assigns codes to the symbols in order of code length and then symbol value.
+func canonicalCodes(lengths []int) []uint32 {
+	codes := make([]uint32, len(lengths))
+	var code uint32
+	for l := 1; l <= maxCodeLen; l++ {
+		for i, length := range lengths {
+			if length == l {
+				codes[i] = code
+				code++
+			}
+		}
+		code <<= 1
+	}
+	return codes
+}
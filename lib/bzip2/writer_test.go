@@ -0,0 +1,85 @@
+package bzip2
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func randomBytes(n int, alphabet int) []byte {
+	r := rand.New(rand.NewSource(1))
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.Intn(alphabet))
+	}
+	return b
+}
+
+var writerTests = []struct {
+	Name string
+	Data []byte
+}{
+	{
+		Name: "Empty",
+		Data: []byte{},
+	},
+	{
+		Name: "Single Byte",
+		Data: []byte("a"),
+	},
+	{
+		Name: "Text",
+		Data: []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"),
+	},
+	{
+		Name: "Runs",
+		Data: []byte("aaaabbbbbbbbccccccccccccccccccccccccccccccccc" + strings.Repeat("d", 1000) + "e" + strings.Repeat("ab", 300)),
+	},
+	{
+		Name: "Periodic",
+		Data: []byte(strings.Repeat("abc", 5000)),
+	},
+	{
+		Name: "Random",
+		Data: randomBytes(100000, 256),
+	},
+	{
+		Name: "Multiple Blocks",
+		Data: randomBytes(2000000, 16),
+	},
+	{
+		Name: "Long Run Across Blocks",
+		Data: append(randomBytes(899990, 200), bytes.Repeat([]byte{'z'}, 10000)...),
+	},
+}
+
+func TestWriter(t *testing.T) {
+	for _, v := range writerTests {
+		buf := &bytes.Buffer{}
+		w := NewWriter(buf)
+		for i := 0; i < len(v.Data); i += 70000 {
+			end := i + 70000
+			if len(v.Data) < end {
+				end = len(v.Data)
+			}
+			if _, err := w.Write(v.Data[i:end]); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := ioutil.ReadAll(bzip2.NewReader(buf))
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !bytes.Equal(result, v.Data) {
+			t.Errorf("%s: decompressed data does not match the original data", v.Name)
+		}
+	}
+}
@@ -27,6 +27,7 @@ const (
 	TimezoneFlag                = "TIMEZONE"
 	DatetimeFormatFlag          = "DATETIME_FORMAT"
 	AnsiQuotesFlag              = "ANSI_QUOTES"
+	NumericModeFlag             = "NUMERIC_MODE"
 	WaitTimeoutFlag             = "WAIT_TIMEOUT"
 	ImportFormatFlag            = "IMPORT_FORMAT"
 	DelimiterFlag               = "DELIMITER"
@@ -59,6 +60,7 @@ var FlagList = []string{
 	TimezoneFlag,
 	DatetimeFormatFlag,
 	AnsiQuotesFlag,
+	NumericModeFlag,
 	WaitTimeoutFlag,
 	ImportFormatFlag,
 	DelimiterFlag,
@@ -94,21 +96,27 @@ const (
 	TSV
 	FIXED
 	JSON
+	JSONL
 	LTSV
+	XLSX
+	PARQUET
 	GFM
 	ORG
 	TEXT
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	TEXT:  "TEXT",
+	CSV:     "CSV",
+	TSV:     "TSV",
+	FIXED:   "FIXED",
+	JSON:    "JSON",
+	JSONL:   "JSONL",
+	LTSV:    "LTSV",
+	XLSX:    "XLSX",
+	PARQUET: "PARQUET",
+	GFM:     "GFM",
+	ORG:     "ORG",
+	TEXT:    "TEXT",
 }
 
 func (f Format) String() string {
@@ -120,7 +128,46 @@ var ImportFormats = []Format{
 	TSV,
 	FIXED,
 	JSON,
+	JSONL,
 	LTSV,
+	XLSX,
+	PARQUET,
+}
+
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	GZIP
+	BZIP2
+	ZSTD
+)
+
+var CompressionLiteral = map[Compression]string{
+	NoCompression: "NONE",
+	GZIP:          "GZIP",
+	BZIP2:         "BZIP2",
+	ZSTD:          "ZSTD",
+}
+
+func (c Compression) String() string {
+	return CompressionLiteral[c]
+}
+
+type NumericMode int
+
+const (
+	FloatMode NumericMode = iota
+	DecimalMode
+)
+
+var NumericModeLiteral = map[NumericMode]string{
+	FloatMode:   "FLOAT",
+	DecimalMode: "DECIMAL",
+}
+
+func (m NumericMode) String() string {
+	return NumericModeLiteral[m]
 }
 
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
@@ -137,12 +184,18 @@ const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
 	JsonExt     = ".json"
+	JsonlExt    = ".jsonl"
 	LtsvExt     = ".ltsv"
+	XlsxExt     = ".xlsx"
+	ParquetExt  = ".parquet"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
 	SqlExt      = ".sql"
 	CsvqProcExt = ".cql"
 	TextExt     = ".txt"
+	GzipExt     = ".gz"
+	Bzip2Ext    = ".bz2"
+	ZstdExt     = ".zst"
 )
 
 type Flags struct {
@@ -151,6 +204,7 @@ type Flags struct {
 	Location       string
 	DatetimeFormat []string
 	AnsiQuotes     bool
+	NumericMode    NumericMode
 
 	// Must be updated from Transaction
 	WaitTimeout float64
@@ -214,6 +268,7 @@ func NewFlags(env *Environment) *Flags {
 		Location:                "Local",
 		DatetimeFormat:          datetimeFormat,
 		AnsiQuotes:              false,
+		NumericMode:             FloatMode,
 		WaitTimeout:             10,
 		Color:                   false,
 		ImportFormat:            CSV,
@@ -244,6 +299,19 @@ func NewFlags(env *Environment) *Flags {
 	}
 }
 
+// Copy returns a copy of the flags that does not share any slices with f.
+func (f *Flags) Copy() *Flags {
+	c := *f
+	c.DatetimeFormat = append(make([]string, 0, len(f.DatetimeFormat)), f.DatetimeFormat...)
+	if f.DelimiterPositions != nil {
+		c.DelimiterPositions = append(make([]int, 0, len(f.DelimiterPositions)), f.DelimiterPositions...)
+	}
+	if f.WriteDelimiterPositions != nil {
+		c.WriteDelimiterPositions = append(make([]int, 0, len(f.WriteDelimiterPositions)), f.WriteDelimiterPositions...)
+	}
+	return &c
+}
+
 func (f *Flags) SetRepository(s string) error {
 	if len(s) < 1 {
 		f.Repository = ""
@@ -303,6 +371,18 @@ func (f *Flags) SetAnsiQuotes(b bool) {
 	f.AnsiQuotes = b
 }
 
+func (f *Flags) SetNumericMode(s string) error {
+	var mode NumericMode
+	var err error
+
+	if mode, err = ParseNumericMode(s); err != nil {
+		return err
+	}
+
+	f.NumericMode = mode
+	return nil
+}
+
 func (f *Flags) SetWaitTimeout(t float64) {
 	if t < 0 {
 		t = 0
@@ -315,16 +395,16 @@ func (f *Flags) SetWaitTimeout(t float64) {
 func (f *Flags) SetImportFormat(s string) error {
 	fm, _, err := ParseFormat(s, f.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, LTSV:
+	case CSV, TSV, FIXED, JSON, JSONL, LTSV, XLSX, PARQUET:
 		f.ImportFormat = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -388,6 +468,7 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 
 	switch s {
 	case "":
+		_, outfile = CompressionOfPath(outfile)
 		switch strings.ToLower(filepath.Ext(outfile)) {
 		case CsvExt:
 			fm = CSV
@@ -395,8 +476,14 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = TSV
 		case JsonExt:
 			fm = JSON
+		case JsonlExt:
+			fm = JSONL
 		case LtsvExt:
 			fm = LTSV
+		case XlsxExt:
+			fm = XLSX
+		case ParquetExt:
+			fm = PARQUET
 		case GfmExt:
 			fm = GFM
 		case OrgExt:
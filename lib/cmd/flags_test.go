@@ -42,6 +42,25 @@ func TestFlags_SetRepository(t *testing.T) {
 	}
 }
 
+func TestFlags_Copy(t *testing.T) {
+	flags := NewFlags(nil)
+	flags.DatetimeFormat = []string{"%Y%m%d"}
+	flags.DelimiterPositions = []int{2, 5}
+	flags.Format = JSON
+
+	c := flags.Copy()
+	if !reflect.DeepEqual(c, flags) {
+		t.Errorf("copy = %v, want %v", c, flags)
+	}
+
+	c.DatetimeFormat[0] = "%H%i"
+	c.DelimiterPositions[0] = 3
+	c.Format = CSV
+	if flags.DatetimeFormat[0] != "%Y%m%d" || flags.DelimiterPositions[0] != 2 || flags.Format != JSON {
+		t.Errorf("original flags are modified by the copy: %v", flags)
+	}
+}
+
 func TestFlags_SetLocation(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -114,6 +133,31 @@ func TestFlags_SetAnsiQuotes(t *testing.T) {
 	}
 }
 
+func TestFlags_SetNumericMode(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := "decimal"
+	_ = flags.SetNumericMode(s)
+	if flags.NumericMode != DecimalMode {
+		t.Errorf("numeric-mode = %v, expect to set %v", flags.NumericMode, DecimalMode)
+	}
+
+	s = "FLOAT"
+	_ = flags.SetNumericMode(s)
+	if flags.NumericMode != FloatMode {
+		t.Errorf("numeric-mode = %v, expect to set %v", flags.NumericMode, FloatMode)
+	}
+
+	s = "error"
+	expectErr := "numeric mode must be one of FLOAT|DECIMAL"
+	err := flags.SetNumericMode(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
 func TestFlags_SetWaitTimeout(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -143,7 +187,12 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportFormat, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV"
+	_ = flags.SetImportFormat("jsonl")
+	if flags.ImportFormat != JSONL {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportFormat, JSONL, "jsonl")
+	}
+
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -304,11 +353,26 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, JSON, "foo.json")
 	}
 
+	_ = flags.SetFormat("", "foo.jsonl")
+	if flags.Format != JSONL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, JSONL, "foo.jsonl")
+	}
+
 	_ = flags.SetFormat("", "foo.ltsv")
 	if flags.Format != LTSV {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, LTSV, "foo.ltsv")
 	}
 
+	_ = flags.SetFormat("", "foo.xlsx")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, XLSX, "foo.xlsx")
+	}
+
+	_ = flags.SetFormat("", "foo.parquet")
+	if flags.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, PARQUET, "foo.parquet")
+	}
+
 	_ = flags.SetFormat("", "foo.md")
 	if flags.Format != GFM {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, GFM, "foo.md")
@@ -319,6 +383,11 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, ORG, "foo.org")
 	}
 
+	_ = flags.SetFormat("", "foo.json.gz")
+	if flags.Format != JSON {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, JSON, "foo.json.gz")
+	}
+
 	_ = flags.SetFormat("csv", "")
 	if flags.Format != CSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, CSV, "csv")
@@ -344,6 +413,16 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, LTSV, "ltsv")
 	}
 
+	_ = flags.SetFormat("xlsx", "")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, XLSX, "xlsx")
+	}
+
+	_ = flags.SetFormat("parquet", "")
+	if flags.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, PARQUET, "parquet")
+	}
+
 	_ = flags.SetFormat("jsonh", "")
 	if flags.Format != JSON {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, JSON, "jsonh")
@@ -375,7 +454,7 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|GFM|ORG|TEXT"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"unicode"
@@ -246,7 +247,16 @@ func FormatInt(i int, thousandsSeparator string) string {
 }
 
 func FormatNumber(f float64, precision int, decimalPoint string, thousandsSeparator string, decimalSeparator string) string {
-	s := strconv.FormatFloat(f, 'f', precision, 64)
+	return FormatNumberString(strconv.FormatFloat(f, 'f', precision, 64), decimalPoint, thousandsSeparator, decimalSeparator)
+}
+
+// FormatNumberString inserts separators into a number string that has no exponent.
+func FormatNumberString(s string, decimalPoint string, thousandsSeparator string, decimalSeparator string) string {
+	sign := ""
+	if 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+		sign = s[:1]
+		s = s[1:]
+	}
 
 	parts := strings.Split(s, ".")
 	intPart := parts[0]
@@ -279,7 +289,7 @@ func FormatNumber(f float64, precision int, decimalPoint string, thousandsSepara
 		decPlaces = append(decPlaces, decPart[i:end])
 	}
 
-	formatted := strings.Join(intPlaces, thousandsSeparator)
+	formatted := sign + strings.Join(intPlaces, thousandsSeparator)
 	if 0 < len(decPlaces) {
 		formatted = formatted + decimalPoint + strings.Join(decPlaces, decimalSeparator)
 	}
@@ -339,8 +349,14 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = FIXED
 	case "JSON":
 		fm = JSON
+	case "JSONL":
+		fm = JSONL
 	case "LTSV":
 		fm = LTSV
+	case "XLSX":
+		fm = XLSX
+	case "PARQUET":
+		fm = PARQUET
 	case "GFM":
 		fm = GFM
 	case "ORG":
@@ -354,11 +370,63 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|GFM|ORG|TEXT")
 	}
 	return fm, et, nil
 }
 
+func ParseCompression(s string) (Compression, error) {
+	var c Compression
+	switch strings.ToUpper(s) {
+	case "NONE":
+		c = NoCompression
+	case "GZIP":
+		c = GZIP
+	case "BZIP2":
+		c = BZIP2
+	case "ZSTD":
+		c = ZSTD
+	default:
+		return c, errors.New("compression must be one of NONE|GZIP|BZIP2|ZSTD")
+	}
+	return c, nil
+}
+
+// CompressionOfPath returns the compression type indicated by the extension of the path,
+// and the path without the compression extension.
+func CompressionOfPath(path string) (Compression, string) {
+	ext := filepath.Ext(path)
+
+	var c Compression
+	switch strings.ToLower(ext) {
+	case GzipExt:
+		c = GZIP
+	case Bzip2Ext:
+		c = BZIP2
+	case ZstdExt:
+		c = ZSTD
+	default:
+		return NoCompression, path
+	}
+	return c, path[:len(path)-len(ext)]
+}
+
+// PathWithCompression returns the path that has the extension of the compression type
+// in place of the compression extension of the path.
+func PathWithCompression(path string, c Compression) string {
+	_, path = CompressionOfPath(path)
+
+	switch c {
+	case GZIP:
+		path = path + GzipExt
+	case BZIP2:
+		path = path + Bzip2Ext
+	case ZSTD:
+		path = path + ZstdExt
+	}
+	return path
+}
+
 func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	var escape txjson.EscapeType
 	switch strings.ToUpper(s) {
@@ -374,6 +442,19 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseNumericMode(s string) (NumericMode, error) {
+	var mode NumericMode
+	switch strings.ToUpper(s) {
+	case "FLOAT":
+		mode = FloatMode
+	case "DECIMAL":
+		mode = DecimalMode
+	default:
+		return mode, errors.New("numeric mode must be one of FLOAT|DECIMAL")
+	}
+	return mode, nil
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -224,6 +224,99 @@ func TestParseEncoding(t *testing.T) {
 	}
 }
 
+func TestParseCompression(t *testing.T) {
+	c, err := ParseCompression("gzip")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if c != GZIP {
+		t.Errorf("compression = %s, expect to set %s for %s", c, GZIP, "gzip")
+	}
+
+	expectErr := "compression must be one of NONE|GZIP|BZIP2|ZSTD"
+	_, err = ParseCompression("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+var compressionOfPathTests = []struct {
+	Path        string
+	Compression Compression
+	Result      string
+}{
+	{
+		Path:        "foo.csv",
+		Compression: NoCompression,
+		Result:      "foo.csv",
+	},
+	{
+		Path:        "foo.csv.gz",
+		Compression: GZIP,
+		Result:      "foo.csv",
+	},
+	{
+		Path:        "foo.tsv.BZ2",
+		Compression: BZIP2,
+		Result:      "foo.tsv",
+	},
+	{
+		Path:        "/path/to/foo.json.zst",
+		Compression: ZSTD,
+		Result:      "/path/to/foo.json",
+	},
+}
+
+func TestCompressionOfPath(t *testing.T) {
+	for _, v := range compressionOfPathTests {
+		c, p := CompressionOfPath(v.Path)
+		if c != v.Compression {
+			t.Errorf("compression = %s, want %s for %q", c, v.Compression, v.Path)
+		}
+		if p != v.Result {
+			t.Errorf("path = %q, want %q for %q", p, v.Result, v.Path)
+		}
+	}
+}
+
+var pathWithCompressionTests = []struct {
+	Path        string
+	Compression Compression
+	Result      string
+}{
+	{
+		Path:        "foo.csv",
+		Compression: NoCompression,
+		Result:      "foo.csv",
+	},
+	{
+		Path:        "foo.csv",
+		Compression: GZIP,
+		Result:      "foo.csv.gz",
+	},
+	{
+		Path:        "foo.csv.gz",
+		Compression: NoCompression,
+		Result:      "foo.csv",
+	},
+	{
+		Path:        "/path/to/foo.json.bz2",
+		Compression: ZSTD,
+		Result:      "/path/to/foo.json.zst",
+	},
+}
+
+func TestPathWithCompression(t *testing.T) {
+	for _, v := range pathWithCompressionTests {
+		result := PathWithCompression(v.Path, v.Compression)
+		if result != v.Result {
+			t.Errorf("path = %q, want %q for %q, %s", result, v.Result, v.Path, v.Compression)
+		}
+	}
+}
+
 func TestParseDelimiter(t *testing.T) {
 	var s string
 
@@ -175,6 +175,8 @@ func ParseValueToStructure(val value.Primary) json.Structure {
 		s = json.Integer(val.(*value.Integer).Raw())
 	case *value.Float:
 		s = json.Float(val.(*value.Float).Raw())
+	case *value.Decimal:
+		s = json.Float(val.(*value.Decimal).Float64())
 	case *value.Boolean:
 		s = json.Boolean(val.(*value.Boolean).Raw())
 	case *value.Ternary:
@@ -186,6 +188,8 @@ func ParseValueToStructure(val value.Primary) json.Structure {
 		}
 	case *value.Datetime:
 		s = json.String(val.(*value.Datetime).Format(time.RFC3339Nano))
+	case *value.Interval:
+		s = json.String(val.(*value.Interval).Literal())
 	case *value.Null:
 		s = json.Null{}
 	}
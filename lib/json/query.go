@@ -1,8 +1,11 @@
 package json
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -47,6 +50,104 @@ func LoadTable(queryString string, jsontext string) ([]string, [][]value.Primary
 	return h, rows, et, err
 }
 
+// LoadTableFromJsonLines reads JSON values from r line by line, and loads a table
+// from the values extracted by applying the query to each line.
+// Each line is converted to records as soon as it is read, so that the decoded JSON values
+// are not kept in memory.
+func LoadTableFromJsonLines(queryString string, r io.Reader) ([]string, [][]value.Primary, json.EscapeType, error) {
+	var escapeType = json.Backslash
+
+	query, err := Query.Parse(queryString)
+	if err != nil {
+		return nil, nil, escapeType, err
+	}
+
+	d := json.NewDecoder()
+	d.UseInteger = true
+
+	header := make([]string, 0, 10)
+	columnIndex := make(map[string]int, 10)
+	rows := make([][]value.Primary, 0, 1000)
+
+	var appendRow = func(elem json.Structure) error {
+		obj, ok := elem.(json.Object)
+		if !ok {
+			return errors.New("rows loaded from json must be objects")
+		}
+
+		row := make([]value.Primary, len(header), len(header)+obj.Len())
+		for _, m := range obj.Members {
+			idx, ok := columnIndex[m.Key]
+			if !ok {
+				idx = len(header)
+				columnIndex[m.Key] = idx
+				header = append(header, m.Key)
+			}
+			for len(row) <= idx {
+				row = append(row, nil)
+			}
+			if row[idx] == nil {
+				row[idx] = ConvertToValue(m.Value)
+			}
+		}
+		rows = append(rows, row)
+		return nil
+	}
+
+	reader := bufio.NewReader(r)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, escapeType, err
+		}
+		eof := err == io.EOF
+
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		if 0 < len(strings.TrimSpace(line)) {
+			data, et, err := d.Decode(line)
+			if err != nil {
+				return nil, nil, escapeType, errors.New(fmt.Sprintf("line %d: %s", lineNumber, err.Error()))
+			}
+			if escapeType < et {
+				escapeType = et
+			}
+
+			st, err := Extract(query, data)
+			if err != nil {
+				return nil, nil, escapeType, errors.New(fmt.Sprintf("line %d: %s", lineNumber, err.Error()))
+			}
+			if elems, ok := st.(json.Array); ok {
+				for _, elem := range elems {
+					if err = appendRow(elem); err != nil {
+						return nil, nil, escapeType, err
+					}
+				}
+			} else if err = appendRow(st); err != nil {
+				return nil, nil, escapeType, err
+			}
+		}
+
+		if eof {
+			break
+		}
+	}
+
+	for i := range rows {
+		for len(rows[i]) < len(header) {
+			rows[i] = append(rows[i], nil)
+		}
+		for j := range rows[i] {
+			if rows[i][j] == nil {
+				rows[i][j] = value.NewNull()
+			}
+		}
+	}
+	return header, rows, escapeType, nil
+}
+
 func load(queryString string, jsontext string) (json.Structure, json.EscapeType, error) {
 	query, err := Query.Parse(queryString)
 	if err != nil {
@@ -2,6 +2,7 @@ package json
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/value"
@@ -163,6 +164,98 @@ func TestLoadTable(t *testing.T) {
 	}
 }
 
+var loadTableFromJsonLinesTests = []struct {
+	Query        string
+	Json         string
+	ExpectHeader []string
+	ExpectValues [][]value.Primary
+	EscapeType   json.EscapeType
+	Error        string
+}{
+	{
+		Query: "",
+		Json: "{\"key1\":1, \"key2\":\"a\"}\n" +
+			"\n" +
+			"{\"key1\":2, \"key3\":\"\\u0062\"}\n",
+		ExpectHeader: []string{"key1", "key2", "key3"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(1),
+				value.NewString("a"),
+				value.NewNull(),
+			},
+			{
+				value.NewInteger(2),
+				value.NewNull(),
+				value.NewString("b"),
+			},
+		},
+		EscapeType: json.AllWithHexDigits,
+	},
+	{
+		Query: "",
+		Json: "{\"key1\":\"\\u0062\"}\n" +
+			"{\"key1\":\"\\u000a\"}\n" +
+			"{\"key1\":\"c\"}\n",
+		ExpectHeader: []string{"key1"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("b")},
+			{value.NewString("\n")},
+			{value.NewString("c")},
+		},
+		EscapeType: json.AllWithHexDigits,
+	},
+	{
+		Query: "key{}",
+		Json: "{\"key\":[{\"key2\":2}, {\"key2\":3}]}\r\n" +
+			"{\"key\":[{\"key2\":4}]}",
+		ExpectHeader: []string{"key2"},
+		ExpectValues: [][]value.Primary{
+			{value.NewInteger(2)},
+			{value.NewInteger(3)},
+			{value.NewInteger(4)},
+		},
+	},
+	{
+		Query: "",
+		Json: "{\"key1\":1}\n" +
+			"{\"key1\":2, key: 3}\n",
+		Error: "line 2: line 1, column 12: unexpected token \"key\"",
+	},
+	{
+		Query: "",
+		Json:  "[1, 2]\n",
+		Error: "rows loaded from json must be objects",
+	},
+}
+
+func TestLoadTableFromJsonLines(t *testing.T) {
+	for _, v := range loadTableFromJsonLinesTests {
+		header, values, et, err := LoadTableFromJsonLines(v.Query, strings.NewReader(v.Json))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q, %q", err.Error(), v.Query, v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q, %q", err, v.Error, v.Query, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q, %q", v.Error, v.Query, v.Json)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("header = %#v, want %#v for %q, %q", header, v.ExpectHeader, v.Query, v.Json)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("values = %#v, want %#v for %q, %q", values, v.ExpectValues, v.Query, v.Json)
+		}
+		if et != v.EscapeType {
+			t.Errorf("escape type = %d, want %d for %q, %q", et, v.EscapeType, v.Query, v.Json)
+		}
+	}
+}
+
 var extractTests = []struct {
 	Query  QueryExpression
 	Data   json.Structure
@@ -0,0 +1,445 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errCorruptedPage = errors.New("page data is corrupted")
+
+type int96 [12]byte
+
+func unpackBits(data []byte, bitWidth int, count int) ([]uint64, error) {
+	if bitWidth < 0 || 64 < bitWidth {
+		return nil, errCorruptedPage
+	}
+	if len(data)*8 < bitWidth*count {
+		return nil, errCorruptedPage
+	}
+
+	values := make([]uint64, count)
+	if bitWidth == 0 {
+		return values, nil
+	}
+
+	bitPos := 0
+	for i := 0; i < count; i++ {
+		var v uint64
+		for read := 0; read < bitWidth; {
+			idx := bitPos >> 3
+			offset := bitPos & 7
+			n := 8 - offset
+			if bitWidth-read < n {
+				n = bitWidth - read
+			}
+			v |= uint64((data[idx]>>uint(offset))&byte(1<<uint(n)-1)) << uint(read)
+			read += n
+			bitPos += n
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// decodeHybrid decodes count values encoded with the RLE/Bit-Packing Hybrid encoding.
+func decodeHybrid(data []byte, bitWidth int, count int) ([]int32, error) {
+	if bitWidth < 0 || 32 < bitWidth {
+		return nil, errCorruptedPage
+	}
+	byteWidth := (bitWidth + 7) / 8
+
+	values := make([]int32, 0, count)
+	pos := 0
+	for len(values) < count {
+		header, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, errCorruptedPage
+		}
+		pos += n
+
+		if header&1 == 0 {
+			if len(data) < pos+byteWidth {
+				return nil, errCorruptedPage
+			}
+			var v uint32
+			for i := 0; i < byteWidth; i++ {
+				v |= uint32(data[pos+i]) << uint(8*i)
+			}
+			pos += byteWidth
+
+			for i := uint64(0); i < header>>1 && len(values) < count; i++ {
+				values = append(values, int32(v))
+			}
+		} else {
+			groups := int(header >> 1)
+			if groups < 1 {
+				return nil, errCorruptedPage
+			}
+			size := groups * bitWidth
+			n := groups * 8
+			if len(data)-pos < size {
+				// The last run may be truncated to the bytes that hold the values.
+				size = len(data) - pos
+				n = size * 8 / bitWidth
+				if n < 1 {
+					return nil, errCorruptedPage
+				}
+			}
+			unpacked, err := unpackBits(data[pos:pos+size], bitWidth, n)
+			if err != nil {
+				return nil, err
+			}
+			pos += size
+
+			for i := 0; i < len(unpacked) && len(values) < count; i++ {
+				values = append(values, int32(unpacked[i]))
+			}
+		}
+	}
+	return values, nil
+}
+
+// encodeHybrid encodes values with the run-length encoding of the RLE/Bit-Packing Hybrid encoding.
+func encodeHybrid(values []int32, bitWidth int) []byte {
+	byteWidth := (bitWidth + 7) / 8
+	buf := make([]byte, 0, 16)
+	var tmp [binary.MaxVarintLen64]byte
+
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+
+		n := binary.PutUvarint(tmp[:], uint64(j-i)<<1)
+		buf = append(buf, tmp[:n]...)
+		for k := 0; k < byteWidth; k++ {
+			buf = append(buf, byte(uint32(values[i])>>uint(8*k)))
+		}
+		i = j
+	}
+	return buf
+}
+
+// decodeLengthPrefixedHybrid decodes values encoded with the RLE/Bit-Packing Hybrid encoding
+// preceded by the 4-byte length, and returns the number of bytes read.
+func decodeLengthPrefixedHybrid(data []byte, bitWidth int, count int) ([]int32, int, error) {
+	if len(data) < 4 {
+		return nil, 0, errCorruptedPage
+	}
+	l := int(binary.LittleEndian.Uint32(data))
+	if l < 0 || len(data)-4 < l {
+		return nil, 0, errCorruptedPage
+	}
+	values, err := decodeHybrid(data[4:4+l], bitWidth, count)
+	return values, 4 + l, err
+}
+
+// decodeDeltaBinaryPacked decodes values encoded with the DELTA_BINARY_PACKED encoding,
+// and returns the number of bytes read.
+func decodeDeltaBinaryPacked(data []byte) ([]int64, int, error) {
+	pos := 0
+	var readUvarint = func() (uint64, error) {
+		v, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return 0, errCorruptedPage
+		}
+		pos += n
+		return v, nil
+	}
+	var readVarint = func() (int64, error) {
+		v, n := binary.Varint(data[pos:])
+		if n <= 0 {
+			return 0, errCorruptedPage
+		}
+		pos += n
+		return v, nil
+	}
+
+	blockSize, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	miniBlocks, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	first, err := readVarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	if blockSize == 0 || miniBlocks == 0 || blockSize%miniBlocks != 0 || uint64(len(data))*8+1 < total {
+		return nil, 0, errCorruptedPage
+	}
+	valuesPerMiniBlock := int(blockSize / miniBlocks)
+
+	values := make([]int64, 0, total)
+	if total == 0 {
+		return values, pos, nil
+	}
+	values = append(values, first)
+	last := first
+
+	for uint64(len(values)) < total {
+		minDelta, err := readVarint()
+		if err != nil {
+			return nil, 0, err
+		}
+		if uint64(len(data)-pos) < miniBlocks {
+			return nil, 0, errCorruptedPage
+		}
+		widths := data[pos : pos+int(miniBlocks)]
+		pos += int(miniBlocks)
+
+		for m := 0; m < len(widths) && uint64(len(values)) < total; m++ {
+			size := valuesPerMiniBlock * int(widths[m]) / 8
+			if len(data)-pos < size {
+				return nil, 0, errCorruptedPage
+			}
+			deltas, err := unpackBits(data[pos:pos+size], int(widths[m]), valuesPerMiniBlock)
+			if err != nil {
+				return nil, 0, err
+			}
+			pos += size
+
+			for _, d := range deltas {
+				if uint64(len(values)) == total {
+					break
+				}
+				last += minDelta + int64(d)
+				values = append(values, last)
+			}
+		}
+	}
+	return values, pos, nil
+}
+
+func decodeDeltaLengthByteArray(data []byte) ([][]byte, error) {
+	lengths, pos, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(lengths))
+	for i, l := range lengths {
+		if l < 0 || int64(len(data)-pos) < l {
+			return nil, errCorruptedPage
+		}
+		values[i] = data[pos : pos+int(l)]
+		pos += int(l)
+	}
+	return values, nil
+}
+
+func decodeDeltaByteArray(data []byte) ([][]byte, error) {
+	prefixes, pos, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return nil, err
+	}
+	suffixes, err := decodeDeltaLengthByteArray(data[pos:])
+	if err != nil {
+		return nil, err
+	}
+	if len(prefixes) != len(suffixes) {
+		return nil, errCorruptedPage
+	}
+
+	values := make([][]byte, len(suffixes))
+	var prev []byte
+	for i := range suffixes {
+		if prefixes[i] < 0 || int64(len(prev)) < prefixes[i] {
+			return nil, errCorruptedPage
+		}
+		v := make([]byte, 0, int(prefixes[i])+len(suffixes[i]))
+		v = append(v, prev[:prefixes[i]]...)
+		v = append(v, suffixes[i]...)
+		values[i] = v
+		prev = v
+	}
+	return values, nil
+}
+
+func plainValueSize(physicalType int32, typeLength int32) int {
+	switch physicalType {
+	case typeInt32, typeFloat:
+		return 4
+	case typeInt64, typeDouble:
+		return 8
+	case typeInt96:
+		return 12
+	case typeFixedLenByteArray:
+		return int(typeLength)
+	}
+	return -1
+}
+
+// decodePlain decodes count values encoded with the PLAIN encoding,
+// and returns the values represented as bool, int32, int64, int96, float32, float64 or []byte.
+func decodePlain(physicalType int32, typeLength int32, data []byte, count int) ([]interface{}, error) {
+	values := make([]interface{}, count)
+
+	switch physicalType {
+	case typeBoolean:
+		if len(data)*8 < count {
+			return nil, errCorruptedPage
+		}
+		for i := 0; i < count; i++ {
+			values[i] = data[i>>3]&(1<<uint(i&7)) != 0
+		}
+		return values, nil
+	case typeByteArray:
+		pos := 0
+		for i := 0; i < count; i++ {
+			if len(data)-pos < 4 {
+				return nil, errCorruptedPage
+			}
+			l := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if l < 0 || len(data)-pos < l {
+				return nil, errCorruptedPage
+			}
+			values[i] = data[pos : pos+l]
+			pos += l
+		}
+		return values, nil
+	}
+
+	size := plainValueSize(physicalType, typeLength)
+	if size < 0 {
+		return nil, fmt.Errorf("unknown physical type %d", physicalType)
+	}
+	if len(data) < size*count {
+		return nil, errCorruptedPage
+	}
+
+	for i := 0; i < count; i++ {
+		b := data[i*size : (i+1)*size]
+		switch physicalType {
+		case typeInt32:
+			values[i] = int32(binary.LittleEndian.Uint32(b))
+		case typeInt64:
+			values[i] = int64(binary.LittleEndian.Uint64(b))
+		case typeInt96:
+			var v int96
+			copy(v[:], b)
+			values[i] = v
+		case typeFloat:
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(b))
+		case typeDouble:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(b))
+		default:
+			values[i] = b
+		}
+	}
+	return values, nil
+}
+
+func decodeByteStreamSplit(physicalType int32, typeLength int32, data []byte, count int) ([]interface{}, error) {
+	size := plainValueSize(physicalType, typeLength)
+	if size < 1 || physicalType == typeInt96 || len(data) < size*count {
+		return nil, errCorruptedPage
+	}
+
+	joined := make([]byte, size*count)
+	for i := 0; i < count; i++ {
+		for b := 0; b < size; b++ {
+			joined[i*size+b] = data[b*count+i]
+		}
+	}
+	return decodePlain(physicalType, typeLength, joined, count)
+}
+
+func decodeValues(physicalType int32, typeLength int32, encoding int32, data []byte, count int) ([]interface{}, error) {
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(physicalType, typeLength, data, count)
+	case encodingRLE:
+		if physicalType != typeBoolean {
+			break
+		}
+		bits, _, err := decodeLengthPrefixedHybrid(data, 1, count)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, count)
+		for i := range bits {
+			values[i] = bits[i] != 0
+		}
+		return values, nil
+	case encodingDeltaBinaryPacked:
+		if physicalType != typeInt32 && physicalType != typeInt64 {
+			break
+		}
+		ints, _, err := decodeDeltaBinaryPacked(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(ints) < count {
+			return nil, errCorruptedPage
+		}
+		values := make([]interface{}, count)
+		for i := range values {
+			if physicalType == typeInt32 {
+				values[i] = int32(ints[i])
+			} else {
+				values[i] = ints[i]
+			}
+		}
+		return values, nil
+	case encodingDeltaLengthByteArray, encodingDeltaByteArray:
+		if physicalType != typeByteArray && physicalType != typeFixedLenByteArray {
+			break
+		}
+		var bytes [][]byte
+		var err error
+		if encoding == encodingDeltaLengthByteArray {
+			bytes, err = decodeDeltaLengthByteArray(data)
+		} else {
+			bytes, err = decodeDeltaByteArray(data)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes) < count {
+			return nil, errCorruptedPage
+		}
+		values := make([]interface{}, count)
+		for i := range values {
+			values[i] = bytes[i]
+		}
+		return values, nil
+	case encodingByteStreamSplit:
+		return decodeByteStreamSplit(physicalType, typeLength, data, count)
+	}
+	return nil, fmt.Errorf("encoding %s is not supported", encodingName(encoding))
+}
+
+func encodingName(encoding int32) string {
+	switch encoding {
+	case encodingPlain:
+		return "PLAIN"
+	case encodingPlainDictionary:
+		return "PLAIN_DICTIONARY"
+	case encodingRLE:
+		return "RLE"
+	case encodingBitPacked:
+		return "BIT_PACKED"
+	case encodingDeltaBinaryPacked:
+		return "DELTA_BINARY_PACKED"
+	case encodingDeltaLengthByteArray:
+		return "DELTA_LENGTH_BYTE_ARRAY"
+	case encodingDeltaByteArray:
+		return "DELTA_BYTE_ARRAY"
+	case encodingRLEDictionary:
+		return "RLE_DICTIONARY"
+	case encodingByteStreamSplit:
+		return "BYTE_STREAM_SPLIT"
+	}
+	return fmt.Sprintf("%d", encoding)
+}
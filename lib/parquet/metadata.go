@@ -0,0 +1,648 @@
+package parquet
+
+// Physical types
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Converted types
+const (
+	convertedUTF8            = 0
+	convertedEnum            = 4
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimeMillis      = 7
+	convertedTimeMicros      = 8
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedJSON            = 19
+)
+
+// Field repetition types
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// Encodings
+const (
+	encodingPlain                = 0
+	encodingPlainDictionary      = 2
+	encodingRLE                  = 3
+	encodingBitPacked            = 4
+	encodingDeltaBinaryPacked    = 5
+	encodingDeltaLengthByteArray = 6
+	encodingDeltaByteArray       = 7
+	encodingRLEDictionary        = 8
+	encodingByteStreamSplit      = 9
+)
+
+// Compression codecs
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+// Page types
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// Time units
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+// Logical types. The values are the field ids of the LogicalType union.
+const (
+	logicalString    = 1
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTime      = 7
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalJSON      = 12
+	logicalUUID      = 14
+)
+
+type logicalType struct {
+	Type int16
+
+	Scale           int32
+	Precision       int32
+	IsAdjustedToUTC bool
+	Unit            int16
+	BitWidth        int8
+	IsSigned        bool
+}
+
+type schemaElement struct {
+	Type           int32
+	TypeLength     int32
+	RepetitionType int32
+	Name           string
+	NumChildren    int32
+	ConvertedType  int32
+	Scale          int32
+	Precision      int32
+	LogicalType    *logicalType
+}
+
+type statistics struct {
+	Max       []byte
+	Min       []byte
+	NullCount int64
+	MaxValue  []byte
+	MinValue  []byte
+
+	HasNullCount bool
+}
+
+type columnMetaData struct {
+	Type                  int32
+	Encodings             []int32
+	PathInSchema          []string
+	Codec                 int32
+	NumValues             int64
+	TotalUncompressedSize int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	DictionaryPageOffset  int64
+	Statistics            *statistics
+}
+
+type columnChunk struct {
+	FilePath   string
+	FileOffset int64
+	MetaData   *columnMetaData
+}
+
+type rowGroup struct {
+	Columns       []columnChunk
+	TotalByteSize int64
+	NumRows       int64
+}
+
+type fileMetaData struct {
+	Version   int32
+	Schema    []schemaElement
+	NumRows   int64
+	RowGroups []rowGroup
+	CreatedBy string
+}
+
+type dataPageHeader struct {
+	NumValues               int32
+	Encoding                int32
+	DefinitionLevelEncoding int32
+	RepetitionLevelEncoding int32
+}
+
+type dictionaryPageHeader struct {
+	NumValues int32
+	Encoding  int32
+}
+
+type dataPageHeaderV2 struct {
+	NumValues                  int32
+	NumNulls                   int32
+	NumRows                    int32
+	Encoding                   int32
+	DefinitionLevelsByteLength int32
+	RepetitionLevelsByteLength int32
+	IsCompressed               bool
+}
+
+type pageHeader struct {
+	Type                 int32
+	UncompressedPageSize int32
+	CompressedPageSize   int32
+	DataPageHeader       *dataPageHeader
+	DictionaryPageHeader *dictionaryPageHeader
+	DataPageHeaderV2     *dataPageHeaderV2
+}
+
+func readI32Field(d *thriftDecoder, fieldType byte, v *int32) error {
+	if fieldType != thriftI32 {
+		return d.skip(fieldType)
+	}
+	i, err := d.readI32()
+	*v = i
+	return err
+}
+
+func readI64Field(d *thriftDecoder, fieldType byte, v *int64) error {
+	if fieldType != thriftI64 {
+		return d.skip(fieldType)
+	}
+	i, err := d.readI64()
+	*v = i
+	return err
+}
+
+func readBinaryField(d *thriftDecoder, fieldType byte, v *[]byte) error {
+	if fieldType != thriftBinary {
+		return d.skip(fieldType)
+	}
+	b, err := d.readBinary()
+	*v = b
+	return err
+}
+
+func readStringField(d *thriftDecoder, fieldType byte, v *string) error {
+	if fieldType != thriftBinary {
+		return d.skip(fieldType)
+	}
+	s, err := d.readString()
+	*v = s
+	return err
+}
+
+func readStructField(d *thriftDecoder, fieldType byte, fn func(id int16, fieldType byte) error) error {
+	if fieldType != thriftStruct {
+		return d.skip(fieldType)
+	}
+	return d.readStruct(fn)
+}
+
+func readStructList(d *thriftDecoder, fieldType byte, fn func() error) error {
+	if fieldType != thriftList {
+		return d.skip(fieldType)
+	}
+	return d.readList(func(elemType byte) error {
+		if elemType != thriftStruct {
+			return errInvalidThrift
+		}
+		return fn()
+	})
+}
+
+func readBool(fieldType byte) bool {
+	return fieldType == thriftBoolTrue
+}
+
+func (m *fileMetaData) decode(d *thriftDecoder) error {
+	return d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readI32Field(d, fieldType, &m.Version)
+		case 2:
+			return readStructList(d, fieldType, func() error {
+				e := schemaElement{ConvertedType: -1, RepetitionType: repetitionRequired}
+				if err := e.decode(d); err != nil {
+					return err
+				}
+				m.Schema = append(m.Schema, e)
+				return nil
+			})
+		case 3:
+			return readI64Field(d, fieldType, &m.NumRows)
+		case 4:
+			return readStructList(d, fieldType, func() error {
+				rg := rowGroup{}
+				if err := rg.decode(d); err != nil {
+					return err
+				}
+				m.RowGroups = append(m.RowGroups, rg)
+				return nil
+			})
+		case 6:
+			return readStringField(d, fieldType, &m.CreatedBy)
+		}
+		return d.skip(fieldType)
+	})
+}
+
+func (e *schemaElement) decode(d *thriftDecoder) error {
+	return d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readI32Field(d, fieldType, &e.Type)
+		case 2:
+			return readI32Field(d, fieldType, &e.TypeLength)
+		case 3:
+			return readI32Field(d, fieldType, &e.RepetitionType)
+		case 4:
+			return readStringField(d, fieldType, &e.Name)
+		case 5:
+			return readI32Field(d, fieldType, &e.NumChildren)
+		case 6:
+			return readI32Field(d, fieldType, &e.ConvertedType)
+		case 7:
+			return readI32Field(d, fieldType, &e.Scale)
+		case 8:
+			return readI32Field(d, fieldType, &e.Precision)
+		case 10:
+			e.LogicalType = &logicalType{}
+			return readStructField(d, fieldType, e.LogicalType.decoder(d))
+		}
+		return d.skip(fieldType)
+	})
+}
+
+func (t *logicalType) decoder(d *thriftDecoder) func(int16, byte) error {
+	return func(id int16, fieldType byte) error {
+		t.Type = id
+
+		switch id {
+		case logicalDecimal:
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					return readI32Field(d, fieldType, &t.Scale)
+				case 2:
+					return readI32Field(d, fieldType, &t.Precision)
+				}
+				return d.skip(fieldType)
+			})
+		case logicalTime, logicalTimestamp:
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					t.IsAdjustedToUTC = readBool(fieldType)
+					return nil
+				case 2:
+					return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+						t.Unit = id
+						return d.skip(fieldType)
+					})
+				}
+				return d.skip(fieldType)
+			})
+		case logicalInteger:
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					if fieldType != thriftByte {
+						return d.skip(fieldType)
+					}
+					b, err := d.readByte()
+					t.BitWidth = int8(b)
+					return err
+				case 2:
+					t.IsSigned = readBool(fieldType)
+					return nil
+				}
+				return d.skip(fieldType)
+			})
+		}
+		return d.skip(fieldType)
+	}
+}
+
+func (rg *rowGroup) decode(d *thriftDecoder) error {
+	return d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readStructList(d, fieldType, func() error {
+				cc := columnChunk{}
+				if err := cc.decode(d); err != nil {
+					return err
+				}
+				rg.Columns = append(rg.Columns, cc)
+				return nil
+			})
+		case 2:
+			return readI64Field(d, fieldType, &rg.TotalByteSize)
+		case 3:
+			return readI64Field(d, fieldType, &rg.NumRows)
+		}
+		return d.skip(fieldType)
+	})
+}
+
+func (cc *columnChunk) decode(d *thriftDecoder) error {
+	return d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readStringField(d, fieldType, &cc.FilePath)
+		case 2:
+			return readI64Field(d, fieldType, &cc.FileOffset)
+		case 3:
+			cc.MetaData = &columnMetaData{}
+			return readStructField(d, fieldType, cc.MetaData.decoder(d))
+		}
+		return d.skip(fieldType)
+	})
+}
+
+func (m *columnMetaData) decoder(d *thriftDecoder) func(int16, byte) error {
+	return func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readI32Field(d, fieldType, &m.Type)
+		case 2:
+			if fieldType != thriftList {
+				return d.skip(fieldType)
+			}
+			return d.readList(func(elemType byte) error {
+				if elemType != thriftI32 {
+					return errInvalidThrift
+				}
+				v, err := d.readI32()
+				m.Encodings = append(m.Encodings, v)
+				return err
+			})
+		case 3:
+			if fieldType != thriftList {
+				return d.skip(fieldType)
+			}
+			return d.readList(func(elemType byte) error {
+				if elemType != thriftBinary {
+					return errInvalidThrift
+				}
+				s, err := d.readString()
+				m.PathInSchema = append(m.PathInSchema, s)
+				return err
+			})
+		case 4:
+			return readI32Field(d, fieldType, &m.Codec)
+		case 5:
+			return readI64Field(d, fieldType, &m.NumValues)
+		case 6:
+			return readI64Field(d, fieldType, &m.TotalUncompressedSize)
+		case 7:
+			return readI64Field(d, fieldType, &m.TotalCompressedSize)
+		case 9:
+			return readI64Field(d, fieldType, &m.DataPageOffset)
+		case 11:
+			return readI64Field(d, fieldType, &m.DictionaryPageOffset)
+		case 12:
+			m.Statistics = &statistics{}
+			return readStructField(d, fieldType, m.Statistics.decoder(d))
+		}
+		return d.skip(fieldType)
+	}
+}
+
+func (s *statistics) decoder(d *thriftDecoder) func(int16, byte) error {
+	return func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readBinaryField(d, fieldType, &s.Max)
+		case 2:
+			return readBinaryField(d, fieldType, &s.Min)
+		case 3:
+			s.HasNullCount = fieldType == thriftI64
+			return readI64Field(d, fieldType, &s.NullCount)
+		case 5:
+			return readBinaryField(d, fieldType, &s.MaxValue)
+		case 6:
+			return readBinaryField(d, fieldType, &s.MinValue)
+		}
+		return d.skip(fieldType)
+	}
+}
+
+func (h *pageHeader) decode(d *thriftDecoder) error {
+	return d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			return readI32Field(d, fieldType, &h.Type)
+		case 2:
+			return readI32Field(d, fieldType, &h.UncompressedPageSize)
+		case 3:
+			return readI32Field(d, fieldType, &h.CompressedPageSize)
+		case 5:
+			ph := &dataPageHeader{}
+			h.DataPageHeader = ph
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					return readI32Field(d, fieldType, &ph.NumValues)
+				case 2:
+					return readI32Field(d, fieldType, &ph.Encoding)
+				case 3:
+					return readI32Field(d, fieldType, &ph.DefinitionLevelEncoding)
+				case 4:
+					return readI32Field(d, fieldType, &ph.RepetitionLevelEncoding)
+				}
+				return d.skip(fieldType)
+			})
+		case 7:
+			ph := &dictionaryPageHeader{}
+			h.DictionaryPageHeader = ph
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					return readI32Field(d, fieldType, &ph.NumValues)
+				case 2:
+					return readI32Field(d, fieldType, &ph.Encoding)
+				}
+				return d.skip(fieldType)
+			})
+		case 8:
+			ph := &dataPageHeaderV2{IsCompressed: true}
+			h.DataPageHeaderV2 = ph
+			return readStructField(d, fieldType, func(id int16, fieldType byte) error {
+				switch id {
+				case 1:
+					return readI32Field(d, fieldType, &ph.NumValues)
+				case 2:
+					return readI32Field(d, fieldType, &ph.NumNulls)
+				case 3:
+					return readI32Field(d, fieldType, &ph.NumRows)
+				case 4:
+					return readI32Field(d, fieldType, &ph.Encoding)
+				case 5:
+					return readI32Field(d, fieldType, &ph.DefinitionLevelsByteLength)
+				case 6:
+					return readI32Field(d, fieldType, &ph.RepetitionLevelsByteLength)
+				case 7:
+					ph.IsCompressed = readBool(fieldType)
+					return nil
+				}
+				return d.skip(fieldType)
+			})
+		}
+		return d.skip(fieldType)
+	})
+}
+
+func (s *statistics) encode(e *thriftEncoder) {
+	if s.Max != nil {
+		e.writeBinaryField(1, s.Max)
+	}
+	if s.Min != nil {
+		e.writeBinaryField(2, s.Min)
+	}
+	e.writeI64Field(3, s.NullCount)
+	if s.MaxValue != nil {
+		e.writeBinaryField(5, s.MaxValue)
+	}
+	if s.MinValue != nil {
+		e.writeBinaryField(6, s.MinValue)
+	}
+}
+
+func (h *pageHeader) encode(e *thriftEncoder) {
+	e.beginStruct()
+	e.writeI32Field(1, h.Type)
+	e.writeI32Field(2, h.UncompressedPageSize)
+	e.writeI32Field(3, h.CompressedPageSize)
+	ph := h.DataPageHeader
+	e.writeStructField(5, func() {
+		e.writeI32Field(1, ph.NumValues)
+		e.writeI32Field(2, ph.Encoding)
+		e.writeI32Field(3, ph.DefinitionLevelEncoding)
+		e.writeI32Field(4, ph.RepetitionLevelEncoding)
+	})
+	e.endStruct()
+}
+
+func (t *logicalType) encode(e *thriftEncoder) {
+	switch t.Type {
+	case logicalDecimal:
+		e.writeStructField(t.Type, func() {
+			e.writeI32Field(1, t.Scale)
+			e.writeI32Field(2, t.Precision)
+		})
+	case logicalTimestamp:
+		e.writeStructField(t.Type, func() {
+			e.writeBoolField(1, t.IsAdjustedToUTC)
+			e.writeStructField(2, func() {
+				e.writeEmptyStructField(t.Unit)
+			})
+		})
+	default:
+		e.writeEmptyStructField(t.Type)
+	}
+}
+
+func (m *fileMetaData) encode(e *thriftEncoder) {
+	e.beginStruct()
+	e.writeI32Field(1, m.Version)
+
+	e.writeListField(2, thriftStruct, len(m.Schema))
+	for _, s := range m.Schema {
+		e.beginStruct()
+		if s.NumChildren < 1 {
+			e.writeI32Field(1, s.Type)
+			e.writeI32Field(3, s.RepetitionType)
+		}
+		e.writeBinaryField(4, []byte(s.Name))
+		if 0 < s.NumChildren {
+			e.writeI32Field(5, s.NumChildren)
+		}
+		if -1 < s.ConvertedType {
+			e.writeI32Field(6, s.ConvertedType)
+		}
+		if s.LogicalType != nil {
+			e.writeStructField(10, func() {
+				s.LogicalType.encode(e)
+			})
+		}
+		e.endStruct()
+	}
+
+	e.writeI64Field(3, m.NumRows)
+
+	e.writeListField(4, thriftStruct, len(m.RowGroups))
+	for _, rg := range m.RowGroups {
+		e.beginStruct()
+		e.writeListField(1, thriftStruct, len(rg.Columns))
+		for _, cc := range rg.Columns {
+			e.beginStruct()
+			e.writeI64Field(2, cc.FileOffset)
+			md := cc.MetaData
+			e.writeStructField(3, func() {
+				e.writeI32Field(1, md.Type)
+				e.writeListField(2, thriftI32, len(md.Encodings))
+				for _, enc := range md.Encodings {
+					e.writeVarint(int64(enc))
+				}
+				e.writeListField(3, thriftBinary, len(md.PathInSchema))
+				for _, p := range md.PathInSchema {
+					e.writeBinary([]byte(p))
+				}
+				e.writeI32Field(4, md.Codec)
+				e.writeI64Field(5, md.NumValues)
+				e.writeI64Field(6, md.TotalUncompressedSize)
+				e.writeI64Field(7, md.TotalCompressedSize)
+				e.writeI64Field(9, md.DataPageOffset)
+				if 0 < md.DictionaryPageOffset {
+					e.writeI64Field(11, md.DictionaryPageOffset)
+				}
+				if md.Statistics != nil {
+					e.writeStructField(12, func() {
+						md.Statistics.encode(e)
+					})
+				}
+			})
+			e.endStruct()
+		}
+		e.writeI64Field(2, rg.TotalByteSize)
+		e.writeI64Field(3, rg.NumRows)
+		e.endStruct()
+	}
+
+	e.writeBinaryField(6, []byte(m.CreatedBy))
+
+	columns := len(m.Schema) - 1
+	e.writeListField(7, thriftStruct, columns)
+	for i := 0; i < columns; i++ {
+		e.beginStruct()
+		e.writeEmptyStructField(1)
+		e.endStruct()
+	}
+	e.endStruct()
+}
@@ -0,0 +1,502 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	defer func(size int) { rowGroupSize = size }(rowGroupSize)
+	rowGroupSize = 2
+
+	loc := time.FixedZone("", 9*3600)
+	names := []string{"id", "amount", "active", "created", "name", "mixed", "empty"}
+	rows := [][]interface{}{
+		{int64(1), int64(10), true, time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC), "apple", int64(1), nil},
+		{int64(2), 1.5, nil, time.Date(2012, 2, 4, 0, 0, 0, 123000, time.UTC), nil, "a", nil},
+		{nil, math.Inf(1), false, nil, "", true},
+	}
+
+	fields := InferFields(names, rows)
+	expectFields := []Field{
+		{Name: "id", Type: IntegerField},
+		{Name: "amount", Type: FloatField},
+		{Name: "active", Type: BooleanField},
+		{Name: "created", Type: DatetimeField},
+		{Name: "name", Type: StringField},
+		{Name: "mixed", Type: StringField},
+		{Name: "empty", Type: StringField},
+	}
+	if !reflect.DeepEqual(fields, expectFields) {
+		t.Fatalf("fields = %v, want %v", fields, expectFields)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := Write(buf, fields, rows); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(f.ColumnNames(), names) {
+		t.Errorf("column names = %q, want %q", f.ColumnNames(), names)
+	}
+	if f.NumRows() != 3 || f.NumRowGroups() != 2 || f.RowGroupNumRows(1) != 1 {
+		t.Errorf("rows = %d, row groups = %d, rows of the last row group = %d, want 3, 2, 1", f.NumRows(), f.NumRowGroups(), f.RowGroupNumRows(1))
+	}
+
+	expect := [][]interface{}{
+		{int64(1), int64(2), nil},
+		{float64(10), 1.5, math.Inf(1)},
+		{true, nil, false},
+		{time.Date(2012, 2, 3, 18, 18, 15, 0, loc), time.Date(2012, 2, 4, 9, 0, 0, 123000, loc), nil},
+		{"apple", nil, ""},
+		{"1", "a", "true"},
+		{nil, nil, nil},
+	}
+	for col := range names {
+		var values []interface{}
+		for rg := 0; rg < f.NumRowGroups(); rg++ {
+			v, err := f.ReadColumn(rg, col, loc)
+			if err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+			values = append(values, v...)
+		}
+		if !reflect.DeepEqual(values, expect[col]) {
+			t.Errorf("values of %s = %v, want %v", names[col], values, expect[col])
+		}
+	}
+
+	stats := f.ColumnStatistics(0, 0, loc)
+	expectStats := Statistics{Min: int64(1), Max: int64(2), HasMinMax: true, NullCount: 0, HasNullCount: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+	stats = f.ColumnStatistics(1, 0, loc)
+	expectStats = Statistics{NullCount: 1, HasNullCount: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+	stats = f.ColumnStatistics(0, 3, loc)
+	expectStats = Statistics{Min: time.Date(2012, 2, 3, 18, 18, 15, 0, loc), Max: time.Date(2012, 2, 4, 9, 0, 0, 123000, loc), HasMinMax: true, NullCount: 0, HasNullCount: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+	if stats = f.ColumnStatistics(0, 4, loc); stats.HasMinMax {
+		t.Errorf("statistics of strings are available, want unavailable")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	if _, err := Open(bytes.NewReader([]byte("PAR1....PAR2")), 12); err != ErrNotParquet {
+		t.Errorf("error = %v, want %v", err, ErrNotParquet)
+	}
+	if _, err := Open(bytes.NewReader([]byte("PAR1")), 4); err != ErrNotParquet {
+		t.Errorf("error = %v, want %v", err, ErrNotParquet)
+	}
+}
+
+// flat.parquet in testdata/parquet is examples/flat.parquet.snappy of
+// github.com/xitongsys/parquet-go-source (Apache License 2.0), written by
+// github.com/xitongsys/parquet-go with the snappy codec.
+func openExternalFile(t *testing.T) *File {
+	fp, err := os.Open(filepath.Join("..", "..", "testdata", "parquet", "flat.parquet"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	t.Cleanup(func() { _ = fp.Close() })
+
+	info, err := fp.Stat()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	f, err := Open(fp, info.Size())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return f
+}
+
+func readAllColumns(t *testing.T, f *File, loc *time.Location) [][]interface{} {
+	columns := make([][]interface{}, len(f.ColumnNames()))
+	for col := range columns {
+		for rg := 0; rg < f.NumRowGroups(); rg++ {
+			v, err := f.ReadColumn(rg, col, loc)
+			if err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+			columns[col] = append(columns[col], v...)
+		}
+	}
+	return columns
+}
+
+func TestOpen_ExternalFile(t *testing.T) {
+	f := openExternalFile(t)
+
+	names := []string{"name", "age", "id", "weight", "sex", "day"}
+	if !reflect.DeepEqual(f.ColumnNames(), names) {
+		t.Errorf("column names = %q, want %q", f.ColumnNames(), names)
+	}
+	if f.NumRows() != 10 {
+		t.Errorf("rows = %d, want 10", f.NumRows())
+	}
+
+	day := time.Date(2019, 5, 24, 0, 0, 0, 0, time.UTC)
+	expect := make([][]interface{}, len(names))
+	weights := []float64{50, 50.1, 50.2, 50.3, 50.4, 50.5, 50.6, 50.7, 50.8, 50.9}
+	for i := 0; i < 10; i++ {
+		expect[0] = append(expect[0], "StudentName")
+		expect[1] = append(expect[1], int64(20+i%5))
+		expect[2] = append(expect[2], int64(i))
+		expect[3] = append(expect[3], weights[i])
+		expect[4] = append(expect[4], i%2 == 0)
+		expect[5] = append(expect[5], day)
+	}
+	if values := readAllColumns(t, f, time.UTC); !reflect.DeepEqual(values, expect) {
+		t.Errorf("values = %v, want %v", values, expect)
+	}
+
+	stats := f.ColumnStatistics(0, 1, time.UTC)
+	expectStats := Statistics{Min: int64(20), Max: int64(24), HasMinMax: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+	stats = f.ColumnStatistics(0, 3, time.UTC)
+	expectStats = Statistics{Min: float64(50), Max: 50.9, HasMinMax: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+	stats = f.ColumnStatistics(0, 5, time.UTC)
+	expectStats = Statistics{Min: day, Max: day, HasMinMax: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+}
+
+func TestWrite_ExternalFile(t *testing.T) {
+	external := openExternalFile(t)
+	names := external.ColumnNames()
+	columns := readAllColumns(t, external, time.UTC)
+
+	rows := make([][]interface{}, external.NumRows())
+	for i := range rows {
+		rows[i] = make([]interface{}, len(names))
+		for col := range names {
+			rows[i][col] = columns[col][i]
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := Write(buf, InferFields(names, rows), rows); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if !reflect.DeepEqual(f.ColumnNames(), names) {
+		t.Errorf("column names = %q, want %q", f.ColumnNames(), names)
+	}
+	if values := readAllColumns(t, f, time.UTC); !reflect.DeepEqual(values, columns) {
+		t.Errorf("values = %v, want %v", values, columns)
+	}
+	for col := range names {
+		expectStats := external.ColumnStatistics(0, col, time.UTC)
+		if !expectStats.HasMinMax {
+			continue
+		}
+		stats := f.ColumnStatistics(0, col, time.UTC)
+		if stats.Min != expectStats.Min || stats.Max != expectStats.Max {
+			t.Errorf("min and max of %s = %v, %v, want %v, %v", names[col], stats.Min, stats.Max, expectStats.Min, expectStats.Max)
+		}
+	}
+}
+
+func TestFile_ReadColumn(t *testing.T) {
+	var pageHeader = func(pageType int32, uncompressed int, compressed int, fn func(e *thriftEncoder)) []byte {
+		e := &thriftEncoder{}
+		e.beginStruct()
+		e.writeI32Field(1, pageType)
+		e.writeI32Field(2, int32(uncompressed))
+		e.writeI32Field(3, int32(compressed))
+		fn(e)
+		e.endStruct()
+		return e.buf
+	}
+	var plainInt32 = func(values ...int32) []byte {
+		b := make([]byte, 4*len(values))
+		for i, v := range values {
+			binary.LittleEndian.PutUint32(b[i*4:], uint32(v))
+		}
+		return b
+	}
+
+	file := []byte(magic)
+	var chunks []columnChunk
+
+	// Column "name": a dictionary page and a data page v2 with dictionary indices.
+	offset := int64(len(file))
+	dictPage := []byte{1, 0, 0, 0, 'x', 1, 0, 0, 0, 'y'}
+	compressed := snappy.Encode(nil, dictPage)
+	file = append(file, pageHeader(pageDictionary, len(dictPage), len(compressed), func(e *thriftEncoder) {
+		e.writeStructField(7, func() {
+			e.writeI32Field(1, 2)
+			e.writeI32Field(2, encodingPlain)
+		})
+	})...)
+	file = append(file, compressed...)
+	dataOffset := int64(len(file))
+	levels := []byte{3, 0x05}
+	values := []byte{1, 0x03, 0x01}
+	file = append(file, pageHeader(pageDataV2, len(levels)+len(values), len(levels)+len(values), func(e *thriftEncoder) {
+		e.writeStructField(8, func() {
+			e.writeI32Field(1, 3)
+			e.writeI32Field(2, 1)
+			e.writeI32Field(3, 3)
+			e.writeI32Field(4, encodingRLEDictionary)
+			e.writeI32Field(5, int32(len(levels)))
+			e.writeI32Field(6, 0)
+			e.writeBoolField(7, false)
+		})
+	})...)
+	file = append(file, levels...)
+	file = append(file, values...)
+	chunks = append(chunks, columnChunk{MetaData: &columnMetaData{
+		Type:                 typeByteArray,
+		Codec:                codecSnappy,
+		NumValues:            3,
+		DataPageOffset:       dataOffset,
+		DictionaryPageOffset: offset,
+		TotalCompressedSize:  int64(len(file)) - offset,
+	}})
+
+	// Column "day": a data page compressed with zstd.
+	offset = int64(len(file))
+	page := plainInt32(0, 19000, -1)
+	enc, _ := zstd.NewWriter(nil)
+	compressed = enc.EncodeAll(page, nil)
+	_ = enc.Close()
+	file = append(file, pageHeader(pageData, len(page), len(compressed), func(e *thriftEncoder) {
+		e.writeStructField(5, func() {
+			e.writeI32Field(1, 3)
+			e.writeI32Field(2, encodingPlain)
+			e.writeI32Field(3, encodingRLE)
+			e.writeI32Field(4, encodingRLE)
+		})
+	})...)
+	file = append(file, compressed...)
+	chunks = append(chunks, columnChunk{MetaData: &columnMetaData{
+		Type:                typeInt32,
+		Codec:               codecZstd,
+		NumValues:           3,
+		DataPageOffset:      offset,
+		TotalCompressedSize: int64(len(file)) - offset,
+		Statistics:          &statistics{Min: plainInt32(-1), Max: plainInt32(19000)},
+	}})
+
+	// Column "amount": a data page encoded with DELTA_BINARY_PACKED.
+	offset = int64(len(file))
+	page = []byte{0x80, 0x01, 0x04, 0x03, 0x0e, 0x03, 0x02, 0x00, 0x00, 0x00, 0x30, 0, 0, 0, 0, 0, 0, 0}
+	file = append(file, pageHeader(pageData, len(page), len(page), func(e *thriftEncoder) {
+		e.writeStructField(5, func() {
+			e.writeI32Field(1, 3)
+			e.writeI32Field(2, encodingDeltaBinaryPacked)
+			e.writeI32Field(3, encodingRLE)
+			e.writeI32Field(4, encodingRLE)
+		})
+	})...)
+	file = append(file, page...)
+	chunks = append(chunks, columnChunk{MetaData: &columnMetaData{
+		Type:                typeInt64,
+		Codec:               codecUncompressed,
+		NumValues:           3,
+		DataPageOffset:      offset,
+		TotalCompressedSize: int64(len(file)) - offset,
+	}})
+
+	meta := &fileMetaData{
+		Version: 1,
+		Schema: []schemaElement{
+			{Name: "schema", NumChildren: 3, ConvertedType: -1},
+			{Name: "name", Type: typeByteArray, RepetitionType: repetitionOptional, ConvertedType: convertedUTF8},
+			{Name: "day", Type: typeInt32, RepetitionType: repetitionRequired, ConvertedType: convertedDate},
+			{Name: "amount", Type: typeInt64, RepetitionType: repetitionRequired, ConvertedType: -1, LogicalType: &logicalType{Type: logicalDecimal, Scale: 2, Precision: 10}},
+		},
+		NumRows:   3,
+		RowGroups: []rowGroup{{Columns: chunks, NumRows: 3}},
+	}
+	e := &thriftEncoder{}
+	meta.encode(e)
+	file = append(file, e.buf...)
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, uint32(len(e.buf)))
+	file = append(file, footer...)
+	file = append(file, magic...)
+
+	f, err := Open(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := [][]interface{}{
+		{"y", nil, "x"},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{0.07, 0.05, 0.03},
+	}
+	for col := range expect {
+		values, err := f.ReadColumn(0, col, time.UTC)
+		if err != nil {
+			t.Fatalf("unexpected error %q for column %d", err, col)
+		}
+		if !reflect.DeepEqual(values, expect[col]) {
+			t.Errorf("values of column %d = %v, want %v", col, values, expect[col])
+		}
+	}
+
+	stats := f.ColumnStatistics(0, 1, time.UTC)
+	expectStats := Statistics{Min: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), Max: time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), HasMinMax: true, HasNullCount: true}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("statistics = %v, want %v", stats, expectStats)
+	}
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	data := []byte{0x03, 0x88, 0xc6, 0xfa, 0x06, 0x05}
+	result, err := decodeHybrid(data, 3, 11)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []int32{0, 1, 2, 3, 4, 5, 6, 7, 5, 5, 5}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	levels := []int32{1, 1, 0, 1, 0, 0, 0}
+	if result, _ = decodeHybrid(encodeHybrid(levels, 1), 1, len(levels)); !reflect.DeepEqual(result, levels) {
+		t.Errorf("result = %v, want %v", result, levels)
+	}
+
+	if _, err = decodeHybrid([]byte{0x03, 0x88}, 3, 8); err == nil {
+		t.Errorf("no error, want error for truncated data")
+	}
+}
+
+func TestDecodeDeltaBinaryPacked(t *testing.T) {
+	data := []byte{0x80, 0x01, 0x04, 0x05, 0x02, 0x02, 0x00, 0x00, 0x00, 0x00, 0xff}
+	result, n, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if expect := []int64{1, 2, 3, 4, 5}; !reflect.DeepEqual(result, expect) || n != 10 {
+		t.Errorf("result = %v, %d, want %v, %d", result, n, expect, 10)
+	}
+
+	data = []byte{0x80, 0x01, 0x04, 0x08, 0x0e, 0x03, 0x02, 0x00, 0x00, 0x00, 0xc0, 0x3f, 0, 0, 0, 0, 0, 0}
+	result, n, err = decodeDeltaBinaryPacked(data)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if expect := []int64{7, 5, 3, 1, 2, 3, 4, 5}; !reflect.DeepEqual(result, expect) || n != len(data) {
+		t.Errorf("result = %v, %d, want %v, %d", result, n, expect, len(data))
+	}
+}
+
+func TestDecodeDeltaByteArray(t *testing.T) {
+	data := append([]byte{0x80, 0x01, 0x04, 0x04, 0x0a, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0, 0, 0}, "HelloWorldFoobarABCDEF"...)
+	result, err := decodeDeltaLengthByteArray(data)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if expect := [][]byte{[]byte("Hello"), []byte("World"), []byte("Foobar"), []byte("ABCDEF")}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+
+	data = []byte{0x80, 0x01, 0x04, 0x04, 0x00, 0x03, 0x03, 0x00, 0x00, 0x00, 0x44, 0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	data = append(data, 0x80, 0x01, 0x04, 0x04, 0x08, 0x03, 0x03, 0x00, 0x00, 0x00, 0x70, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	data = append(data, "axislebabbleyhood"...)
+	result, err = decodeDeltaByteArray(data)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if expect := [][]byte{[]byte("axis"), []byte("axle"), []byte("babble"), []byte("babyhood")}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
+
+func TestDecodeByteStreamSplit(t *testing.T) {
+	plain := make([]byte, 16)
+	binary.LittleEndian.PutUint64(plain, math.Float64bits(1.5))
+	binary.LittleEndian.PutUint64(plain[8:], math.Float64bits(-2))
+	data := make([]byte, 16)
+	for i := 0; i < 2; i++ {
+		for b := 0; b < 8; b++ {
+			data[b*2+i] = plain[i*8+b]
+		}
+	}
+
+	result, err := decodeValues(typeDouble, 0, encodingByteStreamSplit, data, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if expect := []interface{}{1.5, float64(-2)}; !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	if _, err = decodeValues(typeInt32, 0, 42, nil, 0); err == nil || err.Error() != "encoding 42 is not supported" {
+		t.Errorf("error = %v, want %q", err, "encoding 42 is not supported")
+	}
+}
+
+func TestThriftDecoder_Skip(t *testing.T) {
+	e := &thriftEncoder{}
+	e.beginStruct()
+	e.writeI32Field(1, 7)
+	e.writeFieldHeader(2, thriftMap)
+	e.writeUvarint(1)
+	e.writeByte(thriftBinary<<4 | thriftDouble)
+	e.writeBinary([]byte("key"))
+	e.buf = append(e.buf, 0, 0, 0, 0, 0, 0, 0, 0)
+	e.writeListField(3, thriftBoolTrue, 2)
+	e.writeByte(1)
+	e.writeByte(2)
+	e.writeStructField(20, func() {
+		e.writeBinaryField(1, []byte("nested"))
+		e.writeBoolField(2, true)
+	})
+	e.writeI64Field(21, 9)
+	e.endStruct()
+
+	var i32, i64 int64
+	d := newThriftDecoder(e.buf)
+	err := d.readStruct(func(id int16, fieldType byte) error {
+		switch id {
+		case 1:
+			v, err := d.readI32()
+			i32 = int64(v)
+			return err
+		case 21:
+			return readI64Field(d, fieldType, &i64)
+		}
+		return d.skip(fieldType)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if i32 != 7 || i64 != 9 || d.pos != len(e.buf) {
+		t.Errorf("result = %d, %d, %d, want %d, %d, %d", i32, i64, d.pos, 7, 9, len(e.buf))
+	}
+}
@@ -0,0 +1,595 @@
+// Package parquet implements reading and writing of flat tables in the Apache Parquet format.
+//
+// Values are represented as nil, string, int64, float64, bool or time.Time.
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const magic = "PAR1"
+
+var ErrNotParquet = errors.New("not a parquet file")
+
+var (
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+)
+
+type column struct {
+	Name     string
+	Element  schemaElement
+	Optional bool
+}
+
+// File is a parquet file opened for reading.
+type File struct {
+	r    io.ReaderAt
+	size int64
+	meta *fileMetaData
+
+	columns []column
+}
+
+// Statistics holds the statistics of a column chunk.
+// Min and Max are set only if the values of the column are ordered
+// in the same way as the converted values.
+type Statistics struct {
+	Min          interface{}
+	Max          interface{}
+	HasMinMax    bool
+	NullCount    int64
+	HasNullCount bool
+}
+
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(len(magic)*2+4) {
+		return nil, ErrNotParquet
+	}
+
+	footer := make([]byte, 8)
+	if _, err := r.ReadAt(footer, size-8); err != nil {
+		return nil, err
+	}
+	if string(footer[4:]) != magic {
+		return nil, ErrNotParquet
+	}
+	metaLen := int64(binary.LittleEndian.Uint32(footer))
+	if size-int64(len(magic))-8 < metaLen {
+		return nil, errors.New("invalid metadata length")
+	}
+
+	buf := make([]byte, metaLen)
+	if _, err := r.ReadAt(buf, size-8-metaLen); err != nil {
+		return nil, err
+	}
+	meta := &fileMetaData{}
+	if err := meta.decode(newThriftDecoder(buf)); err != nil {
+		return nil, fmt.Errorf("metadata: %s", err.Error())
+	}
+
+	f := &File{
+		r:    r,
+		size: size,
+		meta: meta,
+	}
+
+	if len(meta.Schema) < 1 {
+		return nil, errors.New("schema is empty")
+	}
+	if int(meta.Schema[0].NumChildren) != len(meta.Schema)-1 {
+		return nil, errors.New("nested columns are not supported")
+	}
+	for _, e := range meta.Schema[1:] {
+		if 0 < e.NumChildren {
+			return nil, fmt.Errorf("nested column %s is not supported", e.Name)
+		}
+		if e.RepetitionType == repetitionRepeated {
+			return nil, fmt.Errorf("repeated column %s is not supported", e.Name)
+		}
+		f.columns = append(f.columns, column{
+			Name:     e.Name,
+			Element:  e,
+			Optional: e.RepetitionType == repetitionOptional,
+		})
+	}
+
+	for i, rg := range meta.RowGroups {
+		if len(rg.Columns) != len(f.columns) {
+			return nil, fmt.Errorf("row group %d has a wrong number of columns", i)
+		}
+	}
+	return f, nil
+}
+
+func (f *File) ColumnNames() []string {
+	names := make([]string, len(f.columns))
+	for i := range f.columns {
+		names[i] = f.columns[i].Name
+	}
+	return names
+}
+
+func (f *File) NumRows() int64 {
+	return f.meta.NumRows
+}
+
+func (f *File) NumRowGroups() int {
+	return len(f.meta.RowGroups)
+}
+
+func (f *File) RowGroupNumRows(rowGroup int) int64 {
+	return f.meta.RowGroups[rowGroup].NumRows
+}
+
+// ColumnStatistics returns the statistics of a column in a row group.
+func (f *File) ColumnStatistics(rowGroup int, col int, loc *time.Location) Statistics {
+	stats := Statistics{}
+
+	md := f.meta.RowGroups[rowGroup].Columns[col].MetaData
+	if md == nil || md.Statistics == nil {
+		return stats
+	}
+	s := md.Statistics
+	stats.NullCount, stats.HasNullCount = s.NullCount, s.HasNullCount
+
+	c := f.columns[col]
+	if !c.isSignedOrder() {
+		return stats
+	}
+	min, max := s.MinValue, s.MaxValue
+	if min == nil || max == nil {
+		min, max = s.Min, s.Max
+	}
+	if min == nil || max == nil {
+		return stats
+	}
+
+	minValues, err := decodePlain(c.Element.Type, c.Element.TypeLength, min, 1)
+	if err != nil {
+		return stats
+	}
+	maxValues, err := decodePlain(c.Element.Type, c.Element.TypeLength, max, 1)
+	if err != nil {
+		return stats
+	}
+	stats.Min, stats.Max = c.convert(minValues[0], loc), c.convert(maxValues[0], loc)
+
+	if fmin, ok := stats.Min.(float64); ok && math.IsNaN(fmin) {
+		return stats
+	}
+	if fmax, ok := stats.Max.(float64); ok && math.IsNaN(fmax) {
+		return stats
+	}
+	stats.HasMinMax = true
+	return stats
+}
+
+// ReadColumn reads the values of a column in a row group.
+// Datetime values that are not adjusted to UTC are returned in loc.
+func (f *File) ReadColumn(rowGroup int, col int, loc *time.Location) ([]interface{}, error) {
+	c := f.columns[col]
+	cc := f.meta.RowGroups[rowGroup].Columns[col]
+	if 0 < len(cc.FilePath) {
+		return nil, fmt.Errorf("column %s is stored in an external file", c.Name)
+	}
+	md := cc.MetaData
+	if md == nil {
+		return nil, fmt.Errorf("metadata of column %s does not exist", c.Name)
+	}
+
+	start := md.DataPageOffset
+	if 0 < md.DictionaryPageOffset && md.DictionaryPageOffset < start {
+		start = md.DictionaryPageOffset
+	}
+	if start < int64(len(magic)) || md.NumValues < 0 || md.TotalCompressedSize < 0 || f.size-start < md.TotalCompressedSize {
+		return nil, fmt.Errorf("column %s: invalid column chunk offset", c.Name)
+	}
+	buf := make([]byte, md.TotalCompressedSize)
+	if _, err := f.r.ReadAt(buf, start); err != nil {
+		return nil, err
+	}
+
+	values, err := c.readPages(buf, md, loc)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", c.Name, err.Error())
+	}
+	if int64(len(values)) != f.meta.RowGroups[rowGroup].NumRows {
+		return nil, fmt.Errorf("column %s: wrong number of values", c.Name)
+	}
+	return values, nil
+}
+
+func (c column) readPages(buf []byte, md *columnMetaData, loc *time.Location) ([]interface{}, error) {
+	values := make([]interface{}, 0, md.NumValues)
+	var dictionary []interface{}
+
+	pos := 0
+	for int64(len(values)) < md.NumValues && pos < len(buf) {
+		d := newThriftDecoder(buf[pos:])
+		header := pageHeader{}
+		if err := header.decode(d); err != nil {
+			return nil, err
+		}
+		pos += d.pos
+
+		size := int(header.CompressedPageSize)
+		if size < 0 || len(buf)-pos < size {
+			return nil, errCorruptedPage
+		}
+		page := buf[pos : pos+size]
+		pos += size
+
+		switch header.Type {
+		case pageDictionary:
+			if header.DictionaryPageHeader == nil {
+				return nil, errCorruptedPage
+			}
+			data, err := decompress(md.Codec, page, int(header.UncompressedPageSize))
+			if err != nil {
+				return nil, err
+			}
+			raw, err := decodePlain(c.Element.Type, c.Element.TypeLength, data, int(header.DictionaryPageHeader.NumValues))
+			if err != nil {
+				return nil, err
+			}
+			dictionary = make([]interface{}, len(raw))
+			for i := range raw {
+				dictionary[i] = c.convert(raw[i], loc)
+			}
+
+		case pageData:
+			ph := header.DataPageHeader
+			if ph == nil {
+				return nil, errCorruptedPage
+			}
+			data, err := decompress(md.Codec, page, int(header.UncompressedPageSize))
+			if err != nil {
+				return nil, err
+			}
+
+			var levels []int32
+			if c.Optional {
+				if ph.DefinitionLevelEncoding != encodingRLE {
+					return nil, fmt.Errorf("encoding %s of definition levels is not supported", encodingName(ph.DefinitionLevelEncoding))
+				}
+				var n int
+				if levels, n, err = decodeLengthPrefixedHybrid(data, 1, int(ph.NumValues)); err != nil {
+					return nil, err
+				}
+				data = data[n:]
+			}
+
+			if values, err = c.appendValues(values, levels, int(ph.NumValues), ph.Encoding, data, dictionary, loc); err != nil {
+				return nil, err
+			}
+
+		case pageDataV2:
+			ph := header.DataPageHeaderV2
+			if ph == nil {
+				return nil, errCorruptedPage
+			}
+			repLen, defLen := int(ph.RepetitionLevelsByteLength), int(ph.DefinitionLevelsByteLength)
+			if repLen < 0 || defLen < 0 || len(page) < repLen+defLen {
+				return nil, errCorruptedPage
+			}
+
+			var levels []int32
+			var err error
+			if c.Optional {
+				if levels, err = decodeHybrid(page[repLen:repLen+defLen], 1, int(ph.NumValues)); err != nil {
+					return nil, err
+				}
+			}
+
+			data := page[repLen+defLen:]
+			if ph.IsCompressed {
+				if data, err = decompress(md.Codec, data, int(header.UncompressedPageSize)-repLen-defLen); err != nil {
+					return nil, err
+				}
+			}
+
+			if values, err = c.appendValues(values, levels, int(ph.NumValues), ph.Encoding, data, dictionary, loc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+func (c column) appendValues(values []interface{}, levels []int32, count int, encoding int32, data []byte, dictionary []interface{}, loc *time.Location) ([]interface{}, error) {
+	notNull := count
+	if levels != nil {
+		notNull = 0
+		for _, l := range levels {
+			if l == 1 {
+				notNull++
+			}
+		}
+	}
+
+	var decoded []interface{}
+	switch encoding {
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dictionary == nil {
+			return nil, errors.New("dictionary page does not exist")
+		}
+		if len(data) < 1 {
+			if 0 < notNull {
+				return nil, errCorruptedPage
+			}
+			break
+		}
+		indices, err := decodeHybrid(data[1:], int(data[0]), notNull)
+		if err != nil {
+			return nil, err
+		}
+		decoded = make([]interface{}, notNull)
+		for i, idx := range indices {
+			if idx < 0 || len(dictionary) <= int(idx) {
+				return nil, errCorruptedPage
+			}
+			decoded[i] = dictionary[idx]
+		}
+	default:
+		raw, err := decodeValues(c.Element.Type, c.Element.TypeLength, encoding, data, notNull)
+		if err != nil {
+			return nil, err
+		}
+		decoded = make([]interface{}, notNull)
+		for i := range raw {
+			decoded[i] = c.convert(raw[i], loc)
+		}
+	}
+
+	if levels == nil {
+		return append(values, decoded...), nil
+	}
+	idx := 0
+	for _, l := range levels {
+		if l == 1 {
+			values = append(values, decoded[idx])
+			idx++
+		} else {
+			values = append(values, nil)
+		}
+	}
+	return values, nil
+}
+
+func decompress(codec int32, data []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(make([]byte, 0, size), data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = r.Close()
+		}()
+		return ioutil.ReadAll(r)
+	case codecZstd:
+		zstdDecoderOnce.Do(func() {
+			zstdDecoder, zstdDecoderErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		})
+		if zstdDecoderErr != nil {
+			return nil, zstdDecoderErr
+		}
+		return zstdDecoder.DecodeAll(data, make([]byte, 0, size))
+	}
+	return nil, fmt.Errorf("compression codec %s is not supported", codecName(codec))
+}
+
+func codecName(codec int32) string {
+	switch codec {
+	case 3:
+		return "LZO"
+	case 4:
+		return "BROTLI"
+	case 5:
+		return "LZ4"
+	case 7:
+		return "LZ4_RAW"
+	}
+	return strconv.Itoa(int(codec))
+}
+
+func (c column) logicalType() int16 {
+	if c.Element.LogicalType != nil {
+		return c.Element.LogicalType.Type
+	}
+	switch c.Element.ConvertedType {
+	case convertedUTF8:
+		return logicalString
+	case convertedEnum:
+		return logicalEnum
+	case convertedJSON:
+		return logicalJSON
+	case convertedDecimal:
+		return logicalDecimal
+	case convertedDate:
+		return logicalDate
+	case convertedTimeMillis, convertedTimeMicros:
+		return logicalTime
+	case convertedTimestampMillis, convertedTimestampMicros:
+		return logicalTimestamp
+	case convertedUint8, convertedUint16, convertedUint32, convertedUint64:
+		return logicalInteger
+	}
+	return 0
+}
+
+func (c column) isUnsigned() bool {
+	if c.Element.LogicalType != nil {
+		return c.Element.LogicalType.Type == logicalInteger && !c.Element.LogicalType.IsSigned
+	}
+	switch c.Element.ConvertedType {
+	case convertedUint8, convertedUint16, convertedUint32, convertedUint64:
+		return true
+	}
+	return false
+}
+
+func (c column) timeUnit() int16 {
+	if c.Element.LogicalType != nil {
+		return c.Element.LogicalType.Unit
+	}
+	switch c.Element.ConvertedType {
+	case convertedTimeMillis, convertedTimestampMillis:
+		return unitMillis
+	}
+	return unitMicros
+}
+
+func (c column) isAdjustedToUTC() bool {
+	if c.Element.LogicalType != nil {
+		return c.Element.LogicalType.IsAdjustedToUTC
+	}
+	return true
+}
+
+func (c column) decimalScale() int32 {
+	if c.Element.LogicalType != nil && c.Element.LogicalType.Type == logicalDecimal {
+		return c.Element.LogicalType.Scale
+	}
+	return c.Element.Scale
+}
+
+// isSignedOrder reports whether the statistics of the column can be compared as the converted values.
+func (c column) isSignedOrder() bool {
+	switch c.Element.Type {
+	case typeInt32, typeInt64:
+		if c.isUnsigned() {
+			return false
+		}
+		switch c.logicalType() {
+		case 0, logicalInteger, logicalDecimal, logicalDate, logicalTimestamp:
+			return true
+		}
+	case typeFloat, typeDouble:
+		return true
+	}
+	return false
+}
+
+func (c column) convert(raw interface{}, loc *time.Location) interface{} {
+	switch v := raw.(type) {
+	case bool:
+		return v
+	case int32:
+		switch c.logicalType() {
+		case logicalDate:
+			t := time.Unix(int64(v)*86400, 0).UTC()
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		case logicalTime:
+			return timeOfDay(int64(v), c.timeUnit())
+		case logicalDecimal:
+			return decimalToFloat(big.NewInt(int64(v)), c.decimalScale())
+		}
+		if c.isUnsigned() {
+			return int64(uint32(v))
+		}
+		return int64(v)
+	case int64:
+		switch c.logicalType() {
+		case logicalTimestamp:
+			return timestamp(v, c.timeUnit(), c.isAdjustedToUTC(), loc)
+		case logicalTime:
+			return timeOfDay(v, c.timeUnit())
+		case logicalDecimal:
+			return decimalToFloat(big.NewInt(v), c.decimalScale())
+		}
+		if c.isUnsigned() && v < 0 {
+			return float64(uint64(v))
+		}
+		return v
+	case int96:
+		nanos := int64(binary.LittleEndian.Uint64(v[:8]))
+		days := int64(binary.LittleEndian.Uint32(v[8:]))
+		return time.Unix((days-2440588)*86400, nanos).In(loc)
+	case float32:
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+		return f
+	case float64:
+		return v
+	case []byte:
+		switch c.logicalType() {
+		case logicalDecimal:
+			return decimalToFloat(bigIntFromBytes(v), c.decimalScale())
+		case logicalUUID:
+			if len(v) == 16 {
+				return fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16])
+			}
+		}
+		return string(v)
+	}
+	return nil
+}
+
+func timestamp(v int64, unit int16, adjustedToUTC bool, loc *time.Location) time.Time {
+	var t time.Time
+	switch unit {
+	case unitMillis:
+		t = time.Unix(v/1e3, (v%1e3)*1e6)
+	case unitNanos:
+		t = time.Unix(0, v)
+	default:
+		t = time.Unix(v/1e6, (v%1e6)*1e3)
+	}
+
+	if adjustedToUTC {
+		return t.In(loc)
+	}
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+func timeOfDay(v int64, unit int16) string {
+	var d time.Duration
+	switch unit {
+	case unitMillis:
+		d = time.Duration(v) * time.Millisecond
+	case unitNanos:
+		d = time.Duration(v)
+	default:
+		d = time.Duration(v) * time.Microsecond
+	}
+	return time.Unix(0, 0).UTC().Add(d).Format("15:04:05.999999999")
+}
+
+func bigIntFromBytes(b []byte) *big.Int {
+	i := new(big.Int).SetBytes(b)
+	if 0 < len(b) && b[0]&0x80 != 0 {
+		i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return i
+}
+
+func decimalToFloat(unscaled *big.Int, scale int32) float64 {
+	if scale <= 0 {
+		f, _ := new(big.Float).SetInt(unscaled).Float64()
+		return f
+	}
+	r := new(big.Rat).SetFrac(unscaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	f, _ := r.Float64()
+	return f
+}
@@ -0,0 +1,313 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Type identifiers of the Thrift compact protocol.
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftByte      = 3
+	thriftI16       = 4
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftDouble    = 7
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftSet       = 10
+	thriftMap       = 11
+	thriftStruct    = 12
+)
+
+const maxThriftDepth = 64
+
+var errInvalidThrift = errors.New("invalid thrift data")
+
+type thriftDecoder struct {
+	buf   []byte
+	pos   int
+	depth int
+}
+
+func newThriftDecoder(buf []byte) *thriftDecoder {
+	return &thriftDecoder{buf: buf}
+}
+
+func (d *thriftDecoder) readByte() (byte, error) {
+	if len(d.buf) <= d.pos {
+		return 0, errInvalidThrift
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *thriftDecoder) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errInvalidThrift
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *thriftDecoder) readVarint() (int64, error) {
+	v, n := binary.Varint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errInvalidThrift
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *thriftDecoder) readI32() (int32, error) {
+	v, err := d.readVarint()
+	return int32(v), err
+}
+
+func (d *thriftDecoder) readI64() (int64, error) {
+	return d.readVarint()
+}
+
+func (d *thriftDecoder) readDouble() (float64, error) {
+	if len(d.buf) < d.pos+8 {
+		return 0, errInvalidThrift
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf[d.pos:]))
+	d.pos += 8
+	return v, nil
+}
+
+func (d *thriftDecoder) readBinary() ([]byte, error) {
+	l, err := d.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(d.buf)-d.pos) < l {
+		return nil, errInvalidThrift
+	}
+	b := d.buf[d.pos : d.pos+int(l)]
+	d.pos += int(l)
+	return b, nil
+}
+
+func (d *thriftDecoder) readString() (string, error) {
+	b, err := d.readBinary()
+	return string(b), err
+}
+
+func (d *thriftDecoder) readListHeader() (byte, int, error) {
+	b, err := d.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := int(b >> 4)
+	if size == 15 {
+		l, err := d.readUvarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		if uint64(len(d.buf)-d.pos) < l {
+			return 0, 0, errInvalidThrift
+		}
+		size = int(l)
+	}
+	return b & 0x0f, size, nil
+}
+
+func (d *thriftDecoder) readList(fn func(elemType byte) error) error {
+	elemType, size, err := d.readListHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		if err = fn(elemType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStruct reads the fields of a struct and passes them to fn.
+// fn must read the value of the field or call skip.
+// The values of boolean fields are held in their types.
+func (d *thriftDecoder) readStruct(fn func(id int16, fieldType byte) error) error {
+	d.depth++
+	if maxThriftDepth < d.depth {
+		return errInvalidThrift
+	}
+	defer func() { d.depth-- }()
+
+	var id int16
+	for {
+		b, err := d.readByte()
+		if err != nil {
+			return err
+		}
+		if b == 0 {
+			return nil
+		}
+
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := d.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+
+		if err = fn(id, b&0x0f); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *thriftDecoder) skip(fieldType byte) error {
+	switch fieldType {
+	case thriftBoolTrue, thriftBoolFalse:
+		return nil
+	case thriftByte:
+		_, err := d.readByte()
+		return err
+	case thriftI16, thriftI32, thriftI64:
+		_, err := d.readVarint()
+		return err
+	case thriftDouble:
+		_, err := d.readDouble()
+		return err
+	case thriftBinary:
+		_, err := d.readBinary()
+		return err
+	case thriftList, thriftSet:
+		return d.readList(func(elemType byte) error {
+			if elemType == thriftBoolTrue || elemType == thriftBoolFalse {
+				_, err := d.readByte()
+				return err
+			}
+			return d.skip(elemType)
+		})
+	case thriftMap:
+		size, err := d.readUvarint()
+		if err != nil || size == 0 {
+			return err
+		}
+		types, err := d.readByte()
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < size; i++ {
+			if err = d.skip(types >> 4); err != nil {
+				return err
+			}
+			if err = d.skip(types & 0x0f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftStruct:
+		return d.readStruct(func(_ int16, fieldType byte) error {
+			return d.skip(fieldType)
+		})
+	}
+	return errInvalidThrift
+}
+
+type thriftEncoder struct {
+	buf     []byte
+	lastIds []int16
+}
+
+func (e *thriftEncoder) writeByte(b byte) {
+	e.buf = append(e.buf, b)
+}
+
+func (e *thriftEncoder) writeUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *thriftEncoder) writeVarint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *thriftEncoder) writeBinary(b []byte) {
+	e.writeUvarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *thriftEncoder) beginStruct() {
+	e.lastIds = append(e.lastIds, 0)
+}
+
+func (e *thriftEncoder) endStruct() {
+	e.writeByte(0)
+	e.lastIds = e.lastIds[:len(e.lastIds)-1]
+}
+
+func (e *thriftEncoder) writeFieldHeader(id int16, fieldType byte) {
+	last := &e.lastIds[len(e.lastIds)-1]
+	if delta := id - *last; 0 < delta && delta < 16 {
+		e.writeByte(byte(delta)<<4 | fieldType)
+	} else {
+		e.writeByte(fieldType)
+		e.writeVarint(int64(id))
+	}
+	*last = id
+}
+
+func (e *thriftEncoder) writeBoolField(id int16, v bool) {
+	if v {
+		e.writeFieldHeader(id, thriftBoolTrue)
+	} else {
+		e.writeFieldHeader(id, thriftBoolFalse)
+	}
+}
+
+func (e *thriftEncoder) writeByteField(id int16, v byte) {
+	e.writeFieldHeader(id, thriftByte)
+	e.writeByte(v)
+}
+
+func (e *thriftEncoder) writeI32Field(id int16, v int32) {
+	e.writeFieldHeader(id, thriftI32)
+	e.writeVarint(int64(v))
+}
+
+func (e *thriftEncoder) writeI64Field(id int16, v int64) {
+	e.writeFieldHeader(id, thriftI64)
+	e.writeVarint(v)
+}
+
+func (e *thriftEncoder) writeBinaryField(id int16, v []byte) {
+	e.writeFieldHeader(id, thriftBinary)
+	e.writeBinary(v)
+}
+
+func (e *thriftEncoder) writeListField(id int16, elemType byte, size int) {
+	e.writeFieldHeader(id, thriftList)
+	if size < 15 {
+		e.writeByte(byte(size)<<4 | elemType)
+	} else {
+		e.writeByte(0xf0 | elemType)
+		e.writeUvarint(uint64(size))
+	}
+}
+
+func (e *thriftEncoder) writeStructField(id int16, fn func()) {
+	e.writeFieldHeader(id, thriftStruct)
+	e.beginStruct()
+	fn()
+	e.endStruct()
+}
+
+func (e *thriftEncoder) writeEmptyStructField(id int16) {
+	e.writeStructField(id, func() {})
+}
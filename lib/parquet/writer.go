@@ -0,0 +1,375 @@
+package parquet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+)
+
+const createdBy = "csvq"
+
+// rowGroupSize is the maximum number of rows in a row group.
+var rowGroupSize = 65536
+
+type FieldType int
+
+const (
+	StringField FieldType = iota
+	IntegerField
+	FloatField
+	BooleanField
+	DatetimeField
+)
+
+type Field struct {
+	Name string
+	Type FieldType
+}
+
+// InferFields returns the fields that can hold all the values in the columns.
+// Integer and float values are held in a float field together,
+// and any other combination of types results in a string field.
+func InferFields(names []string, rows [][]interface{}) []Field {
+	fields := make([]Field, len(names))
+
+	for i := range names {
+		fields[i].Name = names[i]
+
+		var fieldType FieldType
+		inferred := false
+		for _, row := range rows {
+			if len(row) <= i || row[i] == nil {
+				continue
+			}
+
+			var t FieldType
+			switch row[i].(type) {
+			case int64:
+				t = IntegerField
+			case float64:
+				t = FloatField
+			case bool:
+				t = BooleanField
+			case time.Time:
+				t = DatetimeField
+			default:
+				t = StringField
+			}
+
+			if !inferred {
+				fieldType = t
+				inferred = true
+				continue
+			}
+			if fieldType == t {
+				continue
+			}
+			if (fieldType == IntegerField && t == FloatField) || (fieldType == FloatField && t == IntegerField) {
+				fieldType = FloatField
+				continue
+			}
+			fieldType = StringField
+			break
+		}
+		fields[i].Type = fieldType
+	}
+	return fields
+}
+
+// Write writes rows as a parquet file.
+// Every column is written as an optional column, and values are compressed with snappy.
+// Integer values in float fields are converted to floats and other values in string fields are formatted with fmt.
+func Write(w io.Writer, fields []Field, rows [][]interface{}) error {
+	bw := bufio.NewWriter(w)
+	pos := int64(0)
+	var write = func(b []byte) error {
+		n, err := bw.Write(b)
+		pos += int64(n)
+		return err
+	}
+
+	if err := write([]byte(magic)); err != nil {
+		return err
+	}
+
+	meta := &fileMetaData{
+		Version:   1,
+		Schema:    make([]schemaElement, 0, len(fields)+1),
+		NumRows:   int64(len(rows)),
+		CreatedBy: createdBy,
+	}
+	meta.Schema = append(meta.Schema, schemaElement{
+		Name:          "schema",
+		NumChildren:   int32(len(fields)),
+		ConvertedType: -1,
+	})
+
+	columns := make([]writerColumn, len(fields))
+	for i, f := range fields {
+		columns[i] = newWriterColumn(f, i, rows)
+		meta.Schema = append(meta.Schema, columns[i].Element)
+	}
+
+	for start := 0; start < len(rows); start += rowGroupSize {
+		end := start + rowGroupSize
+		if len(rows) < end {
+			end = len(rows)
+		}
+
+		rg := rowGroup{
+			Columns: make([]columnChunk, len(columns)),
+			NumRows: int64(end - start),
+		}
+		for i := range columns {
+			chunk, md, err := columns[i].encodeChunk(rows[start:end])
+			if err != nil {
+				return err
+			}
+			md.DataPageOffset = pos
+			if err = write(chunk); err != nil {
+				return err
+			}
+			rg.Columns[i] = columnChunk{FileOffset: md.DataPageOffset, MetaData: md}
+			rg.TotalByteSize += md.TotalUncompressedSize
+		}
+		meta.RowGroups = append(meta.RowGroups, rg)
+	}
+
+	e := &thriftEncoder{}
+	meta.encode(e)
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, uint32(len(e.buf)))
+
+	if err := write(e.buf); err != nil {
+		return err
+	}
+	if err := write(footer); err != nil {
+		return err
+	}
+	if err := write([]byte(magic)); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+type writerColumn struct {
+	Field   Field
+	Index   int
+	Element schemaElement
+	Unit    int16
+}
+
+func newWriterColumn(f Field, idx int, rows [][]interface{}) writerColumn {
+	c := writerColumn{
+		Field: f,
+		Index: idx,
+		Element: schemaElement{
+			Name:           f.Name,
+			RepetitionType: repetitionOptional,
+			ConvertedType:  -1,
+		},
+	}
+
+	switch f.Type {
+	case IntegerField:
+		c.Element.Type = typeInt64
+	case FloatField:
+		c.Element.Type = typeDouble
+	case BooleanField:
+		c.Element.Type = typeBoolean
+	case DatetimeField:
+		c.Unit = unitMicros
+		for _, row := range rows {
+			if t, ok := c.value(row).(time.Time); ok && t.Nanosecond()%1000 != 0 {
+				c.Unit = unitNanos
+				break
+			}
+		}
+		c.Element.Type = typeInt64
+		c.Element.LogicalType = &logicalType{Type: logicalTimestamp, IsAdjustedToUTC: true, Unit: c.Unit}
+		if c.Unit == unitMicros {
+			c.Element.ConvertedType = convertedTimestampMicros
+		}
+	default:
+		c.Element.Type = typeByteArray
+		c.Element.ConvertedType = convertedUTF8
+		c.Element.LogicalType = &logicalType{Type: logicalString}
+	}
+	return c
+}
+
+func (c writerColumn) value(row []interface{}) interface{} {
+	if len(row) <= c.Index {
+		return nil
+	}
+	return row[c.Index]
+}
+
+// encodeChunk encodes the values of the column in rows as a column chunk that consists of one data page.
+func (c writerColumn) encodeChunk(rows [][]interface{}) ([]byte, *columnMetaData, error) {
+	levels := make([]int32, len(rows))
+	values := &bytes.Buffer{}
+	stats := &statistics{}
+
+	var min, max interface{}
+	var bits byte
+	bitCnt := 0
+
+	for i, row := range rows {
+		v := c.value(row)
+		if v == nil {
+			stats.NullCount++
+			continue
+		}
+		levels[i] = 1
+
+		var b [8]byte
+		switch c.Field.Type {
+		case IntegerField:
+			n, ok := v.(int64)
+			if !ok {
+				return nil, nil, fmt.Errorf("value %v of field %s is not an integer", v, c.Field.Name)
+			}
+			binary.LittleEndian.PutUint64(b[:], uint64(n))
+			values.Write(b[:])
+			if min == nil || n < min.(int64) {
+				min = n
+			}
+			if max == nil || max.(int64) < n {
+				max = n
+			}
+		case FloatField:
+			var f float64
+			switch v.(type) {
+			case float64:
+				f = v.(float64)
+			case int64:
+				f = float64(v.(int64))
+			default:
+				return nil, nil, fmt.Errorf("value %v of field %s is not a float", v, c.Field.Name)
+			}
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
+			values.Write(b[:])
+			if !math.IsNaN(f) {
+				if min == nil || f < min.(float64) {
+					min = f
+				}
+				if max == nil || max.(float64) < f {
+					max = f
+				}
+			}
+		case BooleanField:
+			bl, ok := v.(bool)
+			if !ok {
+				return nil, nil, fmt.Errorf("value %v of field %s is not a boolean", v, c.Field.Name)
+			}
+			if bl {
+				bits |= 1 << uint(bitCnt)
+			}
+			if bitCnt++; bitCnt == 8 {
+				values.WriteByte(bits)
+				bits, bitCnt = 0, 0
+			}
+		case DatetimeField:
+			t, ok := v.(time.Time)
+			if !ok {
+				return nil, nil, fmt.Errorf("value %v of field %s is not a datetime", v, c.Field.Name)
+			}
+			var n int64
+			if c.Unit == unitNanos {
+				n = t.UnixNano()
+			} else {
+				n = t.Unix()*1e6 + int64(t.Nanosecond()/1e3)
+			}
+			binary.LittleEndian.PutUint64(b[:], uint64(n))
+			values.Write(b[:])
+			if min == nil || n < min.(int64) {
+				min = n
+			}
+			if max == nil || max.(int64) < n {
+				max = n
+			}
+		default:
+			s, ok := v.(string)
+			if !ok {
+				s = fmt.Sprint(v)
+			}
+			binary.LittleEndian.PutUint32(b[:4], uint32(len(s)))
+			values.Write(b[:4])
+			values.WriteString(s)
+			if min == nil || s < min.(string) {
+				min = s
+			}
+			if max == nil || max.(string) < s {
+				max = s
+			}
+		}
+	}
+	if 0 < bitCnt {
+		values.WriteByte(bits)
+	}
+
+	if min != nil {
+		stats.MinValue, stats.MaxValue = encodeStatisticsValue(min), encodeStatisticsValue(max)
+		if c.Field.Type != StringField {
+			stats.Min, stats.Max = stats.MinValue, stats.MaxValue
+		}
+	}
+
+	encodedLevels := encodeHybrid(levels, 1)
+	page := make([]byte, 4, 4+len(encodedLevels)+values.Len())
+	binary.LittleEndian.PutUint32(page, uint32(len(encodedLevels)))
+	page = append(page, encodedLevels...)
+	page = append(page, values.Bytes()...)
+	compressed := snappy.Encode(nil, page)
+
+	header := &pageHeader{
+		Type:                 pageData,
+		UncompressedPageSize: int32(len(page)),
+		CompressedPageSize:   int32(len(compressed)),
+		DataPageHeader: &dataPageHeader{
+			NumValues:               int32(len(rows)),
+			Encoding:                encodingPlain,
+			DefinitionLevelEncoding: encodingRLE,
+			RepetitionLevelEncoding: encodingRLE,
+		},
+	}
+	e := &thriftEncoder{}
+	header.encode(e)
+
+	chunk := make([]byte, 0, len(e.buf)+len(compressed))
+	chunk = append(chunk, e.buf...)
+	chunk = append(chunk, compressed...)
+
+	md := &columnMetaData{
+		Type:                  c.Element.Type,
+		Encodings:             []int32{encodingPlain, encodingRLE},
+		PathInSchema:          []string{c.Field.Name},
+		Codec:                 codecSnappy,
+		NumValues:             int64(len(rows)),
+		TotalUncompressedSize: int64(len(e.buf) + len(page)),
+		TotalCompressedSize:   int64(len(chunk)),
+		Statistics:            stats,
+	}
+	return chunk, md, nil
+}
+
+func encodeStatisticsValue(v interface{}) []byte {
+	switch v.(type) {
+	case int64:
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v.(int64)))
+		return b
+	case float64:
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(v.(float64)))
+		return b
+	}
+	return []byte(v.(string))
+}
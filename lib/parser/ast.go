@@ -135,6 +135,13 @@ func NewDatetimeValue(t time.Time) PrimitiveType {
 	}
 }
 
+func NewIntervalValueFromString(s string) PrimitiveType {
+	return PrimitiveType{
+		Literal: s,
+		Value:   value.NewIntervalFromString(s),
+	}
+}
+
 func NewNullValueFromString(s string) PrimitiveType {
 	return PrimitiveType{
 		Literal: s,
@@ -153,6 +160,8 @@ func (e PrimitiveType) String() string {
 		switch e.Value.(type) {
 		case *value.String, *value.Datetime:
 			return cmd.QuoteString(e.Literal)
+		case *value.Interval:
+			return "INTERVAL " + cmd.QuoteString(e.Literal)
 		default:
 			return e.Literal
 		}
@@ -173,7 +182,7 @@ type Placeholder struct {
 }
 
 func (e Placeholder) String() string {
-	if len(e.Name) < 1 {
+	if len(e.Name) < 1 && e.Literal == "?" {
 		return fmt.Sprintf("%s{%d}", e.Literal, e.Ordinal)
 	}
 	return e.Literal
@@ -394,6 +403,17 @@ func (gb GroupByClause) String() string {
 	return joinWithSpace(s)
 }
 
+type GroupingSets struct {
+	*BaseExpr
+	Type    int
+	Literal string
+	Values  []QueryExpression
+}
+
+func (e GroupingSets) String() string {
+	return e.Literal + " " + putParentheses(listQueryExpressions(e.Values))
+}
+
 type HavingClause struct {
 	*BaseExpr
 	Having string
@@ -562,6 +582,26 @@ func (e JsonQuery) String() string {
 	return e.JsonQuery + putParentheses(e.Query.String()+", "+e.JsonText.String())
 }
 
+type TableFunction struct {
+	*BaseExpr
+	Name string
+	Args []QueryExpression
+}
+
+func (e TableFunction) String() string {
+	return e.Name + "(" + listQueryExpressions(e.Args) + ")"
+}
+
+type SchemaTable struct {
+	*BaseExpr
+	Schema Identifier
+	Table  Identifier
+}
+
+func (e SchemaTable) String() string {
+	return e.Schema.String() + "." + e.Table.String()
+}
+
 type Comparison struct {
 	*BaseExpr
 	LHS      QueryExpression
@@ -686,6 +726,27 @@ func (l Like) String() string {
 	return joinWithSpace(s)
 }
 
+type RegExp struct {
+	*BaseExpr
+	RegExp   string
+	LHS      QueryExpression
+	Pattern  QueryExpression
+	Negation Token
+}
+
+func (r RegExp) IsNegated() bool {
+	return !r.Negation.IsEmpty()
+}
+
+func (r RegExp) String() string {
+	s := []string{r.LHS.String()}
+	if r.IsNegated() {
+		s = append(s, r.Negation.Literal)
+	}
+	s = append(s, r.RegExp, r.Pattern.String())
+	return joinWithSpace(s)
+}
+
 type Exists struct {
 	*BaseExpr
 	Exists string
@@ -773,6 +834,7 @@ type AggregateFunction struct {
 	Name     string
 	Distinct Token
 	Args     []QueryExpression
+	Filter   QueryExpression
 }
 
 func (e AggregateFunction) String() string {
@@ -782,7 +844,11 @@ func (e AggregateFunction) String() string {
 	}
 	s = append(s, listQueryExpressions(e.Args))
 
-	return e.Name + "(" + joinWithSpace(s) + ")"
+	fn := e.Name + "(" + joinWithSpace(s) + ")"
+	if e.Filter != nil {
+		fn = fn + " " + e.Filter.String()
+	}
+	return fn
 }
 
 func (e AggregateFunction) IsDistinct() bool {
@@ -819,6 +885,10 @@ func (t Table) Name() Identifier {
 		}
 	}
 
+	if st, ok := t.Object.(SchemaTable); ok {
+		return st.Table
+	}
+
 	return Identifier{
 		BaseExpr: t.Object.GetBaseExpr(),
 		Literal:  t.Object.String(),
@@ -834,10 +904,19 @@ type Join struct {
 	Natural   Token
 	JoinType  Token
 	Direction Token
+	Lateral   Token
 	Condition QueryExpression
 }
 
 func (j Join) String() string {
+	if j.Join == "," {
+		s := []string{j.Table.String() + j.Join}
+		if !j.Lateral.IsEmpty() {
+			s = append(s, j.Lateral.Literal)
+		}
+		return joinWithSpace(append(s, j.JoinTable.String()))
+	}
+
 	s := []string{j.Table.String()}
 	if !j.Natural.IsEmpty() {
 		s = append(s, j.Natural.Literal)
@@ -848,13 +927,62 @@ func (j Join) String() string {
 	if !j.JoinType.IsEmpty() {
 		s = append(s, j.JoinType.Literal)
 	}
-	s = append(s, j.Join, j.JoinTable.String())
+	s = append(s, j.Join)
+	if !j.Lateral.IsEmpty() {
+		s = append(s, j.Lateral.Literal)
+	}
+	s = append(s, j.JoinTable.String())
 	if j.Condition != nil {
 		s = append(s, j.Condition.String())
 	}
 	return joinWithSpace(s)
 }
 
+func (j Join) IsLateral() bool {
+	return !j.Lateral.IsEmpty() || strings.EqualFold(j.Join, TokenLiteral(APPLY))
+}
+
+type PivotTable struct {
+	*BaseExpr
+	Pivot     string
+	Table     QueryExpression
+	Aggregate QueryExpression
+	For       string
+	Column    QueryExpression
+	In        string
+	Values    []QueryExpression
+	Any       string
+}
+
+func (e PivotTable) String() string {
+	values := e.Any
+	if e.Values != nil {
+		values = listQueryExpressions(e.Values)
+	}
+	s := []string{e.Aggregate.String(), e.For, e.Column.String(), e.In, putParentheses(values)}
+	return joinWithSpace([]string{e.Table.String(), e.Pivot, putParentheses(joinWithSpace(s))})
+}
+
+func (e PivotTable) IsDynamic() bool {
+	return e.Values == nil
+}
+
+type UnpivotTable struct {
+	*BaseExpr
+	Unpivot string
+	Table   QueryExpression
+	Value   QueryExpression
+	For     string
+	Name    QueryExpression
+	In      string
+	Columns []QueryExpression
+}
+
+func (e UnpivotTable) String() string {
+	s := []string{e.Value.String(), e.For, e.Name.String(), e.In, putParentheses(listQueryExpressions(e.Columns))}
+	return joinWithSpace([]string{e.Table.String(), e.Unpivot, putParentheses(joinWithSpace(s))})
+}
+
 type JoinCondition struct {
 	*BaseExpr
 	Literal string
@@ -1003,6 +1131,7 @@ type ListFunction struct {
 	Args        []QueryExpression
 	WithinGroup string
 	OrderBy     QueryExpression
+	Filter      QueryExpression
 }
 
 func (e ListFunction) String() string {
@@ -1021,6 +1150,9 @@ func (e ListFunction) String() string {
 			s = append(s, "()")
 		}
 	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -1035,6 +1167,7 @@ type AnalyticFunction struct {
 	Args           []QueryExpression
 	IgnoreNulls    bool
 	IgnoreNullsLit string
+	Filter         QueryExpression
 	Over           string
 	AnalyticClause AnalyticClause
 }
@@ -1051,11 +1184,11 @@ func (e AnalyticFunction) String() string {
 		option = append(option, e.IgnoreNullsLit)
 	}
 
-	s := []string{
-		e.Name + "(" + joinWithSpace(option) + ")",
-		e.Over,
-		"(" + e.AnalyticClause.String() + ")",
+	s := []string{e.Name + "(" + joinWithSpace(option) + ")"}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
 	}
+	s = append(s, e.Over, "("+e.AnalyticClause.String()+")")
 	return joinWithSpace(s)
 }
 
@@ -1063,6 +1196,17 @@ func (e AnalyticFunction) IsDistinct() bool {
 	return !e.Distinct.IsEmpty()
 }
 
+type FilterClause struct {
+	*BaseExpr
+	Filter    string
+	Where     string
+	Condition QueryExpression
+}
+
+func (e FilterClause) String() string {
+	return e.Filter + " (" + joinWithSpace([]string{e.Where, e.Condition.String()}) + ")"
+}
+
 type AnalyticClause struct {
 	*BaseExpr
 	PartitionClause QueryExpression
@@ -1105,10 +1249,12 @@ func (e PartitionClause) String() string {
 type WindowingClause struct {
 	*BaseExpr
 	Rows      string
+	Unit      int
 	FrameLow  QueryExpression
 	FrameHigh QueryExpression
 	Between   string
 	And       string
+	Exclusion QueryExpression
 }
 
 func (e WindowingClause) String() string {
@@ -1118,21 +1264,35 @@ func (e WindowingClause) String() string {
 	} else {
 		s = append(s, e.Between, e.FrameLow.String(), e.And, e.FrameHigh.String())
 	}
+	if e.Exclusion != nil {
+		s = append(s, e.Exclusion.String())
+	}
 	return joinWithSpace(s)
 }
 
 type WindowFramePosition struct {
 	*BaseExpr
-	Direction int
-	Unbounded bool
-	Offset    int
-	Literal   string
+	Direction   int
+	Unbounded   bool
+	Offset      int
+	OffsetValue QueryExpression
+	Literal     string
 }
 
 func (e WindowFramePosition) String() string {
 	return e.Literal
 }
 
+type WindowExclusion struct {
+	*BaseExpr
+	Type    int
+	Literal string
+}
+
+func (e WindowExclusion) String() string {
+	return e.Literal
+}
+
 type Variable struct {
 	*BaseExpr
 	Name string
@@ -1254,6 +1414,26 @@ type DeleteQuery struct {
 	WhereClause QueryExpression
 }
 
+type MergeQuery struct {
+	*BaseExpr
+	WithClause  QueryExpression
+	Table       Table
+	Source      QueryExpression
+	Condition   QueryExpression
+	WhenClauses []MergeWhenClause
+}
+
+type MergeWhenClause struct {
+	*BaseExpr
+	Matched   bool
+	BySource  bool
+	Condition QueryExpression
+	Operation Token
+	SetList   []UpdateSet
+	Fields    []QueryExpression
+	Values    QueryExpression
+}
+
 type CreateTable struct {
 	*BaseExpr
 	Table  Identifier
@@ -1261,6 +1441,37 @@ type CreateTable struct {
 	Query  QueryExpression
 }
 
+type ColumnDefinition struct {
+	*BaseExpr
+	Column  Identifier
+	Type    Identifier
+	NotNull bool
+}
+
+func (e ColumnDefinition) String() string {
+	s := []string{e.Column.String()}
+	if 0 < len(e.Type.Literal) {
+		s = append(s, e.Type.String())
+	}
+	if e.NotNull {
+		s = append(s, "NOT NULL")
+	}
+	return joinWithSpace(s)
+}
+
+type CreateIndex struct {
+	*BaseExpr
+	Name   Identifier
+	Table  Identifier
+	Column Identifier
+}
+
+type DropIndex struct {
+	*BaseExpr
+	Name  Identifier
+	Table Identifier
+}
+
 type AddColumns struct {
 	*BaseExpr
 	Table    QueryExpression
@@ -1360,6 +1571,12 @@ type Reload struct {
 	Type Identifier
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze bool
+	Query   SelectQuery
+}
+
 type Execute struct {
 	*BaseExpr
 	Statements QueryExpression
@@ -1448,6 +1665,18 @@ type While struct {
 	Statements []Statement
 }
 
+type ExceptionBlock struct {
+	*BaseExpr
+	Statements []Statement
+	Handlers   []ExceptionHandler
+}
+
+type ExceptionHandler struct {
+	*BaseExpr
+	Codes      []value.Primary
+	Statements []Statement
+}
+
 type WhileInCursor struct {
 	*BaseExpr
 	WithDeclaration bool
@@ -1563,6 +1792,21 @@ type TransactionControl struct {
 	Token int
 }
 
+type Savepoint struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type RollbackToSavepoint struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type ReleaseSavepoint struct {
+	*BaseExpr
+	Name Identifier
+}
+
 type FlowControl struct {
 	*BaseExpr
 	Token int
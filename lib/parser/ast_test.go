@@ -87,6 +87,12 @@ func TestPrimitiveType_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
 	}
+
+	e = NewIntervalValueFromString("3 days 4 hours")
+	expect = "INTERVAL '3 days 4 hours'"
+	if e.String() != expect {
+		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
+	}
 }
 
 func TestPrimitiveType_IsInteger(t *testing.T) {
@@ -117,6 +123,14 @@ func TestPlaceHolder_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	s = "$2"
+	ordinal = 2
+	e = Placeholder{Literal: s, Ordinal: ordinal, Name: ""}
+	expect = "$2"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIdentifier_String(t *testing.T) {
@@ -439,6 +453,25 @@ func TestGroupByClause_String(t *testing.T) {
 	}
 }
 
+func TestGroupingSets_String(t *testing.T) {
+	e := GroupingSets{
+		Type:    GROUPING,
+		Literal: "grouping sets",
+		Values: []QueryExpression{
+			ValueList{Values: []QueryExpression{
+				Identifier{Literal: "column1"},
+				Identifier{Literal: "column2"},
+			}},
+			Identifier{Literal: "column1"},
+			ValueList{},
+		},
+	}
+	expect := "grouping sets ((column1, column2), column1, ())"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestHavingClause_String(t *testing.T) {
 	e := HavingClause{
 		Having: "having",
@@ -722,6 +755,31 @@ func TestJsonQuery_String(t *testing.T) {
 	}
 }
 
+func TestTableFunction_String(t *testing.T) {
+	e := TableFunction{
+		Name: "string_split",
+		Args: []QueryExpression{
+			Identifier{Literal: "column"},
+			NewStringValue(","),
+		},
+	}
+	expect := "string_split(column, ',')"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestSchemaTable_String(t *testing.T) {
+	e := SchemaTable{
+		Schema: Identifier{Literal: "information_schema"},
+		Table:  Identifier{Literal: "tables"},
+	}
+	expect := "information_schema.tables"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestComparison_String(t *testing.T) {
 	e := Comparison{
 		LHS:      Identifier{Literal: "column"},
@@ -917,6 +975,31 @@ func TestLike_String(t *testing.T) {
 	}
 }
 
+func TestRegExp_IsNegated(t *testing.T) {
+	e := RegExp{}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = RegExp{Negation: Token{Token: NOT, Literal: "not"}}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestRegExp_String(t *testing.T) {
+	e := RegExp{
+		RegExp:   "regexp",
+		LHS:      Identifier{Literal: "column"},
+		Pattern:  NewStringValue("^pattern$"),
+		Negation: Token{Token: NOT, Literal: "not"},
+	}
+	expect := "column not regexp '^pattern$'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestExists_String(t *testing.T) {
 	e := Exists{
 		Exists: "exists",
@@ -1038,6 +1121,26 @@ func TestAggregateFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AggregateFunction{
+		Name: "count",
+		Args: []QueryExpression{
+			AllColumns{},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where:  "where",
+			Condition: Comparison{
+				LHS:      Identifier{Literal: "column1"},
+				RHS:      NewIntegerValueFromString("1"),
+				Operator: ">",
+			},
+		},
+	}
+	expect = "count(*) filter (where column1 > 1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_IsDistinct(t *testing.T) {
@@ -1115,6 +1218,17 @@ func TestTable_Name(t *testing.T) {
 	if !reflect.DeepEqual(e.Name(), expect) {
 		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
 	}
+
+	e = Table{
+		Object: SchemaTable{
+			Schema: Identifier{Literal: "information_schema"},
+			Table:  Identifier{Literal: "tables"},
+		},
+	}
+	expect = Identifier{Literal: "tables"}
+	if !reflect.DeepEqual(e.Name(), expect) {
+		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
+	}
 }
 
 func TestJoin_String(t *testing.T) {
@@ -1146,6 +1260,98 @@ func TestJoin_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Join{
+		Join:      "join",
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		JoinTable: Table{Object: Subquery{Query: SelectQuery{SelectEntity: SelectEntity{SelectClause: SelectClause{Select: "select", Fields: []QueryExpression{Field{Object: Identifier{Literal: "column"}}}}}}}, Alias: Identifier{Literal: "t"}},
+		JoinType:  Token{Token: CROSS, Literal: "cross"},
+		Lateral:   Token{Token: LATERAL, Literal: "lateral"},
+	}
+	expect = "table1 cross join lateral (select column) t"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+	if !e.IsLateral() {
+		t.Errorf("IsLateral() = false, want true for %#v", e)
+	}
+
+	e = Join{
+		Join:      ",",
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		JoinTable: Table{Object: Subquery{Query: SelectQuery{SelectEntity: SelectEntity{SelectClause: SelectClause{Select: "select", Fields: []QueryExpression{Field{Object: Identifier{Literal: "column"}}}}}}}, Alias: Identifier{Literal: "t"}},
+		JoinType:  Token{Token: CROSS},
+		Lateral:   Token{Token: LATERAL, Literal: "lateral"},
+	}
+	expect = "table1, lateral (select column) t"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+	if !e.IsLateral() {
+		t.Errorf("IsLateral() = false, want true for %#v", e)
+	}
+
+	e = Join{
+		Join:      "apply",
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		JoinTable: Table{Object: Identifier{Literal: "table2"}},
+		JoinType:  Token{Token: OUTER, Literal: "outer"},
+	}
+	expect = "table1 outer apply table2"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+	if !e.IsLateral() {
+		t.Errorf("IsLateral() = false, want true for %#v", e)
+	}
+}
+
+func TestPivotTable_String(t *testing.T) {
+	e := PivotTable{
+		Pivot: "pivot",
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Aggregate: AggregateFunction{
+			Name: "sum",
+			Args: []QueryExpression{Identifier{Literal: "column2"}},
+		},
+		For:    "for",
+		Column: Identifier{Literal: "column1"},
+		In:     "in",
+		Values: []QueryExpression{
+			Field{Object: NewStringValue("a")},
+			Field{Object: NewStringValue("b"), As: "as", Alias: Identifier{Literal: "b2"}},
+		},
+	}
+	expect := "table1 pivot (sum(column2) for column1 in ('a', 'b' as b2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Values = nil
+	e.Any = "any"
+	expect = "table1 pivot (sum(column2) for column1 in (any))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnpivotTable_String(t *testing.T) {
+	e := UnpivotTable{
+		Unpivot: "unpivot",
+		Table:   Table{Object: Identifier{Literal: "table1"}},
+		Value:   Identifier{Literal: "val"},
+		For:     "for",
+		Name:    Identifier{Literal: "attr"},
+		In:      "in",
+		Columns: []QueryExpression{
+			Field{Object: Identifier{Literal: "column1"}},
+			Field{Object: Identifier{Literal: "column2"}, As: "as", Alias: Identifier{Literal: "c2"}},
+		},
+	}
+	expect := "table1 unpivot (val for attr in (column1, column2 as c2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestJoinCondition_String(t *testing.T) {
@@ -1399,6 +1605,31 @@ func TestListFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = ListFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValueFromString("0.5"),
+		},
+		WithinGroup: "within group",
+		OrderBy: OrderByClause{
+			OrderBy: "order by",
+			Items:   []QueryExpression{Identifier{Literal: "column2"}},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where:  "where",
+			Condition: Comparison{
+				LHS:      Identifier{Literal: "column1"},
+				RHS:      NewIntegerValueFromString("1"),
+				Operator: ">",
+			},
+		},
+	}
+	expect = "percentile_cont(0.5) within group (order by column2) filter (where column1 > 1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestListFunction_IsDistinct(t *testing.T) {
@@ -1443,6 +1674,35 @@ func TestAnalyticFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column4"},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where:  "where",
+			Condition: Comparison{
+				LHS:      Identifier{Literal: "column1"},
+				RHS:      NewIntegerValueFromString("1"),
+				Operator: ">",
+			},
+		},
+		Over: "over",
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				PartitionBy: "partition by",
+				Values: []QueryExpression{
+					Identifier{Literal: "column2"},
+				},
+			},
+		},
+	}
+	expect = "sum(column4) filter (where column1 > 1) over (partition by column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAnalyticFunction_IsDistinct(t *testing.T) {
@@ -1557,6 +1817,24 @@ func TestWindowingClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = WindowingClause{
+		Rows: "range",
+		Unit: RANGE,
+		FrameLow: WindowFramePosition{
+			Direction:   PRECEDING,
+			OffsetValue: NewFloatValueFromString("1.5"),
+			Literal:     "1.5 preceding",
+		},
+		Exclusion: WindowExclusion{
+			Type:    GROUP,
+			Literal: "exclude group",
+		},
+	}
+	expect = "range 1.5 preceding exclude group"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestVariable_String(t *testing.T) {
@@ -1647,3 +1925,24 @@ func TestCursorAttrebute_String(t *testing.T) {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
 }
+
+func TestColumnDefinition_String(t *testing.T) {
+	e := ColumnDefinition{
+		Column:  Identifier{Literal: "column1"},
+		Type:    Identifier{Literal: "integer"},
+		NotNull: true,
+	}
+	expect := "column1 integer NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = ColumnDefinition{
+		Column: Identifier{Literal: "column1"},
+		Type:   Identifier{Literal: "datetime"},
+	}
+	expect = "column1 datetime"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
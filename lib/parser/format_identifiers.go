@@ -5,10 +5,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 func FormatTableName(s string) string {
+	_, s = cmd.CompressionOfPath(s)
 	return strings.TrimSuffix(filepath.Base(s), filepath.Ext(s))
 }
 
@@ -1,4 +1,4 @@
-// Code generated by goyacc -o parser.go -v parser.output parser.y. DO NOT EDIT.
+// Code generated by goyacc -o parser.go -v /tmp/p.output parser.y. DO NOT EDIT.
 
 //line parser.y:2
 package parser
@@ -8,12 +8,14 @@ import __yyfmt__ "fmt"
 //line parser.y:2
 
 import (
+	"fmt"
 	"strconv"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-//line parser.y:11
+//line parser.y:13
 type yySymType struct {
 	yys         int
 	program     []Statement
@@ -40,6 +42,10 @@ type yySymType struct {
 	fetchpos    FetchPosition
 	replaceval  ReplaceValue
 	replacevals []ReplaceValue
+	mergewhen   MergeWhenClause
+	mergewhens  []MergeWhenClause
+	exhandlers  []ExceptionHandler
+	primaries   []value.Primary
 	token       Token
 }
 
@@ -84,117 +90,143 @@ const DEFAULT = 57383
 const RENAME = 57384
 const TO = 57385
 const VIEW = 57386
-const ORDER = 57387
-const GROUP = 57388
-const HAVING = 57389
-const BY = 57390
-const ASC = 57391
-const DESC = 57392
-const LIMIT = 57393
-const OFFSET = 57394
-const PERCENT = 57395
-const JOIN = 57396
-const INNER = 57397
-const OUTER = 57398
-const LEFT = 57399
-const RIGHT = 57400
-const FULL = 57401
-const CROSS = 57402
-const ON = 57403
-const USING = 57404
-const NATURAL = 57405
-const UNION = 57406
-const INTERSECT = 57407
-const EXCEPT = 57408
-const ALL = 57409
-const ANY = 57410
-const EXISTS = 57411
-const IN = 57412
-const AND = 57413
-const OR = 57414
-const NOT = 57415
-const BETWEEN = 57416
-const LIKE = 57417
-const IS = 57418
-const NULL = 57419
-const DISTINCT = 57420
-const WITH = 57421
-const RANGE = 57422
-const UNBOUNDED = 57423
-const PRECEDING = 57424
-const FOLLOWING = 57425
-const CURRENT = 57426
-const ROW = 57427
-const CASE = 57428
-const IF = 57429
-const ELSEIF = 57430
-const WHILE = 57431
-const WHEN = 57432
-const THEN = 57433
-const ELSE = 57434
-const DO = 57435
-const END = 57436
-const DECLARE = 57437
-const CURSOR = 57438
-const FOR = 57439
-const FETCH = 57440
-const OPEN = 57441
-const CLOSE = 57442
-const DISPOSE = 57443
-const PREPARE = 57444
-const NEXT = 57445
-const PRIOR = 57446
-const ABSOLUTE = 57447
-const RELATIVE = 57448
-const SEPARATOR = 57449
-const PARTITION = 57450
-const OVER = 57451
-const COMMIT = 57452
-const ROLLBACK = 57453
-const CONTINUE = 57454
-const BREAK = 57455
-const EXIT = 57456
-const ECHO = 57457
-const PRINT = 57458
-const PRINTF = 57459
-const SOURCE = 57460
-const EXECUTE = 57461
-const CHDIR = 57462
-const PWD = 57463
-const RELOAD = 57464
-const REMOVE = 57465
-const SYNTAX = 57466
-const TRIGGER = 57467
-const FUNCTION = 57468
-const AGGREGATE = 57469
-const BEGIN = 57470
-const RETURN = 57471
-const IGNORE = 57472
-const WITHIN = 57473
-const VAR = 57474
-const SHOW = 57475
-const TIES = 57476
-const NULLS = 57477
-const ROWS = 57478
-const ONLY = 57479
-const CSV = 57480
-const JSON = 57481
-const FIXED = 57482
-const LTSV = 57483
-const JSON_ROW = 57484
-const JSON_TABLE = 57485
-const COUNT = 57486
-const JSON_OBJECT = 57487
-const AGGREGATE_FUNCTION = 57488
-const LIST_FUNCTION = 57489
-const ANALYTIC_FUNCTION = 57490
-const FUNCTION_NTH = 57491
-const FUNCTION_WITH_INS = 57492
-const COMPARISON_OP = 57493
-const STRING_OP = 57494
-const SUBSTITUTION_OP = 57495
-const UMINUS = 57496
-const UPLUS = 57497
+const INDEX = 57387
+const ORDER = 57388
+const GROUP = 57389
+const HAVING = 57390
+const BY = 57391
+const ASC = 57392
+const DESC = 57393
+const LIMIT = 57394
+const OFFSET = 57395
+const PERCENT = 57396
+const JOIN = 57397
+const INNER = 57398
+const OUTER = 57399
+const LEFT = 57400
+const RIGHT = 57401
+const FULL = 57402
+const CROSS = 57403
+const ON = 57404
+const USING = 57405
+const NATURAL = 57406
+const UNION = 57407
+const INTERSECT = 57408
+const EXCEPT = 57409
+const ALL = 57410
+const ANY = 57411
+const EXISTS = 57412
+const IN = 57413
+const AND = 57414
+const OR = 57415
+const NOT = 57416
+const BETWEEN = 57417
+const LIKE = 57418
+const REGEXP = 57419
+const IS = 57420
+const NULL = 57421
+const DISTINCT = 57422
+const WITH = 57423
+const RANGE = 57424
+const UNBOUNDED = 57425
+const PRECEDING = 57426
+const FOLLOWING = 57427
+const CURRENT = 57428
+const ROW = 57429
+const INTERVAL = 57430
+const CASE = 57431
+const IF = 57432
+const ELSEIF = 57433
+const WHILE = 57434
+const WHEN = 57435
+const THEN = 57436
+const ELSE = 57437
+const DO = 57438
+const END = 57439
+const DECLARE = 57440
+const CURSOR = 57441
+const FOR = 57442
+const FETCH = 57443
+const OPEN = 57444
+const CLOSE = 57445
+const DISPOSE = 57446
+const PREPARE = 57447
+const NEXT = 57448
+const PRIOR = 57449
+const ABSOLUTE = 57450
+const RELATIVE = 57451
+const SEPARATOR = 57452
+const PARTITION = 57453
+const OVER = 57454
+const COMMIT = 57455
+const ROLLBACK = 57456
+const SAVEPOINT = 57457
+const RELEASE = 57458
+const CONTINUE = 57459
+const BREAK = 57460
+const EXIT = 57461
+const ECHO = 57462
+const PRINT = 57463
+const PRINTF = 57464
+const SOURCE = 57465
+const EXECUTE = 57466
+const CHDIR = 57467
+const PWD = 57468
+const RELOAD = 57469
+const REMOVE = 57470
+const SYNTAX = 57471
+const TRIGGER = 57472
+const FUNCTION = 57473
+const AGGREGATE = 57474
+const BEGIN = 57475
+const RETURN = 57476
+const EXCEPTION = 57477
+const IGNORE = 57478
+const WITHIN = 57479
+const FILTER = 57480
+const VAR = 57481
+const SHOW = 57482
+const EXPLAIN = 57483
+const ANALYZE = 57484
+const MERGE = 57485
+const MATCHED = 57486
+const TARGET = 57487
+const PIVOT = 57488
+const UNPIVOT = 57489
+const LATERAL = 57490
+const APPLY = 57491
+const TIES = 57492
+const NULLS = 57493
+const ROWS = 57494
+const GROUPS = 57495
+const EXCLUDE = 57496
+const ONLY = 57497
+const ROLLUP = 57498
+const CUBE = 57499
+const GROUPING = 57500
+const SETS = 57501
+const CSV = 57502
+const JSON = 57503
+const JSONL = 57504
+const FIXED = 57505
+const LTSV = 57506
+const XLSX = 57507
+const PARQUET = 57508
+const JSON_ROW = 57509
+const JSON_TABLE = 57510
+const STRING_SPLIT = 57511
+const COUNT = 57512
+const JSON_OBJECT = 57513
+const AGGREGATE_FUNCTION = 57514
+const LIST_FUNCTION = 57515
+const ANALYTIC_FUNCTION = 57516
+const FUNCTION_NTH = 57517
+const FUNCTION_WITH_INS = 57518
+const COMPARISON_OP = 57519
+const STRING_OP = 57520
+const SUBSTITUTION_OP = 57521
+const UMINUS = 57522
+const UPLUS = 57523
 
 var yyToknames = [...]string{
 	"$end",
@@ -241,6 +273,7 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"INDEX",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -272,6 +305,7 @@ var yyToknames = [...]string{
 	"NOT",
 	"BETWEEN",
 	"LIKE",
+	"REGEXP",
 	"IS",
 	"NULL",
 	"DISTINCT",
@@ -282,6 +316,7 @@ var yyToknames = [...]string{
 	"FOLLOWING",
 	"CURRENT",
 	"ROW",
+	"INTERVAL",
 	"CASE",
 	"IF",
 	"ELSEIF",
@@ -308,6 +343,8 @@ var yyToknames = [...]string{
 	"OVER",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
 	"CONTINUE",
 	"BREAK",
 	"EXIT",
@@ -326,20 +363,41 @@ var yyToknames = [...]string{
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"EXCEPTION",
 	"IGNORE",
 	"WITHIN",
+	"FILTER",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
+	"MERGE",
+	"MATCHED",
+	"TARGET",
+	"PIVOT",
+	"UNPIVOT",
+	"LATERAL",
+	"APPLY",
 	"TIES",
 	"NULLS",
 	"ROWS",
+	"GROUPS",
+	"EXCLUDE",
 	"ONLY",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
 	"CSV",
 	"JSON",
+	"JSONL",
 	"FIXED",
 	"LTSV",
+	"XLSX",
+	"PARQUET",
 	"JSON_ROW",
 	"JSON_TABLE",
+	"STRING_SPLIT",
 	"COUNT",
 	"JSON_OBJECT",
 	"AGGREGATE_FUNCTION",
@@ -371,13 +429,26 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2640
+//line parser.y:3202
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
 	yyErrorVerbose = verbose
 }
 
+func setPosition(p PrimitiveType, token Token) PrimitiveType {
+	p.BaseExpr = NewBaseExpr(token)
+	return p
+}
+
+func crossJoinTables(tables []QueryExpression) QueryExpression {
+	table := tables[0]
+	for _, t := range tables[1:] {
+		table = Table{Object: Join{Join: ",", Table: table, JoinTable: t, JoinType: Token{Token: CROSS}}}
+	}
+	return table
+}
+
 func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bool, ansiQuotes bool) ([]Statement, int, error) {
 	l := new(Lexer)
 	l.Init(s, sourceFile, datetimeFormats, forPrepared, ansiQuotes)
@@ -389,1352 +460,1778 @@ func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bo
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 216,
+	-2, 245,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 21,
-	1, 26,
-	88, 26,
-	90, 26,
-	92, 26,
-	94, 26,
-	156, 26,
-	-2, 236,
-	-1, 33,
-	1, 78,
-	88, 78,
-	90, 78,
-	92, 78,
-	94, 78,
-	156, 78,
-	-2, 248,
-	-1, 112,
-	17, 216,
-	19, 216,
-	22, 216,
-	24, 216,
+	-1, 22,
+	1, 27,
+	91, 27,
+	93, 27,
+	95, 27,
+	97, 27,
+	135, 27,
+	182, 27,
+	-2, 268,
+	-1, 26,
+	135, 1,
+	-2, 245,
+	-1, 36,
+	1, 83,
+	91, 83,
+	93, 83,
+	95, 83,
+	97, 83,
+	135, 83,
+	182, 83,
+	-2, 280,
+	-1, 133,
+	17, 245,
+	19, 245,
+	22, 245,
+	24, 245,
+	143, 245,
 	-2, 1,
-	-1, 114,
-	163, 307,
-	-2, 216,
-	-1, 123,
-	64, 184,
-	65, 184,
-	66, 184,
-	-2, 196,
-	-1, 161,
-	1, 122,
-	88, 122,
-	90, 122,
-	92, 122,
-	94, 122,
-	156, 122,
-	-2, 230,
-	-1, 162,
-	1, 163,
-	88, 163,
-	90, 163,
-	92, 163,
-	94, 163,
-	156, 163,
-	-2, 236,
-	-1, 167,
-	1, 156,
-	88, 156,
-	90, 156,
-	92, 156,
-	94, 156,
-	156, 156,
-	-2, 236,
-	-1, 168,
-	1, 157,
-	88, 157,
-	90, 157,
-	92, 157,
-	94, 157,
-	156, 157,
-	-2, 236,
-	-1, 169,
-	1, 158,
-	88, 158,
-	90, 158,
-	92, 158,
-	94, 158,
-	156, 158,
-	-2, 236,
-	-1, 170,
-	1, 161,
-	88, 161,
-	90, 161,
-	92, 161,
-	94, 161,
-	156, 161,
-	-2, 230,
-	-1, 171,
-	1, 162,
-	88, 162,
-	90, 162,
-	92, 162,
-	94, 162,
-	156, 162,
-	-2, 236,
-	-1, 174,
-	1, 169,
-	88, 169,
-	90, 169,
-	92, 169,
-	94, 169,
-	156, 169,
-	-2, 230,
-	-1, 175,
-	1, 170,
-	88, 170,
-	90, 170,
-	92, 170,
-	94, 170,
-	156, 170,
-	-2, 236,
-	-1, 231,
-	88, 1,
-	92, 1,
-	94, 1,
-	-2, 216,
-	-1, 253,
-	162, 353,
-	-2, 463,
-	-1, 254,
-	162, 354,
-	-2, 464,
-	-1, 255,
-	162, 355,
-	-2, 465,
-	-1, 256,
-	162, 356,
-	-2, 466,
+	-1, 135,
+	189, 341,
+	-2, 245,
+	-1, 145,
+	65, 202,
+	66, 202,
+	67, 202,
+	-2, 225,
+	-1, 186,
+	1, 138,
+	91, 138,
+	93, 138,
+	95, 138,
+	97, 138,
+	135, 138,
+	182, 138,
+	-2, 262,
+	-1, 187,
+	1, 179,
+	91, 179,
+	93, 179,
+	95, 179,
+	97, 179,
+	135, 179,
+	182, 179,
+	-2, 268,
+	-1, 195,
+	1, 172,
+	91, 172,
+	93, 172,
+	95, 172,
+	97, 172,
+	135, 172,
+	182, 172,
+	-2, 268,
+	-1, 196,
+	1, 173,
+	91, 173,
+	93, 173,
+	95, 173,
+	97, 173,
+	135, 173,
+	182, 173,
+	-2, 268,
+	-1, 197,
+	1, 174,
+	91, 174,
+	93, 174,
+	95, 174,
+	97, 174,
+	135, 174,
+	182, 174,
+	-2, 268,
+	-1, 198,
+	1, 177,
+	91, 177,
+	93, 177,
+	95, 177,
+	97, 177,
+	135, 177,
+	182, 177,
+	-2, 262,
+	-1, 199,
+	1, 178,
+	91, 178,
+	93, 178,
+	95, 178,
+	97, 178,
+	135, 178,
+	182, 178,
+	-2, 268,
+	-1, 202,
+	1, 185,
+	91, 185,
+	93, 185,
+	95, 185,
+	97, 185,
+	135, 185,
+	182, 185,
+	-2, 262,
+	-1, 203,
+	1, 186,
+	91, 186,
+	93, 186,
+	95, 186,
+	97, 186,
+	135, 186,
+	182, 186,
+	-2, 268,
+	-1, 265,
+	91, 1,
+	95, 1,
+	97, 1,
+	-2, 245,
 	-1, 288,
-	4, 144,
-	134, 144,
-	135, 144,
-	136, 144,
-	138, 144,
-	139, 144,
-	140, 144,
-	141, 144,
-	-2, 236,
+	188, 405,
+	-2, 570,
 	-1, 289,
-	4, 145,
-	134, 145,
-	135, 145,
-	136, 145,
-	138, 145,
-	139, 145,
-	140, 145,
-	141, 145,
-	-2, 236,
-	-1, 299,
-	1, 174,
-	88, 174,
-	90, 174,
-	92, 174,
-	94, 174,
-	156, 174,
-	-2, 236,
-	-1, 307,
-	94, 4,
-	-2, 216,
-	-1, 316,
-	70, 0,
-	74, 0,
+	188, 406,
+	-2, 571,
+	-1, 290,
+	188, 407,
+	-2, 572,
+	-1, 291,
+	188, 408,
+	-2, 573,
+	-1, 292,
+	188, 409,
+	-2, 574,
+	-1, 293,
+	188, 410,
+	-2, 575,
+	-1, 294,
+	188, 411,
+	-2, 576,
+	-1, 329,
+	71, 268,
+	72, 268,
+	73, 268,
+	74, 268,
+	75, 268,
+	76, 268,
+	77, 268,
+	78, 268,
+	177, 268,
+	178, 268,
+	183, 268,
+	184, 268,
+	185, 268,
+	186, 268,
+	190, 268,
+	191, 268,
+	-2, 160,
+	-1, 330,
+	71, 268,
+	72, 268,
+	73, 268,
+	74, 268,
+	75, 268,
+	76, 268,
+	77, 268,
+	78, 268,
+	177, 268,
+	178, 268,
+	183, 268,
+	184, 268,
+	185, 268,
+	186, 268,
+	190, 268,
+	191, 268,
+	-2, 161,
+	-1, 344,
+	1, 192,
+	91, 192,
+	93, 192,
+	95, 192,
+	97, 192,
+	135, 192,
+	182, 192,
+	-2, 268,
+	-1, 351,
+	97, 4,
+	-2, 245,
+	-1, 360,
+	71, 0,
 	75, 0,
 	76, 0,
-	151, 0,
-	158, 0,
-	-2, 277,
-	-1, 317,
-	70, 0,
-	74, 0,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 309,
+	-1, 361,
+	71, 0,
 	75, 0,
 	76, 0,
-	151, 0,
-	158, 0,
-	-2, 279,
-	-1, 326,
-	70, 0,
-	74, 0,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 311,
+	-1, 371,
+	71, 0,
 	75, 0,
 	76, 0,
-	151, 0,
-	158, 0,
-	-2, 289,
-	-1, 374,
-	94, 1,
-	-2, 216,
-	-1, 390,
-	54, 482,
-	-2, 399,
-	-1, 429,
-	1, 80,
-	88, 80,
-	90, 80,
-	92, 80,
-	94, 80,
-	156, 80,
-	-2, 236,
-	-1, 430,
-	1, 81,
-	88, 81,
-	90, 81,
-	92, 81,
-	94, 81,
-	156, 81,
-	-2, 230,
-	-1, 431,
-	1, 82,
-	88, 82,
-	90, 82,
-	92, 82,
-	94, 82,
-	156, 82,
-	-2, 236,
-	-1, 432,
-	1, 83,
-	88, 83,
-	90, 83,
-	92, 83,
-	94, 83,
-	156, 83,
-	-2, 230,
-	-1, 433,
-	1, 149,
-	88, 149,
-	90, 149,
-	92, 149,
-	94, 149,
-	156, 149,
-	-2, 230,
-	-1, 434,
-	1, 150,
-	88, 150,
-	90, 150,
-	92, 150,
-	94, 150,
-	156, 150,
-	-2, 236,
-	-1, 435,
-	1, 151,
-	88, 151,
-	90, 151,
-	92, 151,
-	94, 151,
-	156, 151,
-	-2, 230,
-	-1, 436,
-	1, 152,
-	88, 152,
-	90, 152,
-	92, 152,
-	94, 152,
-	156, 152,
-	-2, 236,
-	-1, 439,
-	1, 117,
-	88, 117,
-	90, 117,
-	92, 117,
-	94, 117,
-	156, 117,
-	166, 117,
-	-2, 236,
-	-1, 444,
-	1, 397,
-	88, 397,
-	90, 397,
-	92, 397,
-	94, 397,
-	156, 397,
-	-2, 236,
-	-1, 451,
-	1, 175,
-	88, 175,
-	90, 175,
-	92, 175,
-	94, 175,
-	156, 175,
-	-2, 236,
-	-1, 476,
-	70, 0,
-	74, 0,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 321,
+	-1, 372,
+	71, 0,
 	75, 0,
 	76, 0,
-	151, 0,
-	158, 0,
-	-2, 290,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 323,
+	-1, 421,
+	97, 1,
+	-2, 245,
+	-1, 439,
+	55, 599,
+	-2, 476,
+	-1, 484,
+	1, 85,
+	91, 85,
+	93, 85,
+	95, 85,
+	97, 85,
+	135, 85,
+	182, 85,
+	-2, 268,
+	-1, 485,
+	1, 86,
+	91, 86,
+	93, 86,
+	95, 86,
+	97, 86,
+	135, 86,
+	182, 86,
+	-2, 262,
+	-1, 486,
+	1, 87,
+	91, 87,
+	93, 87,
+	95, 87,
+	97, 87,
+	135, 87,
+	182, 87,
+	-2, 268,
+	-1, 487,
+	1, 88,
+	91, 88,
+	93, 88,
+	95, 88,
+	97, 88,
+	135, 88,
+	182, 88,
+	-2, 262,
+	-1, 488,
+	1, 165,
+	91, 165,
+	93, 165,
+	95, 165,
+	97, 165,
+	135, 165,
+	182, 165,
+	-2, 262,
+	-1, 489,
+	1, 166,
+	91, 166,
+	93, 166,
+	95, 166,
+	97, 166,
+	135, 166,
+	182, 166,
+	-2, 268,
+	-1, 490,
+	1, 167,
+	91, 167,
+	93, 167,
+	95, 167,
+	97, 167,
+	135, 167,
+	182, 167,
+	-2, 262,
+	-1, 491,
+	1, 168,
+	91, 168,
+	93, 168,
+	95, 168,
+	97, 168,
+	135, 168,
+	182, 168,
+	-2, 268,
+	-1, 494,
+	1, 133,
+	91, 133,
+	93, 133,
+	95, 133,
+	97, 133,
+	135, 133,
+	182, 133,
+	192, 133,
+	-2, 268,
+	-1, 499,
+	1, 474,
+	91, 474,
+	93, 474,
+	95, 474,
+	97, 474,
+	135, 474,
+	182, 474,
+	-2, 268,
 	-1, 507,
-	94, 1,
-	-2, 216,
+	1, 193,
+	91, 193,
+	93, 193,
+	95, 193,
+	97, 193,
+	135, 193,
+	182, 193,
+	-2, 268,
 	-1, 514,
-	90, 1,
-	92, 1,
-	94, 1,
-	-2, 216,
-	-1, 517,
-	1, 206,
-	52, 206,
-	79, 206,
-	88, 206,
-	90, 206,
-	92, 206,
-	94, 206,
-	97, 206,
-	137, 206,
-	156, 206,
-	163, 206,
-	-2, 236,
-	-1, 518,
-	1, 211,
-	88, 211,
-	90, 211,
-	92, 211,
-	94, 211,
-	97, 211,
-	98, 211,
-	156, 211,
-	163, 211,
-	-2, 236,
-	-1, 551,
-	163, 351,
-	166, 351,
-	-2, 230,
-	-1, 593,
-	88, 4,
-	90, 4,
-	92, 4,
-	94, 4,
-	-2, 216,
-	-1, 596,
-	94, 4,
-	-2, 216,
-	-1, 597,
-	94, 4,
-	-2, 216,
-	-1, 679,
-	17, 492,
-	79, 492,
-	162, 492,
-	-2, 87,
-	-1, 705,
-	88, 4,
-	92, 4,
-	94, 4,
-	-2, 216,
-	-1, 710,
-	94, 4,
-	-2, 216,
-	-1, 711,
-	94, 4,
-	-2, 216,
-	-1, 734,
-	88, 1,
-	92, 1,
-	94, 1,
-	-2, 216,
-	-1, 774,
-	1, 95,
-	88, 95,
-	90, 95,
-	92, 95,
-	94, 95,
-	156, 95,
-	-2, 230,
-	-1, 775,
-	1, 96,
-	88, 96,
-	90, 96,
-	92, 96,
-	94, 96,
-	156, 96,
-	-2, 236,
-	-1, 777,
-	94, 6,
-	-2, 216,
-	-1, 783,
-	163, 128,
-	166, 128,
-	-2, 236,
-	-1, 788,
-	94, 4,
-	-2, 216,
-	-1, 853,
-	94, 6,
-	-2, 216,
-	-1, 854,
-	94, 6,
-	-2, 216,
-	-1, 858,
-	94, 4,
-	-2, 216,
-	-1, 862,
-	90, 4,
-	92, 4,
-	94, 4,
-	-2, 216,
-	-1, 900,
-	88, 6,
-	90, 6,
-	92, 6,
-	94, 6,
-	-2, 216,
+	135, 4,
+	-2, 245,
+	-1, 533,
+	71, 0,
+	75, 0,
+	76, 0,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 322,
+	-1, 534,
+	71, 0,
+	75, 0,
+	76, 0,
+	77, 0,
+	78, 0,
+	177, 0,
+	184, 0,
+	-2, 324,
+	-1, 566,
+	97, 1,
+	-2, 245,
+	-1, 573,
+	93, 1,
+	95, 1,
+	97, 1,
+	-2, 245,
+	-1, 581,
+	1, 235,
+	53, 235,
+	81, 235,
+	91, 235,
+	93, 235,
+	95, 235,
+	97, 235,
+	100, 235,
+	135, 235,
+	155, 235,
+	182, 235,
+	189, 235,
+	-2, 268,
+	-1, 582,
+	1, 240,
+	91, 240,
+	93, 240,
+	95, 240,
+	97, 240,
+	100, 240,
+	101, 240,
+	135, 240,
+	182, 240,
+	189, 240,
+	-2, 268,
+	-1, 622,
+	189, 403,
+	192, 403,
+	-2, 262,
+	-1, 671,
+	91, 4,
+	93, 4,
+	95, 4,
+	97, 4,
+	135, 4,
+	-2, 245,
+	-1, 675,
+	97, 4,
+	-2, 245,
+	-1, 676,
+	97, 4,
+	-2, 245,
+	-1, 714,
+	93, 1,
+	97, 1,
+	-2, 245,
+	-1, 736,
+	55, 599,
+	-2, 477,
+	-1, 772,
+	17, 609,
+	81, 609,
+	188, 609,
+	-2, 95,
+	-1, 804,
+	91, 4,
+	95, 4,
+	97, 4,
+	-2, 245,
+	-1, 810,
+	97, 4,
+	-2, 245,
+	-1, 811,
+	97, 4,
+	-2, 245,
+	-1, 840,
+	91, 1,
+	95, 1,
+	97, 1,
+	-2, 245,
+	-1, 860,
+	55, 599,
+	-2, 478,
+	-1, 902,
+	1, 105,
+	91, 105,
+	93, 105,
+	95, 105,
+	97, 105,
+	135, 105,
+	182, 105,
+	-2, 262,
+	-1, 903,
+	1, 106,
+	91, 106,
+	93, 106,
+	95, 106,
+	97, 106,
+	135, 106,
+	182, 106,
+	-2, 268,
 	-1, 907,
-	156, 62,
-	-2, 236,
-	-1, 946,
-	88, 6,
-	92, 6,
-	94, 6,
-	-2, 216,
-	-1, 949,
-	94, 8,
-	-2, 216,
-	-1, 956,
-	94, 6,
-	-2, 216,
-	-1, 959,
-	88, 4,
-	92, 4,
-	94, 4,
-	-2, 216,
-	-1, 986,
-	94, 6,
-	-2, 216,
-	-1, 1019,
-	94, 6,
-	-2, 216,
-	-1, 1023,
-	90, 6,
-	92, 6,
-	94, 6,
-	-2, 216,
-	-1, 1025,
-	88, 8,
-	90, 8,
-	92, 8,
-	94, 8,
-	-2, 216,
-	-1, 1028,
-	94, 8,
-	-2, 216,
-	-1, 1029,
-	94, 8,
-	-2, 216,
-	-1, 1046,
-	88, 8,
-	92, 8,
-	94, 8,
-	-2, 216,
-	-1, 1051,
-	94, 8,
-	-2, 216,
-	-1, 1052,
-	94, 8,
-	-2, 216,
-	-1, 1057,
-	88, 6,
-	92, 6,
-	94, 6,
-	-2, 216,
-	-1, 1062,
-	94, 8,
-	-2, 216,
-	-1, 1077,
-	94, 8,
-	-2, 216,
-	-1, 1081,
-	90, 8,
-	92, 8,
-	94, 8,
-	-2, 216,
-	-1, 1110,
-	88, 8,
-	92, 8,
-	94, 8,
-	-2, 216,
+	97, 6,
+	-2, 245,
+	-1, 913,
+	189, 144,
+	192, 144,
+	-2, 268,
+	-1, 918,
+	97, 4,
+	-2, 245,
+	-1, 1000,
+	135, 6,
+	-2, 245,
+	-1, 1005,
+	97, 6,
+	-2, 245,
+	-1, 1006,
+	97, 6,
+	-2, 245,
+	-1, 1010,
+	97, 4,
+	-2, 245,
+	-1, 1014,
+	93, 4,
+	95, 4,
+	97, 4,
+	-2, 245,
+	-1, 1074,
+	91, 6,
+	93, 6,
+	95, 6,
+	97, 6,
+	135, 6,
+	-2, 245,
+	-1, 1082,
+	182, 65,
+	-2, 268,
+	-1, 1092,
+	93, 4,
+	97, 4,
+	-2, 245,
+	-1, 1140,
+	91, 6,
+	95, 6,
+	97, 6,
+	-2, 245,
+	-1, 1144,
+	97, 8,
+	-2, 245,
+	-1, 1151,
+	97, 6,
+	-2, 245,
+	-1, 1154,
+	91, 4,
+	95, 4,
+	97, 4,
+	-2, 245,
+	-1, 1193,
+	97, 6,
+	-2, 245,
+	-1, 1203,
+	135, 8,
+	-2, 245,
+	-1, 1244,
+	97, 6,
+	-2, 245,
+	-1, 1248,
+	93, 6,
+	95, 6,
+	97, 6,
+	-2, 245,
+	-1, 1252,
+	91, 8,
+	93, 8,
+	95, 8,
+	97, 8,
+	135, 8,
+	-2, 245,
+	-1, 1256,
+	97, 8,
+	-2, 245,
+	-1, 1257,
+	97, 8,
+	-2, 245,
+	-1, 1292,
+	93, 6,
+	97, 6,
+	-2, 245,
+	-1, 1295,
+	91, 8,
+	95, 8,
+	97, 8,
+	-2, 245,
+	-1, 1301,
+	97, 8,
+	-2, 245,
+	-1, 1302,
+	97, 8,
+	-2, 245,
+	-1, 1322,
+	91, 6,
+	95, 6,
+	97, 6,
+	-2, 245,
+	-1, 1328,
+	97, 8,
+	-2, 245,
+	-1, 1354,
+	97, 8,
+	-2, 245,
+	-1, 1358,
+	93, 8,
+	95, 8,
+	97, 8,
+	-2, 245,
+	-1, 1392,
+	93, 8,
+	97, 8,
+	-2, 245,
+	-1, 1413,
+	91, 8,
+	95, 8,
+	97, 8,
+	-2, 245,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3872
+const yyLast = 5816
 
 var yyAct = [...]int{
 
-	122, 21, 1076, 1088, 1047, 1018, 947, 1075, 620, 1017,
-	857, 346, 519, 120, 115, 33, 706, 856, 922, 565,
-	187, 379, 267, 820, 113, 739, 921, 964, 186, 390,
-	506, 65, 681, 686, 639, 380, 583, 581, 584, 459,
-	26, 1, 162, 415, 248, 163, 164, 385, 167, 168,
-	169, 171, 233, 175, 651, 236, 443, 920, 656, 458,
-	25, 544, 237, 140, 140, 344, 143, 437, 530, 242,
-	529, 180, 563, 184, 172, 525, 389, 505, 100, 341,
-	259, 687, 246, 220, 129, 80, 460, 78, 406, 496,
-	137, 191, 264, 181, 559, 999, 68, 229, 454, 3,
-	988, 214, 890, 395, 185, 291, 213, 213, 770, 297,
-	950, 214, 995, 21, 201, 180, 213, 200, 199, 202,
-	198, 832, 123, 141, 833, 753, 534, 33, 535, 536,
-	531, 528, 149, 235, 532, 484, 727, 232, 466, 1036,
-	213, 27, 206, 165, 205, 204, 308, 696, 695, 207,
-	208, 239, 26, 698, 230, 670, 699, 680, 671, 288,
-	289, 678, 672, 201, 210, 209, 200, 199, 202, 198,
-	668, 646, 25, 260, 591, 195, 588, 206, 299, 309,
-	206, 482, 205, 204, 207, 208, 266, 207, 208, 977,
-	279, 309, 405, 178, 400, 196, 195, 313, 272, 1035,
-	1011, 206, 197, 205, 204, 214, 309, 74, 207, 208,
-	213, 3, 183, 296, 1010, 1009, 312, 1008, 534, 311,
-	535, 536, 531, 528, 541, 1007, 532, 110, 93, 178,
-	247, 271, 21, 1006, 309, 101, 981, 533, 268, 378,
-	270, 980, 309, 978, 196, 195, 33, 324, 553, 976,
-	206, 197, 205, 204, 974, 973, 183, 207, 208, 809,
-	963, 111, 962, 944, 941, 891, 855, 338, 834, 831,
-	110, 26, 387, 370, 183, 388, 802, 74, 123, 801,
-	800, 799, 318, 429, 431, 434, 436, 439, 798, 797,
-	324, 25, 439, 444, 794, 772, 769, 444, 444, 762,
-	761, 451, 754, 726, 140, 724, 723, 339, 21, 356,
-	357, 384, 722, 715, 713, 694, 692, 679, 677, 625,
-	366, 450, 33, 618, 421, 617, 663, 616, 604, 575,
-	3, 130, 994, 481, 843, 403, 499, 479, 464, 411,
-	469, 388, 371, 426, 410, 416, 304, 181, 554, 398,
-	305, 408, 409, 448, 449, 303, 497, 975, 412, 442,
-	542, 402, 93, 422, 134, 102, 103, 104, 929, 105,
-	106, 107, 108, 201, 210, 21, 200, 199, 202, 198,
-	580, 928, 517, 518, 927, 926, 925, 924, 896, 33,
-	445, 446, 480, 572, 886, 523, 881, 878, 876, 447,
-	875, 868, 550, 867, 472, 468, 471, 838, 673, 492,
-	493, 622, 600, 562, 26, 130, 510, 126, 540, 503,
-	128, 491, 125, 494, 539, 127, 490, 489, 488, 487,
-	486, 485, 524, 428, 25, 427, 401, 201, 210, 209,
-	200, 199, 202, 198, 138, 578, 549, 133, 502, 234,
-	260, 555, 500, 501, 196, 195, 228, 594, 413, 586,
-	206, 197, 205, 204, 227, 669, 183, 207, 208, 590,
-	132, 595, 388, 3, 470, 217, 132, 425, 546, 414,
-	216, 556, 215, 548, 285, 222, 557, 283, 101, 1025,
-	601, 558, 564, 560, 561, 273, 900, 571, 573, 568,
-	593, 133, 112, 178, 247, 364, 1054, 741, 21, 630,
-	644, 879, 877, 138, 743, 21, 815, 874, 196, 195,
-	730, 956, 33, 854, 206, 197, 205, 204, 853, 33,
-	302, 207, 208, 298, 275, 777, 183, 607, 101, 730,
-	183, 664, 613, 614, 615, 935, 933, 26, 806, 629,
-	665, 640, 258, 923, 26, 804, 633, 183, 873, 872,
-	132, 645, 605, 218, 251, 740, 183, 25, 183, 807,
-	219, 871, 870, 365, 25, 869, 805, 608, 609, 610,
-	611, 612, 803, 641, 628, 796, 274, 636, 938, 439,
-	658, 516, 444, 515, 21, 1109, 424, 21, 21, 284,
-	650, 660, 282, 659, 675, 1095, 3, 1085, 33, 624,
-	661, 33, 33, 3, 667, 666, 276, 277, 102, 103,
-	104, 564, 105, 106, 107, 108, 1084, 674, 1078, 1079,
-	1065, 1064, 1056, 564, 642, 676, 738, 1038, 623, 183,
-	1032, 564, 1024, 1021, 1052, 689, 569, 958, 702, 955,
-	954, 564, 911, 637, 93, 899, 700, 866, 523, 742,
-	865, 716, 717, 718, 719, 721, 860, 791, 102, 103,
-	104, 790, 105, 106, 107, 108, 746, 720, 733, 627,
-	101, 592, 511, 509, 747, 748, 1078, 145, 101, 736,
-	1077, 1077, 775, 156, 157, 735, 1051, 1029, 783, 1028,
-	766, 949, 711, 452, 710, 744, 21, 597, 789, 596,
-	307, 21, 21, 393, 251, 755, 81, 759, 1020, 752,
-	33, 1062, 1019, 765, 758, 33, 33, 586, 782, 1019,
-	756, 586, 780, 781, 779, 21, 808, 785, 378, 144,
-	859, 121, 183, 986, 858, 146, 760, 546, 1110, 33,
-	858, 764, 564, 788, 828, 74, 507, 564, 376, 154,
-	155, 158, 159, 767, 768, 374, 508, 1112, 173, 147,
-	507, 1081, 819, 813, 26, 814, 812, 1057, 21, 823,
-	824, 825, 1046, 1023, 959, 946, 862, 179, 734, 21,
-	705, 514, 33, 231, 25, 1059, 1048, 961, 948, 211,
-	212, 737, 840, 33, 841, 707, 372, 238, 224, 225,
-	102, 103, 104, 1102, 105, 106, 107, 108, 102, 103,
-	104, 1101, 253, 254, 255, 256, 1083, 396, 1082, 1044,
-	203, 179, 918, 3, 917, 864, 121, 863, 892, 883,
-	703, 884, 1020, 882, 859, 897, 394, 887, 901, 508,
-	173, 1116, 903, 907, 21, 21, 889, 1108, 1073, 21,
-	914, 1071, 902, 21, 1055, 1002, 957, 811, 33, 33,
-	732, 1099, 906, 33, 1042, 912, 845, 33, 915, 631,
-	905, 1107, 1093, 904, 1105, 1106, 893, 1118, 1104, 1092,
-	850, 1091, 729, 1014, 931, 301, 183, 931, 74, 265,
-	98, 21, 930, 942, 183, 934, 939, 183, 222, 937,
-	982, 898, 315, 316, 317, 33, 319, 564, 183, 326,
-	221, 329, 330, 331, 332, 333, 334, 335, 1089, 1069,
-	1089, 173, 345, 960, 932, 953, 1070, 1103, 952, 1072,
-	619, 894, 836, 1000, 829, 367, 74, 21, 931, 987,
-	21, 173, 845, 845, 407, 377, 972, 21, 951, 467,
-	21, 33, 789, 74, 33, 310, 850, 850, 99, 943,
-	262, 33, 835, 321, 33, 564, 183, 320, 322, 363,
-	362, 345, 967, 968, 969, 970, 971, 21, 173, 1005,
-	423, 1016, 763, 1026, 74, 74, 931, 74, 361, 845,
-	292, 33, 360, 1114, 1013, 1087, 1090, 1027, 1090, 183,
-	328, 327, 286, 850, 1033, 173, 523, 1034, 657, 826,
-	21, 1041, 751, 1037, 21, 750, 21, 749, 1039, 21,
-	21, 655, 654, 1012, 33, 382, 996, 474, 33, 476,
-	33, 173, 1004, 33, 33, 845, 966, 21, 990, 1063,
-	1058, 653, 21, 21, 383, 845, 173, 652, 21, 850,
-	987, 33, 534, 21, 535, 536, 33, 33, 810, 850,
-	381, 382, 33, 173, 173, 648, 649, 33, 21, 1098,
-	1094, 526, 21, 173, 1096, 845, 183, 240, 89, 377,
-	965, 691, 33, 512, 690, 420, 33, 293, 697, 850,
-	522, 688, 136, 527, 135, 1111, 440, 1115, 417, 418,
-	849, 21, 996, 1063, 101, 996, 996, 419, 845, 194,
-	1119, 910, 845, 183, 990, 33, 1045, 990, 990, 1049,
-	1050, 795, 850, 996, 817, 818, 850, 784, 996, 996,
-	111, 101, 261, 262, 263, 990, 778, 1060, 66, 996,
-	990, 990, 1066, 1067, 776, 416, 845, 682, 683, 684,
-	685, 990, 693, 1080, 996, 306, 393, 251, 996, 5,
-	850, 589, 483, 257, 121, 245, 990, 386, 1097, 101,
-	990, 399, 1100, 979, 148, 150, 849, 849, 908, 909,
-	602, 244, 59, 124, 94, 634, 244, 996, 243, 345,
-	101, 173, 404, 295, 294, 251, 173, 173, 173, 990,
-	290, 1117, 96, 94, 84, 96, 74, 93, 190, 441,
-	131, 626, 193, 67, 139, 1061, 251, 985, 787, 373,
-	632, 10, 9, 849, 545, 945, 8, 7, 375, 62,
-	182, 342, 343, 392, 102, 103, 104, 142, 105, 106,
-	107, 108, 151, 152, 391, 160, 161, 249, 252, 1113,
-	1086, 166, 1068, 1053, 88, 170, 61, 174, 60, 176,
-	177, 102, 103, 104, 64, 253, 254, 255, 256, 849,
-	396, 984, 57, 223, 182, 63, 58, 816, 647, 849,
-	323, 1001, 521, 520, 56, 192, 643, 704, 638, 394,
-	708, 709, 182, 635, 101, 241, 369, 358, 359, 102,
-	103, 104, 226, 105, 106, 107, 108, 6, 20, 849,
-	714, 1022, 19, 69, 153, 173, 173, 173, 173, 173,
-	102, 103, 104, 101, 253, 254, 255, 256, 17, 728,
-	585, 250, 582, 250, 16, 438, 15, 14, 11, 250,
-	269, 250, 849, 18, 1040, 13, 849, 538, 1043, 278,
-	250, 280, 281, 522, 12, 991, 846, 989, 287, 745,
-	173, 101, 844, 337, 455, 453, 131, 4, 2, 0,
-	0, 101, 0, 0, 0, 0, 757, 0, 173, 96,
-	849, 0, 1074, 0, 325, 201, 210, 209, 200, 199,
-	202, 198, 0, 0, 0, 771, 0, 0, 314, 786,
-	475, 325, 325, 0, 792, 793, 477, 478, 0, 0,
-	0, 0, 0, 0, 377, 0, 0, 0, 336, 0,
-	348, 0, 0, 0, 102, 103, 104, 397, 105, 106,
-	107, 108, 0, 101, 368, 0, 0, 0, 0, 495,
-	93, 397, 201, 210, 209, 200, 199, 202, 198, 250,
-	250, 0, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 0, 250, 250, 0, 0, 196, 195, 0, 348,
-	0, 0, 206, 197, 205, 204, 0, 0, 0, 207,
-	208, 504, 861, 0, 182, 0, 0, 430, 432, 433,
-	435, 102, 103, 104, 0, 105, 106, 107, 108, 0,
-	250, 102, 103, 104, 325, 105, 106, 107, 108, 0,
-	325, 325, 0, 463, 0, 465, 880, 0, 0, 0,
-	0, 0, 0, 196, 195, 0, 0, 0, 885, 206,
-	197, 205, 204, 0, 0, 0, 207, 208, 298, 0,
-	173, 0, 0, 325, 498, 498, 498, 0, 0, 0,
-	0, 0, 913, 0, 182, 121, 916, 0, 543, 0,
-	0, 0, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 0, 0, 0, 0, 567, 621, 397, 101, 0,
-	0, 0, 0, 0, 576, 0, 579, 397, 348, 131,
-	0, 131, 131, 0, 0, 940, 537, 0, 0, 250,
-	0, 0, 0, 0, 547, 250, 551, 0, 0, 250,
-	250, 0, 0, 0, 0, 0, 0, 0, 547, 566,
-	621, 0, 570, 547, 547, 574, 0, 0, 0, 577,
-	566, 0, 0, 587, 0, 0, 0, 0, 201, 210,
-	209, 200, 199, 202, 198, 0, 534, 0, 535, 536,
-	531, 528, 888, 1003, 532, 377, 0, 182, 0, 0,
-	0, 0, 0, 201, 210, 209, 200, 199, 202, 198,
-	0, 598, 599, 173, 0, 566, 0, 0, 0, 0,
-	325, 0, 0, 372, 0, 0, 0, 348, 606, 0,
-	0, 201, 210, 209, 200, 199, 202, 198, 725, 0,
-	121, 201, 210, 209, 200, 199, 202, 198, 102, 103,
-	104, 522, 105, 106, 107, 108, 397, 0, 0, 196,
-	195, 0, 513, 0, 325, 206, 197, 205, 204, 0,
-	0, 936, 207, 208, 0, 0, 0, 0, 250, 0,
-	0, 0, 0, 662, 196, 195, 0, 547, 0, 0,
-	206, 197, 205, 204, 0, 377, 0, 207, 208, 547,
-	712, 0, 0, 0, 0, 0, 0, 547, 0, 0,
-	0, 0, 196, 195, 570, 0, 0, 547, 206, 197,
-	205, 204, 196, 195, 731, 207, 208, 0, 206, 197,
-	205, 204, 0, 0, 701, 207, 208, 0, 0, 0,
-	0, 0, 325, 0, 0, 0, 621, 0, 0, 0,
-	201, 210, 209, 200, 199, 202, 198, 534, 0, 535,
-	536, 531, 528, 821, 822, 532, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 397, 397, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 348, 0, 0, 0, 0, 0, 0, 0, 250,
-	250, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 547, 0, 0, 0, 250, 547, 0,
-	0, 0, 0, 547, 0, 566, 0, 0, 0, 547,
-	547, 196, 195, 0, 0, 773, 774, 206, 197, 205,
-	204, 0, 0, 0, 207, 208, 0, 0, 621, 0,
-	325, 0, 0, 0, 830, 621, 0, 0, 0, 0,
-	0, 0, 837, 0, 0, 839, 0, 0, 0, 0,
-	0, 0, 397, 397, 397, 0, 842, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 201, 603, 209,
-	200, 199, 202, 198, 250, 250, 250, 0, 827, 0,
-	0, 0, 0, 201, 473, 209, 200, 199, 202, 198,
-	0, 0, 0, 621, 0, 570, 0, 0, 0, 0,
+	91, 1353, 1366, 97, 87, 1345, 1296, 615, 1370, 1352,
+	1330, 1243, 1141, 1195, 1185, 1232, 575, 1228, 1242, 1009,
+	788, 920, 1098, 392, 583, 141, 805, 1065, 1100, 960,
+	1161, 1008, 1042, 306, 655, 637, 167, 428, 216, 700,
+	847, 176, 177, 782, 185, 186, 1099, 217, 189, 777,
+	429, 854, 194, 71, 721, 565, 198, 426, 202, 659,
+	204, 661, 208, 434, 662, 639, 270, 468, 283, 67,
+	733, 271, 498, 390, 516, 28, 492, 741, 577, 595,
+	277, 594, 387, 783, 152, 564, 221, 589, 165, 165,
+	200, 168, 297, 515, 27, 281, 555, 438, 86, 1,
+	445, 28, 162, 84, 252, 633, 258, 332, 435, 1205,
+	212, 225, 459, 74, 263, 244, 237, 1145, 236, 235,
+	27, 517, 245, 238, 239, 542, 144, 244, 245, 1058,
+	244, 1209, 215, 1057, 1197, 145, 109, 611, 166, 601,
+	598, 602, 603, 596, 593, 352, 523, 597, 1278, 285,
+	977, 285, 174, 978, 340, 385, 136, 36, 285, 308,
+	309, 310, 285, 1275, 797, 193, 269, 798, 1118, 266,
+	319, 285, 321, 322, 760, 955, 274, 761, 237, 328,
+	236, 235, 237, 36, 898, 238, 239, 80, 874, 238,
+	239, 335, 153, 832, 148, 795, 794, 150, 791, 147,
+	773, 771, 149, 762, 758, 728, 715, 669, 28, 666,
+	592, 353, 540, 457, 452, 357, 313, 1408, 1365, 298,
+	101, 131, 1313, 1310, 358, 209, 1309, 27, 1277, 599,
+	600, 612, 245, 264, 1274, 368, 1273, 244, 353, 320,
+	1272, 1271, 369, 624, 1270, 382, 1269, 394, 1268, 282,
+	1267, 1266, 353, 209, 405, 406, 356, 1265, 307, 1264,
+	1184, 1183, 311, 153, 415, 1180, 353, 353, 1179, 1175,
+	1173, 355, 751, 131, 958, 1171, 1170, 592, 1160, 1158,
+	448, 285, 1137, 339, 1129, 1121, 80, 1117, 1059, 1007,
+	36, 989, 979, 976, 369, 526, 285, 448, 936, 935,
+	285, 934, 933, 247, 394, 932, 931, 926, 900, 303,
+	897, 887, 883, 145, 876, 510, 3, 436, 151, 312,
+	875, 831, 826, 825, 485, 487, 488, 490, 824, 143,
+	22, 817, 437, 362, 813, 500, 793, 790, 772, 285,
+	28, 770, 3, 705, 698, 697, 696, 684, 652, 558,
+	550, 539, 537, 520, 134, 522, 22, 433, 481, 27,
+	469, 464, 418, 155, 157, 417, 349, 165, 625, 532,
+	556, 1252, 350, 465, 187, 506, 348, 535, 536, 191,
+	192, 450, 195, 196, 197, 199, 463, 203, 101, 1346,
+	1303, 1182, 521, 613, 455, 508, 454, 1074, 658, 1181,
+	458, 1174, 1172, 212, 403, 404, 211, 437, 214, 497,
+	1169, 554, 504, 505, 461, 462, 477, 413, 1168, 1167,
+	1166, 1165, 36, 1164, 1064, 1049, 1047, 1037, 1034, 1032,
+	1031, 1024, 1023, 394, 155, 1021, 986, 970, 957, 503,
+	956, 604, 1276, 606, 525, 448, 904, 815, 776, 3,
+	501, 502, 618, 285, 622, 527, 587, 448, 285, 630,
+	763, 529, 748, 22, 747, 211, 528, 618, 641, 702,
+	679, 643, 644, 647, 618, 618, 651, 636, 553, 610,
+	654, 656, 609, 549, 665, 231, 241, 240, 230, 229,
+	232, 233, 228, 548, 547, 546, 28, 588, 466, 545,
+	544, 994, 543, 483, 482, 453, 559, 560, 36, 163,
+	156, 268, 262, 329, 330, 27, 561, 261, 480, 626,
+	467, 569, 620, 155, 677, 678, 298, 156, 656, 249,
+	248, 247, 246, 254, 668, 759, 664, 326, 344, 673,
+	671, 394, 686, 324, 632, 133, 634, 635, 619, 437,
+	628, 314, 209, 282, 627, 1367, 645, 849, 1035, 726,
+	701, 1033, 680, 1307, 851, 163, 411, 950, 746, 1134,
+	738, 1187, 1285, 789, 80, 836, 789, 683, 36, 1396,
+	1298, 3, 722, 930, 334, 1143, 807, 273, 617, 940,
+	1151, 226, 225, 448, 1284, 22, 1204, 237, 227, 236,
+	235, 938, 425, 638, 238, 239, 749, 1006, 750, 929,
+	648, 650, 754, 941, 618, 723, 701, 1005, 1395, 907,
+	190, 1262, 1113, 685, 727, 939, 618, 1101, 316, 65,
+	448, 848, 768, 1306, 1308, 206, 250, 618, 1111, 1133,
+	580, 28, 774, 251, 1116, 1107, 1106, 647, 28, 756,
+	618, 708, 755, 484, 486, 489, 491, 494, 412, 154,
+	27, 764, 494, 499, 739, 1105, 709, 27, 800, 499,
+	499, 36, 769, 713, 743, 507, 732, 745, 325, 744,
+	724, 22, 1397, 315, 323, 785, 181, 182, 1104, 1103,
+	1102, 765, 937, 704, 971, 969, 757, 579, 688, 689,
+	690, 691, 692, 830, 479, 816, 601, 598, 602, 603,
+	596, 593, 961, 962, 597, 317, 318, 827, 828, 829,
+	234, 101, 718, 36, 703, 1412, 835, 1386, 255, 394,
+	36, 1364, 1363, 1359, 1356, 1333, 1332, 3, 448, 448,
+	448, 448, 801, 799, 1321, 1286, 1354, 448, 872, 873,
+	638, 22, 587, 850, 170, 179, 180, 183, 184, 618,
+	581, 582, 638, 285, 618, 822, 878, 1260, 448, 1251,
+	1249, 1246, 618, 638, 641, 1153, 1150, 894, 1149, 1086,
+	842, 618, 618, 621, 841, 1073, 638, 901, 902, 28,
+	1020, 719, 656, 1019, 845, 1015, 599, 600, 1012, 882,
+	923, 922, 839, 852, 707, 670, 574, 889, 27, 169,
+	570, 568, 1355, 1302, 844, 171, 1354, 871, 253, 1301,
+	1257, 877, 1256, 869, 1144, 1245, 906, 811, 36, 1244,
+	1011, 881, 36, 36, 1010, 1328, 701, 154, 810, 891,
+	890, 172, 672, 676, 22, 675, 567, 351, 664, 912,
+	566, 1244, 664, 1193, 1010, 918, 910, 911, 915, 909,
+	566, 370, 423, 448, 421, 1413, 448, 448, 448, 448,
+	1392, 36, 942, 1358, 1348, 972, 1347, 1322, 1295, 1292,
+	370, 370, 3, 954, 1283, 1248, 1237, 448, 1154, 3,
+	1140, 1092, 1014, 840, 804, 617, 22, 710, 949, 647,
+	638, 948, 947, 22, 714, 573, 265, 1331, 638, 449,
+	674, 1415, 1196, 1324, 1297, 28, 921, 895, 896, 601,
+	598, 602, 603, 596, 593, 1069, 449, 597, 601, 598,
+	602, 603, 596, 593, 27, 1156, 597, 1142, 1016, 752,
+	946, 1067, 991, 427, 843, 806, 419, 990, 272, 1371,
+	1372, 1394, 1025, 1026, 1027, 1028, 1029, 1030, 1393, 1362,
+	1361, 36, 1293, 1094, 1423, 1093, 448, 36, 36, 448,
+	618, 1018, 1056, 1017, 802, 1355, 1245, 1011, 701, 1340,
+	1341, 567, 1411, 1349, 1320, 1212, 1152, 618, 701, 1039,
+	1046, 945, 1040, 1050, 1051, 370, 494, 36, 838, 499,
+	1390, 22, 1038, 370, 370, 22, 22, 1041, 1290, 599,
+	600, 1090, 711, 1417, 1060, 1406, 439, 1002, 599, 600,
+	1379, 1427, 1071, 1399, 1070, 1076, 1419, 1371, 1372, 1374,
+	3, 108, 1404, 1405, 1402, 1403, 1378, 370, 557, 557,
+	557, 1087, 1080, 1377, 22, 1400, 1401, 846, 1338, 1376,
+	656, 1081, 1375, 1235, 834, 1110, 1339, 1079, 1128, 1343,
+	1109, 108, 80, 1109, 36, 618, 701, 803, 1115, 1189,
+	1062, 808, 809, 984, 449, 36, 974, 304, 1108, 212,
+	1122, 1112, 1124, 106, 1123, 988, 449, 893, 892, 154,
+	254, 154, 154, 365, 1132, 1398, 1135, 364, 366, 367,
+	1131, 408, 1130, 1186, 1369, 407, 1055, 1374, 80, 108,
+	1002, 699, 1210, 1146, 1240, 1002, 1002, 903, 1155, 1127,
+	1148, 460, 1126, 638, 80, 80, 913, 524, 80, 354,
+	980, 80, 410, 409, 22, 1147, 919, 1186, 374, 373,
+	22, 22, 578, 300, 301, 302, 1207, 1208, 301, 888,
+	886, 1177, 767, 107, 333, 327, 3, 36, 601, 598,
+	602, 603, 36, 36, 1188, 471, 470, 36, 742, 968,
+	22, 36, 601, 425, 602, 603, 868, 867, 866, 1214,
+	740, 430, 431, 618, 1002, 431, 370, 1218, 1219, 1220,
+	1221, 1222, 1216, 701, 1163, 1224, 1226, 1217, 1109, 735,
+	916, 638, 1239, 1109, 973, 576, 924, 925, 730, 731,
+	1258, 1259, 1227, 1250, 432, 734, 1223, 394, 944, 590,
+	275, 1225, 449, 996, 1162, 158, 160, 787, 1254, 1241,
+	475, 36, 796, 1261, 159, 786, 336, 22, 188, 701,
+	587, 1263, 370, 472, 473, 784, 952, 953, 22, 36,
+	1002, 72, 474, 778, 779, 780, 781, 161, 224, 449,
+	1279, 1002, 1287, 1085, 927, 343, 1206, 914, 908, 905,
+	469, 792, 667, 541, 1421, 1280, 1380, 1312, 495, 618,
+	1315, 601, 598, 602, 603, 596, 593, 1053, 299, 597,
+	173, 175, 295, 1311, 1314, 146, 280, 36, 279, 1318,
+	1319, 36, 1382, 1002, 1323, 278, 1317, 267, 36, 1383,
+	1342, 36, 1384, 1410, 1013, 1281, 996, 618, 1282, 1234,
+	1097, 996, 996, 992, 1344, 1206, 1336, 928, 1075, 370,
+	22, 435, 1316, 1078, 1082, 22, 22, 451, 1255, 1351,
+	22, 1089, 1176, 1373, 22, 716, 279, 618, 1360, 456,
+	36, 338, 337, 331, 1002, 104, 102, 102, 1002, 104,
+	36, 101, 1385, 1387, 578, 220, 496, 449, 449, 449,
+	449, 599, 600, 1305, 1206, 211, 449, 223, 1206, 1206,
+	259, 73, 1381, 164, 1407, 260, 1327, 1294, 1409, 1192,
+	996, 1299, 1300, 917, 420, 1066, 11, 449, 1414, 10,
+	9, 36, 1002, 1373, 22, 36, 1088, 1420, 616, 36,
+	1091, 618, 8, 36, 36, 1234, 7, 1206, 1422, 422,
+	68, 1426, 22, 1206, 1206, 1428, 1429, 1425, 388, 389,
+	1326, 1233, 1002, 1230, 442, 441, 1334, 1335, 601, 598,
+	602, 603, 596, 593, 982, 440, 597, 284, 287, 36,
+	1206, 1418, 36, 617, 1368, 1337, 996, 29, 36, 36,
+	1199, 608, 370, 1357, 305, 5, 1304, 996, 96, 66,
+	22, 70, 1194, 63, 22, 69, 1206, 64, 951, 36,
+	1206, 22, 729, 638, 22, 36, 919, 585, 1157, 1388,
+	584, 62, 449, 1391, 222, 449, 449, 449, 449, 725,
+	720, 717, 1077, 1043, 1001, 855, 276, 1083, 1084, 996,
+	6, 36, 21, 20, 1206, 36, 449, 75, 207, 1199,
+	178, 18, 663, 22, 660, 17, 205, 1416, 599, 600,
+	493, 1253, 16, 22, 207, 1206, 15, 640, 12, 88,
+	19, 14, 213, 13, 1200, 997, 1198, 617, 1424, 36,
+	1213, 995, 511, 384, 509, 402, 4, 2, 0, 0,
+	996, 0, 0, 0, 996, 142, 0, 0, 1199, 0,
+	36, 0, 1199, 1199, 22, 1289, 1139, 0, 22, 0,
+	0, 0, 22, 0, 0, 0, 22, 22, 0, 0,
+	0, 0, 0, 207, 0, 449, 201, 1001, 449, 0,
+	0, 213, 1001, 1001, 370, 0, 0, 0, 996, 736,
+	0, 1199, 0, 207, 370, 0, 210, 1199, 1199, 0,
+	476, 213, 22, 0, 0, 22, 0, 1329, 0, 242,
+	243, 22, 22, 0, 0, 0, 0, 0, 996, 256,
+	257, 0, 1191, 0, 1199, 0, 766, 0, 0, 0,
+	0, 0, 22, 1211, 1194, 0, 0, 0, 22, 0,
+	0, 0, 0, 0, 207, 0, 0, 0, 0, 0,
+	1199, 1001, 342, 0, 1199, 210, 0, 0, 0, 0,
+	142, 0, 0, 0, 22, 1389, 0, 0, 22, 0,
+	0, 0, 370, 538, 0, 1247, 201, 0, 601, 598,
+	602, 603, 596, 593, 884, 0, 597, 0, 1199, 0,
+	0, 551, 552, 0, 0, 0, 0, 0, 0, 0,
+	0, 562, 22, 0, 0, 0, 0, 0, 0, 1199,
+	0, 0, 0, 0, 0, 0, 0, 1001, 0, 0,
+	0, 0, 0, 22, 0, 1329, 1288, 0, 1001, 346,
+	1291, 0, 0, 0, 860, 861, 863, 864, 0, 0,
+	0, 0, 0, 870, 0, 359, 360, 361, 0, 363,
+	0, 0, 371, 372, 0, 375, 376, 377, 378, 379,
+	380, 381, 0, 0, 885, 201, 391, 201, 599, 600,
+	1001, 0, 0, 0, 1325, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 424, 0, 0, 0, 0, 0, 0, 370,
+	0, 0, 0, 0, 1350, 0, 0, 207, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 0, 0, 0,
+	0, 1001, 0, 391, 0, 1001, 0, 0, 0, 0,
+	687, 0, 201, 0, 478, 693, 694, 695, 0, 0,
+	0, 0, 0, 0, 231, 370, 0, 230, 229, 232,
+	233, 228, 0, 0, 0, 0, 0, 0, 0, 959,
+	0, 201, 963, 964, 966, 967, 0, 0, 0, 1001,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 895, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
-	0, 0, 325, 0, 0, 0, 0, 0, 0, 325,
-	0, 0, 0, 0, 0, 0, 0, 919, 196, 195,
-	0, 250, 0, 0, 206, 197, 205, 204, 0, 0,
-	0, 207, 208, 547, 196, 195, 0, 0, 0, 0,
-	206, 197, 205, 204, 0, 0, 0, 207, 208, 0,
-	0, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 325, 0, 0,
+	0, 0, 207, 983, 531, 0, 533, 534, 207, 201,
+	213, 0, 0, 0, 0, 0, 614, 0, 753, 1001,
+	0, 0, 0, 0, 0, 201, 207, 0, 0, 0,
+	0, 0, 0, 0, 642, 0, 0, 207, 0, 207,
+	0, 0, 0, 201, 201, 653, 0, 657, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 424,
+	0, 0, 0, 571, 0, 0, 0, 0, 110, 0,
+	226, 225, 586, 0, 0, 591, 237, 227, 236, 235,
+	0, 0, 1052, 238, 239, 1054, 0, 0, 0, 0,
+	0, 0, 0, 443, 286, 0, 818, 819, 820, 821,
+	823, 0, 0, 0, 0, 0, 0, 0, 0, 124,
+	370, 0, 0, 0, 207, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 110, 81, 82, 83, 0, 106,
+	85, 101, 104, 102, 103, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 80, 0, 138, 0, 0,
+	132, 370, 0, 142, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 880,
+	681, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	391, 0, 201, 0, 0, 0, 0, 201, 201, 201,
+	98, 0, 0, 0, 99, 0, 0, 0, 0, 107,
+	0, 0, 129, 706, 0, 0, 123, 0, 108, 140,
+	137, 0, 712, 128, 111, 112, 113, 114, 115, 105,
+	125, 126, 0, 127, 288, 289, 290, 291, 292, 293,
+	294, 0, 446, 447, 0, 0, 0, 207, 0, 0,
+	0, 0, 0, 0, 0, 812, 0, 0, 0, 0,
+	201, 0, 444, 0, 0, 0, 0, 0, 129, 396,
+	0, 0, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 0, 125, 126, 93, 127,
+	116, 117, 118, 119, 120, 121, 122, 131, 0, 0,
+	397, 92, 395, 398, 399, 400, 401, 0, 0, 0,
+	0, 0, 0, 393, 0, 89, 90, 100, 76, 386,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 566, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 547, 0, 0, 983, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 75, 76, 77,
-	0, 98, 79, 93, 96, 94, 95, 22, 71, 0,
-	0, 0, 35, 36, 0, 0, 0, 0, 0, 28,
-	0, 1015, 111, 0, 29, 44, 0, 30, 0, 0,
-	0, 0, 0, 0, 0, 997, 998, 0, 0, 0,
-	0, 0, 0, 0, 0, 325, 0, 0, 0, 0,
+	0, 0, 814, 0, 0, 0, 0, 0, 201, 201,
+	201, 201, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 833, 0, 110, 81, 82, 83, 0, 106,
+	85, 101, 104, 102, 103, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 586, 0,
+	132, 0, 0, 0, 853, 856, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 1061, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 879,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 99, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 899, 0, 0, 0, 108, 140,
+	137, 0, 0, 0, 207, 0, 0, 0, 0, 105,
+	0, 0, 975, 0, 0, 207, 424, 0, 207, 0,
+	0, 0, 0, 985, 0, 0, 987, 0, 0, 0,
+	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
+	0, 993, 0, 0, 0, 0, 0, 0, 129, 396,
+	0, 0, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 0, 125, 126, 93, 127,
+	116, 117, 118, 119, 120, 121, 122, 131, 110, 0,
+	397, 92, 395, 398, 399, 400, 401, 0, 0, 0,
+	0, 0, 0, 393, 981, 89, 90, 100, 76, 0,
+	0, 0, 0, 443, 286, 0, 0, 207, 0, 0,
+	0, 0, 0, 0, 0, 1063, 0, 0, 0, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 90, 0, 0, 0, 91, 0, 0, 0, 99,
-	0, 74, 0, 0, 0, 0, 0, 325, 993, 992,
-	0, 851, 0, 0, 1030, 1031, 0, 32, 97, 348,
-	39, 37, 38, 34, 40, 0, 0, 0, 0, 0,
-	0, 0, 42, 43, 461, 462, 0, 47, 48, 49,
-	50, 41, 52, 53, 54, 45, 51, 55, 0, 0,
-	0, 852, 0, 0, 31, 46, 102, 103, 104, 0,
-	105, 106, 107, 108, 110, 0, 87, 85, 86, 109,
+	0, 0, 0, 0, 0, 0, 0, 110, 1022, 231,
+	241, 240, 230, 229, 232, 233, 228, 0, 0, 207,
+	0, 0, 0, 1036, 0, 0, 0, 1095, 0, 0,
+	0, 0, 443, 286, 0, 856, 1044, 1044, 0, 0,
+	0, 1048, 0, 207, 0, 0, 0, 0, 124, 0,
+	0, 213, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 1068, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1072, 0, 0, 0, 0, 0, 0, 142,
+	0, 0, 129, 0, 0, 0, 123, 0, 0, 0,
+	0, 0, 965, 128, 111, 112, 113, 114, 115, 0,
+	125, 126, 0, 127, 288, 289, 290, 291, 292, 293,
+	294, 0, 446, 447, 0, 226, 225, 0, 0, 0,
+	0, 237, 227, 236, 235, 1120, 0, 1044, 238, 239,
+	943, 0, 444, 1125, 0, 207, 0, 0, 0, 0,
+	0, 129, 0, 1190, 0, 123, 0, 0, 0, 1136,
+	0, 865, 128, 111, 112, 113, 114, 115, 0, 125,
+	126, 0, 127, 288, 289, 290, 291, 292, 293, 294,
+	0, 446, 447, 0, 0, 0, 0, 1159, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 0, 0, 0,
+	1236, 444, 0, 0, 0, 0, 0, 0, 1044, 0,
+	0, 0, 0, 0, 110, 81, 82, 83, 0, 106,
+	85, 101, 104, 102, 103, 23, 77, 0, 0, 0,
+	38, 39, 424, 0, 0, 0, 0, 30, 0, 0,
+	132, 0, 31, 49, 32, 33, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 124, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 1231, 0, 0, 0, 0, 1238, 0, 0, 0,
+	98, 0, 0, 0, 99, 0, 0, 0, 0, 107,
+	0, 80, 142, 0, 0, 0, 0, 0, 108, 1202,
+	1201, 0, 1003, 0, 0, 0, 586, 0, 35, 105,
+	0, 42, 40, 41, 37, 43, 0, 0, 0, 0,
+	0, 0, 0, 45, 46, 47, 48, 518, 519, 0,
+	52, 53, 54, 55, 44, 57, 58, 59, 50, 56,
+	61, 0, 0, 1203, 1004, 0, 0, 0, 129, 34,
+	51, 60, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 1231, 125, 126, 93, 127,
+	116, 117, 118, 119, 120, 121, 122, 131, 0, 0,
+	95, 92, 94, 130, 0, 0, 0, 424, 0, 0,
+	0, 0, 0, 0, 0, 89, 90, 100, 76, 110,
+	81, 82, 83, 0, 106, 85, 101, 104, 102, 103,
+	23, 77, 0, 0, 0, 38, 39, 0, 0, 0,
+	0, 0, 30, 0, 0, 132, 0, 31, 49, 32,
+	33, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 231, 241, 240,
+	230, 229, 232, 233, 228, 98, 0, 0, 0, 99,
+	0, 0, 0, 0, 107, 0, 80, 0, 0, 0,
+	0, 0, 0, 108, 513, 512, 0, 78, 0, 0,
+	0, 0, 0, 35, 105, 0, 42, 40, 41, 37,
+	43, 0, 0, 0, 0, 0, 0, 0, 45, 46,
+	47, 48, 518, 519, 79, 52, 53, 54, 55, 44,
+	57, 58, 59, 50, 56, 61, 0, 0, 514, 0,
+	0, 0, 0, 129, 34, 51, 60, 123, 0, 0,
+	0, 0, 0, 0, 128, 111, 112, 113, 114, 115,
+	0, 125, 126, 93, 127, 116, 117, 118, 119, 120,
+	121, 122, 131, 226, 225, 95, 92, 94, 130, 237,
+	227, 236, 235, 0, 0, 347, 238, 239, 1178, 0,
+	89, 90, 100, 76, 110, 81, 82, 83, 0, 106,
+	85, 101, 104, 102, 103, 23, 77, 0, 0, 0,
+	38, 39, 0, 0, 0, 0, 0, 30, 0, 0,
+	132, 0, 31, 49, 32, 33, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 92, 70, 101, 75, 76, 77, 0,
-	98, 79, 93, 96, 94, 95, 22, 71, 0, 0,
-	0, 35, 36, 0, 0, 0, 0, 0, 28, 0,
-	0, 111, 0, 29, 44, 0, 30, 0, 0, 0,
+	0, 0, 231, 241, 240, 230, 229, 232, 233, 228,
+	98, 0, 0, 0, 99, 0, 110, 0, 0, 107,
+	0, 80, 0, 0, 0, 0, 0, 0, 108, 999,
+	998, 0, 1003, 0, 0, 0, 0, 0, 35, 105,
+	0, 42, 40, 41, 37, 43, 0, 0, 0, 0,
+	0, 0, 0, 45, 46, 47, 48, 124, 0, 0,
+	52, 53, 54, 55, 44, 57, 58, 59, 50, 56,
+	61, 0, 0, 1000, 1004, 0, 0, 0, 129, 34,
+	51, 60, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 0, 125, 126, 93, 127,
+	116, 117, 118, 119, 120, 121, 122, 131, 226, 225,
+	95, 92, 94, 130, 237, 227, 236, 235, 0, 0,
+	347, 238, 239, 341, 0, 89, 90, 100, 76, 110,
+	81, 82, 83, 0, 106, 85, 101, 104, 102, 103,
+	23, 77, 0, 0, 0, 38, 39, 0, 0, 0,
+	129, 0, 30, 0, 123, 132, 0, 31, 49, 32,
+	33, 128, 111, 112, 113, 114, 115, 0, 125, 126,
+	124, 127, 116, 117, 118, 119, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 231, 241, 240,
+	230, 229, 232, 233, 228, 98, 0, 0, 0, 99,
+	646, 0, 0, 0, 107, 0, 80, 0, 0, 0,
+	0, 0, 0, 108, 25, 24, 0, 78, 0, 0,
+	0, 0, 0, 35, 105, 0, 42, 40, 41, 37,
+	43, 0, 0, 0, 0, 0, 0, 0, 45, 46,
+	47, 48, 0, 0, 79, 52, 53, 54, 55, 44,
+	57, 58, 59, 50, 56, 61, 0, 0, 26, 0,
+	0, 0, 0, 129, 34, 51, 60, 123, 0, 0,
+	0, 0, 0, 0, 128, 111, 112, 113, 114, 115,
+	0, 125, 126, 93, 127, 116, 117, 118, 119, 120,
+	121, 122, 131, 226, 225, 95, 92, 94, 130, 237,
+	227, 236, 235, 0, 0, 0, 238, 239, 563, 0,
+	89, 90, 100, 76, 110, 81, 82, 83, 0, 106,
+	85, 101, 104, 102, 103, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 0,
+	132, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 110, 81, 82, 83,
+	0, 106, 85, 101, 104, 102, 103, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	98, 0, 132, 0, 99, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 124, 108, 140,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 105,
+	0, 0, 0, 0, 231, 241, 240, 230, 229, 232,
+	233, 228, 98, 0, 0, 0, 99, 0, 0, 0,
+	0, 107, 0, 80, 0, 0, 0, 0, 0, 0,
+	108, 140, 137, 0, 0, 0, 0, 0, 129, 396,
+	0, 105, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 0, 125, 126, 93, 127,
+	116, 117, 118, 119, 120, 121, 122, 131, 0, 0,
+	397, 92, 395, 398, 399, 400, 401, 0, 0, 0,
+	129, 139, 0, 0, 123, 89, 90, 100, 76, 0,
+	0, 128, 111, 112, 113, 114, 115, 0, 125, 126,
+	93, 127, 116, 117, 118, 119, 120, 121, 122, 131,
+	226, 225, 95, 92, 94, 130, 237, 227, 236, 235,
+	0, 0, 0, 238, 239, 341, 0, 89, 90, 100,
+	76, 1119, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 0, 0, 132, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1229, 98, 0,
+	0, 0, 99, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 231, 241,
+	240, 230, 229, 232, 233, 228, 0, 0, 0, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 226, 225, 219, 105, 0, 0,
+	237, 227, 236, 235, 0, 0, 1215, 238, 239, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 218, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 231, 241,
+	240, 230, 229, 232, 233, 228, 0, 0, 0, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 393, 99, 89, 90, 100, 76, 107, 304, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 226, 225, 0, 105, 0, 0,
+	237, 227, 236, 235, 0, 0, 1138, 238, 239, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 80,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 231, 241,
+	240, 230, 229, 232, 233, 228, 0, 0, 0, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 226, 225, 0, 105, 0, 0,
+	237, 227, 236, 235, 0, 0, 1114, 238, 239, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 231, 241,
+	240, 230, 229, 232, 233, 228, 0, 0, 0, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 135, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 226, 225, 0, 105, 0, 0,
+	237, 227, 236, 235, 0, 0, 1096, 238, 239, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 1045, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 0, 231,
+	241, 240, 230, 229, 232, 233, 228, 0, 0, 0,
+	0, 0, 110, 81, 82, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 129, 139, 623, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 857, 858, 859, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 0, 95, 92,
+	94, 130, 0, 0, 0, 110, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 140, 137, 0,
+	443, 286, 0, 0, 0, 226, 225, 105, 0, 0,
+	0, 237, 227, 236, 235, 0, 124, 837, 238, 239,
+	0, 0, 110, 81, 345, 83, 0, 106, 85, 101,
+	104, 102, 103, 0, 77, 231, 241, 240, 230, 229,
+	232, 233, 228, 0, 0, 138, 129, 139, 132, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 124, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 0, 110, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 99, 89, 90, 100, 76, 107, 0, 0,
+	0, 0, 443, 286, 0, 0, 108, 140, 137, 129,
+	0, 0, 0, 123, 0, 0, 0, 105, 124, 862,
+	128, 111, 112, 113, 114, 115, 0, 125, 126, 0,
+	127, 288, 289, 290, 291, 292, 293, 294, 0, 446,
+	447, 226, 225, 0, 0, 0, 0, 237, 227, 236,
+	235, 0, 0, 0, 238, 239, 129, 139, 0, 444,
+	123, 110, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 0, 125, 126, 93, 127, 116, 117,
+	118, 119, 120, 121, 122, 131, 443, 286, 95, 92,
+	94, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 89, 90, 100, 76, 0, 0, 0,
+	0, 129, 0, 0, 110, 123, 0, 0, 0, 0,
+	0, 737, 128, 111, 112, 113, 114, 115, 0, 125,
+	126, 0, 127, 288, 289, 290, 291, 292, 293, 294,
+	132, 446, 447, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 231, 241, 240, 230, 229, 232, 233, 228, 0,
+	0, 0, 231, 241, 240, 230, 229, 232, 233, 228,
+	0, 0, 0, 1067, 0, 129, 0, 0, 0, 123,
+	0, 0, 0, 0, 419, 0, 128, 111, 112, 113,
+	114, 115, 0, 125, 126, 0, 127, 288, 289, 290,
+	291, 292, 293, 294, 110, 446, 447, 231, 241, 240,
+	230, 229, 232, 233, 228, 0, 0, 231, 682, 240,
+	230, 229, 232, 233, 228, 444, 0, 0, 129, 0,
+	572, 0, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 124, 125, 126, 0, 127,
+	116, 117, 118, 119, 120, 121, 122, 226, 225, 0,
+	0, 0, 0, 237, 227, 236, 235, 0, 226, 225,
+	238, 239, 0, 0, 237, 227, 236, 235, 649, 0,
+	0, 238, 239, 231, 530, 240, 230, 229, 232, 233,
+	228, 0, 0, 231, 241, 0, 230, 229, 232, 233,
+	228, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 226, 225, 0, 0, 110, 0, 237,
+	227, 236, 235, 226, 225, 0, 238, 239, 0, 237,
+	227, 236, 235, 0, 0, 0, 238, 239, 129, 139,
+	0, 631, 123, 0, 0, 0, 0, 0, 0, 128,
+	111, 112, 113, 114, 115, 0, 125, 126, 124, 127,
+	116, 117, 118, 119, 120, 121, 122, 0, 110, 0,
+	95, 0, 94, 130, 0, 0, 629, 0, 0, 0,
+	0, 0, 296, 0, 0, 0, 0, 0, 0, 226,
+	225, 0, 0, 0, 286, 237, 227, 236, 235, 226,
+	225, 110, 238, 239, 0, 237, 227, 236, 235, 124,
+	0, 0, 238, 239, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 0, 0,
+	110, 0, 383, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 0, 0, 123, 0, 0, 0, 110,
+	0, 0, 128, 111, 112, 113, 114, 115, 0, 125,
+	126, 124, 127, 116, 117, 118, 119, 120, 121, 122,
+	0, 0, 0, 0, 0, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 110, 0, 0, 0, 0,
+	124, 0, 129, 0, 0, 0, 123, 0, 0, 0,
+	0, 0, 0, 128, 111, 112, 113, 114, 115, 0,
+	125, 126, 0, 127, 116, 117, 118, 119, 120, 121,
+	122, 0, 0, 110, 0, 129, 124, 0, 0, 123,
+	0, 0, 0, 0, 0, 0, 128, 111, 112, 113,
+	114, 115, 0, 125, 126, 0, 127, 116, 117, 118,
+	119, 120, 121, 122, 129, 775, 0, 0, 123, 0,
+	0, 0, 110, 0, 124, 128, 111, 112, 113, 114,
+	115, 0, 125, 126, 0, 127, 116, 117, 118, 119,
+	120, 121, 122, 129, 0, 0, 0, 123, 286, 0,
+	0, 0, 0, 0, 128, 111, 112, 113, 114, 115,
+	80, 125, 126, 124, 127, 116, 117, 118, 119, 120,
+	121, 122, 110, 0, 0, 0, 0, 0, 0, 129,
+	0, 0, 0, 123, 0, 0, 0, 0, 0, 0,
+	128, 111, 112, 113, 114, 115, 607, 125, 126, 0,
+	127, 116, 117, 118, 119, 120, 121, 122, 0, 0,
+	110, 0, 0, 124, 0, 0, 0, 129, 0, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 128, 111,
+	112, 113, 114, 115, 605, 125, 126, 0, 127, 116,
+	117, 118, 119, 120, 121, 122, 110, 0, 416, 0,
+	0, 124, 0, 0, 0, 0, 129, 0, 0, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 0, 125, 126, 0, 127, 288, 289,
+	290, 291, 292, 293, 294, 110, 0, 124, 0, 0,
+	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	123, 0, 0, 0, 0, 0, 0, 128, 111, 112,
+	113, 114, 115, 0, 125, 126, 124, 127, 116, 117,
+	118, 119, 120, 121, 122, 110, 0, 0, 0, 0,
+	0, 0, 101, 0, 129, 0, 0, 0, 123, 0,
+	0, 0, 0, 0, 0, 128, 111, 112, 113, 114,
+	115, 0, 125, 126, 0, 127, 116, 117, 118, 119,
+	120, 121, 122, 110, 0, 0, 124, 0, 0, 0,
+	129, 0, 0, 0, 123, 0, 0, 0, 0, 0,
+	0, 128, 111, 112, 113, 114, 115, 0, 125, 126,
+	0, 127, 116, 117, 118, 119, 120, 121, 122, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 129,
+	0, 0, 0, 123, 0, 0, 0, 0, 0, 0,
+	128, 111, 112, 113, 114, 115, 0, 125, 126, 0,
+	127, 116, 117, 118, 119, 120, 121, 122, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 0, 0, 91, 0, 0, 0, 99, 0,
-	74, 0, 0, 0, 0, 0, 0, 457, 456, 0,
-	72, 0, 0, 0, 0, 0, 32, 97, 0, 39,
-	37, 38, 34, 40, 0, 0, 0, 0, 0, 0,
-	0, 42, 43, 461, 462, 73, 47, 48, 49, 50,
-	41, 52, 53, 54, 45, 51, 55, 0, 0, 0,
-	0, 0, 0, 31, 46, 102, 103, 104, 0, 105,
-	106, 107, 108, 110, 0, 87, 85, 86, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 92, 70, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 22, 71, 0, 0, 0,
-	35, 36, 0, 0, 0, 0, 0, 28, 0, 0,
-	111, 0, 29, 44, 0, 30, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 0, 74,
-	0, 0, 0, 0, 0, 0, 848, 847, 0, 851,
-	0, 0, 0, 0, 0, 32, 97, 0, 39, 37,
-	38, 34, 40, 0, 0, 0, 0, 0, 0, 0,
-	42, 43, 0, 0, 0, 47, 48, 49, 50, 41,
-	52, 53, 54, 45, 51, 55, 0, 0, 0, 852,
-	0, 0, 31, 46, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 87, 85, 86, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	83, 92, 70, 101, 75, 76, 77, 0, 98, 79,
-	93, 96, 94, 95, 22, 71, 0, 0, 0, 35,
-	36, 0, 0, 0, 0, 0, 28, 0, 0, 111,
-	0, 29, 44, 0, 30, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 74, 0,
-	0, 0, 0, 0, 0, 24, 23, 0, 72, 0,
-	0, 0, 0, 0, 32, 97, 0, 39, 37, 38,
-	34, 40, 0, 0, 0, 0, 0, 0, 0, 42,
-	43, 0, 0, 73, 47, 48, 49, 50, 41, 52,
-	53, 54, 45, 51, 55, 0, 0, 0, 0, 0,
-	0, 31, 46, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 87, 85, 86, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	92, 70, 101, 75, 76, 77, 0, 98, 79, 93,
-	96, 94, 95, 0, 71, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 117, 0, 0, 111, 0,
-	0, 0, 0, 0, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 90, 0, 0,
-	0, 91, 0, 0, 0, 99, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 116, 0, 0, 0, 0,
-	0, 0, 0, 0, 97, 0, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 116, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	350, 0, 102, 103, 104, 0, 105, 106, 107, 108,
-	110, 0, 351, 85, 349, 352, 353, 354, 355, 0,
-	0, 0, 0, 0, 0, 347, 0, 82, 83, 92,
-	70, 340, 350, 0, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 351, 85, 349, 352, 353, 354,
-	355, 0, 0, 0, 0, 0, 0, 347, 0, 82,
-	83, 92, 70, 101, 75, 76, 77, 0, 98, 79,
-	93, 96, 94, 95, 0, 71, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 117, 0, 0, 111,
-	0, 0, 0, 0, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 116, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 116, 0, 0,
-	0, 0, 0, 0, 0, 189, 97, 0, 0, 0,
-	0, 350, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 351, 85, 349, 352, 353, 354, 355,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	92, 70, 188, 0, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 87, 85, 86, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	83, 92, 70, 101, 75, 76, 77, 0, 98, 79,
-	93, 96, 94, 95, 0, 71, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 117, 0, 0, 111,
-	0, 0, 0, 0, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 116, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 265, 0,
-	0, 0, 0, 0, 0, 0, 119, 116, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	0, 118, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 87, 85, 86, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 347, 0, 82, 83,
-	92, 70, 118, 0, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 87, 85, 86, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	83, 92, 70, 101, 75, 76, 77, 0, 98, 79,
-	93, 96, 94, 95, 0, 71, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 117, 0, 0, 111,
-	0, 0, 0, 0, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 74, 0,
-	0, 0, 0, 0, 0, 119, 116, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 116, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	0, 118, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 87, 85, 86, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	92, 70, 118, 0, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 87, 85, 86, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	83, 92, 70, 101, 75, 76, 77, 0, 98, 79,
-	93, 96, 94, 95, 0, 71, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 117, 0, 0, 111,
-	0, 0, 0, 0, 101, 75, 76, 77, 0, 98,
-	79, 93, 96, 94, 95, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
-	552, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 116, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 90,
-	0, 0, 0, 91, 0, 0, 0, 99, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 116, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	0, 118, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 87, 85, 86, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	92, 114, 118, 0, 102, 103, 104, 0, 105, 106,
-	107, 108, 110, 0, 87, 85, 86, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	83, 92, 70, 101, 75, 300, 77, 0, 98, 79,
-	93, 96, 94, 95, 0, 71, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 117, 0, 0, 111,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 0,
-	0, 0, 91, 0, 0, 0, 99, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 116, 0, 0, 0,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 118, 0, 102, 103, 104, 0, 105, 106, 107,
-	108, 110, 0, 87, 85, 86, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	92, 70,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 0, 0, 123, 0, 0, 0, 0, 0, 0,
+	128, 111, 112, 113, 114, 115, 0, 125, 126, 0,
+	127, 116, 117, 118, 119, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 128, 111,
+	112, 113, 114, 115, 0, 125, 126, 0, 127, 116,
+	117, 118, 119, 120, 121, 122,
 }
 var yyPact = [...]int{
 
-	2599, -1000, 346, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3215, -1000, 363, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 4288, 4178, 3215, -1000, -1000, 175,
+	339, 1189, 1181, 1221, 377, 5611, -1000, 710, 1343, 1344,
+	5649, 5649, 649, 5649, 4178, -1000, 1195, 5649, 505, 4178,
+	4178, 5561, 4178, 4178, 4178, 4178, 4178, 4178, -1000, 5649,
+	493, 5649, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 373, -1000, -1000, -1000, -1000, 4068, -1000, 3738, 1359,
+	1227, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4674, 4178,
+	4178, -66, 344, 343, 342, 341, -1000, 459, 335, 4178,
+	4178, -1000, -1000, -1000, -1000, 5649, -1000, -1000, 1375, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3519, 3360, -1000, -1000, 398, 339, 1068,
-	1066, 351, 1439, -1000, 643, 1200, 1181, 1584, 1584, 656,
-	1584, 3360, -1000, -1000, 3360, 3360, 1377, 3360, 3360, 3360,
-	3360, 3360, 3360, -1000, 1584, 1584, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 350, -1000, -1000, -1000, -1000,
-	3329, -1000, 2980, 1212, 1088, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1750, 3360, 3360, -51, 320, 318, 313, -1000, 412,
-	308, 3360, 3360, -1000, -1000, -1000, -1000, 1584, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 302,
-	294, -70, 2599, 702, 3329, -1000, 287, 285, 282, 3360,
-	717, 1750, -1000, 1042, 1173, 1150, 1196, 1148, 534, 1078,
-	821, -1000, 819, 3360, 1196, 1584, 1196, -1000, 821, 32,
-	342, -1000, 490, -1000, 1584, 1175, 1584, 1584, 444, 441,
-	-1000, 950, -1000, 1584, -1000, -1000, -1000, -1000, 3360, 3360,
-	1192, 43, 938, 1054, 1186, -1000, 1185, -1000, -1000, 47,
-	-51, -1000, -1000, 1382, -51, -1000, -1000, 3709, 3360, 367,
-	192, 183, 187, 314, 617, 76, 895, 1206, 282, -1000,
-	-1000, -1000, 31, 1584, -1000, 3360, 3360, 3360, 835, 3360,
-	903, 85, 3360, 943, 3360, 3360, 3360, 3360, 3360, 3360,
-	3360, -1000, -1000, 1367, 3170, 2758, 821, 821, 85, 85,
-	928, 912, -1000, -1000, 44, -1000, 429, 821, 3360, 1300,
-	-1000, 2599, 183, 179, 3360, 716, 673, 666, 3360, 1019,
-	1006, 1178, 1154, 1206, 684, 1196, 1161, 28, -1000, -1000,
-	-1000, -1000, 274, -1000, -1000, -1000, -1000, 1196, 684, 1184,
-	26, 887, 887, 887, 2790, -1000, 176, -1000, 296, 317,
-	1075, 3360, 1206, 3360, 499, 315, 273, 271, -1000, -1000,
-	-1000, -1000, 3360, 3360, 3360, 3360, 3360, 1081, -1000, -1000,
-	1214, 3360, 3360, 1203, 1203, 1196, 3360, 3360, 3360, -1000,
-	3360, 1750, -1000, -1000, -1000, -1000, 1178, 2281, 1584, 1206,
-	1584, 68, 889, 1088, 312, -15, 23, 23, 891, 1903,
-	3360, 85, 3360, -1000, 3329, -1000, 23, 85, 85, 20,
-	20, -1000, -1000, -1000, 303, 44, -1000, -1000, 174, 3360,
-	-1000, 170, 15, 1144, -1000, 1750, -1000, -1000, -27, 269,
-	268, 267, 266, 265, 264, 259, 3360, 3139, -1000, -1000,
-	85, 194, 194, 194, 835, -1000, 3360, 1325, -1000, -1000,
-	678, -1000, 3360, 589, 2599, 588, 3360, 1641, 700, 496,
-	493, 3360, 3360, 2949, 1154, 1035, 3360, -1000, 13, -1000,
-	71, 1329, -1000, -1000, 1137, -1000, 256, -1000, 198, 1110,
-	1196, 3550, 186, 1154, 684, 1175, 314, -1000, 314, 314,
-	-1000, -1000, 251, 1110, 1584, 819, -1000, 484, 231, 1110,
-	1584, 166, -1000, 1750, 676, 1584, 819, 217, 1584, -1000,
-	-51, -1000, -51, -51, -1000, -51, -1000, -1000, 10, 1143,
-	1206, -1000, -1000, -1000, 8, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 587, 344, -1000, -1000, 3519, 3360, -1000, -1000,
-	-1000, -1000, -1000, 616, -1000, 614, 1584, 1584, -1000, 250,
-	1584, -1000, -1000, 3360, 1887, -1000, 23, -1000, -1000, -1000,
-	165, -1000, 2790, 1584, 3170, 821, 821, 821, 821, 3360,
-	3360, 3360, 164, 162, 160, 869, -1000, 128, -1000, 249,
-	-1000, -1000, 539, 156, 3360, 585, 664, 2599, 3360, 793,
-	-1000, -1000, 1750, 3360, 2599, 1176, 550, 498, 425, -1000,
-	5, 1026, 1750, -1000, 1035, 1010, 1003, 1750, 978, 977,
-	962, 962, 1007, 684, -1000, -1000, -1000, -1000, 1584, 163,
-	3360, 85, 1110, -1000, 1178, 4, 307, -60, -1000, -8,
-	-4, -51, -70, 246, 1110, -1000, 1154, -1000, 905, -1000,
-	-1000, 905, 1110, 155, -5, 154, -9, -1000, 1120, 1584,
-	1060, -1000, 1110, 1051, 1048, -1000, -1000, -1000, 153, -1000,
-	1134, 152, -18, -1000, -1000, -19, 1057, -10, 3360, 1584,
-	-1000, 3360, 751, 2281, 699, 715, 2281, 2281, 611, 609,
-	819, 151, 44, 3360, -1000, -1000, -1000, 150, 3360, 3360,
-	3360, 3139, 3360, 149, 143, 142, -1000, -1000, -1000, 85,
-	140, -30, 3360, -1000, 812, 389, 1631, 783, 584, -1000,
-	697, -1000, 1603, 711, -1000, 3360, -1000, -1000, 428, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2949, 379, -1000, -1000,
-	1010, -1000, 3360, 3360, 684, 684, 973, -1000, 971, 968,
-	962, -1000, -1000, -1000, -41, -1000, 139, 1154, 1110, 3360,
-	-1000, 3360, 1175, 1110, 137, -1000, 136, 930, 1110, 1127,
-	1584, -1000, -1000, -1000, 1110, 1110, 133, -58, 3360, 132,
-	1584, 3360, 1126, 407, 1118, 1206, 1206, 3360, 1109, 1206,
-	-1000, -1000, -1000, -1000, -1000, 2281, 661, 3360, 577, 573,
-	2281, 2281, 131, 1103, 44, 476, 126, 125, 118, 117,
-	116, 113, 473, 446, 439, -1000, -1000, 85, 93, -1000,
-	1022, -1000, -1000, 780, 2599, -1000, -1000, 3360, 498, 983,
-	-1000, 382, -1000, 1097, 1042, 1750, -1000, 1007, 1772, 684,
-	684, 684, 965, 3360, 918, -1000, -1000, 1750, 106, -42,
-	105, 910, 916, 245, -1000, 819, -1000, -1000, -1000, 1120,
-	1584, 1750, -1000, -1000, -51, -1000, 819, 2440, 400, -1000,
-	-1000, -1000, 1057, -1000, 395, 103, 652, 572, 2281, 695,
-	748, 746, 566, 563, -1000, 241, 239, 466, 463, 462,
-	450, 449, 408, 238, 236, 377, 235, 376, -1000, 3360,
-	234, -1000, 761, 428, -1000, -1000, -1000, -1000, -1000, 1019,
-	-1000, 3360, 232, 1772, 1601, 1007, 684, -61, 102, 85,
-	-1000, -1000, -1000, 3360, 915, 226, 85, -1000, 1110, -1000,
-	-1000, -1000, -1000, 561, 340, -1000, -1000, 3519, 3360, -1000,
-	-1000, 2980, 3360, 2440, 2440, 1093, 558, 658, 2281, 3360,
-	792, -1000, 2281, -1000, -1000, 745, 743, 819, 445, 225,
-	224, 223, 222, 219, 206, 445, 445, 437, 445, 436,
-	1578, 1042, -1000, -1000, 491, 1750, 1584, -1000, 3360, 1007,
-	-1000, -1000, -1000, 101, 85, -1000, 1110, -1000, 100, -1000,
-	2440, 694, 708, 608, 40, 888, 1206, -1000, 556, 555,
-	393, 779, 553, -1000, 693, -1000, 707, -1000, -1000, 99,
-	97, -1000, 1045, 998, 445, 445, 445, 445, 445, 445,
-	92, 1042, 91, 195, 86, 27, -1000, 80, 1164, 78,
-	1750, -1000, -1000, 73, 884, -1000, 2440, 651, 3360, 2122,
-	1584, 1584, 25, 873, -1000, -1000, 2440, -1000, 778, 2281,
-	-1000, 3360, -1000, -1000, -1000, 994, 3360, 70, 62, 54,
-	52, 51, 37, -1000, -1000, 445, -1000, 445, -1000, -1000,
-	-1000, 867, 85, -1000, 630, 549, 2440, 692, 548, 333,
-	-1000, -1000, 3519, 3360, -1000, -1000, -1000, 606, 604, 1584,
-	1584, 546, -1000, 756, 2949, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 36, -24, 85, -1000, -1000, 543, 637, 2440,
-	3360, 788, -1000, 2440, 740, 2122, 691, 706, 2122, 2122,
-	603, 551, -1000, -1000, 370, -1000, -1000, -1000, 777, 538,
-	-1000, 686, -1000, 705, -1000, -1000, 2122, 629, 3360, 537,
-	536, 2122, 2122, -1000, 855, -1000, 771, 2440, -1000, 3360,
-	598, 535, 2122, 680, 739, 737, 532, 513, -1000, 924,
-	809, 807, 797, -1000, 754, 511, 599, 2122, 3360, 785,
-	-1000, 2122, -1000, -1000, 732, 724, 866, 806, -1000, 802,
-	796, -1000, -1000, -1000, -1000, 770, 501, -1000, 657, -1000,
-	677, -1000, -1000, 922, -1000, -1000, -1000, -1000, -1000, 764,
-	2122, -1000, 3360, -1000, 804, -1000, -1000, 540, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	329, 324, -79, 3215, 812, 4068, -1000, 323, 322, 321,
+	4178, 855, 4674, -1000, 452, 1174, 1280, 1271, 5398, 1267,
+	5194, 1263, 1078, 997, -1000, 981, 4178, 5398, 5649, 5649,
+	5649, 5398, -1000, 997, 24, 372, -1000, 584, -1000, 5649,
+	5285, 5649, 5649, 500, 494, -1000, 1092, -1000, 5649, -1000,
+	-1000, -1000, -1000, 4178, 4178, 1335, 44, 1091, 469, -1000,
+	5649, 1193, 1334, -1000, 1333, -1000, -1000, 91, -66, -1000,
+	-1000, 3433, -66, -1000, -1000, -1000, 981, 246, 4728, 4178,
+	3021, 187, 177, 183, 751, 74, 1058, 1350, 321, -1000,
+	-1000, -1000, 23, 5649, -1000, 4178, 4178, 4178, 1016, 4178,
+	1022, 54, 4178, 4178, 1070, 4178, 4178, 4178, 4178, 4178,
+	4178, 4178, -1000, -1000, 5256, 3958, 2020, 4178, 997, 997,
+	54, 54, 1030, 1064, -1000, -1000, 1793, -1000, 488, -1000,
+	-1000, 997, 4178, 5522, -1000, 3215, 177, 173, 4178, 853,
+	769, 767, 4178, 850, 1129, 1165, 1328, 1308, 1350, 4867,
+	5398, 1317, 22, -1000, -1000, -1000, -1000, 317, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5398, 4867, 1331, 21, 5398,
+	1053, 1053, 1053, 2240, -1000, 172, -1000, 310, 332, 1104,
+	1103, 1210, 4178, 1350, 4178, 604, 330, 316, 315, -1000,
+	-1000, -1000, -1000, 4178, 4178, 4178, 4178, 4178, 1253, -1000,
+	-1000, 1361, 4178, 4178, 5649, -1000, 1347, 1347, 5398, 4178,
+	4178, 4178, -1000, 1328, -1000, 4178, 4674, -1000, -1000, -1000,
+	-1000, 2845, 5649, 1350, 5649, 75, 1056, 1227, 267, -5,
+	-67, -67, 1074, 5042, 4178, 54, 4178, 4178, -1000, 4068,
+	-1000, -67, -67, 54, 54, -1, -1, -1000, -1000, -1000,
+	5052, 1793, -1000, -1000, 163, 4178, -1000, 162, 20, 1245,
+	-1000, 4674, -1000, -1000, -63, 314, 312, 311, 307, 306,
+	305, 295, 161, 4178, 3848, -1000, -1000, 54, 182, 182,
+	182, 1016, -1000, 4178, 3206, -1000, -1000, 755, -1000, 4178,
+	714, 3215, 713, 4178, 4966, 811, 709, 1136, 597, 539,
+	4178, 4178, 3400, 1308, 1172, 4178, -1000, 19, 18, 872,
+	5486, -1000, 5448, -1000, 1964, -1000, 294, 291, -56, -1000,
+	205, 5227, 5398, 4618, 180, 85, 4867, 5285, 5143, 246,
+	-1000, 246, 246, -1000, -1000, 289, 5227, 5649, 981, -1000,
+	5649, 5649, 3102, 4920, 5227, 5649, 159, -1000, 4674, 5359,
+	5649, 981, 209, 5649, -1000, -66, -1000, -66, -66, -1000,
+	-66, -1000, -1000, 17, 1244, 1350, -1000, -1000, -1000, 15,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 708, 358,
+	-1000, -1000, 4288, 4178, 2845, -1000, -1000, -1000, -1000, -1000,
+	749, -1000, 747, 5649, 5649, -1000, 282, 5649, -1000, -1000,
+	4178, 4976, -1000, -67, -67, -1000, -1000, 439, 158, -1000,
+	2240, 5649, 3958, 997, 997, 997, 997, 4178, 4178, 4178,
+	-1000, 157, 156, 155, 1039, -1000, 106, -1000, 281, -1000,
+	-1000, 622, 154, 4178, 707, 765, 3215, 4178, 923, -1000,
+	-1000, 4674, 4178, 3215, -1000, 810, -1000, -1000, 14, 1326,
+	685, 528, 472, -1000, 13, 1158, 4674, -1000, 1172, 1167,
+	1150, 4674, 4783, 515, 1125, 1111, 1111, 1116, 419, 276,
+	274, -1000, -1000, -1000, -1000, 5649, -1000, 5649, 83, 4178,
+	4178, 5649, 54, 5227, -1000, 1328, 12, 351, -78, -1000,
+	-15, 11, -66, -79, 272, 5227, -1000, 85, -1000, 4867,
+	1089, 5649, 1082, -1000, -1000, 1082, 5227, 152, 9, 149,
+	8, 5321, -1000, 260, -1000, 1216, 5649, 1204, -1000, 5227,
+	1192, 1184, 435, -1000, -1000, 148, 6, -1000, 1243, 147,
+	4, -1000, -1000, 3, 1191, -25, 4178, 5649, -1000, 4178,
+	882, 2845, 800, 852, 451, 2845, 2845, 742, 731, 981,
+	145, 1793, 4178, 259, 435, -1000, -1000, 142, 4178, 4178,
+	4178, 3848, 4178, 139, 134, 133, 435, 435, 435, 54,
+	132, 1, 4178, -1000, 972, 438, 4538, 908, 705, -1000,
+	799, -1000, 4921, 851, 3215, 1358, -1000, 4178, -1000, -1000,
+	476, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3400, 413,
+	-1000, -1000, 1167, -1000, 4178, 4508, 872, 4867, 4681, 4867,
+	2463, 1123, -1000, 1122, 1121, 1111, 4867, 5030, 5649, -1000,
+	-1000, -1000, -4, 131, -1000, -1000, 125, 1308, 5227, 4178,
+	-1000, 4178, 5285, 5227, 123, -1000, 1642, 4867, 1087, 122,
+	1086, 5227, 1242, 5649, 1014, 1008, 5649, -1000, -1000, -1000,
+	5227, 5227, 121, -8, 4178, 119, 5649, 4178, -1000, 258,
+	1241, 5649, 486, 1240, 1350, 1350, 4178, 1239, 1350, -1000,
+	-1000, -1000, -1000, -1000, 2845, 760, 4178, 823, 704, 703,
+	2845, 2845, 118, 1236, 1793, 1304, -1000, 471, 117, 116,
+	113, 112, 110, 109, 580, 489, 477, -1000, -1000, -1000,
+	-1000, -1000, 54, 2398, -1000, -1000, 1171, -1000, -1000, 901,
+	3215, -1000, -1000, 4178, 850, -1000, 528, 1132, -1000, 417,
+	-1000, 1209, 1174, 4674, -1000, -17, 4674, 252, 250, 115,
+	872, 1102, 4867, 1102, 650, 4867, 2404, 4867, 4867, 1114,
+	1102, 595, 249, 594, 4178, -1000, 1050, -1000, -1000, 4674,
+	104, -39, 103, 1067, 4178, 1382, 4867, 1047, 248, -1000,
+	981, -1000, 1006, -1000, 102, -1000, -1000, 1216, 5649, 4674,
+	-1000, -1000, -66, -1000, 1300, 981, -1000, 3030, 484, -1000,
+	-1000, -1000, 1191, -1000, 474, 100, 739, 701, 2845, 798,
+	698, 1136, 881, 879, 696, 693, -1000, 247, 4178, 244,
+	243, 435, 435, 435, 435, 435, 438, 242, 241, 410,
+	240, 407, -1000, 4178, 239, -1000, 890, -1000, 476, -1000,
+	-1000, -1000, -1000, -1000, 1129, 4508, 4398, 4398, 238, 1102,
+	-1000, 4178, 237, 650, 650, 4867, 1225, 1102, 4867, 5227,
+	997, 5649, -60, 99, 54, -1000, -1000, -1000, 4178, 1044,
+	236, 4910, 4178, 863, 54, -1000, 5227, -1000, -1000, -1000,
+	-1000, -1000, 4178, -1000, 688, 215, -1000, -1000, 4288, 4178,
+	3030, -1000, -1000, 3738, 4178, 3030, 3030, 1235, 682, 759,
+	2845, 4178, 922, -1000, 2845, -1000, 797, -1000, -1000, 873,
+	871, 981, 4317, 1297, 516, 578, 577, 576, 553, 534,
+	533, 516, 516, 526, 516, 510, 4097, 1174, -1000, -1000,
+	544, -1000, 98, -24, 4674, 3442, 96, 4398, 4674, 5649,
+	-1000, -1000, 650, 4178, 1102, 1051, 1048, 5256, -1000, -1000,
+	-1000, 95, 54, -1000, 5227, -1000, 848, 495, 4910, 4178,
+	-1000, 93, 3877, -1000, 3030, 796, 844, 450, 728, 46,
+	1042, 1350, -1000, 681, 679, 457, 896, 678, -1000, 794,
+	-1000, 842, 2845, -1000, -1000, 90, -1000, 4178, 89, -1000,
+	1178, 1145, 235, 233, 232, 231, 230, 222, 87, 1174,
+	86, 214, 81, 213, -1000, 80, 1323, -1000, 4398, -1000,
+	2836, -1000, 79, 76, -1000, 4674, 211, 203, 72, -1000,
+	-1000, 71, -1000, 1031, 427, -1000, 4910, 1043, -1000, -1000,
+	3030, 758, 4178, 819, 2660, 5649, 5649, 60, 1041, -1000,
+	-1000, 3030, -1000, 895, 2845, -1000, 4178, 823, -1000, 3657,
+	-1000, -1000, 1143, 4178, 516, 516, 516, 516, 516, 516,
+	-1000, -1000, 516, -1000, 516, 435, -1000, -1000, 4178, -1000,
+	-1000, 3628, 5227, -1000, 1027, 792, 4178, 1065, -1000, 54,
+	-1000, 734, 674, 3030, 791, 673, 1136, 672, 189, -1000,
+	-1000, 4288, 4178, 2660, -1000, -1000, -1000, 726, 724, 5649,
+	5649, 670, -1000, 886, -1000, 509, 3400, -1000, 70, 68,
+	62, 61, 59, 57, 55, 52, -1000, 51, 47, 45,
+	-29, 414, 39, -44, 1232, 54, -1000, 1296, 4674, 790,
+	449, -1000, 648, 756, 3030, 4178, 919, -1000, 3030, -1000,
+	785, 870, 2660, 784, 821, 445, 2660, 2660, 723, 717,
+	-1000, -1000, 202, 481, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 37, 34, 4178, 5649, 33, 5227, 5649,
+	-1000, 1312, -1000, 1282, 1031, 1031, 894, 647, -1000, 783,
+	-1000, 820, 3030, -1000, -1000, 2660, 740, 4178, 814, 639,
+	638, 2660, 2660, 516, -1000, 973, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 5227, 201, 782, 780,
+	-1000, 893, 3030, -1000, 4178, 819, 721, 637, 2660, 779,
+	636, 1136, 868, 867, 635, 634, 29, 401, 1021, 968,
+	965, 959, 952, 933, -1000, 1250, 5227, 1278, 1290, -1000,
+	885, -1000, 630, 651, 2660, 4178, 911, -1000, 2660, -1000,
+	776, -1000, -1000, 866, 859, -1000, -1000, 532, 1023, 939,
+	-1000, 961, 950, 948, 928, -1000, -1000, -1000, -1000, -1000,
+	54, 28, 201, 1293, -1000, -1000, 892, 628, -1000, 771,
+	-1000, 818, 2660, -1000, -1000, 926, -1000, -1000, 943, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1248,
+	5227, -1000, 874, 2660, -1000, 4178, 814, -1000, 401, 936,
+	-1000, 54, -1000, -1000, 884, -1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 41, 703, 334, 100, 98, 86, 1378, 59, 20,
-	39, 1377, 1375, 1374, 1372, 332, 112, 1367, 1366, 1365,
-	1364, 1355, 1353, 1348, 81, 33, 32, 1347, 1346, 1345,
-	67, 1344, 38, 1342, 1340, 36, 37, 1338, 1324, 1323,
-	1322, 1318, 1169, 1317, 94, 84, 1165, 1305, 69, 47,
-	75, 54, 27, 21, 25, 1303, 1298, 34, 1296, 35,
-	141, 1295, 91, 1294, 87, 85, 78, 716, 0, 65,
-	1088, 8, 12, 1293, 1292, 1288, 1287, 1192, 1286, 89,
-	1285, 1282, 1274, 52, 1268, 1266, 1264, 11, 26, 57,
-	18, 1263, 1262, 3, 1260, 1259, 44, 1258, 1257, 103,
-	80, 82, 1254, 29, 1243, 23, 1242, 1241, 1239, 13,
-	62, 1238, 72, 22, 56, 76, 19, 79, 1237, 1236,
-	1234, 61, 1232, 1231, 30, 77, 10, 17, 5, 9,
-	2, 7, 55, 1229, 16, 1228, 6, 1227, 4, 1225,
-	1214, 31, 28, 14, 1224, 90, 1148, 1223, 96, 92,
-	83, 70, 58, 68, 88, 1222, 43, 830,
+	0, 99, 395, 501, 134, 315, 121, 1557, 93, 47,
+	74, 1556, 1554, 1552, 1551, 596, 109, 1546, 1545, 1544,
+	1543, 1541, 1540, 1538, 1537, 65, 83, 43, 49, 1536,
+	1532, 1530, 76, 1525, 64, 1524, 1522, 61, 59, 1521,
+	1520, 1517, 1513, 1512, 1465, 1510, 105, 84, 1265, 1506,
+	80, 63, 87, 51, 1505, 32, 1503, 70, 30, 37,
+	40, 1501, 1500, 54, 1499, 50, 1457, 1494, 86, 1491,
+	4, 103, 98, 136, 1539, 329, 73, 3, 39, 24,
+	1490, 1487, 1482, 1478, 629, 1477, 96, 1475, 1473, 1471,
+	1307, 1469, 69, 1468, 20, 23, 46, 22, 28, 1466,
+	1455, 8, 1454, 1451, 2, 68, 1448, 1447, 100, 92,
+	95, 1445, 1016, 1435, 1434, 17, 1433, 15, 1431, 29,
+	1429, 1428, 1420, 25, 71, 1419, 35, 33, 72, 97,
+	34, 82, 1416, 1412, 1408, 7, 1400, 1399, 1396, 1395,
+	27, 14, 5, 55, 85, 19, 31, 11, 18, 1,
+	9, 66, 1394, 26, 1393, 12, 1389, 6, 1386, 57,
+	21, 13, 10, 16, 78, 0, 53, 38, 156, 1383,
+	102, 1251, 1381, 113, 155, 104, 81, 77, 79, 112,
+	1377, 67, 720, 1373,
 }
 var yyR1 = [...]int{
 
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 5, 6, 6,
-	7, 7, 8, 8, 8, 8, 8, 9, 9, 10,
-	10, 12, 12, 11, 11, 11, 11, 11, 13, 13,
-	13, 13, 13, 13, 14, 14, 15, 15, 15, 15,
-	15, 16, 16, 17, 17, 18, 18, 18, 18, 18,
-	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
-	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 25,
-	25, 26, 26, 26, 26, 26, 27, 27, 27, 27,
-	27, 27, 27, 28, 28, 28, 28, 29, 29, 30,
-	30, 31, 31, 31, 31, 32, 33, 33, 34, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 38,
-	38, 38, 38, 38, 38, 38, 39, 39, 39, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 41, 41, 41, 42, 42, 43, 43,
-	44, 44, 44, 44, 45, 45, 46, 47, 48, 48,
-	49, 49, 50, 50, 51, 51, 52, 52, 53, 53,
-	53, 54, 54, 54, 55, 55, 56, 56, 57, 57,
-	57, 58, 58, 58, 59, 59, 60, 60, 61, 61,
-	62, 62, 63, 63, 63, 63, 63, 63, 64, 65,
-	66, 66, 66, 66, 66, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 69, 70, 70, 70, 71,
-	71, 72, 72, 73, 73, 74, 74, 75, 75, 75,
-	76, 76, 77, 78, 79, 79, 79, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 81, 81, 81, 81,
-	81, 81, 81, 82, 82, 82, 82, 83, 83, 84,
-	84, 84, 84, 84, 85, 85, 85, 85, 85, 85,
-	86, 86, 87, 87, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 88, 89, 89, 90, 90, 91,
-	91, 92, 92, 92, 93, 93, 93, 94, 94, 95,
-	95, 96, 96, 97, 97, 97, 97, 98, 98, 98,
-	98, 99, 99, 102, 102, 102, 102, 103, 103, 103,
-	103, 103, 103, 104, 104, 104, 104, 104, 104, 105,
-	105, 106, 106, 107, 107, 107, 108, 109, 109, 110,
-	110, 111, 111, 112, 112, 113, 113, 114, 114, 115,
-	115, 100, 100, 101, 101, 116, 116, 117, 117, 118,
-	118, 118, 118, 119, 120, 121, 121, 122, 122, 122,
-	122, 122, 122, 122, 122, 123, 123, 124, 124, 125,
-	125, 126, 126, 127, 127, 128, 128, 129, 129, 130,
-	130, 131, 131, 132, 132, 133, 133, 134, 134, 135,
-	135, 136, 136, 137, 137, 138, 138, 139, 139, 140,
-	140, 140, 140, 140, 140, 140, 140, 141, 142, 142,
-	143, 144, 144, 145, 145, 146, 147, 148, 149, 149,
-	150, 150, 151, 151, 152, 152, 153, 153, 154, 154,
-	155, 155, 156, 156, 157, 157,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 6,
+	6, 7, 7, 8, 8, 8, 8, 8, 9, 9,
+	10, 10, 12, 12, 11, 11, 11, 11, 11, 11,
+	13, 13, 13, 13, 13, 13, 13, 14, 14, 15,
+	15, 15, 15, 15, 16, 16, 17, 17, 18, 18,
+	18, 18, 18, 18, 19, 19, 19, 19, 19, 19,
+	19, 20, 20, 20, 20, 21, 21, 21, 21, 21,
+	22, 22, 22, 22, 22, 23, 23, 23, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 24, 24, 24,
+	24, 25, 25, 26, 26, 27, 27, 28, 28, 28,
+	28, 28, 29, 29, 29, 29, 29, 29, 29, 30,
+	30, 30, 30, 31, 31, 32, 32, 33, 33, 33,
+	33, 34, 35, 35, 36, 37, 37, 38, 38, 38,
+	39, 39, 39, 39, 39, 40, 40, 40, 40, 40,
+	40, 40, 41, 41, 41, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	42, 43, 43, 43, 44, 44, 45, 45, 46, 46,
+	46, 46, 47, 47, 48, 49, 50, 50, 51, 51,
+	52, 52, 53, 53, 54, 54, 54, 54, 55, 55,
+	56, 56, 56, 57, 57, 58, 58, 59, 59, 59,
+	60, 60, 60, 61, 61, 62, 62, 63, 63, 63,
+	64, 64, 64, 65, 65, 66, 66, 67, 67, 68,
+	68, 69, 69, 69, 69, 69, 69, 69, 70, 70,
+	71, 72, 73, 73, 73, 73, 73, 74, 74, 74,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 76, 77, 77,
+	77, 78, 78, 79, 79, 80, 80, 81, 81, 82,
+	82, 82, 83, 83, 84, 85, 86, 86, 86, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 87, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 87, 87,
+	88, 88, 88, 88, 88, 88, 88, 89, 89, 89,
+	89, 90, 90, 91, 91, 91, 91, 91, 91, 91,
+	92, 92, 92, 92, 92, 92, 93, 93, 94, 94,
+	95, 95, 95, 95, 95, 95, 95, 95, 95, 95,
+	95, 95, 95, 96, 97, 97, 98, 98, 99, 99,
+	183, 183, 183, 100, 100, 100, 100, 100, 101, 101,
+	101, 101, 101, 101, 101, 102, 102, 103, 103, 104,
+	104, 104, 104, 105, 105, 106, 106, 106, 106, 106,
+	106, 106, 107, 107, 107, 107, 108, 108, 111, 111,
+	111, 111, 111, 111, 111, 112, 112, 112, 112, 112,
+	112, 112, 112, 112, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 114, 114, 114, 115, 115,
+	116, 116, 117, 117, 118, 118, 119, 119, 120, 120,
+	121, 121, 121, 122, 123, 123, 124, 124, 125, 125,
+	126, 126, 127, 127, 128, 128, 129, 129, 129, 109,
+	109, 110, 110, 130, 130, 131, 131, 132, 132, 132,
+	132, 133, 134, 135, 135, 136, 136, 136, 136, 136,
+	136, 136, 136, 137, 137, 138, 138, 138, 139, 139,
+	139, 139, 139, 139, 140, 140, 141, 141, 142, 142,
+	143, 143, 144, 144, 145, 145, 146, 146, 147, 147,
+	148, 148, 149, 149, 150, 150, 151, 151, 152, 152,
+	153, 153, 154, 154, 155, 155, 156, 156, 157, 157,
+	158, 158, 159, 159, 160, 160, 161, 161, 162, 162,
+	163, 163, 164, 164, 165, 165, 165, 165, 165, 165,
+	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 165, 165, 165, 166, 167, 167, 168, 169, 169,
+	170, 170, 171, 172, 173, 174, 174, 175, 175, 176,
+	176, 177, 177, 178, 178, 179, 179, 180, 180, 181,
+	181, 182, 182,
 }
 var yyR2 = [...]int{
 
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 6, 8, 8, 9, 9, 1, 1, 1,
-	2, 1, 1, 7, 8, 6, 1, 1, 7, 8,
-	6, 1, 1, 1, 1, 1, 6, 8, 8, 9,
-	9, 1, 2, 1, 1, 7, 8, 6, 1, 1,
-	7, 8, 6, 1, 1, 1, 2, 2, 1, 2,
-	4, 4, 4, 4, 2, 1, 1, 6, 8, 5,
-	6, 8, 5, 7, 7, 7, 7, 1, 3, 1,
-	3, 0, 1, 1, 2, 2, 5, 5, 2, 4,
-	2, 3, 5, 6, 8, 5, 3, 1, 3, 1,
-	3, 4, 2, 4, 3, 1, 1, 3, 3, 1,
-	3, 1, 1, 3, 9, 10, 10, 12, 3, 0,
-	1, 1, 1, 1, 2, 2, 5, 6, 3, 4,
-	4, 4, 4, 4, 4, 2, 2, 2, 2, 4,
-	4, 2, 2, 2, 4, 1, 2, 2, 4, 2,
-	2, 1, 2, 2, 3, 4, 4, 6, 9, 11,
-	5, 4, 4, 4, 1, 1, 3, 2, 0, 2,
-	0, 2, 0, 3, 0, 2, 0, 3, 1, 6,
-	5, 0, 1, 2, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 3, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 3, 1, 1,
+	1, 1, 1, 6, 8, 8, 9, 9, 1, 1,
+	1, 2, 1, 1, 7, 8, 6, 5, 1, 1,
+	7, 8, 6, 5, 1, 1, 1, 1, 1, 6,
+	8, 8, 9, 9, 1, 2, 1, 1, 7, 8,
+	6, 5, 1, 1, 7, 8, 6, 5, 1, 1,
+	1, 2, 2, 1, 2, 4, 4, 4, 4, 2,
+	1, 1, 2, 4, 3, 6, 8, 5, 8, 5,
+	6, 8, 5, 7, 7, 7, 7, 1, 2, 4,
+	3, 1, 3, 1, 3, 1, 3, 0, 1, 1,
+	2, 2, 5, 5, 2, 4, 2, 3, 5, 6,
+	8, 5, 3, 1, 3, 1, 3, 4, 2, 4,
+	3, 1, 1, 3, 3, 1, 3, 1, 1, 3,
+	9, 10, 10, 12, 3, 0, 1, 1, 1, 1,
+	2, 2, 5, 6, 3, 4, 4, 4, 4, 4,
+	4, 2, 2, 2, 2, 4, 4, 2, 2, 2,
+	4, 1, 2, 2, 4, 2, 2, 1, 2, 2,
+	3, 2, 3, 4, 4, 6, 9, 11, 5, 4,
+	4, 4, 1, 1, 3, 2, 0, 2, 0, 2,
+	0, 3, 1, 3, 1, 4, 4, 5, 1, 3,
+	1, 2, 5, 0, 2, 0, 3, 1, 6, 5,
+	0, 1, 2, 1, 1, 0, 1, 1, 1, 1,
+	0, 1, 1, 0, 3, 0, 2, 6, 9, 1,
+	3, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	1, 1, 1, 3, 3, 3, 3, 1, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 1, 3, 1,
+	6, 1, 3, 1, 3, 2, 4, 1, 1, 0,
+	1, 1, 1, 1, 3, 3, 3, 1, 6, 3,
+	3, 3, 3, 4, 4, 5, 6, 6, 3, 4,
+	4, 3, 4, 3, 4, 4, 4, 4, 4, 2,
+	3, 3, 3, 3, 3, 2, 2, 3, 3, 2,
+	2, 0, 1, 4, 3, 4, 4, 4, 4, 9,
+	6, 6, 6, 6, 6, 1, 6, 11, 0, 5,
+	8, 13, 10, 10, 10, 10, 10, 10, 8, 8,
+	10, 8, 10, 2, 1, 5, 0, 3, 3, 6,
+	1, 1, 1, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 1, 2, 1, 0,
+	3, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 6, 6, 8, 1, 1, 1, 6,
+	6, 8, 4, 3, 1, 1, 2, 3, 1, 1,
+	2, 3, 1, 3, 4, 5, 6, 7, 5, 6,
+	5, 6, 7, 4, 4, 11, 11, 11, 1, 3,
+	1, 3, 1, 3, 1, 3, 2, 4, 1, 1,
+	1, 3, 1, 5, 0, 1, 4, 5, 0, 2,
+	1, 3, 1, 3, 1, 3, 1, 3, 4, 1,
+	3, 1, 3, 1, 3, 1, 3, 6, 9, 5,
+	8, 7, 3, 1, 3, 10, 13, 9, 12, 9,
+	12, 8, 11, 5, 6, 9, 10, 11, 7, 5,
+	9, 11, 10, 8, 1, 2, 0, 2, 0, 3,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 4, 5, 4, 5, 4, 5,
+	1, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 1, 3, 1, 6, 1,
-	3, 1, 3, 2, 4, 1, 1, 0, 1, 1,
-	1, 1, 3, 3, 3, 1, 6, 3, 3, 3,
-	3, 4, 4, 5, 6, 6, 3, 4, 4, 3,
-	4, 4, 4, 4, 4, 2, 3, 3, 3, 3,
-	3, 2, 2, 3, 3, 2, 2, 0, 1, 4,
-	3, 4, 4, 4, 5, 5, 5, 5, 5, 1,
-	5, 10, 8, 9, 9, 9, 9, 9, 9, 8,
-	8, 10, 8, 10, 2, 1, 5, 0, 3, 2,
-	5, 2, 2, 2, 2, 2, 2, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 4, 6, 6,
-	8, 1, 1, 1, 6, 6, 1, 1, 2, 3,
-	1, 1, 3, 4, 5, 6, 7, 5, 6, 2,
-	4, 1, 1, 1, 3, 1, 5, 0, 1, 4,
-	5, 0, 2, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 1, 3, 1, 3, 1, 3, 6,
-	9, 5, 8, 7, 3, 1, 3, 10, 13, 9,
-	12, 9, 12, 8, 11, 5, 6, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	3, 1, 3, 1, 3, 1, 1, 1, 0, 1,
-	0, 1, 0, 1, 0, 1, 1, 1, 0, 1,
-	0, 1, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 3, 1, 3,
+	1, 3, 1, 1, 1, 0, 1, 0, 1, 0,
+	1, 0, 1, 1, 1, 0, 1, 0, 1, 0,
+	1, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -42, -43, -118, -119, -122,
-	-123, -23, -20, -21, -27, -28, -31, -37, -22, -40,
-	-41, -68, 15, 87, 86, -8, -10, -60, 27, 32,
-	35, 132, 95, -143, 101, 20, 21, 99, 100, 98,
-	102, 119, 110, 111, 33, 123, 133, 115, 116, 117,
-	118, 124, 120, 121, 122, 125, -63, -81, -78, -77,
-	-84, -85, -108, -80, -82, -141, -146, -147, -148, -39,
-	162, 16, 89, 114, 79, 5, 6, 7, -64, 10,
-	-65, -67, 159, 160, -140, 145, 146, 144, -86, -70,
-	69, 73, 161, 11, 13, 14, 12, 96, 9, 77,
-	-66, 4, 134, 135, 136, 138, 139, 140, 141, 147,
-	142, 30, 156, -68, 162, -143, 87, 27, 132, 86,
-	-109, -67, -68, -44, -46, 24, 19, 27, 22, -45,
-	17, -77, 162, 162, 25, 36, 36, -145, 162, -144,
-	-141, -145, -140, -141, 96, 44, 102, 126, -146, -148,
-	-146, -140, -140, -38, 103, 104, 37, 38, 105, 106,
-	-140, -140, -68, -68, -68, -148, -140, -68, -68, -68,
-	-140, -68, -113, -67, -140, -68, -140, -140, 153, -67,
-	-68, -113, -42, -60, -68, -141, -142, -9, 132, 95,
-	6, -62, -61, -155, 31, 152, 151, 158, 76, 74,
-	73, 70, 75, -157, 160, 159, 157, 164, 165, 72,
-	71, -67, -67, 167, 162, 162, 162, 162, 151, 158,
-	-150, -157, 73, -77, -67, -67, -140, 162, 162, 167,
-	-1, 91, -113, -83, 162, -109, -132, -110, 90, -52,
-	45, -47, -48, 25, 18, 25, -101, -99, -96, -98,
-	-140, 30, -97, 138, 139, 140, 141, 25, 18, -100,
-	-96, 64, 65, 66, -149, 78, -83, -113, -99, -140,
-	-99, -149, 166, 153, 96, 44, 126, 127, -140, -96,
-	-140, -140, 158, 43, 158, 43, 62, -140, -68, -68,
-	18, 62, 62, 43, 18, 18, 166, 62, 166, -68,
-	6, -67, 163, 163, 163, 163, -46, 93, 70, 166,
-	70, -141, -142, 166, -140, -67, -67, -67, -150, -67,
-	74, 70, 75, -70, 162, -77, -67, 68, 67, -67,
-	-67, -67, -67, -67, -67, -67, -140, 6, -83, -149,
-	163, -117, -107, -106, -69, -67, -87, 157, -140, 146,
-	132, 144, 147, 148, 149, 150, -149, -149, -70, -70,
-	74, 70, 68, 67, 76, 144, -149, -67, -140, 6,
-	-1, 163, 90, -133, 92, -111, 92, -67, -68, -53,
-	-59, 51, 52, 48, -48, -49, 23, -142, -141, -115,
-	-103, -102, -104, 29, 162, -99, 143, -77, -99, 20,
-	166, 162, -99, -115, 18, 166, -154, 67, -154, -154,
-	-117, 163, 62, 162, 162, -156, 28, 33, 34, 42,
-	20, -83, -145, -67, 97, 162, 28, 162, 162, -68,
-	-140, -68, -140, -140, -68, -140, -68, -30, -29, -68,
-	25, 5, -30, -114, -68, -148, -148, -99, -114, -114,
-	-113, -68, -2, -12, -5, -13, 87, 86, -8, -10,
-	-6, 112, 113, -140, -142, -140, 70, 70, -62, 28,
-	162, -64, -65, 71, -67, -70, -67, -70, -70, 163,
-	-83, 163, 166, 28, 162, 162, 162, 162, 162, 162,
-	162, 162, -83, -83, -69, -70, -79, 162, -77, 142,
-	-79, -79, -150, -83, 166, -125, -124, 92, 88, 94,
-	-1, 94, -67, 91, 91, 97, 98, -68, -68, -72,
-	-73, -74, -67, -87, -49, -50, 46, -67, 60, -151,
-	-153, 59, 63, 166, 55, 57, 58, -140, 28, -103,
-	162, 26, 162, -42, -121, -120, -66, -140, -101, -96,
-	-68, -140, 30, 62, 162, -49, -115, -100, -45, -44,
-	-45, -45, 162, -112, -66, -116, -140, -42, -24, 162,
-	-140, -66, 162, -66, -140, 163, -42, -140, -116, -42,
-	163, -36, -33, -35, -32, -34, -141, -140, 166, 28,
-	-142, 166, 94, 156, -68, -109, 93, 93, -140, -140,
-	162, -116, -67, 71, 163, -117, -140, -83, -149, -149,
-	-149, -149, -149, -83, -83, -83, 163, 163, 163, 71,
-	-71, -70, 162, 99, 70, 163, -67, 94, -125, -1,
-	-68, 86, -67, -1, 19, -55, 37, 103, -56, -57,
-	53, 85, 136, -58, 85, 136, 166, -75, 49, 50,
-	-50, -51, 47, 48, 54, 54, -152, 56, -152, -151,
-	-153, -115, -140, 163, -68, -71, -112, -48, 166, 158,
-	163, 166, 166, 162, -112, -49, -112, 163, 166, 163,
-	166, -26, 37, 38, 39, 40, -25, -24, 41, -112,
-	43, 43, 163, 28, 163, 166, 166, 41, 163, 166,
-	-30, -140, -114, 89, -2, 91, -134, 90, -2, -2,
-	93, 93, -42, 163, -67, 163, -83, -83, -83, -83,
-	-69, -83, 163, 163, 163, -70, 163, 166, -67, 80,
-	131, 163, 87, 94, 91, -110, -132, 90, -68, -54,
-	137, 79, -72, 135, -51, -67, -113, -103, -103, 54,
-	54, 54, -152, 166, 163, -49, -121, -67, -83, -96,
-	-112, 163, 163, 62, -112, -156, -116, -66, -66, 163,
-	166, -67, 163, -140, -140, -68, 28, 128, 28, -32,
-	-35, -35, -141, -68, 28, -36, -2, -135, 92, -68,
-	94, 94, -2, -2, 163, 28, 109, 163, 163, 163,
-	163, 163, 163, 109, 109, 130, 109, 130, -71, 166,
-	46, 87, -1, -57, -59, 134, -76, 37, 38, -52,
-	-105, 61, 62, -103, -103, -103, 54, -140, -68, 26,
-	-42, 163, 163, 166, 163, 62, 26, -42, 162, -42,
-	-26, -25, -42, -3, -14, -5, -18, 87, 86, -15,
-	-16, 89, 129, 128, 128, 163, -127, -126, 92, 88,
-	94, -2, 91, 89, 89, 94, 94, 162, 162, 109,
-	109, 109, 109, 109, 109, 162, 162, 135, 162, 135,
-	-67, 162, -124, -54, -53, -67, 162, -105, 61, -103,
-	163, 163, -71, -83, 26, -42, 162, -71, -112, 94,
-	156, -68, -109, -68, -141, -142, -9, -68, -3, -3,
-	28, 94, -127, -2, -68, 86, -2, 89, 89, -42,
-	-89, -88, -90, 108, 162, 162, 162, 162, 162, 162,
-	-88, -90, -89, 109, -88, 109, 163, -52, 97, -116,
-	-67, 163, -71, -112, 163, -3, 91, -136, 90, 93,
-	70, 70, -141, -142, 94, 94, 128, 87, 94, 91,
-	-134, 90, 163, 163, -52, 45, 48, -89, -89, -89,
-	-89, -89, -88, 163, 163, 162, 163, 162, 163, 19,
-	163, 163, 26, -42, -3, -137, 92, -68, -4, -17,
-	-5, -19, 87, 86, -15, -16, -6, -140, -140, 70,
-	70, -3, 87, -2, 48, -113, 163, 163, 163, 163,
-	163, 163, -89, -88, 26, -42, -71, -129, -128, 92,
-	88, 94, -3, 91, 94, 156, -68, -109, 93, 93,
-	-140, -140, 94, -126, -72, 163, 163, -71, 94, -129,
-	-3, -68, 86, -3, 89, -4, 91, -138, 90, -4,
-	-4, 93, 93, -91, 136, 87, 94, 91, -136, 90,
-	-4, -139, 92, -68, 94, 94, -4, -4, -92, 74,
-	81, 6, 84, 87, -3, -131, -130, 92, 88, 94,
-	-4, 91, 89, 89, 94, 94, -94, 81, -93, 6,
-	84, 82, 82, 85, -128, 94, -131, -4, -68, 86,
-	-4, 89, 89, 71, 82, 82, 83, 85, 87, 94,
-	91, -138, 90, -95, 81, -93, 87, -4, 83, -130,
+	-1000, -1, -7, -5, -11, -44, -45, -132, -133, -136,
+	-137, -138, -23, -20, -21, -29, -30, -33, -39, -22,
+	-42, -43, -75, 15, 90, 89, 133, -8, -10, -66,
+	27, 32, 34, 35, 139, 98, -168, 104, 20, 21,
+	102, 103, 101, 105, 124, 113, 114, 115, 116, 33,
+	128, 140, 120, 121, 122, 123, 129, 125, 126, 127,
+	141, 130, -69, -88, -85, -84, -91, -92, -122, -87,
+	-89, -166, -171, -172, -173, -41, 188, 16, 92, 119,
+	81, 5, 6, 7, -71, 10, -72, -70, -74, 185,
+	186, -165, 171, 158, 172, 170, -93, -77, 70, 74,
+	187, 11, 13, 14, 12, 99, 9, 79, 88, -73,
+	4, 150, 151, 152, 153, 154, 160, 161, 162, 163,
+	164, 165, 166, 142, 45, 156, 157, 159, 149, 138,
+	173, 167, 30, 182, -75, 188, -168, 90, 27, 139,
+	89, -123, -74, -75, -1, -46, -48, 24, 19, 27,
+	22, 143, -47, 17, -84, 188, 188, 25, 36, 45,
+	45, 36, -170, 188, -169, -166, -170, -165, -166, 99,
+	44, 105, 131, -171, -173, -171, -165, -165, -40, 106,
+	107, 37, 38, 108, 109, -165, -165, -75, 43, -165,
+	115, -75, -75, -173, -165, -75, -75, -75, -165, -75,
+	-127, -74, -165, -75, -165, -44, 142, -66, -165, 179,
+	-74, -75, -127, -44, -75, -166, -167, -9, 139, 98,
+	6, -68, -67, -180, 31, 178, 177, 184, 78, 75,
+	74, 71, 76, 77, -182, 186, 185, 183, 190, 191,
+	73, 72, -74, -74, 193, 188, 188, 188, 188, 188,
+	177, 184, -175, -182, 74, -84, -74, -74, -165, 5,
+	10, 188, 188, 193, -1, 94, -127, -90, 188, -123,
+	-151, -124, 93, 135, -58, 46, -49, -50, 25, 18,
+	25, -110, -108, -105, -107, -165, 30, -106, 160, 161,
+	162, 163, 164, 165, 166, 25, 18, -109, -105, 25,
+	65, 66, 67, -174, 80, -90, -127, -108, -165, -165,
+	-165, -108, -174, 192, 179, 99, 44, 131, 132, -165,
+	-105, -165, -165, 184, 43, 184, 43, 63, -165, -75,
+	-75, 18, 63, 63, 115, -165, 43, 18, 18, 192,
+	63, 192, -44, -48, -75, 6, -74, 189, 189, 189,
+	189, 96, 71, 192, 71, -166, -167, 192, -165, -74,
+	-74, -74, -175, -74, 75, 71, 76, 77, -77, 188,
+	-84, -74, -74, 69, 68, -74, -74, -74, -74, -74,
+	-74, -74, -165, 6, -90, -174, 189, -131, -121, -120,
+	-76, -74, -95, 183, -165, 172, 139, 170, 173, 174,
+	175, 176, -90, -174, -174, -77, -77, 75, 71, 69,
+	68, 78, 170, -174, -74, -165, 6, -1, 189, 93,
+	-152, 95, -125, 95, -74, -75, -159, 93, -59, -65,
+	52, 53, 49, -50, -51, 23, -167, -166, -129, -112,
+	-111, -113, -114, 29, 188, -108, 168, 169, -165, -84,
+	-108, 20, 192, 188, -108, -129, 18, 192, -108, -179,
+	68, -179, -179, -131, 189, 63, 188, 188, -181, 28,
+	62, 62, 33, 34, 42, 20, -90, -170, -74, 100,
+	188, 28, 188, 188, -75, -165, -75, -165, -165, -75,
+	-165, -75, -32, -31, -75, 25, 5, -32, -128, -75,
+	-165, -173, -173, -108, -128, -128, -127, -75, -2, -12,
+	-5, -13, 90, 89, 133, -8, -10, -6, 117, 118,
+	-165, -167, -165, 71, 71, -68, 28, 188, -71, -72,
+	72, -74, -77, -74, -74, -77, -77, 189, -90, 189,
+	192, 28, 188, 188, 188, 188, 188, 188, 188, 188,
+	189, -90, -90, -76, -77, -86, 188, -84, 167, -86,
+	-86, -175, -90, 192, -144, -143, 95, 91, 97, -1,
+	97, -74, 94, 94, 97, -163, 69, -164, 6, 100,
+	101, -75, -75, -79, -80, -81, -74, -95, -51, -52,
+	47, -74, 192, 61, -176, -178, 60, 64, 57, 146,
+	147, 56, 58, 59, -165, 28, -165, 28, -112, 188,
+	188, 193, 26, 188, -44, -135, -134, -73, -165, -110,
+	-105, -75, -165, 30, 63, 188, -51, -129, -109, 63,
+	-165, 28, -47, -46, -47, -47, 188, -126, -73, -25,
+	-24, -165, -44, -165, -165, -26, 188, -165, -73, 188,
+	-73, -165, 189, -44, -165, -130, -165, -44, 189, -38,
+	-35, -37, -34, -36, -166, -165, 192, 28, -167, 192,
+	97, 182, -75, -123, -2, 96, 96, -165, -165, 188,
+	-130, -74, 72, 138, 189, -131, -165, -90, -174, -174,
+	-174, -174, -174, -90, -90, -90, 189, 189, 189, 72,
+	-78, -77, 188, 102, 71, 189, -74, 97, -144, -1,
+	-75, 89, -74, -1, 94, 192, 19, -61, 37, 106,
+	-62, -63, 54, 87, 152, -64, 87, 152, 192, -82,
+	50, 51, -52, -57, 48, 49, -112, 148, 55, 149,
+	55, -177, 57, -177, -176, -178, 149, 188, 188, -165,
+	-165, 189, -75, -90, -165, -78, -126, -50, 192, 184,
+	189, 192, 192, 188, -126, -51, -112, 63, -165, -126,
+	189, 192, 189, 192, -165, 74, 188, -28, 37, 38,
+	39, 40, -27, -26, 41, -126, 43, 43, -94, 138,
+	189, 192, 28, 189, 192, 192, 41, 189, 192, -32,
+	-165, -128, 92, -2, 94, -153, 93, 135, -2, -2,
+	96, 96, -44, 189, -74, 188, -94, 189, -90, -90,
+	-90, -90, -76, -90, 189, 189, 189, -94, -94, -94,
+	-77, 189, 192, -74, 82, -94, 137, 189, 90, 97,
+	94, -124, -151, 93, -1, -164, -75, -60, 155, 81,
+	-79, 151, -57, -74, -53, -54, -74, 156, 157, 158,
+	-112, -112, 148, -112, -112, 148, 55, 55, 55, -177,
+	-112, -92, -165, -165, 192, 189, 189, -51, -135, -74,
+	-90, -105, -126, 189, 62, -112, 63, 189, 63, -126,
+	-181, -25, 74, 79, -165, -73, -73, 189, 192, -74,
+	189, -165, -165, -75, 188, 28, -130, 133, 28, -34,
+	-37, -37, -166, -75, 28, -38, -2, -154, 95, -75,
+	-160, 93, 97, 97, -2, -2, 189, 28, 23, 138,
+	112, 189, 189, 189, 189, 189, 189, 112, 112, 136,
+	112, 136, -78, 192, 47, 90, -1, -159, -63, -65,
+	150, -83, 37, 38, -58, 192, 188, 188, 159, -112,
+	-119, 62, 63, -112, -112, 148, -112, -112, 55, 100,
+	188, 100, -165, -75, 26, -44, 189, 189, 192, 189,
+	63, -74, 62, -112, 26, -44, 188, -44, 79, 189,
+	-28, -27, 23, -44, -3, -14, -5, -18, 90, 89,
+	133, -15, -16, 92, 134, 133, 133, 189, -146, -145,
+	95, 91, 97, -2, 94, 97, -163, 92, 92, 97,
+	97, 188, -74, 188, 188, -94, -94, -94, -94, -94,
+	-94, 188, 188, 151, 188, 151, -74, 188, -143, -60,
+	-59, -53, -55, -56, -74, 188, -55, 188, -74, 188,
+	-119, -119, -112, 62, -112, -73, -165, 193, 189, 189,
+	-78, -90, 26, -44, 188, -140, -139, 93, -74, 62,
+	-78, -126, -74, 97, 182, -75, -123, -3, -75, -166,
+	-167, -9, -75, -3, -3, 28, 97, -146, -2, -75,
+	89, -2, 94, 92, 92, -44, 189, 23, -97, -96,
+	-98, 111, 112, 112, 112, 112, 112, 112, -96, -98,
+	-97, 112, -96, 112, 189, -58, 100, 189, 192, 189,
+	-74, 189, -55, -130, -119, -74, 71, 71, -165, 189,
+	-78, -126, -140, 144, 74, -140, -74, 189, 189, -3,
+	94, -155, 93, 135, 96, 71, 71, -166, -167, 97,
+	97, 133, 90, 97, 94, -153, 93, -2, 189, -74,
+	189, -58, 46, 49, 188, 188, 188, 188, 188, 188,
+	189, 189, 188, 189, 188, 189, 19, -55, 192, 189,
+	189, 188, 188, 189, 189, -141, 72, 144, -140, 26,
+	-44, -3, -156, 95, -75, -161, 93, -4, -17, -5,
+	-19, 90, 89, 133, -15, -16, -6, -165, -165, 71,
+	71, -3, 90, -2, -160, 189, 49, -127, -97, -97,
+	-97, -97, -97, -96, -97, -96, -94, -127, -115, 69,
+	-116, -74, -117, -118, -73, 26, -44, 94, -74, -141,
+	49, -78, -148, -147, 95, 91, 97, -3, 94, 97,
+	-163, 97, 182, -75, -123, -4, 96, 96, -165, -165,
+	97, -145, 112, -79, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 192, 28, 189, 192, 28,
+	-78, 19, 22, 94, 145, 123, 97, -148, -3, -75,
+	89, -3, 94, 92, -4, 94, -157, 93, 135, -4,
+	-4, 96, 96, 188, -99, -183, 152, 82, 153, 189,
+	189, -115, -165, 189, -117, -165, 20, 24, -141, -141,
+	90, 97, 94, -155, 93, -3, -4, -158, 95, -75,
+	-162, 93, 97, 97, -4, -4, -97, -100, 75, 83,
+	6, 7, -70, 86, -135, -142, 188, 94, 94, 90,
+	-3, -161, -150, -149, 95, 91, 97, -4, 94, 97,
+	-163, 92, 92, 97, 97, 189, -104, 154, -102, 83,
+	-101, 6, 7, -70, 86, 84, 84, 84, 84, 87,
+	26, -126, 24, 19, 22, -147, 97, -150, -4, -75,
+	89, -4, 94, 92, 92, 86, 47, 150, 72, 84,
+	84, 85, 84, 85, 84, 85, 87, -77, 189, -142,
+	20, 90, 97, 94, -157, 93, -4, 87, -103, 83,
+	-101, 26, -135, 90, -4, -162, -104, 85, -77, -149,
 }
 var yyDef = [...]int{
 
-	-2, -2, 2, 30, 31, 10, 11, 12, 13, 14,
+	-2, -2, 2, 31, 32, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	25, -2, 27, 0, 387, 46, 47, 0, 0, 0,
-	0, 0, 0, -2, 0, 0, 0, 0, 0, 139,
-	0, 0, 85, 86, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 171, 0, 0, 238, 239, 240, 241,
-	242, 243, 244, 245, 246, 247, 249, 250, 251, 252,
-	216, 254, 0, 39, 490, 222, 223, 224, 225, 226,
-	227, 0, 0, 0, 230, 0, 0, 0, 319, 480,
-	0, 0, 0, 467, 475, 476, 477, 0, 228, 229,
-	235, 459, 460, 461, 462, 463, 464, 465, 466, 0,
-	0, 0, -2, 236, -2, 248, 0, 0, 0, 387,
-	0, 388, 236, -2, 188, 0, 0, 0, 0, 0,
-	478, 185, 216, 307, 0, 0, 0, 76, 478, 473,
-	471, 77, 0, 79, 0, 0, 0, 0, 0, 0,
-	84, 108, 110, 0, 140, 141, 142, 143, 0, 0,
-	0, -2, -2, 236, 236, 155, 167, -2, -2, -2,
-	-2, -2, 166, 395, -2, -2, 172, 173, 0, 0,
-	236, 0, 0, 0, 236, 247, 0, 0, 37, 38,
-	40, 217, 220, 0, 491, 0, 494, 495, 480, 0,
+	25, 26, -2, 28, 0, 464, -2, 48, 49, 0,
+	0, 0, 0, 0, 0, 0, -2, 0, 0, 0,
+	0, 0, 155, 0, 0, 90, 91, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 187, 0,
+	245, 0, 270, 271, 272, 273, 274, 275, 276, 277,
+	278, 279, 281, 282, 283, 284, 245, 286, 0, 40,
+	607, 251, 252, 253, 254, 255, 256, 257, 0, 0,
+	0, 262, 0, 0, 0, 0, 355, 597, 0, 0,
+	0, 584, 592, 593, 594, 0, 260, 261, 0, 267,
+	564, 565, 566, 567, 568, 569, 570, 571, 572, 573,
+	574, 575, 576, 577, 578, 579, 580, 581, 582, 583,
+	0, 0, 0, -2, 268, -2, 280, 0, 0, 0,
+	464, 0, 465, 268, 0, -2, 206, 0, 0, 0,
+	0, 0, 0, 595, 203, 245, 341, 0, 0, 0,
+	0, 0, 81, 595, 590, 588, 82, 0, 84, 0,
+	0, 0, 0, 0, 0, 89, 124, 126, 0, 156,
+	157, 158, 159, 0, 0, 0, -2, -2, 0, 92,
+	0, 268, 268, 171, 183, -2, -2, -2, -2, -2,
+	182, 472, -2, -2, 188, 189, 245, 0, 191, 0,
+	0, 268, 0, 0, 268, 279, 0, 0, 38, 39,
+	41, 246, 249, 0, 608, 0, 611, 612, 597, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 301, 302, 0, 307, 0, 478, 478, 494, 495,
-	0, 0, 481, 295, 305, 306, 0, 478, 0, 0,
-	3, -2, 0, 0, 307, 0, 445, 391, 0, 214,
-	0, 188, 190, 0, 0, 0, 0, 403, 361, 362,
-	351, 352, 0, -2, -2, -2, -2, 0, 0, 0,
-	401, 488, 488, 488, 0, 479, 0, 308, 0, 492,
-	0, 307, 0, 0, 0, 0, 0, 0, 111, 116,
-	124, 138, 0, 0, 0, 0, 0, 0, -2, -2,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
-	223, 470, 237, 253, 256, 272, 188, -2, 0, 0,
-	0, 0, 0, 490, 0, 273, -2, -2, 0, 0,
-	0, 0, 0, 286, 216, 257, -2, 0, 0, 296,
-	297, 298, 299, 300, 303, 304, 231, 233, 0, 307,
-	310, 0, 407, 383, 385, 381, 382, 255, 230, 0,
-	0, 0, 0, 0, 0, 0, 307, 307, 278, 280,
-	0, 0, 0, 0, 480, 148, 307, 0, 232, 234,
-	429, 312, 0, 0, -2, 0, 0, 0, 236, 176,
-	198, 0, 0, 0, 190, 192, 0, 187, 468, 189,
-	-2, 367, 370, 371, 216, 363, 0, 366, 216, 0,
-	0, 0, 0, 190, 0, 0, 0, 489, 0, 0,
-	186, 313, 0, 0, 0, 216, 493, 0, 0, 0,
-	0, 0, 474, 472, 216, 0, 216, 0, 0, -2,
-	-2, -2, -2, -2, -2, -2, -2, 109, 119, -2,
-	0, 121, 123, 164, -2, 153, 154, 168, 159, 160,
-	396, -2, 0, 0, 41, 42, 0, 387, 51, 52,
-	53, 28, 29, 0, 469, 0, 0, 0, 221, 0,
-	0, 281, 282, 0, 0, 287, -2, 291, 293, 309,
-	0, 311, 0, 0, 307, 478, 478, 478, 478, 307,
-	307, 307, 0, 0, 0, 0, 288, 216, 275, 0,
-	292, 294, 0, 0, 0, 0, 429, -2, 0, 0,
-	446, 386, 392, 0, -2, 0, 0, -2, -2, 197,
-	261, 267, 265, 266, 192, 194, 0, 191, 0, 0,
-	484, 484, 482, 0, 483, 486, 487, 368, 0, 482,
-	0, 0, 0, 411, 188, 415, 0, 230, 404, 0,
-	236, -2, 352, 0, 0, 425, 190, 402, 181, 184,
-	182, 183, 0, 0, 393, 0, 405, 89, 101, 0,
-	97, 92, 0, 0, 0, 316, 106, 107, 0, 115,
-	0, 0, 131, 132, 126, 129, 125, 0, 0, 0,
-	112, 0, 0, -2, 236, 0, -2, -2, 0, 0,
-	216, 0, 283, 0, 314, 408, 384, 0, 307, 307,
-	307, 307, 307, 0, 0, 0, 315, 317, 318, 0,
-	0, 259, 0, 146, 0, 320, 0, 0, 0, 430,
-	236, 45, 389, 443, 177, 0, 204, 205, 201, 207,
-	208, 209, 210, 215, 212, 213, 0, 263, 268, 269,
-	194, 180, 0, 0, 0, 0, 0, 485, 0, 0,
-	484, 400, 369, 372, 236, 409, 0, 190, 0, 0,
-	357, 307, 0, 0, 0, 426, 0, 0, 0, -2,
-	0, 90, 102, 103, 0, 0, 0, 99, 0, 0,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	120, 118, 398, 32, 5, -2, 449, 0, 0, 0,
-	-2, -2, 0, 0, 284, 309, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 285, 274, 0, 0, 147,
-	0, 258, 43, 0, -2, 390, 444, 0, 236, 214,
-	202, 0, 262, 0, 196, 195, 193, 373, 482, 0,
-	0, 0, 0, 0, 216, 413, 416, 414, 0, 0,
-	0, 0, 216, 0, 394, 216, 406, 104, 105, 101,
-	0, 98, 93, 94, -2, -2, 216, -2, 0, 127,
-	133, 130, 0, -2, 0, 0, 433, 0, -2, 236,
-	0, 0, 0, 0, 218, 0, 0, 314, 315, 316,
-	317, 318, 320, 0, 0, 0, 0, 0, 260, 0,
-	0, 44, 427, 201, 200, 203, 264, 270, 271, 214,
-	374, 0, 0, 482, 482, 377, 0, 230, 236, 0,
-	412, 358, 359, 307, 216, 0, 0, 423, 0, 88,
-	91, 100, 114, 0, 0, 54, 55, 0, 387, 68,
-	69, 0, 61, -2, -2, 0, 0, 433, -2, 0,
-	0, 450, -2, 33, 34, 0, 0, 216, 337, 0,
-	0, 0, 0, 0, 0, 337, 337, 0, 337, 0,
-	0, 196, 428, 199, 178, 379, 0, 375, 0, 378,
-	364, 365, 410, 0, 0, 419, 0, 421, 0, 134,
-	-2, 236, 0, 236, 247, 0, 0, -2, 0, 0,
-	0, 0, 0, 434, 236, 50, 447, 35, 36, 0,
-	0, 335, 196, 0, 337, 337, 337, 337, 337, 337,
-	0, 196, 0, 0, 0, 0, 276, 0, 0, 0,
-	376, 360, 417, 0, 216, 7, -2, 453, 0, -2,
-	0, 0, 0, 0, 135, 136, -2, 48, 0, -2,
-	448, 0, 219, 322, 334, 0, 0, 0, 0, 0,
-	0, 0, 0, 329, 330, 337, 332, 337, 321, 179,
-	380, 216, 0, 424, 437, 0, -2, 236, 0, 0,
-	63, 64, 0, 387, 73, 74, 75, 0, 0, 0,
-	0, 0, 49, 431, 0, 338, 323, 324, 325, 326,
-	327, 328, 0, 0, 0, 420, 422, 0, 437, -2,
-	0, 0, 454, -2, 0, -2, 236, 0, -2, -2,
-	0, 0, 137, 432, 197, 331, 333, 418, 0, 0,
-	438, 236, 67, 451, 56, 9, -2, 457, 0, 0,
-	0, -2, -2, 336, 0, 65, 0, -2, 452, 0,
-	441, 0, -2, 236, 0, 0, 0, 0, 339, 0,
-	0, 0, 0, 66, 435, 0, 441, -2, 0, 0,
-	458, -2, 57, 58, 0, 0, 0, 0, 348, 0,
-	0, 341, 342, 343, 436, 0, 0, 442, 236, 72,
-	455, 59, 60, 0, 347, 344, 345, 346, 70, 0,
-	-2, 456, 0, 340, 0, 350, 71, 439, 349, 440,
+	0, 0, 335, 336, 0, 341, 0, 341, 595, 595,
+	611, 612, 0, 0, 598, 329, 339, 340, 0, 258,
+	259, 595, 0, 0, 3, -2, 0, 0, 341, 0,
+	538, 468, 0, 0, 243, 0, 206, 208, 0, 0,
+	0, 0, 481, 416, 417, 403, 404, 0, -2, -2,
+	-2, -2, -2, -2, -2, 0, 0, 0, 479, 0,
+	605, 605, 605, 0, 596, 0, 342, 0, 609, 0,
+	0, 0, 341, 0, 0, 0, 0, 0, 0, 127,
+	132, 140, 154, 0, 0, 0, 0, 0, 0, -2,
+	-2, 0, 0, 0, 0, 94, 0, 0, 0, 0,
+	0, 0, 190, 206, -2, 252, 587, 269, 285, 288,
+	304, -2, 0, 0, 0, 0, 0, 607, 0, 305,
+	-2, -2, 0, 0, 0, 0, 0, 0, 318, 245,
+	289, -2, -2, 0, 0, 330, 331, 332, 333, 334,
+	337, 338, 263, 265, 0, 341, 344, 0, 485, 460,
+	462, 458, 459, 287, 262, 0, 0, 0, 0, 0,
+	0, 0, 0, 341, 341, 310, 312, 0, 0, 0,
+	0, 597, 164, 341, 0, 264, 266, 522, 346, 0,
+	0, -2, 0, 0, 0, 268, 0, 0, 194, 227,
+	0, 0, 0, 208, 210, 0, 205, 585, 207, -2,
+	425, 428, 429, 432, 245, 418, 0, 0, 403, 424,
+	245, 0, 0, 0, 0, 208, 0, 0, 0, 0,
+	606, 0, 0, 204, 347, 0, 0, 0, 245, 610,
+	0, 0, 0, 0, 0, 0, 0, 591, 589, 245,
+	0, 245, 0, 0, -2, -2, -2, -2, -2, -2,
+	-2, -2, 125, 135, -2, 0, 137, 139, 180, -2,
+	93, 169, 170, 184, 175, 176, 473, -2, 0, 0,
+	42, 43, 0, 464, -2, 54, 55, 56, 29, 30,
+	0, 586, 0, 0, 0, 250, 0, 0, 313, 314,
+	0, 0, 319, -2, -2, 325, 327, 343, 0, 345,
+	0, 0, 341, 595, 595, 595, 595, 341, 341, 341,
+	348, 0, 0, 0, 0, 320, 245, 307, 0, 326,
+	328, 0, 0, 0, 0, 522, -2, 0, 0, 539,
+	463, 469, 0, -2, 47, 0, 560, 561, 562, 0,
+	0, -2, -2, 226, 293, 299, 297, 298, 210, 223,
+	0, 209, 0, 0, 0, 601, 601, 599, 0, 0,
+	0, 600, 603, 604, 426, 0, 430, 0, 599, 0,
+	341, 0, 0, 0, 489, 206, 493, 0, 262, 482,
+	0, 268, -2, 404, 0, 0, 503, 208, 480, 0,
+	0, 0, 199, 202, 200, 201, 0, 0, 470, 0,
+	111, 107, 97, 0, 99, 117, 0, 113, 102, 0,
+	0, 0, 358, 122, 123, 0, 483, 131, 0, 0,
+	147, 148, 142, 145, 141, 0, 0, 0, 128, 0,
+	0, -2, 268, 0, 0, -2, -2, 0, 0, 245,
+	0, 315, 0, 0, 358, 486, 461, 0, 341, 341,
+	341, 341, 341, 0, 0, 0, 358, 358, 358, 0,
+	0, 291, 0, 162, 0, 358, 0, 0, 0, 523,
+	268, 46, 466, 536, -2, 0, 195, 0, 233, 234,
+	230, 236, 237, 238, 239, 244, 241, 242, 0, 295,
+	300, 301, 223, 198, 0, 0, -2, 0, 0, 0,
+	0, 0, 602, 0, 0, 601, 0, 0, 0, 427,
+	431, 433, 268, 0, 423, 487, 0, 208, 0, 0,
+	412, 341, 0, 0, 0, 504, 599, 0, 0, 0,
+	0, 0, -2, 0, 108, 0, 0, 100, 118, 119,
+	0, 0, 0, 115, 0, 0, 0, 0, 352, 0,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	134, 475, 33, 5, -2, 542, 0, 0, 0, 0,
+	-2, -2, 0, 0, 316, 0, 350, 343, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 351, 353, 354,
+	317, 306, 0, 0, 163, 356, 0, 290, 44, 0,
+	-2, 467, 537, 0, 552, 563, 268, 243, 231, 0,
+	294, 0, 225, 224, 211, 212, 214, 579, 580, 0,
+	-2, 434, 0, 443, 599, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 422, 245, 491, 494, 492,
+	0, 0, 0, 0, 0, 599, 0, 245, 0, 471,
+	245, 112, 0, 110, 0, 120, 121, 117, 0, 114,
+	103, 104, -2, -2, 0, 245, 484, -2, 0, 143,
+	149, 146, 0, -2, 0, 0, 526, 0, -2, 268,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 358, 358, 358, 358, 358, 358, 0, 0, 0,
+	0, 0, 292, 0, 0, 45, 520, 553, 230, 229,
+	232, 296, 302, 303, 243, 0, 0, 0, 0, 440,
+	435, 0, 0, 599, 599, 0, 599, 438, 0, 0,
+	595, 0, 262, 268, 0, 490, 413, 414, 341, 245,
+	0, 0, 0, 599, 0, 501, 0, 96, 109, 98,
+	101, 116, 0, 130, 0, 0, 57, 58, 0, 464,
+	-2, 72, 73, 0, 64, -2, -2, 0, 0, 526,
+	-2, 0, 0, 543, -2, 53, 0, 34, 35, 0,
+	0, 245, 0, 0, 376, 350, 351, 352, 353, 354,
+	356, 376, 376, 0, 376, 0, 0, 225, 521, 228,
+	196, 213, 0, 218, 220, 245, 0, 0, 456, 0,
+	441, 436, 599, 0, 439, 0, 0, 0, 419, 420,
+	488, 0, 0, 497, 0, 505, 514, 0, 0, 0,
+	499, 0, 0, 150, -2, 268, 0, 0, 268, 279,
+	0, 0, -2, 0, 0, 0, 0, 0, 527, 268,
+	52, 540, -2, 36, 37, 0, 349, 0, 0, 374,
+	225, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 308, 0, 0, 215, 0, 221,
+	0, 216, 0, 0, 442, 437, 0, 0, 263, 415,
+	495, 0, 515, 516, 0, 506, 0, 245, 359, 7,
+	-2, 546, 0, 0, -2, 0, 0, 0, 0, 151,
+	152, -2, 50, 0, -2, 541, 0, 554, 248, 0,
+	360, 373, 0, 0, 376, 376, 376, 376, 376, 376,
+	368, 369, 376, 371, 376, 358, 197, 219, 0, 217,
+	457, 0, 0, 421, 245, 0, 0, 516, 507, 0,
+	502, 530, 0, -2, 268, 0, 0, 0, 0, 66,
+	67, 0, 464, -2, 78, 79, 80, 0, 0, 0,
+	0, 0, 51, 524, 555, 349, 0, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 357, 0, 0, 0,
+	448, 450, 0, 452, 454, 0, 498, 0, 517, 0,
+	0, 500, 0, 530, -2, 0, 0, 547, -2, 71,
+	0, 0, -2, 268, 0, 0, -2, -2, 0, 0,
+	153, 525, 0, 226, 362, 363, 364, 365, 366, 367,
+	370, 372, 222, 0, 0, 0, 0, 0, 0, 0,
+	496, 0, 509, 0, 516, 516, 0, 0, 531, 268,
+	70, 544, -2, 59, 9, -2, 550, 0, 0, 0,
+	0, -2, -2, 376, 375, 0, 380, 381, 382, 445,
+	446, 449, 451, 447, 453, 455, 0, 518, 0, 0,
+	68, 0, -2, 545, 0, 556, 534, 0, -2, 268,
+	0, 0, 0, 0, 0, 0, 0, 399, 0, 0,
+	0, 0, 0, 0, 508, 0, 0, 0, 0, 69,
+	528, 557, 0, 534, -2, 0, 0, 551, -2, 77,
+	0, 60, 61, 0, 0, 361, 378, 0, 0, 0,
+	396, 0, 0, 0, 0, 383, 384, 385, 386, 387,
+	0, 0, 518, 0, 513, 529, 0, 0, 535, 268,
+	76, 548, -2, 62, 63, 0, 401, 402, 0, 395,
+	388, 389, 390, 392, 391, 393, 394, 510, 519, 0,
+	0, 74, 0, -2, 549, 0, 558, 400, 399, 0,
+	398, 0, 512, 75, 532, 559, 379, 397, 511, 533,
 }
 var yyTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 161, 3, 3, 3, 165, 3, 3,
-	162, 163, 157, 160, 166, 159, 167, 164, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 156,
-	3, 158,
+	3, 3, 3, 187, 3, 3, 3, 191, 3, 3,
+	188, 189, 183, 186, 192, 185, 193, 190, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 182,
+	3, 184,
 }
 var yyTok2 = [...]int{
 
@@ -1753,7 +2250,9 @@ var yyTok2 = [...]int{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
 }
 var yyTok3 = [...]int{
 	0,
@@ -2098,1060 +2597,1168 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:245
+		//line parser.y:281
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:250
+		//line parser.y:286
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:255
+		//line parser.y:291
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:262
+		//line parser.y:298
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:266
+		//line parser.y:302
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:272
+		//line parser.y:308
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:276
+		//line parser.y:312
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:282
+		//line parser.y:318
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:286
+		//line parser.y:322
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:292
+		//line parser.y:328
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:296
+		//line parser.y:332
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:300
+		//line parser.y:336
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:304
+		//line parser.y:340
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:308
+		//line parser.y:344
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:312
+		//line parser.y:348
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:316
+		//line parser.y:352
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:320
+		//line parser.y:356
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:324
+		//line parser.y:360
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:328
+		//line parser.y:364
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:332
+		//line parser.y:368
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:336
+		//line parser.y:372
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:340
+		//line parser.y:376
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:344
+		//line parser.y:380
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:348
+		//line parser.y:384
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:352
+		//line parser.y:388
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:356
+		//line parser.y:392
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:360
+		//line parser.y:396
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:366
+		//line parser.y:400
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:370
+		//line parser.y:406
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:376
+		//line parser.y:410
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:380
+		//line parser.y:416
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:420
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:386
+		//line parser.y:426
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:390
+		//line parser.y:430
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:394
+		//line parser.y:434
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:398
+		//line parser.y:438
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:402
+		//line parser.y:442
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:408
+		//line parser.y:448
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:412
+		//line parser.y:452
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:418
+		//line parser.y:458
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:422
+		//line parser.y:462
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:428
+		//line parser.y:468
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:432
+		//line parser.y:472
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:438
+		//line parser.y:478
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:442
+		//line parser.y:482
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:446
+		//line parser.y:486
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 46:
+	case 47:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:490
+		{
+			yyVAL.statement = ExceptionBlock{Statements: yyDollar[2].program, Handlers: yyDollar[4].exhandlers}
+		}
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:450
+		//line parser.y:494
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:454
+		//line parser.y:498
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:460
+		//line parser.y:504
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:464
+		//line parser.y:508
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:468
+		//line parser.y:512
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 51:
+	case 53:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:516
+		{
+			yyVAL.statement = ExceptionBlock{Statements: yyDollar[2].program, Handlers: yyDollar[4].exhandlers}
+		}
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:472
+		//line parser.y:520
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:476
+		//line parser.y:524
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:480
+		//line parser.y:528
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:486
+		//line parser.y:534
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:490
+		//line parser.y:538
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:496
+		//line parser.y:544
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:500
+		//line parser.y:548
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:504
+		//line parser.y:552
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:508
+		//line parser.y:556
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:512
+		//line parser.y:560
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:518
+		//line parser.y:566
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:522
+		//line parser.y:570
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:528
+		//line parser.y:576
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:532
+		//line parser.y:580
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:538
+		//line parser.y:586
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:542
+		//line parser.y:590
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:546
+		//line parser.y:594
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 71:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:598
+		{
+			yyVAL.statement = ExceptionBlock{Statements: yyDollar[2].program, Handlers: yyDollar[4].exhandlers}
+		}
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:550
+		//line parser.y:602
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:554
+		//line parser.y:606
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:560
+		//line parser.y:612
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:564
+		//line parser.y:616
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:568
+		//line parser.y:620
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 73:
+	case 77:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:624
+		{
+			yyVAL.statement = ExceptionBlock{Statements: yyDollar[2].program, Handlers: yyDollar[4].exhandlers}
+		}
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:572
+		//line parser.y:628
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 74:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:576
+		//line parser.y:632
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 75:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:580
+		//line parser.y:636
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 76:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:586
+		//line parser.y:642
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 77:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:590
+		//line parser.y:646
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 78:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:594
+		//line parser.y:650
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 79:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:598
+		//line parser.y:654
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 80:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:604
+		//line parser.y:660
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 81:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:608
+		//line parser.y:664
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 82:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:612
+		//line parser.y:668
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 83:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:616
+		//line parser.y:672
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 84:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:620
+		//line parser.y:676
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 85:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:626
+		//line parser.y:682
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 86:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:630
+		//line parser.y:686
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 87:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:636
+	case 92:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:690
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
+			yyVAL.statement = Savepoint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 88:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:640
+	case 93:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:694
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+			yyVAL.statement = RollbackToSavepoint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].identifier}
 		}
-	case 89:
+	case 94:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:698
+		{
+			yyVAL.statement = ReleaseSavepoint{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier}
+		}
+	case 95:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:704
+		{
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
+		}
+	case 96:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:708
+		{
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+		}
+	case 97:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:644
+		//line parser.y:712
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 90:
+	case 98:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:716
+		{
+			yyVAL.statement = CreateIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].identifier, Column: yyDollar[7].identifier}
+		}
+	case 99:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:720
+		{
+			yyVAL.statement = DropIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].identifier}
+		}
+	case 100:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:648
+		//line parser.y:724
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 91:
+	case 101:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:652
+		//line parser.y:728
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 92:
+	case 102:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:656
+		//line parser.y:732
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 93:
+	case 103:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:660
+		//line parser.y:736
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 94:
+	case 104:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:664
+		//line parser.y:740
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 95:
+	case 105:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:668
+		//line parser.y:744
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 96:
+	case 106:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:672
+		//line parser.y:748
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 97:
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:754
+		{
+			yyVAL.queryexpr = yyDollar[1].identifier
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:758
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
+		}
+	case 109:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:762
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true}
+		}
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:766
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, NotNull: true}
+		}
+	case 111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:772
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 112:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:776
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:678
+		//line parser.y:782
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
-	case 98:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:682
+		//line parser.y:786
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
-	case 99:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:688
+		//line parser.y:792
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 100:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:692
+		//line parser.y:796
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 101:
+	case 117:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:698
+		//line parser.y:802
 		{
 			yyVAL.expression = nil
 		}
-	case 102:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:702
+		//line parser.y:806
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 103:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:706
+		//line parser.y:810
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 104:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:710
+		//line parser.y:814
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 105:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:714
+		//line parser.y:818
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 106:
+	case 122:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:720
+		//line parser.y:824
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 107:
+	case 123:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:724
+		//line parser.y:828
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Statement: yyDollar[5].identifier}
 		}
-	case 108:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:728
+		//line parser.y:832
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 109:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:732
+		//line parser.y:836
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 110:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:736
+		//line parser.y:840
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 111:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:740
+		//line parser.y:844
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 112:
+	case 128:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:744
+		//line parser.y:848
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 113:
+	case 129:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:750
+		//line parser.y:854
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 114:
+	case 130:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:754
+		//line parser.y:858
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 115:
+	case 131:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:758
+		//line parser.y:862
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 116:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:762
+		//line parser.y:866
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].queryexpr}
 		}
-	case 117:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:768
+		//line parser.y:872
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr}
 		}
-	case 118:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:772
+		//line parser.y:876
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
 		}
-	case 119:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:778
+		//line parser.y:882
 		{
 			yyVAL.replacevals = []ReplaceValue{yyDollar[1].replaceval}
 		}
-	case 120:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:782
+		//line parser.y:886
 		{
 			yyVAL.replacevals = append([]ReplaceValue{yyDollar[1].replaceval}, yyDollar[3].replacevals...)
 		}
-	case 121:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:788
+		//line parser.y:892
 		{
 			yyVAL.statement = StatementPreparation{Name: yyDollar[2].identifier, Statement: value.NewString(yyDollar[4].token.Literal)}
 		}
-	case 122:
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:792
+		//line parser.y:896
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 123:
+	case 139:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:796
+		//line parser.y:900
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 124:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:800
+		//line parser.y:904
 		{
 			yyVAL.statement = DisposeStatement{Name: yyDollar[3].identifier}
 		}
-	case 125:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:806
+		//line parser.y:910
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 126:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:812
+		//line parser.y:916
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 127:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:816
+		//line parser.y:920
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 128:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:822
+		//line parser.y:926
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 129:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:828
+		//line parser.y:932
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 130:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:832
+		//line parser.y:936
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 131:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:838
+		//line parser.y:942
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 132:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:842
+		//line parser.y:946
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 133:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:846
+		//line parser.y:950
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 134:
+	case 150:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:852
+		//line parser.y:956
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 135:
+	case 151:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:856
+		//line parser.y:960
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 136:
+	case 152:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:860
+		//line parser.y:964
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 137:
+	case 153:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:864
+		//line parser.y:968
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 138:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:868
+		//line parser.y:972
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 139:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:874
+		//line parser.y:978
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 140:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:878
+		//line parser.y:982
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 141:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:882
+		//line parser.y:986
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 142:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:886
+		//line parser.y:990
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 143:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:890
+		//line parser.y:994
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 144:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:894
+		//line parser.y:998
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 145:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:898
+		//line parser.y:1002
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 146:
+	case 162:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:904
+		//line parser.y:1008
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[5].token.Token, TypeLit: yyDollar[5].token.Literal}
 		}
-	case 147:
+	case 163:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:908
+		//line parser.y:1012
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[6].token.Token, TypeLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal}
 		}
-	case 148:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:912
+		//line parser.y:1016
 		{
 			yyVAL.queryexpr = CursorAttrebute{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 149:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:918
+		//line parser.y:1022
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 150:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:922
+		//line parser.y:1026
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 151:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:926
+		//line parser.y:1030
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 152:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:930
+		//line parser.y:1034
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 153:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:934
+		//line parser.y:1038
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 154:
+	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:938
+		//line parser.y:1042
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:942
+		//line parser.y:1046
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag}
 		}
-	case 156:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:946
+		//line parser.y:1050
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 157:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:950
+		//line parser.y:1054
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 158:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:954
+		//line parser.y:1058
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 159:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:958
+		//line parser.y:1062
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 160:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:962
+		//line parser.y:1066
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 161:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:966
+		//line parser.y:1070
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 162:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:970
+		//line parser.y:1074
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 163:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:974
+		//line parser.y:1078
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:978
+		//line parser.y:1082
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 165:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:982
+		//line parser.y:1086
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 166:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:986
+		//line parser.y:1090
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 167:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:990
+		//line parser.y:1094
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 168:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:994
+		//line parser.y:1098
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].queryexpr}
 		}
-	case 169:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:998
+		//line parser.y:1102
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 170:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1002
+		//line parser.y:1106
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 171:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1006
+		//line parser.y:1110
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 172:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1010
+		//line parser.y:1114
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 173:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1016
+		//line parser.y:1118
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
+		}
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1122
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: true, Query: yyDollar[3].queryexpr.(SelectQuery)}
+		}
+	case 191:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1128
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 174:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1020
+		//line parser.y:1132
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 175:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1024
+		//line parser.y:1136
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 176:
+	case 194:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1030
+		//line parser.y:1142
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3160,9 +3767,9 @@ yydefault:
 				LimitClause:   yyDollar[4].queryexpr,
 			}
 		}
-	case 177:
+	case 195:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1039
+		//line parser.y:1151
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:       yyDollar[1].queryexpr,
@@ -3173,9 +3780,9 @@ yydefault:
 				ForUpdateLiteral: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal,
 			}
 		}
-	case 178:
+	case 196:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1052
+		//line parser.y:1164
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3191,9 +3798,9 @@ yydefault:
 				LimitClause:   yyDollar[9].queryexpr,
 			}
 		}
-	case 179:
+	case 197:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:1068
+		//line parser.y:1180
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3211,9 +3818,9 @@ yydefault:
 				ForUpdateLiteral: yyDollar[10].token.Literal + " " + yyDollar[11].token.Literal,
 			}
 		}
-	case 180:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1088
+		//line parser.y:1200
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -3223,9 +3830,9 @@ yydefault:
 				HavingClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 181:
+	case 199:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1098
+		//line parser.y:1210
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3234,9 +3841,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 182:
+	case 200:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1107
+		//line parser.y:1219
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3245,9 +3852,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 183:
+	case 201:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1116
+		//line parser.y:1228
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3256,93 +3863,159 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 184:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1127
+		//line parser.y:1239
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 185:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1131
+		//line parser.y:1243
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 186:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1137
+		//line parser.y:1249
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 187:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1143
+		//line parser.y:1255
 		{
 			yyVAL.queryexpr = IntoClause{Into: yyDollar[1].token.Literal, Variables: yyDollar[2].variables}
 		}
-	case 188:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1149
+		//line parser.y:1261
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 189:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1153
+		//line parser.y:1265
 		{
 			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
 		}
-	case 190:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1159
+		//line parser.y:1271
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 191:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1163
+		//line parser.y:1275
 		{
 			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 192:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1169
+		//line parser.y:1281
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 193:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1173
+		//line parser.y:1285
 		{
 			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 194:
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1291
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1295
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 214:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1301
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 215:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1305
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal, Values: yyDollar[3].queryexprs}
+		}
+	case 216:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1309
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal, Values: yyDollar[3].queryexprs}
+		}
+	case 217:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1313
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[4].queryexprs}
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1319
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1323
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1329
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 221:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1333
+		{
+			yyVAL.queryexpr = ValueList{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 222:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:1337
+		{
+			yyVAL.queryexpr = ValueList{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: append([]QueryExpression{yyDollar[2].queryexpr}, yyDollar[4].queryexprs...)}
+		}
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1179
+		//line parser.y:1343
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 195:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1183
+		//line parser.y:1347
 		{
 			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 196:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1189
+		//line parser.y:1353
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 197:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1193
+		//line parser.y:1357
 		{
 			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 198:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1199
+		//line parser.y:1363
 		{
 			if yyDollar[1].queryexpr == nil {
 				yyVAL.queryexpr = yyDollar[1].queryexpr
@@ -3350,9 +4023,9 @@ yydefault:
 				yyVAL.queryexpr = LimitClause{BaseExpr: yyDollar[1].queryexpr.(OffsetClause).BaseExpr, OffsetClause: yyDollar[1].queryexpr}
 			}
 		}
-	case 199:
+	case 228:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1207
+		//line parser.y:1371
 		{
 			var base *BaseExpr
 			if yyDollar[1].queryexpr == nil {
@@ -3362,335 +4035,359 @@ yydefault:
 			}
 			yyVAL.queryexpr = LimitClause{BaseExpr: base, Type: yyDollar[2].token, Position: yyDollar[3].token, Value: yyDollar[4].queryexpr, Unit: yyDollar[5].token, Restriction: yyDollar[6].token, OffsetClause: yyDollar[1].queryexpr}
 		}
-	case 200:
+	case 229:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1217
+		//line parser.y:1381
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token, Restriction: yyDollar[4].token, OffsetClause: yyDollar[5].queryexpr}
 		}
-	case 201:
+	case 230:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1223
+		//line parser.y:1387
 		{
 			yyVAL.token = Token{}
 		}
-	case 202:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1227
+		//line parser.y:1391
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 203:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1231
+		//line parser.y:1395
 		{
 			tok := yyDollar[2].token
 			tok.Literal = joinWithSpace([]string{yyDollar[1].token.Literal, tok.Literal})
 			yyVAL.token = tok
 		}
-	case 204:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1239
+		//line parser.y:1403
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 205:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1243
+		//line parser.y:1407
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 206:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1249
+		//line parser.y:1413
 		{
 			yyVAL.token = Token{}
 		}
-	case 207:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1253
+		//line parser.y:1417
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 208:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1259
+		//line parser.y:1423
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 209:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1263
+		//line parser.y:1427
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1267
+		//line parser.y:1431
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1273
+		//line parser.y:1437
 		{
 			yyVAL.token = Token{}
 		}
-	case 212:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1277
+		//line parser.y:1441
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1281
+		//line parser.y:1445
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 214:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1287
+		//line parser.y:1451
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 215:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1291
+		//line parser.y:1455
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Offset: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token}
 		}
-	case 216:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1297
+		//line parser.y:1461
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 217:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1301
+		//line parser.y:1465
 		{
 			yyVAL.queryexpr = WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
 		}
-	case 218:
+	case 247:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1307
+		//line parser.y:1471
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, As: yyDollar[3].token.Literal, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 219:
+	case 248:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1311
+		//line parser.y:1475
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, As: yyDollar[6].token.Literal, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 220:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1317
+		//line parser.y:1481
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 221:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1321
+		//line parser.y:1485
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 222:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1327
+		//line parser.y:1491
 		{
-			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = setPosition(NewStringValue(yyDollar[1].token.Literal), yyDollar[1].token)
 		}
-	case 223:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1331
+		//line parser.y:1495
 		{
-			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = setPosition(NewIntegerValueFromString(yyDollar[1].token.Literal), yyDollar[1].token)
 		}
-	case 224:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1335
+		//line parser.y:1499
 		{
-			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = setPosition(NewFloatValueFromString(yyDollar[1].token.Literal), yyDollar[1].token)
 		}
-	case 225:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1339
+		//line parser.y:1503
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 226:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1343
+		//line parser.y:1507
 		{
-			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).GetDatetimeFormats())
+			yyVAL.queryexpr = setPosition(NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).GetDatetimeFormats()), yyDollar[1].token)
 		}
-	case 227:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1347
+		//line parser.y:1511
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 228:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1353
+		//line parser.y:1515
 		{
-			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 229:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1359
+	case 258:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1521
 		{
-			yyVAL.queryexpr = NewNullValueFromString(yyDollar[1].token.Literal)
+			if _, ok := value.StrToInterval(yyDollar[2].token.Literal); !ok {
+				yylex.Error(fmt.Sprintf("invalid interval %s", cmd.QuoteString(yyDollar[2].token.Literal)))
+			}
+			yyVAL.queryexpr = setPosition(NewIntervalValueFromString(yyDollar[2].token.Literal), yyDollar[1].token)
 		}
-	case 230:
+	case 259:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:1528
+		{
+			if _, ok := value.StrToInterval(yyDollar[2].token.Literal); !ok {
+				yylex.Error(fmt.Sprintf("invalid interval %s", cmd.QuoteString(yyDollar[2].token.Literal)))
+			}
+			yyVAL.queryexpr = setPosition(NewIntervalValueFromString(yyDollar[2].token.Literal), yyDollar[1].token)
+		}
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1365
+		//line parser.y:1537
 		{
-			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
+			yyVAL.queryexpr = setPosition(NewTernaryValueFromString(yyDollar[1].token.Literal), yyDollar[1].token)
 		}
-	case 231:
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1543
+		{
+			yyVAL.queryexpr = setPosition(NewNullValueFromString(yyDollar[1].token.Literal), yyDollar[1].token)
+		}
+	case 262:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1549
+		{
+			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
+		}
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1369
+		//line parser.y:1553
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 232:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1373
+		//line parser.y:1557
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 233:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1377
+		//line parser.y:1561
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 234:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1381
+		//line parser.y:1565
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 235:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1387
+		//line parser.y:1571
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 236:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1391
+		//line parser.y:1575
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 237:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1395
+		//line parser.y:1579
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 238:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1401
+		//line parser.y:1585
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 239:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1405
+		//line parser.y:1589
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 240:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1409
+		//line parser.y:1593
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 241:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1413
+		//line parser.y:1597
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1417
+		//line parser.y:1601
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 243:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1421
+		//line parser.y:1605
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 244:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1425
+		//line parser.y:1609
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 245:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1429
+		//line parser.y:1613
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 246:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1433
+		//line parser.y:1617
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 247:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1437
+		//line parser.y:1621
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 248:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1441
+		//line parser.y:1625
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 249:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1445
+		//line parser.y:1629
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 250:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1449
+		//line parser.y:1633
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 251:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1453
+		//line parser.y:1637
 		{
 			yyVAL.queryexpr = yyDollar[1].flag
 		}
-	case 252:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1457
+		//line parser.y:1641
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 253:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1461
+		//line parser.y:1645
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 254:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1465
+		//line parser.y:1649
 		{
 			name := ""
 			if yyDollar[1].token.Literal[0] == ':' {
@@ -3698,117 +4395,117 @@ yydefault:
 			}
 			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal, Name: name}
 		}
-	case 255:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1475
+		//line parser.y:1659
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 256:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1481
+		//line parser.y:1665
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 257:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1485
+		//line parser.y:1669
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 258:
+	case 290:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1489
+		//line parser.y:1673
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 259:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1495
+		//line parser.y:1679
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 260:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1499
+		//line parser.y:1683
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 261:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1505
+		//line parser.y:1689
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 262:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1509
+		//line parser.y:1693
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 263:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1515
+		//line parser.y:1699
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 264:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1519
+		//line parser.y:1703
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, Nulls: yyDollar[3].token.Literal, Position: yyDollar[4].token}
 		}
-	case 265:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1525
+		//line parser.y:1709
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 266:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1529
+		//line parser.y:1713
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 267:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1535
+		//line parser.y:1719
 		{
 			yyVAL.token = Token{}
 		}
-	case 268:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1539
+		//line parser.y:1723
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 269:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1543
+		//line parser.y:1727
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 270:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1549
+		//line parser.y:1733
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 271:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1553
+		//line parser.y:1737
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 272:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1559
+		//line parser.y:1743
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 273:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1565
+		//line parser.y:1749
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3829,1341 +4526,1851 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 274:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1588
+		//line parser.y:1772
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 275:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1592
+		//line parser.y:1776
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 276:
+	case 308:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1596
+		//line parser.y:1780
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 277:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1602
+		//line parser.y:1786
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 278:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1606
+		//line parser.y:1790
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 279:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1610
+		//line parser.y:1794
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 280:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1614
+		//line parser.y:1798
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 281:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1618
+		//line parser.y:1802
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 282:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1622
+		//line parser.y:1806
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 283:
+	case 315:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1626
+		//line parser.y:1810
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 284:
+	case 316:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1630
+		//line parser.y:1814
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 285:
+	case 317:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1634
+		//line parser.y:1818
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 286:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1638
+		//line parser.y:1822
 		{
 			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 287:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1642
+		//line parser.y:1826
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 288:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1646
+		//line parser.y:1830
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 289:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1650
+		//line parser.y:1834
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 290:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1654
+		//line parser.y:1838
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 291:
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:1842
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[2].token), RegExp: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 324:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1846
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[3].token), RegExp: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 325:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1658
+		//line parser.y:1850
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 292:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1662
+		//line parser.y:1854
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 293:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1666
+		//line parser.y:1858
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 294:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1670
+		//line parser.y:1862
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 295:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1674
+		//line parser.y:1866
 		{
 			yyVAL.queryexpr = Exists{Exists: yyDollar[1].token.Literal, Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 296:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1680
+		//line parser.y:1872
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
 		}
-	case 297:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1684
+		//line parser.y:1876
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
 		}
-	case 298:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1688
+		//line parser.y:1880
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
 		}
-	case 299:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1692
+		//line parser.y:1884
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
 		}
-	case 300:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1696
+		//line parser.y:1888
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
 		}
-	case 301:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1700
+		//line parser.y:1892
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 302:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1704
+		//line parser.y:1896
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 303:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1710
+		//line parser.y:1902
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 304:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1714
+		//line parser.y:1906
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 305:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1718
+		//line parser.y:1910
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 306:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1722
+		//line parser.y:1914
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 307:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1728
+		//line parser.y:1920
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 308:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1732
+		//line parser.y:1924
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 309:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1738
+		//line parser.y:1930
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 310:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1742
+		//line parser.y:1934
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 311:
+	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1746
+		//line parser.y:1938
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 312:
+	case 346:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1750
+		//line parser.y:1942
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 313:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1754
+		//line parser.y:1946
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 314:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1761
+	case 348:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:1950
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 315:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1765
+	case 349:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line parser.y:1954
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: FilterClause{Filter: yyDollar[5].token.Literal, Where: yyDollar[7].token.Literal, Condition: yyDollar[8].queryexpr}}
 		}
-	case 316:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1769
+	case 350:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1961
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 317:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1773
+	case 351:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1965
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 318:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1777
+	case 352:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1969
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 319:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1781
+	case 353:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1973
 		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 320:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1787
+	case 354:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1977
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr}
 		}
-	case 321:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1791
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:1981
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 322:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1797
+	case 356:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:1987
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 323:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1801
+	case 357:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:1991
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr, Filter: yyDollar[11].queryexpr}
 		}
-	case 324:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1805
+	case 358:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line parser.y:1997
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = nil
 		}
-	case 325:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1809
+	case 359:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2001
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = FilterClause{Filter: yyDollar[1].token.Literal, Where: yyDollar[3].token.Literal, Condition: yyDollar[4].queryexpr}
 		}
-	case 326:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1813
+	case 360:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:2007
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 327:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1817
+	case 361:
+		yyDollar = yyS[yypt-13 : yypt+1]
+		//line parser.y:2011
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: FilterClause{Filter: yyDollar[5].token.Literal, Where: yyDollar[7].token.Literal, Condition: yyDollar[8].queryexpr}, Over: yyDollar[10].token.Literal, AnalyticClause: yyDollar[12].queryexpr.(AnalyticClause)}
 		}
-	case 328:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1821
+	case 362:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2015
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 329:
+	case 363:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2019
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+		}
+	case 364:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2023
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+		}
+	case 365:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2027
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+		}
+	case 366:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2031
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+		}
+	case 367:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2035
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+		}
+	case 368:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1825
+		//line parser.y:2039
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 330:
+	case 369:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1829
+		//line parser.y:2043
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 331:
+	case 370:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1833
+		//line parser.y:2047
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 332:
+	case 371:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1837
+		//line parser.y:2051
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 333:
+	case 372:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1841
+		//line parser.y:2055
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 334:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1847
+		//line parser.y:2061
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 335:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1853
+		//line parser.y:2067
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 336:
+	case 375:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1857
+		//line parser.y:2071
 		{
 			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 337:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1864
+		//line parser.y:2078
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 338:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1868
+		//line parser.y:2082
 		{
 			yyVAL.queryexpr = PartitionClause{PartitionBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[3].queryexprs}
 		}
-	case 339:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1874
+	case 378:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2088
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[2].queryexpr}
+			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, Unit: yyDollar[1].token.Token, FrameLow: yyDollar[2].queryexpr, Exclusion: yyDollar[3].queryexpr}
 		}
-	case 340:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1878
+	case 379:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2092
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
+			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, Unit: yyDollar[1].token.Token, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, Exclusion: yyDollar[6].queryexpr}
 		}
-	case 341:
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2098
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2102
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2106
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1884
+		//line parser.y:2112
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 342:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1888
+		//line parser.y:2116
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 343:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1893
+		//line parser.y:2121
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2125
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: yyDollar[1].queryexpr, Literal: yyDollar[1].queryexpr.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2129
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 344:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1899
+		//line parser.y:2135
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 345:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1904
+		//line parser.y:2140
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 346:
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2145
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 391:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2149
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: yyDollar[1].queryexpr, Literal: yyDollar[1].queryexpr.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2153
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 393:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2157
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: yyDollar[1].queryexpr, Literal: yyDollar[1].queryexpr.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1909
+		//line parser.y:2161
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 347:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1915
+		//line parser.y:2167
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 348:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1919
+		//line parser.y:2171
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 349:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1925
+		//line parser.y:2177
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 350:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1929
+		//line parser.y:2181
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 351:
+	case 399:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line parser.y:2187
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 400:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2191
+		{
+			yyVAL.queryexpr = WindowExclusion{Type: yyDollar[2].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal}
+		}
+	case 401:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2195
+		{
+			yyVAL.queryexpr = WindowExclusion{Type: yyDollar[2].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 402:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2199
+		{
+			yyVAL.queryexpr = WindowExclusion{Type: yyDollar[2].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1935
+		//line parser.y:2205
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 352:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1939
+		//line parser.y:2209
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token), Stdin: yyDollar[1].token.Literal}
 		}
-	case 353:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1945
+		//line parser.y:2215
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 354:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1949
+		//line parser.y:2219
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 355:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1953
+		//line parser.y:2223
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 356:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1957
+		//line parser.y:2227
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 357:
+	case 409:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2231
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2235
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 411:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2239
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1963
+		//line parser.y:2245
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].queryexpr, Args: nil}
 		}
-	case 358:
+	case 413:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1967
+		//line parser.y:2249
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].queryexpr, Args: yyDollar[5].queryexprs}
 		}
-	case 359:
+	case 414:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1971
+		//line parser.y:2253
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 360:
+	case 415:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1975
+		//line parser.y:2257
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 361:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1981
+		//line parser.y:2263
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 362:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1985
+		//line parser.y:2267
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 363:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1991
+		//line parser.y:2273
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 364:
+	case 419:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1995
+		//line parser.y:2277
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 365:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1999
+		//line parser.y:2281
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 366:
+	case 421:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:2285
+		{
+			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: FieldReference{BaseExpr: yyDollar[5].identifier.BaseExpr, View: yyDollar[5].identifier, Column: yyDollar[7].identifier}}
+		}
+	case 422:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2289
+		{
+			yyVAL.queryexpr = TableFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2293
+		{
+			yyVAL.queryexpr = SchemaTable{BaseExpr: yyDollar[1].identifier.BaseExpr, Schema: yyDollar[1].identifier, Table: yyDollar[3].identifier}
+		}
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2003
+		//line parser.y:2297
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 367:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2009
+		//line parser.y:2303
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 368:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2013
+		//line parser.y:2307
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 369:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2017
+		//line parser.y:2311
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 370:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2021
+		//line parser.y:2315
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 371:
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2319
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 430:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2323
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2327
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2025
+		//line parser.y:2331
 		{
 			yyVAL.queryexpr = Table{Object: Dual{Dual: yyDollar[1].token.Literal}}
 		}
-	case 372:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2029
+		//line parser.y:2335
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 373:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2035
+		//line parser.y:2341
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 374:
+	case 435:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2039
+		//line parser.y:2345
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 375:
+	case 436:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2043
+		//line parser.y:2349
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 376:
+	case 437:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2047
+		//line parser.y:2353
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: JoinCondition{Literal: yyDollar[6].token.Literal, On: yyDollar[7].queryexpr}}
 		}
-	case 377:
+	case 438:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2051
+		//line parser.y:2357
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 378:
+	case 439:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2055
+		//line parser.y:2361
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 379:
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2365
+		{
+			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[2].token, Lateral: yyDollar[4].token, Condition: nil}
+		}
+	case 441:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line parser.y:2369
+		{
+			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[2].token, Lateral: yyDollar[4].token, Condition: yyDollar[6].queryexpr}
+		}
+	case 442:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		//line parser.y:2373
+		{
+			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Lateral: yyDollar[5].token, Condition: yyDollar[7].queryexpr}
+		}
+	case 443:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2377
+		{
+			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
+		}
+	case 444:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2381
+		{
+			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
+		}
+	case 445:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:2387
+		{
+			yyVAL.queryexpr = PivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Pivot: yyDollar[2].token.Literal, Table: yyDollar[1].queryexpr, Aggregate: yyDollar[4].queryexpr, For: yyDollar[5].token.Literal, Column: yyDollar[6].queryexpr, In: yyDollar[7].token.Literal, Values: yyDollar[9].queryexprs}
+		}
+	case 446:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:2391
+		{
+			yyVAL.queryexpr = PivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Pivot: yyDollar[2].token.Literal, Table: yyDollar[1].queryexpr, Aggregate: yyDollar[4].queryexpr, For: yyDollar[5].token.Literal, Column: yyDollar[6].queryexpr, In: yyDollar[7].token.Literal, Any: yyDollar[9].token.Literal}
+		}
+	case 447:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:2395
+		{
+			yyVAL.queryexpr = UnpivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Unpivot: yyDollar[2].token.Literal, Table: yyDollar[1].queryexpr, Value: yyDollar[4].identifier, For: yyDollar[5].token.Literal, Name: yyDollar[6].identifier, In: yyDollar[7].token.Literal, Columns: yyDollar[9].queryexprs}
+		}
+	case 448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2401
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 449:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2405
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2411
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
+		}
+	case 451:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2415
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2421
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 453:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2425
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 454:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2431
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
+		}
+	case 455:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2435
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2061
+		//line parser.y:2441
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, On: yyDollar[2].queryexpr}
 		}
-	case 380:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2065
+		//line parser.y:2445
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, Using: yyDollar[3].queryexprs}
 		}
-	case 381:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2071
+		//line parser.y:2451
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 382:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2075
+		//line parser.y:2455
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 383:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2081
+		//line parser.y:2461
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 384:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2085
+		//line parser.y:2465
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 385:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2089
+		//line parser.y:2469
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 386:
+	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2095
+		//line parser.y:2475
 		{
 			yyVAL.queryexpr = CaseExpr{Case: yyDollar[1].token.Literal, End: yyDollar[5].token.Literal, Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 387:
+	case 464:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2101
+		//line parser.y:2481
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 388:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2105
+		//line parser.y:2485
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 389:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2111
+		//line parser.y:2491
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 390:
+	case 467:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2115
+		//line parser.y:2495
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 391:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2121
+		//line parser.y:2501
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 392:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2125
+		//line parser.y:2505
 		{
 			yyVAL.queryexpr = CaseExprElse{Else: yyDollar[1].token.Literal, Result: yyDollar[2].queryexpr}
 		}
-	case 393:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2131
+		//line parser.y:2511
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 394:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2135
+		//line parser.y:2515
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 395:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2141
+		//line parser.y:2521
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 396:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2145
+		//line parser.y:2525
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 397:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2151
+		//line parser.y:2531
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 398:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2155
+		//line parser.y:2535
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 399:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2161
+		//line parser.y:2541
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 400:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2165
+		//line parser.y:2545
 		{
-			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+			yyVAL.queryexprs = append(yyDollar[1].queryexprs, yyDollar[3].queryexpr)
 		}
-	case 401:
+	case 478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2549
+		{
+			yyVAL.queryexprs = []QueryExpression{Table{Object: Join{Join: ",", Table: crossJoinTables(yyDollar[1].queryexprs), JoinTable: yyDollar[4].queryexpr, JoinType: Token{Token: CROSS}, Lateral: yyDollar[3].token}}}
+		}
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2171
+		//line parser.y:2555
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 402:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2175
+		//line parser.y:2559
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 403:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2181
+		//line parser.y:2565
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 404:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2185
+		//line parser.y:2569
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 405:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2191
+		//line parser.y:2575
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 406:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2195
+		//line parser.y:2579
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 407:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2201
+		//line parser.y:2585
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 408:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2205
+		//line parser.y:2589
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 409:
+	case 487:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2211
+		//line parser.y:2595
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 410:
+	case 488:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2215
+		//line parser.y:2599
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 411:
+	case 489:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2219
+		//line parser.y:2603
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 412:
+	case 490:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2223
+		//line parser.y:2607
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 413:
+	case 491:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2229
+		//line parser.y:2613
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 414:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2235
+		//line parser.y:2619
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 415:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2241
+		//line parser.y:2625
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 416:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2245
+		//line parser.y:2629
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 417:
+	case 495:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:2251
+		//line parser.y:2635
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
 		}
-	case 418:
+	case 496:
 		yyDollar = yyS[yypt-13 : yypt+1]
-		//line parser.y:2255
+		//line parser.y:2639
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
 		}
-	case 419:
+	case 497:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2259
+		//line parser.y:2643
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
 		}
-	case 420:
+	case 498:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2263
+		//line parser.y:2647
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
 		}
-	case 421:
+	case 499:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2267
+		//line parser.y:2651
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 422:
+	case 500:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2271
+		//line parser.y:2655
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
 		}
-	case 423:
+	case 501:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2275
+		//line parser.y:2659
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 424:
+	case 502:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:2279
+		//line parser.y:2663
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
 		}
-	case 425:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2285
+		//line parser.y:2669
 		{
 			from := FromClause{From: yyDollar[3].token.Literal, Tables: yyDollar[4].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: from, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 426:
+	case 504:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2290
+		//line parser.y:2674
 		{
 			from := FromClause{From: yyDollar[4].token.Literal, Tables: yyDollar[5].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: from, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 427:
+	case 505:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line parser.y:2681
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Source: yyDollar[6].queryexpr, Condition: yyDollar[8].queryexpr, WhenClauses: yyDollar[9].mergewhens}
+		}
+	case 506:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2685
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr, Alias: yyDollar[5].identifier}, Source: yyDollar[7].queryexpr, Condition: yyDollar[9].queryexpr, WhenClauses: yyDollar[10].mergewhens}
+		}
+	case 507:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:2689
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr, As: yyDollar[5].token.Literal, Alias: yyDollar[6].identifier}, Source: yyDollar[8].queryexpr, Condition: yyDollar[10].queryexpr, WhenClauses: yyDollar[11].mergewhens}
+		}
+	case 508:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		//line parser.y:2695
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token, SetList: yyDollar[7].updatesets}
+		}
+	case 509:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2699
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token}
+		}
+	case 510:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line parser.y:2703
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[4].queryexpr, Operation: yyDollar[6].token, Fields: yyDollar[7].queryexprs, Values: yyDollar[9].queryexpr}
+		}
+	case 511:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line parser.y:2707
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[6].queryexpr, Operation: yyDollar[8].token, Fields: yyDollar[9].queryexprs, Values: yyDollar[11].queryexpr}
+		}
+	case 512:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		//line parser.y:2711
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), BySource: true, Condition: yyDollar[6].queryexpr, Operation: yyDollar[8].token, SetList: yyDollar[10].updatesets}
+		}
+	case 513:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line parser.y:2715
+		{
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), BySource: true, Condition: yyDollar[6].queryexpr, Operation: yyDollar[8].token}
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2721
+		{
+			yyVAL.mergewhens = []MergeWhenClause{yyDollar[1].mergewhen}
+		}
+	case 515:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2725
+		{
+			yyVAL.mergewhens = append([]MergeWhenClause{yyDollar[1].mergewhen}, yyDollar[2].mergewhens...)
+		}
+	case 516:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line parser.y:2731
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 517:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line parser.y:2735
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 518:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line parser.y:2741
+		{
+			yyVAL.queryexprs = nil
+		}
+	case 519:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2745
+		{
+			yyVAL.queryexprs = yyDollar[2].queryexprs
+		}
+	case 520:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2297
+		//line parser.y:2751
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 428:
+	case 521:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2301
+		//line parser.y:2755
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 429:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2307
+		//line parser.y:2761
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 430:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2311
+		//line parser.y:2765
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 431:
+	case 524:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2317
+		//line parser.y:2771
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 432:
+	case 525:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2321
+		//line parser.y:2775
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 433:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2327
+		//line parser.y:2781
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 434:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2331
+		//line parser.y:2785
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 435:
+	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2337
+		//line parser.y:2791
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 436:
+	case 529:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2341
+		//line parser.y:2795
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 437:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2347
+		//line parser.y:2801
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 438:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2351
+		//line parser.y:2805
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 439:
+	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2357
+		//line parser.y:2811
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 440:
+	case 533:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2361
+		//line parser.y:2815
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 441:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2367
+		//line parser.y:2821
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 442:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2371
+		//line parser.y:2825
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 443:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2377
+		//line parser.y:2831
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 444:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2381
+		//line parser.y:2835
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 445:
+	case 538:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2387
+		//line parser.y:2841
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 446:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2391
+		//line parser.y:2845
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 447:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2397
+		//line parser.y:2851
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 448:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2401
+		//line parser.y:2855
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 449:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2407
+		//line parser.y:2861
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 450:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2411
+		//line parser.y:2865
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 451:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2417
+		//line parser.y:2871
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 452:
+	case 545:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2421
+		//line parser.y:2875
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 453:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2427
+		//line parser.y:2881
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 454:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2431
+		//line parser.y:2885
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 455:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2437
+		//line parser.y:2891
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 456:
+	case 549:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2441
+		//line parser.y:2895
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 457:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2447
+		//line parser.y:2901
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 458:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2451
+		//line parser.y:2905
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 459:
+	case 552:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2911
+		{
+			yyVAL.exhandlers = []ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}
+		}
+	case 553:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2915
+		{
+			yyVAL.exhandlers = append([]ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}, yyDollar[5].exhandlers...)
+		}
+	case 554:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2921
+		{
+			yyVAL.exhandlers = []ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}
+		}
+	case 555:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2925
+		{
+			yyVAL.exhandlers = append([]ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}, yyDollar[5].exhandlers...)
+		}
+	case 556:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2931
+		{
+			yyVAL.exhandlers = []ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}
+		}
+	case 557:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2935
+		{
+			yyVAL.exhandlers = append([]ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}, yyDollar[5].exhandlers...)
+		}
+	case 558:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line parser.y:2941
+		{
+			yyVAL.exhandlers = []ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}
+		}
+	case 559:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line parser.y:2945
+		{
+			yyVAL.exhandlers = append([]ExceptionHandler{{Codes: yyDollar[2].primaries, Statements: yyDollar[4].program}}, yyDollar[5].exhandlers...)
+		}
+	case 560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2951
+		{
+			yyVAL.primaries = nil
+		}
+	case 561:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2955
+		{
+			yyVAL.primaries = yyDollar[1].primaries
+		}
+	case 562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:2961
+		{
+			yyVAL.primaries = []value.Primary{value.NewIntegerFromString(yyDollar[1].token.Literal)}
+		}
+	case 563:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line parser.y:2965
+		{
+			yyVAL.primaries = append([]value.Primary{value.NewIntegerFromString(yyDollar[1].token.Literal)}, yyDollar[3].primaries...)
+		}
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2457
+		//line parser.y:2971
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 460:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2461
+		//line parser.y:2975
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 461:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2465
+		//line parser.y:2979
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 462:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2469
+		//line parser.y:2983
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 463:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2473
+		//line parser.y:2987
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 464:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2477
+		//line parser.y:2991
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 465:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2481
+		//line parser.y:2995
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 466:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2485
+		//line parser.y:2999
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 467:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2491
+		//line parser.y:3003
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3007
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3011
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3015
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3019
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3023
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3027
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3031
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3035
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3039
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3043
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3047
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line parser.y:3053
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 468:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2497
+		//line parser.y:3059
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 469:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2501
+		//line parser.y:3063
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 470:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2507
+		//line parser.y:3069
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 471:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2513
+		//line parser.y:3075
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 472:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2517
+		//line parser.y:3079
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 473:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2523
+		//line parser.y:3085
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 474:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2527
+		//line parser.y:3089
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 475:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2533
+		//line parser.y:3095
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 476:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2539
+		//line parser.y:3101
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 477:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2545
+		//line parser.y:3107
 		{
 			yyVAL.flag = Flag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 478:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2551
+		//line parser.y:3113
 		{
 			yyVAL.token = Token{}
 		}
-	case 479:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2555
+		//line parser.y:3117
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 480:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2561
+		//line parser.y:3123
 		{
 			yyVAL.token = Token{}
 		}
-	case 481:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2565
+		//line parser.y:3127
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 482:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2571
+		//line parser.y:3133
 		{
 			yyVAL.token = Token{}
 		}
-	case 483:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2575
+		//line parser.y:3137
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 484:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2581
+		//line parser.y:3143
 		{
 			yyVAL.token = Token{}
 		}
-	case 485:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2585
+		//line parser.y:3147
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 486:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2591
+		//line parser.y:3153
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 487:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2595
+		//line parser.y:3157
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 488:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2601
+		//line parser.y:3163
 		{
 			yyVAL.token = Token{}
 		}
-	case 489:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2605
+		//line parser.y:3167
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 490:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2611
+		//line parser.y:3173
 		{
 			yyVAL.token = Token{}
 		}
-	case 491:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2615
+		//line parser.y:3177
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 492:
+	case 609:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2621
+		//line parser.y:3183
 		{
 			yyVAL.token = Token{}
 		}
-	case 493:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2625
+		//line parser.y:3187
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 494:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2631
+		//line parser.y:3193
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 495:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2635
+		//line parser.y:3197
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
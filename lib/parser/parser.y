@@ -2,8 +2,10 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 %}
@@ -33,6 +35,10 @@ import (
     fetchpos    FetchPosition
     replaceval  ReplaceValue
     replacevals []ReplaceValue
+    mergewhen   MergeWhenClause
+    mergewhens  []MergeWhenClause
+    exhandlers  []ExceptionHandler
+    primaries   []value.Primary
     token       Token
 }
 
@@ -59,6 +65,8 @@ import (
 %type<statement>   environment_variable_statement
 %type<statement>   transaction_statement
 %type<statement>   table_operation_statement
+%type<queryexpr>   column_definition
+%type<queryexprs>  column_definitions
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
 %type<expression>  column_position
@@ -86,6 +94,10 @@ import (
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
+%type<queryexprs>  grouping_elements
+%type<queryexpr>   grouping_element
+%type<queryexprs>  grouping_set_values
+%type<queryexpr>   grouping_set_value
 %type<queryexpr>   having_clause
 %type<queryexpr>   order_by_clause
 %type<queryexpr>   limit_clause
@@ -99,6 +111,7 @@ import (
 %type<queryexpr>   inline_table
 %type<queryexprs>  inline_tables
 %type<queryexpr>   primitive_type
+%type<queryexpr>   interval
 %type<queryexpr>   ternary
 %type<queryexpr>   null
 %type<queryexpr>   field_reference
@@ -122,6 +135,7 @@ import (
 %type<queryexpr>   function
 %type<queryexpr>   aggregate_function
 %type<queryexpr>   list_function
+%type<queryexpr>   aggregate_filter
 %type<queryexpr>   analytic_function
 %type<queryexpr>   analytic_clause
 %type<queryexpr>   analytic_clause_with_windowing
@@ -131,6 +145,7 @@ import (
 %type<queryexpr>   window_relative_position
 %type<queryexpr>   window_frame_low
 %type<queryexpr>   window_frame_high
+%type<queryexpr>   window_exclusion
 %type<queryexpr>   table_identifier
 %type<identifier>  table_object_identifier
 %type<queryexpr>   table_object
@@ -140,6 +155,11 @@ import (
 %type<queryexpr>   virtual_table_object
 %type<queryexpr>   table
 %type<queryexpr>   join
+%type<queryexpr>   pivot
+%type<queryexprs>  pivot_values
+%type<queryexpr>   pivot_value
+%type<queryexprs>  unpivot_columns
+%type<queryexpr>   unpivot_column
 %type<queryexpr>   join_condition
 %type<queryexpr>   field_object
 %type<queryexpr>   field
@@ -159,6 +179,11 @@ import (
 %type<updatesets>  update_set_list
 %type<expression>  replace_query
 %type<expression>  delete_query
+%type<expression>  merge_query
+%type<mergewhen>   merge_when_clause
+%type<mergewhens>  merge_when_clauses
+%type<queryexpr>   merge_when_condition
+%type<queryexprs>  merge_insert_fields
 %type<elseif>      elseif
 %type<elseexpr>    else
 %type<elseif>      in_loop_elseif
@@ -175,6 +200,12 @@ import (
 %type<caseelse>    in_function_case_else
 %type<casewhen>    in_function_in_loop_case_when
 %type<caseelse>    in_function_in_loop_case_else
+%type<exhandlers>  exception_handlers
+%type<exhandlers>  in_loop_exception_handlers
+%type<exhandlers>  in_function_exception_handlers
+%type<exhandlers>  in_function_in_loop_exception_handlers
+%type<primaries>   exception_codes
+%type<primaries>   exception_code_list
 %type<identifier>  identifier
 %type<variable>    variable
 %type<variables>   variables
@@ -193,32 +224,37 @@ import (
 %type<token>       recursive
 %type<token>       as
 %type<token>       comparison_operator
+%type<token>       window_frame_unit
 
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
 %token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES REPLACE AS DUAL STDIN
 %token<token> RECURSIVE
-%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW INDEX
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
-%token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> AND OR NOT BETWEEN LIKE REGEXP IS NULL
 %token<token> DISTINCT WITH
-%token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
+%token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW INTERVAL
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE PREPARE
 %token<token> NEXT PRIOR ABSOLUTE RELATIVE
 %token<token> SEPARATOR PARTITION OVER
-%token<token> COMMIT ROLLBACK
+%token<token> COMMIT ROLLBACK SAVEPOINT RELEASE
 %token<token> CONTINUE BREAK EXIT
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
-%token<token> FUNCTION AGGREGATE BEGIN RETURN
-%token<token> IGNORE WITHIN
+%token<token> FUNCTION AGGREGATE BEGIN RETURN EXCEPTION
+%token<token> IGNORE WITHIN FILTER
 %token<token> VAR SHOW
-%token<token> TIES NULLS ROWS ONLY
-%token<token> CSV JSON FIXED LTSV
-%token<token> JSON_ROW JSON_TABLE
+%token<token> EXPLAIN ANALYZE
+%token<token> MERGE MATCHED TARGET
+%token<token> PIVOT UNPIVOT LATERAL APPLY
+%token<token> TIES NULLS ROWS GROUPS EXCLUDE ONLY
+%token<token> ROLLUP CUBE GROUPING SETS
+%token<token> CSV JSON JSONL FIXED LTSV XLSX PARQUET
+%token<token> JSON_ROW JSON_TABLE STRING_SPLIT
 %token<token> COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
@@ -228,11 +264,11 @@ import (
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
-%left CROSS FULL NATURAL JOIN
+%left CROSS FULL NATURAL JOIN APPLY PIVOT UNPIVOT
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE REGEXP
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
@@ -312,6 +348,10 @@ common_statement
     {
         $$ = $1
     }
+    | merge_query
+    {
+        $$ = $1
+    }
     | table_operation_statement
     {
         $$ = $1
@@ -446,6 +486,10 @@ flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | BEGIN program EXCEPTION exception_handlers END
+    {
+        $$ = ExceptionBlock{Statements: $2, Handlers: $4}
+    }
     | while_statement
     {
         $$ = $1
@@ -468,6 +512,10 @@ loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | BEGIN loop_program EXCEPTION in_loop_exception_handlers END
+    {
+        $$ = ExceptionBlock{Statements: $2, Handlers: $4}
+    }
     | while_statement
     {
         $$ = $1
@@ -546,6 +594,10 @@ function_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | BEGIN function_program EXCEPTION in_function_exception_handlers END
+    {
+        $$ = ExceptionBlock{Statements: $2, Handlers: $4}
+    }
     | function_while_statement
     {
         $$ = $1
@@ -568,6 +620,10 @@ function_loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | BEGIN function_loop_program EXCEPTION in_function_in_loop_exception_handlers END
+    {
+        $$ = ExceptionBlock{Statements: $2, Handlers: $4}
+    }
     | function_while_statement
     {
         $$ = $1
@@ -630,13 +686,25 @@ transaction_statement
     {
         $$ = TransactionControl{BaseExpr: NewBaseExpr($1), Token: $1.Token}
     }
+    | SAVEPOINT identifier
+    {
+        $$ = Savepoint{BaseExpr: NewBaseExpr($1), Name: $2}
+    }
+    | ROLLBACK TO SAVEPOINT identifier
+    {
+        $$ = RollbackToSavepoint{BaseExpr: NewBaseExpr($1), Name: $4}
+    }
+    | RELEASE SAVEPOINT identifier
+    {
+        $$ = ReleaseSavepoint{BaseExpr: NewBaseExpr($1), Name: $3}
+    }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' column_definitions ')'
     {
         $$ = CreateTable{Table: $3, Fields: $5}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' column_definitions ')' as select_query
     {
         $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
     }
@@ -644,6 +712,14 @@ table_operation_statement
     {
         $$ = CreateTable{Table: $3, Query: $5}
     }
+    | CREATE INDEX identifier ON identifier '(' identifier ')'
+    {
+        $$ = CreateIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5, Column: $7}
+    }
+    | DROP INDEX identifier ON identifier
+    {
+        $$ = DropIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5}
+    }
     | ALTER TABLE updatable_table_identifier ADD column_default column_position
     {
         $$ = AddColumns{Table: $3, Columns: []ColumnDefault{$5}, Position: $6}
@@ -673,6 +749,34 @@ table_operation_statement
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
 
+column_definition
+    : identifier
+    {
+        $$ = $1
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+    | identifier identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2, NotNull: true}
+    }
+    | identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, NotNull: true}
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_definition ',' column_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 column_default
     : identifier
     {
@@ -1010,6 +1114,14 @@ command_statement
     {
         $$ = Reload{BaseExpr: NewBaseExpr($1), Type: $2}
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: true, Query: $3.(SelectQuery)}
+    }
 
 trigger_statement
     : TRIGGER identifier
@@ -1169,11 +1281,63 @@ group_by_clause
     {
         $$ = nil
     }
-    | GROUP BY values
+    | GROUP BY grouping_elements
     {
         $$ = GroupByClause{GroupBy: $1.Literal + " " + $2.Literal, Items: $3}
     }
 
+grouping_elements
+    : grouping_element
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_element ',' grouping_elements
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+grouping_element
+    : value
+    {
+        $$ = $1
+    }
+    | ROLLUP '(' grouping_set_values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1.Token, Literal: $1.Literal, Values: $3}
+    }
+    | CUBE '(' grouping_set_values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1.Token, Literal: $1.Literal, Values: $3}
+    }
+    | GROUPING SETS '(' grouping_set_values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1.Token, Literal: $1.Literal + " " + $2.Literal, Values: $4}
+    }
+
+grouping_set_values
+    : grouping_set_value
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_set_value ',' grouping_set_values
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+grouping_set_value
+    : value
+    {
+        $$ = $1
+    }
+    | '(' ')'
+    {
+        $$ = ValueList{BaseExpr: NewBaseExpr($1)}
+    }
+    | '(' value ',' values ')'
+    {
+        $$ = ValueList{BaseExpr: NewBaseExpr($1), Values: append([]QueryExpression{$2}, $4...)}
+    }
+
 having_clause
     :
     {
@@ -1325,15 +1489,15 @@ inline_tables
 primitive_type
     : STRING
     {
-        $$ = NewStringValue($1.Literal)
+        $$ = setPosition(NewStringValue($1.Literal), $1)
     }
     | INTEGER
     {
-        $$ = NewIntegerValueFromString($1.Literal)
+        $$ = setPosition(NewIntegerValueFromString($1.Literal), $1)
     }
     | FLOAT
     {
-        $$ = NewFloatValueFromString($1.Literal)
+        $$ = setPosition(NewFloatValueFromString($1.Literal), $1)
     }
     | ternary
     {
@@ -1341,23 +1505,43 @@ primitive_type
     }
     | DATETIME
     {
-        $$ = NewDatetimeValueFromString($1.Literal, yylex.(*Lexer).GetDatetimeFormats())
+        $$ = setPosition(NewDatetimeValueFromString($1.Literal, yylex.(*Lexer).GetDatetimeFormats()), $1)
     }
     | null
     {
         $$ = $1
     }
+    | interval
+    {
+        $$ = $1
+    }
+
+interval
+    : INTERVAL STRING
+    {
+        if _, ok := value.StrToInterval($2.Literal); !ok {
+            yylex.Error(fmt.Sprintf("invalid interval %s", cmd.QuoteString($2.Literal)))
+        }
+        $$ = setPosition(NewIntervalValueFromString($2.Literal), $1)
+    }
+    | INTERVAL DATETIME
+    {
+        if _, ok := value.StrToInterval($2.Literal); !ok {
+            yylex.Error(fmt.Sprintf("invalid interval %s", cmd.QuoteString($2.Literal)))
+        }
+        $$ = setPosition(NewIntervalValueFromString($2.Literal), $1)
+    }
 
 ternary
     : TERNARY
     {
-        $$ = NewTernaryValueFromString($1.Literal)
+        $$ = setPosition(NewTernaryValueFromString($1.Literal), $1)
     }
 
 null
     : NULL
     {
-        $$ = NewNullValueFromString($1.Literal)
+        $$ = setPosition(NewNullValueFromString($1.Literal), $1)
     }
 
 field_reference
@@ -1654,6 +1838,14 @@ comparison
     {
         $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value REGEXP value
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($2), RegExp: $2.Literal, LHS: $1, Pattern: $3}
+    }
+    | value NOT REGEXP value
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($3), RegExp: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{Any: $3.Literal, LHS: $1, Operator: $2.Literal, Values: $4}
@@ -1754,28 +1946,36 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | GROUPING '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | identifier '(' arguments ')' FILTER '(' WHERE value ')'
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: FilterClause{Filter: $5.Literal, Where: $7.Literal, Condition: $8}}
+    }
 
 
 aggregate_function
-    : identifier '(' distinct arguments ')'
+    : identifier '(' distinct arguments ')' aggregate_filter
     {
-        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' aggregate_filter
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | VAR '(' distinct arguments ')'
+    | VAR '(' distinct arguments ')' aggregate_filter
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | COUNT '(' distinct arguments ')'
+    | COUNT '(' distinct arguments ')' aggregate_filter
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | COUNT '(' distinct wildcard ')'
+    | COUNT '(' distinct wildcard ')' aggregate_filter
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6}
     }
     | list_function
     {
@@ -1783,13 +1983,23 @@ aggregate_function
     }
 
 list_function
-    : LIST_FUNCTION '(' distinct arguments ')'
+    : LIST_FUNCTION '(' distinct arguments ')' aggregate_filter
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' aggregate_filter
     {
-        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9, Filter: $11}
+    }
+
+aggregate_filter
+    :
+    {
+        $$ = nil
     }
-    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    | FILTER '(' WHERE value ')'
     {
-        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9}
+        $$ = FilterClause{Filter: $1.Literal, Where: $3.Literal, Condition: $4}
     }
 
 analytic_function
@@ -1797,29 +2007,33 @@ analytic_function
     {
         $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | identifier '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | identifier '(' arguments ')' FILTER '(' WHERE value ')' OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: FilterClause{Filter: $5.Literal, Where: $7.Literal, Condition: $8}, Over: $10.Literal, AnalyticClause: $12.(AnalyticClause)}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | identifier '(' distinct arguments ')' aggregate_filter OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
     }
-    | VAR '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' aggregate_filter OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
     }
-    | COUNT '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | VAR '(' distinct arguments ')' aggregate_filter OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
     }
-    | COUNT '(' distinct wildcard ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct arguments ')' aggregate_filter OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
     }
-    | LIST_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause ')'
+    | COUNT '(' distinct wildcard ')' aggregate_filter OVER '(' analytic_clause_with_windowing ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' aggregate_filter OVER '(' analytic_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
     }
     | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
     {
@@ -1870,13 +2084,27 @@ partition_clause
     }
 
 windowing_clause
-    : ROWS window_position
+    : window_frame_unit window_position window_exclusion
+    {
+        $$ = WindowingClause{Rows: $1.Literal, Unit: $1.Token, FrameLow: $2, Exclusion: $3}
+    }
+    | window_frame_unit BETWEEN window_frame_low AND window_frame_high window_exclusion
+    {
+        $$ = WindowingClause{Rows: $1.Literal, Unit: $1.Token, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal, Exclusion: $6}
+    }
+
+window_frame_unit
+    : ROWS
+    {
+        $$ = $1
+    }
+    | RANGE
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $2}
+        $$ = $1
     }
-    | ROWS BETWEEN window_frame_low AND window_frame_high
+    | GROUPS
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal}
+        $$ = $1
     }
 
 window_position
@@ -1889,6 +2117,14 @@ window_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
     }
+    | FLOAT PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | interval PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: $1, Literal: $1.String() + " " + $2.Literal}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1.Token, Literal: $1.Literal + " " + $2.Literal}
@@ -1905,6 +2141,22 @@ window_relative_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
     }
+    | FLOAT PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | interval PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: $1, Literal: $1.String() + " " + $2.Literal}
+    }
+    | FLOAT FOLLOWING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | interval FOLLOWING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: $1, Literal: $1.String() + " " + $2.Literal}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1.Token, Literal: $1.Literal + " " + $2.Literal}
@@ -1930,6 +2182,24 @@ window_frame_high
         $$ = $1
     }
 
+window_exclusion
+    :
+    {
+        $$ = nil
+    }
+    | EXCLUDE CURRENT ROW
+    {
+        $$ = WindowExclusion{Type: $2.Token, Literal: $1.Literal + " " + $2.Literal + " " + $3.Literal}
+    }
+    | EXCLUDE GROUP
+    {
+        $$ = WindowExclusion{Type: $2.Token, Literal: $1.Literal + " " + $2.Literal}
+    }
+    | EXCLUDE TIES
+    {
+        $$ = WindowExclusion{Type: $2.Token, Literal: $1.Literal + " " + $2.Literal}
+    }
+
 table_identifier
     : identifier
     {
@@ -1949,6 +2219,10 @@ table_object_identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | JSONL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | FIXED
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -1957,6 +2231,14 @@ table_object_identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | XLSX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PARQUET
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 table_object
     : table_object_identifier '(' table_identifier ')'
@@ -1999,6 +2281,18 @@ virtual_table_object
     {
         $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1.Literal, Query: $3, JsonText: $5}
     }
+    | JSON_TABLE '(' substantial_value ',' identifier '.' identifier ')'
+    {
+        $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1.Literal, Query: $3, JsonText: FieldReference{BaseExpr: $5.BaseExpr, View: $5, Column: $7}}
+    }
+    | STRING_SPLIT '(' arguments ')'
+    {
+        $$ = TableFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | identifier '.' identifier
+    {
+        $$ = SchemaTable{BaseExpr: $1.BaseExpr, Schema: $1, Table: $3}
+    }
     | subquery
     {
         $$ = $1
@@ -2021,6 +2315,18 @@ table
     {
         $$ = Table{Object: $1}
     }
+    | pivot
+    {
+        $$ = Table{Object: $1}
+    }
+    | pivot identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | pivot AS identifier
+    {
+        $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
+    }
     | DUAL
     {
         $$ = Table{Object: Dual{Dual: $1.Literal}}
@@ -2055,6 +2361,80 @@ join
     {
         $$ = Join{Join: $5.Literal, Table: $1, JoinTable: $6, JoinType: $4, Direction: $3, Natural: $2}
     }
+    | table CROSS JOIN LATERAL table %prec JOIN
+    {
+        $$ = Join{Join: $3.Literal, Table: $1, JoinTable: $5, JoinType: $2, Lateral: $4, Condition: nil}
+    }
+    | table join_type_inner JOIN LATERAL table join_condition %prec JOIN
+    {
+        $$ = Join{Join: $3.Literal, Table: $1, JoinTable: $5, JoinType: $2, Lateral: $4, Condition: $6}
+    }
+    | table join_outer_direction join_type_outer JOIN LATERAL table join_condition %prec JOIN
+    {
+        $$ = Join{Join: $4.Literal, Table: $1, JoinTable: $6, JoinType: $3, Direction: $2, Lateral: $5, Condition: $7}
+    }
+    | table CROSS APPLY table
+    {
+        $$ = Join{Join: $3.Literal, Table: $1, JoinTable: $4, JoinType: $2, Condition: nil}
+    }
+    | table OUTER APPLY table
+    {
+        $$ = Join{Join: $3.Literal, Table: $1, JoinTable: $4, JoinType: $2, Condition: nil}
+    }
+
+pivot
+    : table PIVOT '(' aggregate_function FOR field_reference IN '(' pivot_values ')' ')'
+    {
+        $$ = PivotTable{BaseExpr: NewBaseExpr($2), Pivot: $2.Literal, Table: $1, Aggregate: $4, For: $5.Literal, Column: $6, In: $7.Literal, Values: $9}
+    }
+    | table PIVOT '(' aggregate_function FOR field_reference IN '(' ANY ')' ')'
+    {
+        $$ = PivotTable{BaseExpr: NewBaseExpr($2), Pivot: $2.Literal, Table: $1, Aggregate: $4, For: $5.Literal, Column: $6, In: $7.Literal, Any: $9.Literal}
+    }
+    | table UNPIVOT '(' identifier FOR identifier IN '(' unpivot_columns ')' ')'
+    {
+        $$ = UnpivotTable{BaseExpr: NewBaseExpr($2), Unpivot: $2.Literal, Table: $1, Value: $4, For: $5.Literal, Name: $6, In: $7.Literal, Columns: $9}
+    }
+
+pivot_values
+    : pivot_value
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | pivot_value ',' pivot_values
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+pivot_value
+    : value
+    {
+        $$ = Field{Object: $1}
+    }
+    | value AS identifier
+    {
+        $$ = Field{Object: $1, As: $2.Literal, Alias: $3}
+    }
+
+unpivot_columns
+    : unpivot_column
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | unpivot_column ',' unpivot_columns
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+unpivot_column
+    : field_reference
+    {
+        $$ = Field{Object: $1}
+    }
+    | field_reference AS identifier
+    {
+        $$ = Field{Object: $1, As: $2.Literal, Alias: $3}
+    }
 
 join_condition
     : ON value
@@ -2161,9 +2541,13 @@ tables
     {
         $$ = []QueryExpression{$1}
     }
-    | table ',' tables
+    | tables ',' table
     {
-        $$ = append([]QueryExpression{$1}, $3...)
+        $$ = append($1, $3)
+    }
+    | tables ',' LATERAL table
+    {
+        $$ = []QueryExpression{Table{Object: Join{Join: ",", Table: crossJoinTables($1), JoinTable: $4, JoinType: Token{Token: CROSS}, Lateral: $3}}}
     }
 
 identified_tables
@@ -2292,6 +2676,76 @@ delete_query
         $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: from, WhereClause: $6}
     }
 
+merge_query
+    : with_clause MERGE INTO updatable_table_identifier USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4}, Source: $6, Condition: $8, WhenClauses: $9}
+    }
+    | with_clause MERGE INTO updatable_table_identifier identifier USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4, Alias: $5}, Source: $7, Condition: $9, WhenClauses: $10}
+    }
+    | with_clause MERGE INTO updatable_table_identifier AS identifier USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: Table{Object: $4, As: $5.Literal, Alias: $6}, Source: $8, Condition: $10, WhenClauses: $11}
+    }
+
+merge_when_clause
+    : WHEN MATCHED merge_when_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Operation: $5, SetList: $7}
+    }
+    | WHEN MATCHED merge_when_condition THEN DELETE
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Operation: $5}
+    }
+    | WHEN NOT MATCHED merge_when_condition THEN INSERT merge_insert_fields VALUES row_value
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Condition: $4, Operation: $6, Fields: $7, Values: $9}
+    }
+    | WHEN NOT MATCHED BY TARGET merge_when_condition THEN INSERT merge_insert_fields VALUES row_value
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Condition: $6, Operation: $8, Fields: $9, Values: $11}
+    }
+    | WHEN NOT MATCHED BY SOURCE merge_when_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), BySource: true, Condition: $6, Operation: $8, SetList: $10}
+    }
+    | WHEN NOT MATCHED BY SOURCE merge_when_condition THEN DELETE
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), BySource: true, Condition: $6, Operation: $8}
+    }
+
+merge_when_clauses
+    : merge_when_clause
+    {
+        $$ = []MergeWhenClause{$1}
+    }
+    | merge_when_clause merge_when_clauses
+    {
+        $$ = append([]MergeWhenClause{$1}, $2...)
+    }
+
+merge_when_condition
+    :
+    {
+        $$ = nil
+    }
+    | AND value
+    {
+        $$ = $2
+    }
+
+merge_insert_fields
+    :
+    {
+        $$ = nil
+    }
+    | '(' field_references ')'
+    {
+        $$ = $2
+    }
+
 elseif
     : ELSEIF substantial_value THEN program
     {
@@ -2452,6 +2906,66 @@ in_function_in_loop_case_else
         $$ = CaseElse{Statements: $2}
     }
 
+exception_handlers
+    : WHEN exception_codes THEN program
+    {
+        $$ = []ExceptionHandler{{Codes: $2, Statements: $4}}
+    }
+    | WHEN exception_codes THEN program exception_handlers
+    {
+        $$ = append([]ExceptionHandler{{Codes: $2, Statements: $4}}, $5...)
+    }
+
+in_loop_exception_handlers
+    : WHEN exception_codes THEN loop_program
+    {
+        $$ = []ExceptionHandler{{Codes: $2, Statements: $4}}
+    }
+    | WHEN exception_codes THEN loop_program in_loop_exception_handlers
+    {
+        $$ = append([]ExceptionHandler{{Codes: $2, Statements: $4}}, $5...)
+    }
+
+in_function_exception_handlers
+    : WHEN exception_codes THEN function_program
+    {
+        $$ = []ExceptionHandler{{Codes: $2, Statements: $4}}
+    }
+    | WHEN exception_codes THEN function_program in_function_exception_handlers
+    {
+        $$ = append([]ExceptionHandler{{Codes: $2, Statements: $4}}, $5...)
+    }
+
+in_function_in_loop_exception_handlers
+    : WHEN exception_codes THEN function_loop_program
+    {
+        $$ = []ExceptionHandler{{Codes: $2, Statements: $4}}
+    }
+    | WHEN exception_codes THEN function_loop_program in_function_in_loop_exception_handlers
+    {
+        $$ = append([]ExceptionHandler{{Codes: $2, Statements: $4}}, $5...)
+    }
+
+exception_codes
+    : ANY
+    {
+        $$ = nil
+    }
+    | exception_code_list
+    {
+        $$ = $1
+    }
+
+exception_code_list
+    : INTEGER
+    {
+        $$ = []value.Primary{value.NewIntegerFromString($1.Literal)}
+    }
+    | INTEGER ',' exception_code_list
+    {
+        $$ = append([]value.Primary{value.NewIntegerFromString($1.Literal)}, $3...)
+    }
+
 identifier
     : IDENTIFIER
     {
@@ -2469,6 +2983,14 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | GROUPS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXCLUDE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | CSV
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2477,6 +2999,10 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | JSONL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | FIXED
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2485,6 +3011,42 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | XLSX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PARQUET
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INDEX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ROLLUP
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CUBE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SETS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | APPLY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | FILTER
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -2644,6 +3206,19 @@ func SetDebugLevel(level int, verbose bool) {
 	yyErrorVerbose = verbose
 }
 
+func setPosition(p PrimitiveType, token Token) PrimitiveType {
+    p.BaseExpr = NewBaseExpr(token)
+    return p
+}
+
+func crossJoinTables(tables []QueryExpression) QueryExpression {
+    table := tables[0]
+    for _, t := range tables[1:] {
+        table = Table{Object: Join{Join: ",", Table: table, JoinTable: t, JoinType: Token{Token: CROSS}}}
+    }
+    return table
+}
+
 func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bool, ansiQuotes bool) ([]Statement, int, error) {
     l := new(Lexer)
     l.Init(s, sourceFile, datetimeFormats, forPrepared, ansiQuotes)
@@ -49,23 +49,23 @@ var parseTests = []struct {
 				SelectEntity: SelectSet{
 					LHS: SelectSet{
 						LHS: SelectEntity{
-							SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+							SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 						},
 						Operator: Token{Token: UNION, Literal: "union", Line: 1, Char: 10},
 						All:      Token{Token: ALL, Literal: "all", Line: 1, Char: 16},
 						RHS: SelectSet{
 							LHS: SelectEntity{
-								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 20}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 20}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
 							},
 							Operator: Token{Token: INTERSECT, Literal: "intersect", Line: 1, Char: 29},
 							RHS: SelectEntity{
-								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 39}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("3")}}},
+								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 39}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "3", Value: value.NewIntegerFromString("3")}}}},
 							},
 						},
 					},
 					Operator: Token{Token: EXCEPT, Literal: "except", Line: 1, Char: 48},
 					RHS: SelectEntity{
-						SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 55}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("4")}}},
+						SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 55}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "4", Value: value.NewIntegerFromString("4")}}}},
 					},
 				},
 			},
@@ -77,14 +77,14 @@ var parseTests = []struct {
 			SelectQuery{
 				SelectEntity: SelectSet{
 					LHS: SelectEntity{
-						SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+						SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					},
 					Operator: Token{Token: UNION, Literal: "union", Line: 1, Char: 10},
 					RHS: Subquery{
 						BaseExpr: &BaseExpr{line: 1, char: 16},
 						Query: SelectQuery{
 							SelectEntity: SelectEntity{
-								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 17}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+								SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 17}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
 							},
 						},
 					},
@@ -102,7 +102,7 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{
-								Object: NewIntegerValueFromString("1"),
+								Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								As:     "as",
 								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "a"},
 							},
@@ -192,7 +192,7 @@ var parseTests = []struct {
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 1, char: 32},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("1"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					},
 				},
 				ForUpdate:        true,
@@ -219,7 +219,7 @@ var parseTests = []struct {
 							Object: TableObject{
 								BaseExpr:      &BaseExpr{line: 1, char: 16},
 								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "fixed"},
-								FormatElement: NewStringValue("[1, 2, 3]"),
+								FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "[1, 2, 3]", Value: value.NewString("[1, 2, 3]")},
 								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "fixed_length.dat", Quoted: true},
 							},
 							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "fl"},
@@ -248,9 +248,9 @@ var parseTests = []struct {
 							Object: TableObject{
 								BaseExpr:      &BaseExpr{line: 1, char: 16},
 								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "csv"},
-								FormatElement: NewStringValue(","),
+								FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: ",", Value: value.NewString(",")},
 								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "table.csv", Quoted: true},
-								Args:          []QueryExpression{NewStringValue("utf8"), NewNullValueFromString("null")},
+								Args:          []QueryExpression{PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "utf8", Value: value.NewString("utf8")}, PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "null", Value: value.NewNull()}},
 							},
 						},
 					}},
@@ -277,7 +277,7 @@ var parseTests = []struct {
 							Object: TableObject{
 								BaseExpr:      &BaseExpr{line: 1, char: 16},
 								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "json"},
-								FormatElement: NewStringValue("{}"),
+								FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "{}", Value: value.NewString("{}")},
 								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table.txt", Quoted: true},
 							},
 							As:    "as",
@@ -335,7 +335,7 @@ var parseTests = []struct {
 								BaseExpr: &BaseExpr{line: 1, char: 16},
 								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ltsv"},
 								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table.ltsv", Quoted: true},
-								Args:     []QueryExpression{NewStringValue("utf8")},
+								Args:     []QueryExpression{PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "utf8", Value: value.NewString("utf8")}},
 							},
 						},
 					}},
@@ -363,7 +363,7 @@ var parseTests = []struct {
 								BaseExpr: &BaseExpr{line: 1, char: 16},
 								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ltsv"},
 								Path:     Stdin{BaseExpr: &BaseExpr{line: 1, char: 21}, Stdin: "stdin"},
-								Args:     []QueryExpression{NewStringValue("utf8")},
+								Args:     []QueryExpression{PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "utf8", Value: value.NewString("utf8")}},
 							},
 						},
 					}},
@@ -390,7 +390,7 @@ var parseTests = []struct {
 							Object: JsonQuery{
 								BaseExpr:  &BaseExpr{line: 1, char: 16},
 								JsonQuery: "json_table",
-								Query:     NewStringValue("key"),
+								Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "key", Value: value.NewString("key")},
 								JsonText:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "table.json", Quoted: true},
 							},
 						},
@@ -418,8 +418,8 @@ var parseTests = []struct {
 							Object: JsonQuery{
 								BaseExpr:  &BaseExpr{line: 1, char: 16},
 								JsonQuery: "json_table",
-								Query:     NewStringValue("key"),
-								JsonText:  NewStringValue("{\"key2\":1}"),
+								Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "key", Value: value.NewString("key")},
+								JsonText:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "{\"key2\":1}", Value: value.NewString("{\"key2\":1}")},
 							},
 							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "jt"},
 						},
@@ -447,8 +447,8 @@ var parseTests = []struct {
 							Object: JsonQuery{
 								BaseExpr:  &BaseExpr{line: 1, char: 16},
 								JsonQuery: "json_table",
-								Query:     NewStringValue("key"),
-								JsonText:  NewStringValue("{\"key2\":1}"),
+								Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "key", Value: value.NewString("key")},
+								JsonText:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "{\"key2\":1}", Value: value.NewString("{\"key2\":1}")},
 							},
 							As:    "as",
 							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "jt"},
@@ -463,7 +463,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -475,7 +475,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 23},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 24}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 24}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
 											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 										},
 									},
@@ -492,7 +492,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -505,7 +505,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 29},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 30}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 30}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
 											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 										},
 									},
@@ -523,7 +523,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -537,7 +537,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 32},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 33}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("2")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 33}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
 											FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 										},
 									},
@@ -568,14 +568,14 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 					WhereClause: WhereClause{
 						Where: "where",
 						Filter: Comparison{
-							LHS:      NewIntegerValueFromString("1"),
+							LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: "=",
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 12}, Literal: "1", Value: value.NewIntegerFromString("1")},
 						},
 					},
 					GroupByClause: GroupByClause{
@@ -588,9 +588,9 @@ var parseTests = []struct {
 					HavingClause: HavingClause{
 						Having: "having",
 						Filter: Comparison{
-							LHS:      NewIntegerValueFromString("1"),
+							LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 5, char: 9}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: ">",
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 5, char: 13}, Literal: "1", Value: value.NewIntegerFromString("1")},
 						},
 					},
 				},
@@ -616,11 +616,11 @@ var parseTests = []struct {
 				LimitClause: LimitClause{
 					BaseExpr: &BaseExpr{line: 12, char: 2},
 					Type:     Token{Token: LIMIT, Literal: "limit", Line: 12, Char: 2},
-					Value:    NewIntegerValueFromString("10"),
+					Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 12, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 13, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("10"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 13, char: 9}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					},
 				},
 			},
@@ -633,7 +633,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
@@ -641,7 +641,7 @@ var parseTests = []struct {
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 3, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("1"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 9}, Literal: "1", Value: value.NewIntegerFromString("1")},
 						Unit:     Token{Token: ROW, Literal: "row", Line: 3, Char: 11},
 					},
 				},
@@ -655,7 +655,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
@@ -663,7 +663,7 @@ var parseTests = []struct {
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 3, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("2"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 9}, Literal: "2", Value: value.NewIntegerFromString("2")},
 						Unit:     Token{Token: ROWS, Literal: "rows", Line: 3, Char: 11},
 					},
 				},
@@ -685,13 +685,13 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr: &BaseExpr{line: 3, char: 2},
 					Type:     Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:    NewIntegerValueFromString("10"),
+					Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Unit:     Token{Token: PERCENT, Literal: "percent", Line: 3, Char: 11},
 				},
 			},
@@ -704,13 +704,13 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr: &BaseExpr{line: 3, char: 2},
 					Type:     Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:    NewIntegerValueFromString("10"),
+					Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Unit:     Token{Token: ROW, Literal: "row", Line: 3, Char: 11},
 				},
 			},
@@ -723,13 +723,13 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr: &BaseExpr{line: 3, char: 2},
 					Type:     Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:    NewIntegerValueFromString("10"),
+					Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Unit:     Token{Token: ROWS, Literal: "rows", Line: 3, Char: 11},
 				},
 			},
@@ -742,13 +742,13 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:       NewIntegerValueFromString("10"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Restriction: Token{Token: TIES, Literal: "with ties", Line: 3, Char: 16},
 				},
 			},
@@ -761,13 +761,13 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:       NewIntegerValueFromString("10"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Unit:        Token{Token: ROWS, Literal: "rows", Line: 3, Char: 11},
 					Restriction: Token{Token: TIES, Literal: "with ties", Line: 3, Char: 21},
 				},
@@ -782,18 +782,18 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: LIMIT, Literal: "limit", Line: 3, Char: 2},
-					Value:       NewIntegerValueFromString("10"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 8}, Literal: "10", Value: value.NewIntegerFromString("10")},
 					Restriction: Token{Token: ONLY, Literal: "only", Line: 3, Char: 11},
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 4, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("1"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 4, char: 9}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					},
 				},
 			},
@@ -807,20 +807,20 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: FETCH, Literal: "fetch", Line: 4, Char: 2},
 					Position:    Token{Token: FIRST, Literal: "first", Line: 4, Char: 8},
-					Value:       NewIntegerValueFromString("1"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 4, char: 14}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					Unit:        Token{Token: ROW, Literal: "row", Line: 4, Char: 16},
 					Restriction: Token{Token: ONLY, Literal: "only", Line: 4, Char: 20},
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 3, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("10"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 9}, Literal: "10", Value: value.NewIntegerFromString("10")},
 						Unit:     Token{Token: ROWS, Literal: "rows", Line: 3, Char: 12},
 					},
 				},
@@ -835,20 +835,20 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: FETCH, Literal: "fetch", Line: 4, Char: 2},
 					Position:    Token{Token: NEXT, Literal: "next", Line: 4, Char: 8},
-					Value:       NewIntegerValueFromString("1"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 4, char: 13}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					Unit:        Token{Token: PERCENT, Literal: "percent", Line: 4, Char: 15},
 					Restriction: Token{Token: TIES, Literal: "with ties", Line: 4, Char: 28},
 					OffsetClause: OffsetClause{
 						BaseExpr: &BaseExpr{line: 3, char: 2},
 						Offset:   "offset",
-						Value:    NewIntegerValueFromString("1"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 9}, Literal: "1", Value: value.NewIntegerFromString("1")},
 						Unit:     Token{Token: ROW, Literal: "row", Line: 3, Char: 11},
 					},
 				},
@@ -862,14 +862,14 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause:   FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
 				},
 				LimitClause: LimitClause{
 					BaseExpr:    &BaseExpr{line: 3, char: 2},
 					Type:        Token{Token: FETCH, Literal: "fetch", Line: 3, Char: 2},
 					Position:    Token{Token: NEXT, Literal: "next", Line: 3, Char: 8},
-					Value:       NewIntegerValueFromString("1"),
+					Value:       PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 13}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					Unit:        Token{Token: PERCENT, Literal: "percent", Line: 3, Char: 15},
 					Restriction: Token{Token: TIES, Literal: "with ties", Line: 3, Char: 28},
 				},
@@ -926,7 +926,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -967,7 +967,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 23},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -1006,7 +1006,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 23},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -1021,7 +1021,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 42},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("2")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 										},
 									},
 								},
@@ -1044,7 +1044,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select ident, tbl.3, (ident), 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar') from dual",
+		Input: "select ident, tbl.3, (ident), 'foo', 1, 1.234, true, '2010-01-01 12:00:00', interval '3 days 4 hours', null, ('bar') from dual",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -1055,13 +1055,14 @@ var parseTests = []struct {
 							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}}},
 							Field{Object: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 15}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "tbl"}, Number: value.NewInteger(3)}},
 							Field{Object: Parentheses{Expr: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 23}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "ident"}}}},
-							Field{Object: NewStringValue("foo")},
-							Field{Object: NewIntegerValueFromString("1")},
-							Field{Object: NewFloatValueFromString("1.234")},
-							Field{Object: NewTernaryValueFromString("true")},
-							Field{Object: NewDatetimeValueFromString("2010-01-01 12:00:00", nil)},
-							Field{Object: NewNullValueFromString("null")},
-							Field{Object: Parentheses{Expr: NewStringValue("bar")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "foo", Value: value.NewString("foo")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "1.234", Value: value.NewFloatFromString("1.234")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "true", Value: value.NewTernaryFromString("true")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "2010-01-01 12:00:00", Value: value.NewDatetimeFromString("2010-01-01 12:00:00", nil)}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "3 days 4 hours", Value: value.NewIntervalFromString("3 days 4 hours")}},
+							Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 104}, Literal: "null", Value: value.NewNull()}},
+							Field{Object: Parentheses{Expr: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 111}, Literal: "bar", Value: value.NewString("bar")}}},
 						},
 					},
 					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
@@ -1101,8 +1102,8 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: Concat{Items: []QueryExpression{
 								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "ident"}},
-								NewStringValue("foo"),
-								NewStringValue("bar"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "foo", Value: value.NewString("foo")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "bar", Value: value.NewString("bar")},
 							}}},
 						},
 					},
@@ -1122,7 +1123,7 @@ var parseTests = []struct {
 							Field{Object: Comparison{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: "=",
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1154,8 +1155,8 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 29},
 									Value: ValueList{
 										Values: []QueryExpression{
-											NewIntegerValueFromString("1"),
-											NewIntegerValueFromString("2"),
+											PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")},
+											PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "2", Value: value.NewIntegerFromString("2")},
 										},
 									},
 								},
@@ -1178,7 +1179,7 @@ var parseTests = []struct {
 							Field{Object: Comparison{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: "<",
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1216,8 +1217,8 @@ var parseTests = []struct {
 													BaseExpr: &BaseExpr{line: 1, char: 30},
 													Select:   "select",
 													Fields: []QueryExpression{
-														Field{Object: NewIntegerValueFromString("1")},
-														Field{Object: NewIntegerValueFromString("2")},
+														Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+														Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 													},
 												},
 											},
@@ -1243,7 +1244,7 @@ var parseTests = []struct {
 							Field{Object: Is{
 								Is:       "is",
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								RHS:      NewNullValueFromString("null"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "null", Value: value.NewNull()},
 								Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 19},
 							}},
 						},
@@ -1264,7 +1265,7 @@ var parseTests = []struct {
 							Field{Object: Is{
 								Is:  "is",
 								LHS: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-								RHS: NewTernaryValueFromString("true"),
+								RHS: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "true", Value: value.NewTernaryFromString("true")},
 							}},
 						},
 					},
@@ -1287,11 +1288,11 @@ var parseTests = []struct {
 									And:     "and",
 									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 									Low: UnaryArithmetic{
-										Operand:  NewIntegerValueFromString("10"),
+										Operand:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "10", Value: value.NewIntegerFromString("10")},
 										Operator: Token{Token: '-', Literal: "-", Line: 1, Char: 28},
 									},
 									High: UnaryArithmetic{
-										Operand:  NewIntegerValueFromString("10"),
+										Operand:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "10", Value: value.NewIntegerFromString("10")},
 										Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 36},
 									},
 									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
@@ -1301,8 +1302,8 @@ var parseTests = []struct {
 									Between: "between",
 									And:     "and",
 									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"}},
-									Low:     NewIntegerValueFromString("20"),
-									High:    NewIntegerValueFromString("30"),
+									Low:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "20", Value: value.NewIntegerFromString("20")},
+									High:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "30", Value: value.NewIntegerFromString("30")},
 								},
 							}},
 						},
@@ -1337,8 +1338,8 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 39},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("1"),
-												NewIntegerValueFromString("2"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "1", Value: value.NewIntegerFromString("1")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "2", Value: value.NewIntegerFromString("2")},
 											},
 										},
 									},
@@ -1346,8 +1347,8 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 50},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("3"),
-												NewIntegerValueFromString("4"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "3", Value: value.NewIntegerFromString("3")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "4", Value: value.NewIntegerFromString("4")},
 											},
 										},
 									},
@@ -1369,8 +1370,8 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 88},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("5"),
-												NewIntegerValueFromString("6"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 89}, Literal: "5", Value: value.NewIntegerFromString("5")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 92}, Literal: "6", Value: value.NewIntegerFromString("6")},
 											},
 										},
 									},
@@ -1378,8 +1379,8 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 99},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("7"),
-												NewIntegerValueFromString("8"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 100}, Literal: "7", Value: value.NewIntegerFromString("7")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 103}, Literal: "8", Value: value.NewIntegerFromString("8")},
 											},
 										},
 									},
@@ -1409,9 +1410,9 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 23},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("1"),
-												NewIntegerValueFromString("2"),
-												NewIntegerValueFromString("3"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "1", Value: value.NewIntegerFromString("1")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "2", Value: value.NewIntegerFromString("2")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "3", Value: value.NewIntegerFromString("3")},
 											},
 										},
 									},
@@ -1424,9 +1425,9 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 48},
 										Value: ValueList{
 											Values: []QueryExpression{
-												NewIntegerValueFromString("4"),
-												NewIntegerValueFromString("5"),
-												NewIntegerValueFromString("6"),
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "4", Value: value.NewIntegerFromString("4")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "5", Value: value.NewIntegerFromString("5")},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "6", Value: value.NewIntegerFromString("6")},
 											},
 										},
 									},
@@ -1455,8 +1456,8 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 19},
 									Value: JsonQuery{
 										JsonQuery: "json_row",
-										Query:     NewStringValue("key"),
-										JsonText:  NewStringValue("{\"key\":1}"),
+										Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "key", Value: value.NewString("key")},
+										JsonText:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "{\"key\":1}", Value: value.NewString("{\"key\":1}")},
 									},
 								},
 							}},
@@ -1492,8 +1493,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 35},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("1"),
-													NewIntegerValueFromString("2"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "1", Value: value.NewIntegerFromString("1")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "2", Value: value.NewIntegerFromString("2")},
 												},
 											},
 										},
@@ -1501,8 +1502,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 43},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("3"),
-													NewIntegerValueFromString("4"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "3", Value: value.NewIntegerFromString("3")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "4", Value: value.NewIntegerFromString("4")},
 												},
 											},
 										},
@@ -1540,7 +1541,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 30},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 										},
 									},
 								},
@@ -1574,8 +1575,8 @@ var parseTests = []struct {
 								Values: JsonQuery{
 									BaseExpr:  &BaseExpr{line: 1, char: 30},
 									JsonQuery: "json_row",
-									Query:     NewStringValue("key"),
-									JsonText:  NewStringValue("{\"key\":1}"),
+									Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "key", Value: value.NewString("key")},
+									JsonText:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "{\"key\":1}", Value: value.NewString("{\"key\":1}")},
 								},
 							}},
 						},
@@ -1597,14 +1598,14 @@ var parseTests = []struct {
 								LHS: Like{
 									Like:     "like",
 									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern:  NewStringValue("pattern1"),
+									Pattern:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "pattern1", Value: value.NewString("pattern1")},
 									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
 								},
 								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 36},
 								RHS: Like{
 									Like:    "like",
 									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
-									Pattern: NewStringValue("pattern2"),
+									Pattern: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "pattern2", Value: value.NewString("pattern2")},
 								},
 							}},
 						},
@@ -1626,13 +1627,13 @@ var parseTests = []struct {
 								LHS: Like{
 									Like:    "like",
 									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern: NewStringValue("pattern1"),
+									Pattern: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "pattern1", Value: value.NewString("pattern1")},
 								},
 								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 32},
 								RHS: Like{
 									Like:     "like",
 									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
-									Pattern:  NewStringValue("pattern2"),
+									Pattern:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "pattern2", Value: value.NewString("pattern2")},
 									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 43},
 								},
 							}},
@@ -1642,6 +1643,37 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 regexp '^a' and column2 not regexp '^b'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									RegExp:   "regexp",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "^a", Value: value.NewString("^a")},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 28},
+								RHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 44},
+									RegExp:   "regexp",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "column2"}},
+									Pattern:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "^b", Value: value.NewString("^b")},
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 40},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 = any (select 1)",
 		Output: []Statement{
@@ -1661,7 +1693,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 22},
 										Query: SelectQuery{
 											SelectEntity: SelectEntity{
-												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 											},
 										},
 									},
@@ -1700,8 +1732,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 34},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("1"),
-													NewIntegerValueFromString("2"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "1", Value: value.NewIntegerFromString("1")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "2", Value: value.NewIntegerFromString("2")},
 												},
 											},
 										},
@@ -1709,8 +1741,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 42},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("3"),
-													NewIntegerValueFromString("4"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "3", Value: value.NewIntegerFromString("3")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "4", Value: value.NewIntegerFromString("4")},
 												},
 											},
 										},
@@ -1748,7 +1780,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 33},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 										},
 									},
 								},
@@ -1778,7 +1810,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 22},
 										Query: SelectQuery{
 											SelectEntity: SelectEntity{
-												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+												SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 23}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 											},
 										},
 									},
@@ -1817,8 +1849,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 34},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("1"),
-													NewIntegerValueFromString("2"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "1", Value: value.NewIntegerFromString("1")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "2", Value: value.NewIntegerFromString("2")},
 												},
 											},
 										},
@@ -1826,8 +1858,8 @@ var parseTests = []struct {
 											BaseExpr: &BaseExpr{line: 1, char: 42},
 											Value: ValueList{
 												Values: []QueryExpression{
-													NewIntegerValueFromString("3"),
-													NewIntegerValueFromString("4"),
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "3", Value: value.NewIntegerFromString("3")},
+													PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "4", Value: value.NewIntegerFromString("4")},
 												},
 											},
 										},
@@ -1865,7 +1897,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 33},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 34}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 										},
 									},
 								},
@@ -1891,7 +1923,7 @@ var parseTests = []struct {
 									BaseExpr: &BaseExpr{line: 1, char: 15},
 									Query: SelectQuery{
 										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 16}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 16}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 										},
 									},
 								},
@@ -1914,7 +1946,7 @@ var parseTests = []struct {
 							Field{Object: Arithmetic{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: int('+'),
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1934,7 +1966,7 @@ var parseTests = []struct {
 							Field{Object: Arithmetic{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: int('-'),
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1954,7 +1986,7 @@ var parseTests = []struct {
 							Field{Object: Arithmetic{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: int('*'),
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1974,7 +2006,7 @@ var parseTests = []struct {
 							Field{Object: Arithmetic{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: int('/'),
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -1994,7 +2026,7 @@ var parseTests = []struct {
 							Field{Object: Arithmetic{
 								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
 								Operator: int('%'),
-								RHS:      NewIntegerValueFromString("1"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -2012,9 +2044,9 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
+								LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
-								RHS:      NewTernaryValueFromString("false"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "false", Value: value.NewTernaryFromString("false")},
 							}},
 						},
 					},
@@ -2032,9 +2064,9 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
+								LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
-								RHS:      NewTernaryValueFromString("false"),
+								RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "false", Value: value.NewTernaryFromString("false")},
 							}},
 						},
 					},
@@ -2053,7 +2085,7 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: UnaryLogic{
 								Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 8},
-								Operand:  NewTernaryValueFromString("false"),
+								Operand:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "false", Value: value.NewTernaryFromString("false")},
 							}},
 						},
 					},
@@ -2071,13 +2103,13 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{Object: Logic{
-								LHS:      NewTernaryValueFromString("true"),
+								LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 13},
 								RHS: Parentheses{
 									Expr: Logic{
-										LHS:      NewTernaryValueFromString("false"),
+										LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "false", Value: value.NewTernaryFromString("false")},
 										Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 23},
-										RHS:      NewTernaryValueFromString("false"),
+										RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "false", Value: value.NewTernaryFromString("false")},
 									},
 								},
 							}},
@@ -2098,20 +2130,20 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: Logic{
 								LHS: Logic{
-									LHS:      NewTernaryValueFromString("true"),
+									LHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "true", Value: value.NewTernaryFromString("true")},
 									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 13},
-									RHS:      NewTernaryValueFromString("true"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								},
 								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 22},
 								RHS: Logic{
 									LHS: UnaryLogic{
 										Operator: Token{Token: '!', Literal: "!", Line: 1, Char: 25},
-										Operand:  NewTernaryValueFromString("false"),
+										Operand:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "false", Value: value.NewTernaryFromString("false")},
 									},
 									Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 32},
 									RHS: UnaryLogic{
 										Operator: Token{Token: NOT, Literal: "not", Line: 1, Char: 36},
-										Operand:  NewTernaryValueFromString("false"),
+										Operand:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "false", Value: value.NewTernaryFromString("false")},
 									},
 								},
 							}},
@@ -2148,7 +2180,7 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: VariableSubstitution{
 								Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "var"},
-								Value:    NewIntegerValueFromString("1"),
+								Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							}},
 						},
 					},
@@ -2172,14 +2204,14 @@ var parseTests = []struct {
 									CaseExprWhen{
 										When:      "when",
 										Then:      "then",
-										Condition: NewTernaryValueFromString("true"),
-										Result:    NewStringValue("A"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "true", Value: value.NewTernaryFromString("true")},
+										Result:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "A", Value: value.NewString("A")},
 									},
 									CaseExprWhen{
 										When:      "when",
 										Then:      "then",
-										Condition: NewTernaryValueFromString("false"),
-										Result:    NewStringValue("B"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "false", Value: value.NewTernaryFromString("false")},
+										Result:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "B", Value: value.NewString("B")},
 									},
 								},
 							}},
@@ -2206,19 +2238,19 @@ var parseTests = []struct {
 									CaseExprWhen{
 										When:      "when",
 										Then:      "then",
-										Condition: NewIntegerValueFromString("1"),
-										Result:    NewStringValue("A"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "1", Value: value.NewIntegerFromString("1")},
+										Result:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "A", Value: value.NewString("A")},
 									},
 									CaseExprWhen{
 										When:      "when",
 										Then:      "then",
-										Condition: NewIntegerValueFromString("2"),
-										Result:    NewStringValue("B"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "2", Value: value.NewIntegerFromString("2")},
+										Result:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "B", Value: value.NewString("B")},
 									},
 								},
 								Else: CaseExprElse{
 									Else:   "else",
-									Result: NewStringValue("C"),
+									Result: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "C", Value: value.NewString("C")},
 								},
 							}},
 						},
@@ -2553,7 +2585,7 @@ var parseTests = []struct {
 								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 16},
 								Args: []QueryExpression{
 									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"}},
-									NewStringValue(","),
+									PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: ",", Value: value.NewString(",")},
 								},
 							}},
 						},
@@ -2606,7 +2638,7 @@ var parseTests = []struct {
 								Name:     "listagg",
 								Args: []QueryExpression{
 									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
-									NewStringValue(","),
+									PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: ",", Value: value.NewString(",")},
 								},
 								WithinGroup: "within group",
 								OrderBy: OrderByClause{
@@ -2622,6 +2654,183 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select count(*) filter (where column1 > 1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Filter: FilterClause{
+									Filter: "filter",
+									Where:  "where",
+									Condition: Comparison{
+										LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column1"}},
+										Operator: ">",
+										RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "1", Value: value.NewIntegerFromString("1")},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select useraggfunc(column1) filter (where true)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "useraggfunc",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "column1"}},
+								},
+								Filter: FilterClause{
+									Filter:    "filter",
+									Where:     "where",
+									Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "true", Value: value.NewTernaryFromString("true")},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select percentile_cont(0.5) within group (order by column1 desc) filter (where true)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_cont",
+								Args: []QueryExpression{
+									PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "0.5", Value: value.NewFloatFromString("0.5")},
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{
+											Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}},
+											Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 60},
+										},
+									},
+								},
+								Filter: FilterClause{
+									Filter:    "filter",
+									Where:     "where",
+									Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "true", Value: value.NewTernaryFromString("true")},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode() within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr:    &BaseExpr{line: 1, char: 8},
+								Name:        "mode",
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select sum(column2) filter (where true) over (partition by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "sum",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column2"}},
+								},
+								Filter: FilterClause{
+									Filter:    "filter",
+									Where:     "where",
+									Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "true", Value: value.NewTernaryFromString("true")},
+								},
+								Over: "over",
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 60}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "column1"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select filter from t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "filter"}}},
+						},
+					},
+					FromClause: FromClause{
+						From:   "from",
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "t"}}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -2748,6 +2957,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2785,6 +2995,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Unbounded: true,
@@ -2823,6 +3034,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Offset:    1,
@@ -2861,6 +3073,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Unbounded: true,
@@ -2906,6 +3119,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Offset:    1,
@@ -2951,6 +3165,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2971,6 +3186,341 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select userfunc() over (order by column2 range between 1.5 preceding and current row exclude ties)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Rows: "range",
+										Unit: RANGE,
+										FrameLow: WindowFramePosition{
+											Direction:   PRECEDING,
+											OffsetValue: NewFloatValueFromString("1.5"),
+											Literal:     "1.5 preceding",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: CURRENT,
+											Literal:   "current row",
+										},
+										Between:   "between",
+										And:       "and",
+										Exclusion: WindowExclusion{Type: TIES, Literal: "exclude ties"},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 range between interval '1 day' preceding and interval '2 hours' following)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Rows: "range",
+										Unit: RANGE,
+										FrameLow: WindowFramePosition{
+											Direction:   PRECEDING,
+											OffsetValue: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "1 day", Value: value.NewIntervalFromString("1 day")},
+											Literal:     "INTERVAL '1 day' preceding",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction:   FOLLOWING,
+											OffsetValue: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "2 hours", Value: value.NewIntervalFromString("2 hours")},
+											Literal:     "INTERVAL '2 hours' following",
+										},
+										Between: "between",
+										And:     "and",
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 groups 1 preceding exclude current row)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Rows: "groups",
+										Unit: GROUPS,
+										FrameLow: WindowFramePosition{
+											Direction: PRECEDING,
+											Offset:    1,
+											Literal:   "1 preceding",
+										},
+										Exclusion: WindowExclusion{Type: CURRENT, Literal: "exclude current row"},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select groups, exclude from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "groups"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "exclude"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select grouping(c1) from t group by rollup(c1, (c2, c3)), cube(c4), grouping sets ((), c1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "grouping", Args: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "c1"}},
+							}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "t"}},
+						},
+					},
+					GroupByClause: GroupByClause{
+						GroupBy: "group by",
+						Items: []QueryExpression{
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 37},
+								Type:     ROLLUP,
+								Literal:  "rollup",
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 44}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "c1"}},
+									ValueList{BaseExpr: &BaseExpr{line: 1, char: 48}, Values: []QueryExpression{
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 49}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "c2"}},
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "c3"}},
+									}},
+								},
+							},
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 59},
+								Type:     CUBE,
+								Literal:  "cube",
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 64}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 64}, Literal: "c4"}},
+								},
+							},
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 69},
+								Type:     GROUPING,
+								Literal:  "grouping sets",
+								Values: []QueryExpression{
+									ValueList{BaseExpr: &BaseExpr{line: 1, char: 84}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 88}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 88}, Literal: "c1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select rollup, cube, sets from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "rollup"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "cube"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "sets"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select * from table1 pivot (sum(column2) for column1 in ('a', 'b' as b2)) as p",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: PivotTable{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Pivot:    "pivot",
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Aggregate: AggregateFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 29},
+										Name:     "sum",
+										Args: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column2"}},
+										},
+									},
+									For:    "for",
+									Column: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 46}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "column1"}},
+									In:     "in",
+									Values: []QueryExpression{
+										Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "a", Value: value.NewString("a")}},
+										Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "b", Value: value.NewString("b")}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "b2"}},
+									},
+								},
+								As:    "as",
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select * from table1 pivot (count(*) for column1 in (any)) unpivot (val for attr in (column2, column3 as c3)) u",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: UnpivotTable{
+									BaseExpr: &BaseExpr{line: 1, char: 60},
+									Unpivot:  "unpivot",
+									Table: Table{
+										Object: PivotTable{
+											BaseExpr: &BaseExpr{line: 1, char: 22},
+											Pivot:    "pivot",
+											Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+											Aggregate: AggregateFunction{
+												BaseExpr: &BaseExpr{line: 1, char: 29},
+												Name:     "count",
+												Args: []QueryExpression{
+													AllColumns{BaseExpr: &BaseExpr{line: 1, char: 35}},
+												},
+											},
+											For:    "for",
+											Column: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column1"}},
+											In:     "in",
+											Any:    "any",
+										},
+									},
+									Value: Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "val"},
+									For:   "for",
+									Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "attr"},
+									In:    "in",
+									Columns: []QueryExpression{
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 86}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 86}, Literal: "column2"}}},
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 95}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 95}, Literal: "column3"}}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 106}, Literal: "c3"}},
+									},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 111}, Literal: "u"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select f(column1) over (partition by column1 order by column2)",
 		Output: []Statement{
@@ -3083,6 +3633,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3129,6 +3680,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3175,6 +3727,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3221,6 +3774,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3287,7 +3841,7 @@ var parseTests = []struct {
 								Name:     "listagg",
 								Args: []QueryExpression{
 									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
-									NewStringValue(","),
+									PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: ",", Value: value.NewString(",")},
 								},
 								Over: "over",
 								AnalyticClause: AnalyticClause{
@@ -3382,6 +3936,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3430,6 +3985,7 @@ var parseTests = []struct {
 									},
 									WindowingClause: WindowingClause{
 										Rows: "rows",
+										Unit: ROWS,
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3507,16 +4063,166 @@ var parseTests = []struct {
 											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column1"}},
 										},
 									},
-									OrderByClause: OrderByClause{
-										OrderBy: "order by",
-										Items: []QueryExpression{
-											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "column2"}},
-											},
-										},
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "column2"}},
+											},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 cross join table2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Join:      "join",
+									Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									JoinTable: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "table2"}},
+									JoinType:  Token{Token: CROSS, Literal: "cross", Line: 1, Char: 22},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from t1 cross apply string_split(t1.c, ';') s",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Join:  "apply",
+									Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+									JoinTable: Table{
+										Object: TableFunction{
+											BaseExpr: &BaseExpr{line: 1, char: 30},
+											Name:     "string_split",
+											Args: []QueryExpression{
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "t1"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "c"}},
+												PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: ";", Value: value.NewString(";")},
+											},
+										},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "s"},
+									},
+									JoinType: Token{Token: CROSS, Literal: "cross", Line: 1, Char: 18},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from information_schema.tables t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: SchemaTable{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Schema:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "information_schema"},
+									Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "tables"},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "t"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from t1 outer apply (select t1.c) s",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Join:  "apply",
+									Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+									JoinTable: Table{
+										Object: Subquery{
+											BaseExpr: &BaseExpr{line: 1, char: 30},
+											Query: SelectQuery{
+												SelectEntity: SelectEntity{
+													SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{
+														Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "t1"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "c"}}},
+													}},
+												},
+											},
+										},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "s"},
+									},
+									JoinType: Token{Token: OUTER, Literal: "outer", Line: 1, Char: 18},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from t1 left join lateral json_table('', t1.c) j on true",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Join:  "join",
+									Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+									JoinTable: Table{
+										Object: JsonQuery{
+											BaseExpr:  &BaseExpr{line: 1, char: 36},
+											JsonQuery: "json_table",
+											Query:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "", Value: value.NewString("")},
+											JsonText:  FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "t1"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "c"}},
+										},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "j"},
+									},
+									Direction: Token{Token: LEFT, Literal: "left", Line: 1, Char: 18},
+									Lateral:   Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 28},
+									Condition: JoinCondition{
+										Literal: "on",
+										On:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "true", Value: value.NewTernaryFromString("true")},
 									},
 								},
-							}},
+							},
 						},
 					},
 				},
@@ -3524,22 +4230,41 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select 1 from table1 cross join table2",
+		Input: "select 1 from t1, t2, lateral (select 2) s, t3",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
 							Table{
 								Object: Join{
-									Join:      "join",
-									Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
-									JoinTable: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "table2"}},
-									JoinType:  Token{Token: CROSS, Literal: "cross", Line: 1, Char: 22},
+									Join: ",",
+									Table: Table{
+										Object: Join{
+											Join:      ",",
+											Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+											JoinTable: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t2"}},
+											JoinType:  Token{Token: CROSS},
+										},
+									},
+									JoinTable: Table{
+										Object: Subquery{
+											BaseExpr: &BaseExpr{line: 1, char: 31},
+											Query: SelectQuery{
+												SelectEntity: SelectEntity{
+													SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 32}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "2", Value: value.NewIntegerFromString("2")}}}},
+												},
+											},
+										},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "s"},
+									},
+									JoinType: Token{Token: CROSS},
+									Lateral:  Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 23},
 								},
 							},
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "t3"}},
 						},
 					},
 				},
@@ -3551,7 +4276,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3581,7 +4306,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3626,7 +4351,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3657,7 +4382,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3687,7 +4412,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3729,7 +4454,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3759,7 +4484,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3783,7 +4508,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3829,7 +4554,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
-					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "1", Value: value.NewIntegerFromString("1")}}}},
 					FromClause: FromClause{
 						From: "from",
 						Tables: []QueryExpression{
@@ -3864,7 +4589,7 @@ var parseTests = []struct {
 					},
 					{
 						Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 12}, Name: "var2"},
-						Value:    NewIntegerValueFromString("2"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "2", Value: value.NewIntegerFromString("2")},
 					},
 				},
 			},
@@ -3873,7 +4598,7 @@ var parseTests = []struct {
 					BaseExpr: &BaseExpr{line: 1, char: 24},
 					Name:     "var1",
 				},
-				Value: NewIntegerValueFromString("1"),
+				Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "1", Value: value.NewIntegerFromString("1")},
 			},
 		},
 	},
@@ -3884,7 +4609,7 @@ var parseTests = []struct {
 				Assignments: []VariableAssignment{
 					{
 						Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 9}, Name: "var1"},
-						Value:    NewIntegerValueFromString("1"),
+						Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					},
 				},
 			},
@@ -3912,7 +4637,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SetEnvVar{
 				EnvVar: EnvironmentVariable{BaseExpr: &BaseExpr{line: 1, char: 5}, Name: "var"},
-				Value:  NewIntegerValueFromString("1"),
+				Value:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "1", Value: value.NewIntegerFromString("1")},
 			},
 		},
 	},
@@ -3930,7 +4655,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			SetEnvVar{
 				EnvVar: EnvironmentVariable{BaseExpr: &BaseExpr{line: 1, char: 5}, Name: "var"},
-				Value:  NewIntegerValueFromString("1"),
+				Value:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "1", Value: value.NewIntegerFromString("1")},
 			},
 		},
 	},
@@ -3949,8 +4674,8 @@ var parseTests = []struct {
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Name:     "func",
 				Args: []QueryExpression{
-					NewStringValue("arg1"),
-					NewStringValue("arg2"),
+					PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "arg1", Value: value.NewString("arg1")},
+					PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "arg2", Value: value.NewString("arg2")},
 				},
 			},
 		},
@@ -3971,7 +4696,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -3985,8 +4710,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 49},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -3994,8 +4719,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 62},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4018,8 +4743,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 56},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -4027,8 +4752,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 69},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 73}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4047,8 +4772,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 20},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4071,8 +4796,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 39},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4096,7 +4821,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4108,9 +4833,9 @@ var parseTests = []struct {
 					Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "table1"}},
 				},
 				SetList: []UpdateSet{
-					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
-					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column2"}}, Value: NewIntegerValueFromString("2")},
-					{Field: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 67}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 67}, Literal: "table1"}, Number: value.NewInteger(3)}, Value: NewIntegerValueFromString("3")},
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column2"}}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 64}, Literal: "2", Value: value.NewIntegerFromString("2")}},
+					{Field: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 67}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 67}, Literal: "table1"}, Number: value.NewInteger(3)}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "3", Value: value.NewIntegerFromString("3")}},
 				},
 				FromClause: FromClause{
 					From: "from",
@@ -4120,7 +4845,7 @@ var parseTests = []struct {
 				},
 				WhereClause: WhereClause{
 					Where:  "where",
-					Filter: NewTernaryValueFromString("true"),
+					Filter: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 98}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				},
 			},
 		},
@@ -4133,18 +4858,18 @@ var parseTests = []struct {
 					Table{Object: TableObject{
 						BaseExpr:      &BaseExpr{line: 1, char: 8},
 						Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "csv"},
-						FormatElement: NewStringValue(","),
+						FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: ",", Value: value.NewString(",")},
 						Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "table1"},
 					}},
 				},
 				SetList: []UpdateSet{
-					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 29}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
-					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}}, Value: NewIntegerValueFromString("2")},
-					{Field: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 55}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "table1"}, Number: value.NewInteger(3)}, Value: NewIntegerValueFromString("3")},
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 29}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"}}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "2", Value: value.NewIntegerFromString("2")}},
+					{Field: ColumnNumber{BaseExpr: &BaseExpr{line: 1, char: 55}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "table1"}, Number: value.NewInteger(3)}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "3", Value: value.NewIntegerFromString("3")}},
 				},
 				WhereClause: WhereClause{
 					Where:  "where",
-					Filter: NewTernaryValueFromString("true"),
+					Filter: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 74}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				},
 			},
 		},
@@ -4165,7 +4890,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4182,8 +4907,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 62},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -4191,8 +4916,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 75},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 76}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 79}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4216,7 +4941,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4239,8 +4964,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 104},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 105}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 108}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -4248,8 +4973,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 117},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 118}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 121}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4273,7 +4998,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4291,8 +5016,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 60},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 67}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4316,7 +5041,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4338,8 +5063,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 78},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 85}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 88}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4360,8 +5085,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 40},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -4369,8 +5094,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 53},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4397,8 +5122,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 82},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("1"),
-								NewStringValue("str1"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 83}, Literal: "1", Value: value.NewIntegerFromString("1")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 86}, Literal: "str1", Value: value.NewString("str1")},
 							},
 						},
 					},
@@ -4406,8 +5131,8 @@ var parseTests = []struct {
 						BaseExpr: &BaseExpr{line: 1, char: 95},
 						Value: ValueList{
 							Values: []QueryExpression{
-								NewIntegerValueFromString("2"),
-								NewStringValue("str2"),
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 96}, Literal: "2", Value: value.NewIntegerFromString("2")},
+								PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 99}, Literal: "str2", Value: value.NewString("str2")},
 							},
 						},
 					},
@@ -4429,8 +5154,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 38},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4456,8 +5181,8 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 56},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
-								Field{Object: NewIntegerValueFromString("2")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 							},
 						},
 					},
@@ -4482,7 +5207,7 @@ var parseTests = []struct {
 										BaseExpr: &BaseExpr{line: 1, char: 13},
 										Select:   "select",
 										Fields: []QueryExpression{
-											Field{Object: NewIntegerValueFromString("1")},
+											Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 										},
 									},
 								},
@@ -4515,7 +5240,105 @@ var parseTests = []struct {
 				},
 				WhereClause: WhereClause{
 					Where:  "where",
-					Filter: NewTernaryValueFromString("true"),
+					Filter: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "true", Value: value.NewTernaryFromString("true")},
+				},
+			},
+		},
+	},
+	{
+		Input: "merge into table1 t using table2 s on t.column1 = s.column3 when matched and t.column2 = 'x' then update set column2 = s.column4 when not matched then insert (column1, column2) values (s.column3, s.column4) when not matched by source then delete",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t"}},
+				Source:   Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table2"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "s"}},
+				Condition: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}},
+					Operator: "=",
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column3"}},
+				},
+				WhenClauses: []MergeWhenClause{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 61},
+						Matched:  true,
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 78}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "column2"}},
+							Operator: "=",
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 90}, Literal: "x", Value: value.NewString("x")},
+						},
+						Operation: Token{Token: UPDATE, Literal: "update", Line: 1, Char: 99},
+						SetList: []UpdateSet{
+							{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 110}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 110}, Literal: "column2"}}, Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 120}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 120}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 122}, Literal: "column4"}}},
+						},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 130},
+						Operation: Token{Token: INSERT, Literal: "insert", Line: 1, Char: 152},
+						Fields: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 160}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 160}, Literal: "column1"}},
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 169}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 169}, Literal: "column2"}},
+						},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 185},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 186}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 186}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 188}, Literal: "column3"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 197}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 197}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 199}, Literal: "column4"}},
+								},
+							},
+						},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 208},
+						BySource:  true,
+						Operation: Token{Token: DELETE, Literal: "delete", Line: 1, Char: 240},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "merge into table1 as t using (select 1 as c1) s on t.column1 = s.c1 when not matched by target then insert values (s.c1, 'x')",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "t"}},
+				Source: Table{
+					Object: Subquery{
+						BaseExpr: &BaseExpr{line: 1, char: 30},
+						Query: SelectQuery{
+							SelectEntity: SelectEntity{
+								SelectClause: SelectClause{
+									BaseExpr: &BaseExpr{line: 1, char: 31},
+									Select:   "select",
+									Fields: []QueryExpression{
+										Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "1", Value: value.NewIntegerFromString("1")}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "c1"}},
+									},
+								},
+							},
+						},
+					},
+					Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "s"},
+				},
+				Condition: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column1"}},
+					Operator: "=",
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 64}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 64}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "c1"}},
+				},
+				WhenClauses: []MergeWhenClause{
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 69},
+						Operation: Token{Token: INSERT, Literal: "insert", Line: 1, Char: 101},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 115},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 116}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 116}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 118}, Literal: "c1"}},
+									PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 122}, Literal: "x", Value: value.NewString("x")},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -4532,6 +5355,32 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 integer not null, column2 datetime, column3 not null)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "integer"},
+						NotNull:  true,
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 50},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "column2"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "datetime"},
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 68},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 68}, Literal: "column3"},
+						NotNull:  true,
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -4548,10 +5397,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -4572,10 +5421,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -4600,10 +5449,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -4624,10 +5473,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -4636,6 +5485,49 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create index idx1 on table1 (column1)",
+		Output: []Statement{
+			CreateIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "idx1"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table1"},
+				Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column1"},
+			},
+		},
+	},
+	{
+		Input: "drop index idx1 on table1",
+		Output: []Statement{
+			DropIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "idx1"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "select index from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "index"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 add column1",
 		Output: []Statement{
@@ -4656,7 +5548,7 @@ var parseTests = []struct {
 				Table: TableObject{
 					BaseExpr:      &BaseExpr{line: 1, char: 13},
 					Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "csv"},
-					FormatElement: NewStringValue(","),
+					FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: ",", Value: value.NewString(",")},
 					Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table1"},
 				},
 				Columns: []ColumnDefault{
@@ -4678,7 +5570,7 @@ var parseTests = []struct {
 					},
 					{
 						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"},
-						Value:  NewIntegerValueFromString("1"),
+						Value:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					},
 				},
 				Position: ColumnPosition{
@@ -4786,7 +5678,7 @@ var parseTests = []struct {
 				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Table:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
 				Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "format"},
-				Value:     NewStringValue("json"),
+				Value:     PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "json", Value: value.NewString("json")},
 			},
 		},
 	},
@@ -4819,11 +5711,38 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "savepoint sp1",
+		Output: []Statement{
+			Savepoint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "sp1"},
+			},
+		},
+	},
+	{
+		Input: "rollback to savepoint sp1",
+		Output: []Statement{
+			RollbackToSavepoint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "sp1"},
+			},
+		},
+	},
+	{
+		Input: "release savepoint sp1",
+		Output: []Statement{
+			ReleaseSavepoint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "sp1"},
+			},
+		},
+	},
 	{
 		Input: "echo 'foo'",
 		Output: []Statement{
 			Echo{
-				Value: NewStringValue("foo"),
+				Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "foo", Value: value.NewString("foo")},
 			},
 		},
 	},
@@ -4831,7 +5750,7 @@ var parseTests = []struct {
 		Input: "print 'foo'",
 		Output: []Statement{
 			Print{
-				Value: NewStringValue("foo"),
+				Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "foo", Value: value.NewString("foo")},
 			},
 		},
 	},
@@ -4840,7 +5759,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			Printf{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Format:   NewStringValue("foo"),
+				Format:   PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "foo", Value: value.NewString("foo")},
 			},
 		},
 	},
@@ -4849,9 +5768,9 @@ var parseTests = []struct {
 		Output: []Statement{
 			Printf{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Format:   NewStringValue("foo"),
+				Format:   PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "foo", Value: value.NewString("foo")},
 				Values: []QueryExpression{
-					NewStringValue("bar"),
+					PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "bar", Value: value.NewString("bar")},
 				},
 			},
 		},
@@ -4861,9 +5780,9 @@ var parseTests = []struct {
 		Output: []Statement{
 			Printf{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Format:   NewStringValue("foo"),
+				Format:   PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "foo", Value: value.NewString("foo")},
 				Values: []QueryExpression{
-					NewStringValue("bar"),
+					PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "bar", Value: value.NewString("bar")},
 				},
 			},
 		},
@@ -4882,7 +5801,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			Source{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				FilePath: NewStringValue("/path/to/file.sql"),
+				FilePath: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "/path/to/file.sql", Value: value.NewString("/path/to/file.sql")},
 			},
 		},
 	},
@@ -4891,7 +5810,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			Execute{
 				BaseExpr:   &BaseExpr{line: 1, char: 1},
-				Statements: NewStringValue("select 1"),
+				Statements: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "select 1", Value: value.NewString("select 1")},
 			},
 		},
 	},
@@ -4900,9 +5819,9 @@ var parseTests = []struct {
 		Output: []Statement{
 			Execute{
 				BaseExpr:   &BaseExpr{line: 1, char: 1},
-				Statements: NewStringValue("select %s"),
+				Statements: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "select %s", Value: value.NewString("select %s")},
 				Values: []QueryExpression{
-					NewStringValue("a"),
+					PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "a", Value: value.NewString("a")},
 				},
 			},
 		},
@@ -4940,7 +5859,7 @@ var parseTests = []struct {
 		Output: []Statement{
 			Chdir{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
-				DirPath:  NewStringValue("dirpath"),
+				DirPath:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "dirpath", Value: value.NewString("dirpath")},
 			},
 		},
 	},
@@ -4967,7 +5886,7 @@ var parseTests = []struct {
 			SetFlag{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Flag:     Flag{BaseExpr: &BaseExpr{line: 1, char: 5}, Name: "delimiter"},
-				Value:    NewStringValue(","),
+				Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: ",", Value: value.NewString(",")},
 			},
 		},
 	},
@@ -4987,7 +5906,7 @@ var parseTests = []struct {
 			SetFlag{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Flag:     Flag{BaseExpr: &BaseExpr{line: 1, char: 5}, Name: "delimiter"},
-				Value:    NewStringValue(","),
+				Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: ",", Value: value.NewString(",")},
 			},
 		},
 	},
@@ -5007,7 +5926,7 @@ var parseTests = []struct {
 			AddFlagElement{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Flag:     Flag{BaseExpr: &BaseExpr{line: 1, char: 17}, Name: "datetime_format"},
-				Value:    NewStringValue("%Y%m%d"),
+				Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 5}, Literal: "%Y%m%d", Value: value.NewString("%Y%m%d")},
 			},
 		},
 	},
@@ -5017,7 +5936,7 @@ var parseTests = []struct {
 			RemoveFlagElement{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Flag:     Flag{BaseExpr: &BaseExpr{line: 1, char: 22}, Name: "datetime_format"},
-				Value:    NewStringValue("%Y%m%d"),
+				Value:    PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "%Y%m%d", Value: value.NewString("%Y%m%d")},
 			},
 		},
 	},
@@ -5030,6 +5949,67 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select 1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze select 1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  true,
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select analyze from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "analyze"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "show tables",
 		Output: []Statement{
@@ -5058,7 +6038,7 @@ var parseTests = []struct {
 				Table: TableObject{
 					BaseExpr:      &BaseExpr{line: 1, char: 18},
 					Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "csv"},
-					FormatElement: NewStringValue(","),
+					FormatElement: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: ",", Value: value.NewString(",")},
 					Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table1"},
 				},
 			},
@@ -5079,7 +6059,7 @@ var parseTests = []struct {
 			Trigger{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
-				Message:  NewStringValue("user error"),
+				Message:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "user error", Value: value.NewString("user error")},
 			},
 		},
 	},
@@ -5089,7 +6069,7 @@ var parseTests = []struct {
 			Trigger{
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "error"},
-				Message:  NewStringValue("user error"),
+				Message:  PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "user error", Value: value.NewString("user error")},
 				Code:     value.NewInteger(300),
 			},
 		},
@@ -5105,7 +6085,7 @@ var parseTests = []struct {
 							BaseExpr: &BaseExpr{line: 1, char: 24},
 							Select:   "select",
 							Fields: []QueryExpression{
-								Field{Object: NewIntegerValueFromString("1")},
+								Field{Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 							},
 						},
 					},
@@ -5136,8 +6116,8 @@ var parseTests = []struct {
 			OpenCursor{
 				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "cur"},
 				Values: []ReplaceValue{
-					{Value: NewIntegerValueFromString("1")},
-					{Value: NewStringValue("a"), Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "a"}},
+					{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+					{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "a", Value: value.NewString("a")}, Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "a"}},
 				},
 			},
 		},
@@ -5234,7 +6214,7 @@ var parseTests = []struct {
 				Position: FetchPosition{
 					BaseExpr: &BaseExpr{line: 1, char: 7},
 					Position: Token{Token: ABSOLUTE, Literal: "absolute", Line: 1, Char: 7},
-					Number:   NewIntegerValueFromString("1"),
+					Number:   PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "1", Value: value.NewIntegerFromString("1")},
 				},
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"},
@@ -5250,7 +6230,7 @@ var parseTests = []struct {
 				Position: FetchPosition{
 					BaseExpr: &BaseExpr{line: 1, char: 7},
 					Position: Token{Token: RELATIVE, Literal: "relative", Line: 1, Char: 7},
-					Number:   NewIntegerValueFromString("1"),
+					Number:   PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "1", Value: value.NewIntegerFromString("1")},
 				},
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"},
@@ -5286,10 +6266,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -5310,10 +6290,10 @@ var parseTests = []struct {
 							Select:   "select",
 							Fields: []QueryExpression{
 								Field{
-									Object: NewIntegerValueFromString("1"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "1", Value: value.NewIntegerFromString("1")},
 								},
 								Field{
-									Object: NewIntegerValueFromString("2"),
+									Object: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "2", Value: value.NewIntegerFromString("2")},
 								},
 							},
 						},
@@ -5355,8 +6335,8 @@ var parseTests = []struct {
 				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "stmt"},
 				Values: []ReplaceValue{
-					{Value: NewStringValue("a")},
-					{Value: NewIntegerValueFromString("1"), Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "val"}},
+					{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "a", Value: value.NewString("a")}},
+					{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "1", Value: value.NewIntegerFromString("1")}, Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "val"}},
 				},
 			},
 		},
@@ -5375,11 +6355,11 @@ var parseTests = []struct {
 			If{
 				Condition: Comparison{
 					LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 4}, Name: "var1"},
-					RHS:      NewIntegerValueFromString("1"),
+					RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					Operator: "=",
 				},
 				Statements: []Statement{
-					Print{Value: NewIntegerValueFromString("1")},
+					Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 				},
 			},
 		},
@@ -5390,37 +6370,37 @@ var parseTests = []struct {
 			If{
 				Condition: Comparison{
 					LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 4}, Name: "var1"},
-					RHS:      NewIntegerValueFromString("1"),
+					RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "1", Value: value.NewIntegerFromString("1")},
 					Operator: "=",
 				},
 				Statements: []Statement{
-					Print{Value: NewIntegerValueFromString("1")},
+					Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 				},
 				ElseIf: []ElseIf{
 					{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 35}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("2"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "2", Value: value.NewIntegerFromString("2")},
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("2")},
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 						},
 					},
 					{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 66}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("3"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 74}, Literal: "3", Value: value.NewIntegerFromString("3")},
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("3")},
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "3", Value: value.NewIntegerFromString("3")}},
 						},
 					},
 				},
 				Else: Else{
 					Statements: []Statement{
-						Print{Value: NewIntegerValueFromString("4")},
+						Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 101}, Literal: "4", Value: value.NewIntegerFromString("4")}},
 					},
 				},
 			},
@@ -5437,6 +6417,94 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "begin print 1; exception when 1, 4 then print 2; when any then print 3; end",
+		Output: []Statement{
+			ExceptionBlock{
+				Statements: []Statement{
+					Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+				},
+				Handlers: []ExceptionHandler{
+					{
+						Codes: []value.Primary{value.NewInteger(1), value.NewInteger(4)},
+						Statements: []Statement{
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "2", Value: value.NewIntegerFromString("2")}},
+						},
+					},
+					{
+						Statements: []Statement{
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "3", Value: value.NewIntegerFromString("3")}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "while true do begin print 1; exception when any then continue; end; end while",
+		Output: []Statement{
+			While{
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
+				Statements: []Statement{
+					ExceptionBlock{
+						Statements: []Statement{
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+						},
+						Handlers: []ExceptionHandler{
+							{
+								Statements: []Statement{
+									FlowControl{Token: CONTINUE},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin " +
+			"begin return 1; exception when any then return; end; " +
+			"while true do begin break; exception when 64 then return; end; end while; " +
+			"end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					ExceptionBlock{
+						Statements: []Statement{
+							Return{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "1", Value: value.NewIntegerFromString("1")}},
+						},
+						Handlers: []ExceptionHandler{
+							{
+								Statements: []Statement{
+									Return{Value: NewNullValue()},
+								},
+							},
+						},
+					},
+					While{
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 95}, Literal: "true", Value: value.NewTernaryFromString("true")},
+						Statements: []Statement{
+							ExceptionBlock{
+								Statements: []Statement{
+									FlowControl{Token: BREAK},
+								},
+								Handlers: []ExceptionHandler{
+									{
+										Codes: []value.Primary{value.NewInteger(64)},
+										Statements: []Statement{
+											Return{Value: NewNullValue()},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "while @var1 in cur do print @var1; end while",
 		Output: []Statement{
@@ -5503,13 +6571,13 @@ var parseTests = []struct {
 			Case{
 				When: []CaseWhen{
 					{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
 						},
 					},
 					{
-						Condition: NewTernaryValueFromString("false"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "false", Value: value.NewTernaryFromString("false")},
 						Statements: []Statement{
 							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
 						},
@@ -5524,13 +6592,13 @@ var parseTests = []struct {
 			Case{
 				When: []CaseWhen{
 					{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "var1"}},
 						},
 					},
 					{
-						Condition: NewTernaryValueFromString("false"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "false", Value: value.NewTernaryFromString("false")},
 						Statements: []Statement{
 							Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 56}, Name: "var2"}},
 						},
@@ -5560,7 +6628,7 @@ var parseTests = []struct {
 		Input: "while true do print @var1; continue; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 21}, Name: "var1"}},
 					FlowControl{Token: CONTINUE},
@@ -5572,7 +6640,7 @@ var parseTests = []struct {
 		Input: "while true do break; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					FlowControl{Token: BREAK},
 				},
@@ -5583,7 +6651,7 @@ var parseTests = []struct {
 		Input: "while true do exit; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					Exit{},
 				},
@@ -5594,12 +6662,12 @@ var parseTests = []struct {
 		Input: "while true do if @var1 = 1 then continue; end if; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					If{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 18}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: "=",
 						},
 						Statements: []Statement{
@@ -5614,12 +6682,12 @@ var parseTests = []struct {
 		Input: "while true do if @var1 = 1 then continue; elseif @var1 = 2 then break; elseif @var1 = 3 then exit; else continue; end if; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					If{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 18}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: "=",
 						},
 						Statements: []Statement{
@@ -5629,7 +6697,7 @@ var parseTests = []struct {
 							{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 50}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("2"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "2", Value: value.NewIntegerFromString("2")},
 									Operator: "=",
 								},
 								Statements: []Statement{
@@ -5639,7 +6707,7 @@ var parseTests = []struct {
 							{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 1, char: 79}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("3"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "3", Value: value.NewIntegerFromString("3")},
 									Operator: "=",
 								},
 								Statements: []Statement{
@@ -5661,18 +6729,18 @@ var parseTests = []struct {
 		Input: "while true do case when true then print @var1; when false then continue; end case; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					Case{
 						When: []CaseWhen{
 							{
-								Condition: NewTernaryValueFromString("true"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Statements: []Statement{
 									Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
 								},
 							},
 							{
-								Condition: NewTernaryValueFromString("false"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "false", Value: value.NewTernaryFromString("false")},
 								Statements: []Statement{
 									FlowControl{Token: CONTINUE},
 								},
@@ -5687,18 +6755,18 @@ var parseTests = []struct {
 		Input: "while true do case when true then print @var1; when false then exit; else continue; end case; end while",
 		Output: []Statement{
 			While{
-				Condition: NewTernaryValueFromString("true"),
+				Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 				Statements: []Statement{
 					Case{
 						When: []CaseWhen{
 							{
-								Condition: NewTernaryValueFromString("true"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Statements: []Statement{
 									Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 41}, Name: "var1"}},
 								},
 							},
 							{
-								Condition: NewTernaryValueFromString("false"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "false", Value: value.NewTernaryFromString("false")},
 								Statements: []Statement{
 									Exit{},
 								},
@@ -5728,8 +6796,8 @@ var parseTests = []struct {
 			FunctionDeclaration{
 				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
 				Parameters: []VariableAssignment{
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}, Value: NewIntegerValueFromString("0")},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 42}, Name: "arg2"}, Value: NewIntegerValueFromString("1")},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "0", Value: value.NewIntegerFromString("0")}},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 42}, Name: "arg2"}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 				},
 			},
 		},
@@ -5755,69 +6823,69 @@ var parseTests = []struct {
 				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
 				Parameters: []VariableAssignment{
 					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: NewIntegerValueFromString("0")},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "0", Value: value.NewIntegerFromString("0")}},
 				},
 				Statements: []Statement{
 					If{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 2, char: 4}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 2, char: 12}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("1")},
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 2, char: 25}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 						},
 					},
 					If{
 						Condition: Comparison{
 							LHS:      Variable{BaseExpr: &BaseExpr{line: 3, char: 4}, Name: "var1"},
-							RHS:      NewIntegerValueFromString("1"),
+							RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 12}, Literal: "1", Value: value.NewIntegerFromString("1")},
 							Operator: "=",
 						},
 						Statements: []Statement{
-							Print{Value: NewIntegerValueFromString("1")},
+							Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 25}, Literal: "1", Value: value.NewIntegerFromString("1")}},
 						},
 						ElseIf: []ElseIf{
 							{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 3, char: 35}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("2"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 43}, Literal: "2", Value: value.NewIntegerFromString("2")},
 									Operator: "=",
 								},
 								Statements: []Statement{
-									Print{Value: NewIntegerValueFromString("2")},
+									Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 56}, Literal: "2", Value: value.NewIntegerFromString("2")}},
 								},
 							},
 							{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 3, char: 66}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("3"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 74}, Literal: "3", Value: value.NewIntegerFromString("3")},
 									Operator: "=",
 								},
 								Statements: []Statement{
-									Print{Value: NewIntegerValueFromString("3")},
+									Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 87}, Literal: "3", Value: value.NewIntegerFromString("3")}},
 								},
 							},
 						},
 						Else: Else{
 							Statements: []Statement{
-								Print{Value: NewIntegerValueFromString("4")},
+								Print{Value: PrimitiveType{BaseExpr: &BaseExpr{line: 3, char: 101}, Literal: "4", Value: value.NewIntegerFromString("4")}},
 							},
 						},
 					},
 					While{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 4, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							FlowControl{Token: BREAK},
 						},
 					},
 					While{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 5, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							If{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 5, char: 18}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("1"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 5, char: 26}, Literal: "1", Value: value.NewIntegerFromString("1")},
 									Operator: "=",
 								},
 								Statements: []Statement{
@@ -5827,12 +6895,12 @@ var parseTests = []struct {
 						},
 					},
 					While{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 6, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							If{
 								Condition: Comparison{
 									LHS:      Variable{BaseExpr: &BaseExpr{line: 6, char: 18}, Name: "var1"},
-									RHS:      NewIntegerValueFromString("1"),
+									RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 6, char: 26}, Literal: "1", Value: value.NewIntegerFromString("1")},
 									Operator: "=",
 								},
 								Statements: []Statement{
@@ -5842,7 +6910,7 @@ var parseTests = []struct {
 									{
 										Condition: Comparison{
 											LHS:      Variable{BaseExpr: &BaseExpr{line: 6, char: 50}, Name: "var1"},
-											RHS:      NewIntegerValueFromString("2"),
+											RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 6, char: 58}, Literal: "2", Value: value.NewIntegerFromString("2")},
 											Operator: "=",
 										},
 										Statements: []Statement{
@@ -5852,7 +6920,7 @@ var parseTests = []struct {
 									{
 										Condition: Comparison{
 											LHS:      Variable{BaseExpr: &BaseExpr{line: 6, char: 79}, Name: "var1"},
-											RHS:      NewIntegerValueFromString("3"),
+											RHS:      PrimitiveType{BaseExpr: &BaseExpr{line: 6, char: 87}, Literal: "3", Value: value.NewIntegerFromString("3")},
 											Operator: "=",
 										},
 										Statements: []Statement{
@@ -5890,13 +6958,13 @@ var parseTests = []struct {
 					Case{
 						When: []CaseWhen{
 							{
-								Condition: NewTernaryValueFromString("true"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 9, char: 11}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Statements: []Statement{
 									Print{Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 27}, Name: "var1"}},
 								},
 							},
 							{
-								Condition: NewTernaryValueFromString("false"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 9, char: 39}, Literal: "false", Value: value.NewTernaryFromString("false")},
 								Statements: []Statement{
 									Print{Value: Variable{BaseExpr: &BaseExpr{line: 9, char: 56}, Name: "var2"}},
 								},
@@ -5906,13 +6974,13 @@ var parseTests = []struct {
 					Case{
 						When: []CaseWhen{
 							{
-								Condition: NewTernaryValueFromString("true"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 10, char: 11}, Literal: "true", Value: value.NewTernaryFromString("true")},
 								Statements: []Statement{
 									Print{Value: Variable{BaseExpr: &BaseExpr{line: 10, char: 27}, Name: "var1"}},
 								},
 							},
 							{
-								Condition: NewTernaryValueFromString("false"),
+								Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 10, char: 39}, Literal: "false", Value: value.NewTernaryFromString("false")},
 								Statements: []Statement{
 									Return{Value: NewNullValue()},
 								},
@@ -5925,18 +6993,18 @@ var parseTests = []struct {
 						},
 					},
 					While{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 11, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							Case{
 								When: []CaseWhen{
 									{
-										Condition: NewTernaryValueFromString("true"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 11, char: 25}, Literal: "true", Value: value.NewTernaryFromString("true")},
 										Statements: []Statement{
 											Print{Value: Variable{BaseExpr: &BaseExpr{line: 11, char: 41}, Name: "var1"}},
 										},
 									},
 									{
-										Condition: NewTernaryValueFromString("false"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 11, char: 53}, Literal: "false", Value: value.NewTernaryFromString("false")},
 										Statements: []Statement{
 											FlowControl{Token: CONTINUE},
 										},
@@ -5946,18 +7014,18 @@ var parseTests = []struct {
 						},
 					},
 					While{
-						Condition: NewTernaryValueFromString("true"),
+						Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 12, char: 7}, Literal: "true", Value: value.NewTernaryFromString("true")},
 						Statements: []Statement{
 							Case{
 								When: []CaseWhen{
 									{
-										Condition: NewTernaryValueFromString("true"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 12, char: 25}, Literal: "true", Value: value.NewTernaryFromString("true")},
 										Statements: []Statement{
 											Print{Value: Variable{BaseExpr: &BaseExpr{line: 12, char: 41}, Name: "var1"}},
 										},
 									},
 									{
-										Condition: NewTernaryValueFromString("false"),
+										Condition: PrimitiveType{BaseExpr: &BaseExpr{line: 12, char: 53}, Literal: "false", Value: value.NewTernaryFromString("false")},
 										Statements: []Statement{
 											Return{Value: NewNullValue()},
 										},
@@ -5991,7 +7059,7 @@ var parseTests = []struct {
 				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
 				Parameters: []VariableAssignment{
 					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "arg1"}},
-					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: NewIntegerValueFromString("0")},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 32}, Name: "arg2"}, Value: PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "0", Value: value.NewIntegerFromString("0")}},
 				},
 				Statements: []Statement{
 					WhileInCursor{
@@ -6343,7 +7411,7 @@ var parseTests = []struct {
 	{
 		Input: "'abc'",
 		Output: []Statement{
-			NewStringValue("abc"),
+			PrimitiveType{BaseExpr: &BaseExpr{line: 1, char: 1}, Literal: "abc", Value: value.NewString("abc")},
 		},
 	},
 	{
@@ -6414,6 +7482,12 @@ var parseTests = []struct {
 		ErrorLine: 1,
 		ErrorChar: 14,
 	},
+	{
+		Input:     "select interval '3 dayz'",
+		Error:     "invalid interval '3 dayz'",
+		ErrorLine: 1,
+		ErrorChar: 17,
+	},
 	{
 		Input:     "select 1 < 2 < 3",
 		Error:     "syntax error: unexpected token \"<\"",
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -37,6 +38,9 @@ const (
 	EndExpression   = '}'
 )
 
+// FileColumn is the pseudo-column that holds the source file path of each record in a table of multiple files.
+const FileColumn = "@__file"
+
 var comparisonOperators = []string{
 	">",
 	"<",
@@ -65,6 +69,9 @@ var aggregateFunctions = []string{
 var listFunctions = []string{
 	"LISTAGG",
 	"JSON_AGG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"MODE",
 }
 
 var analyticFunctions = []string{
@@ -210,6 +217,20 @@ func (s *Scanner) Scan() (Token, error) {
 				}
 				return Token{Token: PLACEHOLDER, Literal: holderName, HolderOrdinal: s.holderOrdinal, Line: line, Char: char, SourceFile: s.sourceFile}, err
 			}
+		case ExternalCommandSign:
+			if s.isDecimal(s.peek()) {
+				s.literal.Reset()
+				s.literal.WriteRune(ch)
+				for s.isDecimal(s.peek()) {
+					s.literal.WriteRune(s.next())
+				}
+				literal = s.literal.String()
+				ordinal, _ := strconv.Atoi(literal[1:])
+				if s.holderNumber < ordinal {
+					s.holderNumber = ordinal
+				}
+				return Token{Token: PLACEHOLDER, Literal: literal, HolderOrdinal: ordinal, Line: line, Char: char, SourceFile: s.sourceFile}, err
+			}
 		}
 	}
 
@@ -281,6 +302,9 @@ func (s *Scanner) Scan() (Token, error) {
 
 		if len(literal) < 1 {
 			err = errors.New("invalid variable symbol")
+		} else if token == VARIABLE && strings.EqualFold(string(VariableSign)+literal, FileColumn) {
+			token = IDENTIFIER
+			literal = FileColumn
 		}
 	case ch == ExternalCommandSign:
 		s.scanExternalCommand()
@@ -196,6 +196,24 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "File Pseudo-Column",
+		Input: "t.@__FILE",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "t",
+			},
+			{
+				Token:   '.',
+				Literal: ".",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "@__file",
+			},
+		},
+	},
 	{
 		Name:  "Environment Variable",
 		Input: "@%var",
@@ -550,6 +568,28 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:        "Numbered Placeholders",
+		Input:       "$2 $1 $2",
+		ForPrepared: true,
+		Output: []scanResult{
+			{
+				Token:         PLACEHOLDER,
+				Literal:       "$2",
+				HolderOrdinal: 2,
+			},
+			{
+				Token:         PLACEHOLDER,
+				Literal:       "$1",
+				HolderOrdinal: 1,
+			},
+			{
+				Token:         PLACEHOLDER,
+				Literal:       "$2",
+				HolderOrdinal: 2,
+			},
+		},
+	},
 	{
 		Name:        "Placeholder Disabled",
 		Input:       "?",
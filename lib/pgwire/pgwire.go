@@ -0,0 +1,399 @@
+// Package pgwire implements the messages of the PostgreSQL frontend/backend protocol version 3.
+package pgwire
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	ProtocolVersion   = 196608
+	SSLRequestCode    = 80877103
+	GSSENCRequestCode = 80877104
+	CancelRequestCode = 80877102
+)
+
+const MaxMessageLength = 1 << 30
+
+// Message types sent by frontends.
+const (
+	QueryMessage     byte = 'Q'
+	ParseMessage     byte = 'P'
+	BindMessage      byte = 'B'
+	DescribeMessage  byte = 'D'
+	ExecuteMessage   byte = 'E'
+	CloseMessage     byte = 'C'
+	SyncMessage      byte = 'S'
+	FlushMessage     byte = 'H'
+	TerminateMessage byte = 'X'
+	PasswordMessage  byte = 'p'
+)
+
+// Message types sent by backends.
+const (
+	AuthenticationMessage        byte = 'R'
+	ParameterStatusMessage       byte = 'S'
+	BackendKeyDataMessage        byte = 'K'
+	ReadyForQueryMessage         byte = 'Z'
+	RowDescriptionMessage        byte = 'T'
+	DataRowMessage               byte = 'D'
+	CommandCompleteMessage       byte = 'C'
+	EmptyQueryResponseMessage    byte = 'I'
+	ErrorResponseMessage         byte = 'E'
+	NoticeResponseMessage        byte = 'N'
+	ParseCompleteMessage         byte = '1'
+	BindCompleteMessage          byte = '2'
+	CloseCompleteMessage         byte = '3'
+	NoDataMessage                byte = 'n'
+	ParameterDescriptionMessage  byte = 't'
+	PortalSuspendedMessage       byte = 's'
+	TransactionStatusIdle        byte = 'I'
+	TransactionStatusInBlock     byte = 'T'
+	TransactionStatusFailedBlock byte = 'E'
+)
+
+const (
+	TextFormat   int16 = 0
+	BinaryFormat int16 = 1
+)
+
+var ErrInvalidMessage = errors.New("invalid message")
+
+// StartupMessage is the first message sent by a frontend.
+// SSLRequest, GSSENCRequest and CancelRequest are also represented by StartupMessage with their request codes as Version.
+type StartupMessage struct {
+	Version    int32
+	Parameters map[string]string
+	ProcessID  int32
+	SecretKey  int32
+}
+
+func ReadStartupMessage(r io.Reader) (*StartupMessage, error) {
+	var length int32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if length < 8 || 10000 < length {
+		return nil, ErrInvalidMessage
+	}
+
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+
+	buf := NewBuffer(body)
+	version, _ := buf.Int32()
+	msg := &StartupMessage{
+		Version: version,
+	}
+
+	switch version {
+	case SSLRequestCode, GSSENCRequestCode:
+	case CancelRequestCode:
+		var err error
+		if msg.ProcessID, err = buf.Int32(); err != nil {
+			return nil, err
+		}
+		if msg.SecretKey, err = buf.Int32(); err != nil {
+			return nil, err
+		}
+	default:
+		if version>>16 != ProtocolVersion>>16 {
+			return nil, fmt.Errorf("unsupported frontend protocol %d.%d", version>>16, version&0xffff)
+		}
+
+		msg.Parameters = make(map[string]string)
+		for {
+			key, err := buf.String()
+			if err != nil {
+				return nil, err
+			}
+			if len(key) < 1 {
+				break
+			}
+			val, err := buf.String()
+			if err != nil {
+				return nil, err
+			}
+			msg.Parameters[key] = val
+		}
+	}
+	return msg, nil
+}
+
+// ReadMessage reads a message and returns its type and body.
+func ReadMessage(r io.Reader) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+
+	length := int32(binary.BigEndian.Uint32(header[1:]))
+	if length < 4 || MaxMessageLength < length {
+		return 0, nil, ErrInvalidMessage
+	}
+
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header[0], body, nil
+}
+
+// Buffer reads values from the body of a message.
+type Buffer struct {
+	b   []byte
+	pos int
+}
+
+func NewBuffer(b []byte) *Buffer {
+	return &Buffer{
+		b: b,
+	}
+}
+
+func (b *Buffer) Byte() (byte, error) {
+	if len(b.b) < b.pos+1 {
+		return 0, ErrInvalidMessage
+	}
+	c := b.b[b.pos]
+	b.pos++
+	return c, nil
+}
+
+func (b *Buffer) Int16() (int16, error) {
+	if len(b.b) < b.pos+2 {
+		return 0, ErrInvalidMessage
+	}
+	i := int16(binary.BigEndian.Uint16(b.b[b.pos:]))
+	b.pos += 2
+	return i, nil
+}
+
+func (b *Buffer) Int32() (int32, error) {
+	if len(b.b) < b.pos+4 {
+		return 0, ErrInvalidMessage
+	}
+	i := int32(binary.BigEndian.Uint32(b.b[b.pos:]))
+	b.pos += 4
+	return i, nil
+}
+
+// String reads a null-terminated string.
+func (b *Buffer) String() (string, error) {
+	for i := b.pos; i < len(b.b); i++ {
+		if b.b[i] == 0 {
+			s := string(b.b[b.pos:i])
+			b.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", ErrInvalidMessage
+}
+
+func (b *Buffer) Bytes(n int) ([]byte, error) {
+	if n < 0 || len(b.b) < b.pos+n {
+		return nil, ErrInvalidMessage
+	}
+	p := b.b[b.pos : b.pos+n]
+	b.pos += n
+	return p, nil
+}
+
+// FieldDescription is a field in a RowDescription message.
+type FieldDescription struct {
+	Name         string
+	TableOID     int32
+	ColumnNumber int16
+	TypeOID      int32
+	TypeSize     int16
+	TypeModifier int32
+	Format       int16
+}
+
+// Writer writes messages to a buffered writer.
+// A message is written by Begin, the methods that write values, and End.
+// Once an error occurs, Err returns the error and no more messages are written.
+type Writer struct {
+	w   *bufio.Writer
+	buf []byte
+	err error
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w:   bufio.NewWriter(w),
+		buf: make([]byte, 0, 1024),
+	}
+}
+
+func (w *Writer) Begin(t byte) {
+	w.buf = append(w.buf[:0], t, 0, 0, 0, 0)
+}
+
+func (w *Writer) WriteByte(c byte) error {
+	w.buf = append(w.buf, c)
+	return nil
+}
+
+func (w *Writer) WriteInt16(i int16) {
+	w.buf = append(w.buf, byte(i>>8), byte(i))
+}
+
+func (w *Writer) WriteInt32(i int32) {
+	w.buf = append(w.buf, byte(i>>24), byte(i>>16), byte(i>>8), byte(i))
+}
+
+// WriteString writes a null-terminated string.
+func (w *Writer) WriteString(s string) {
+	w.buf = append(w.buf, s...)
+	w.buf = append(w.buf, 0)
+}
+
+func (w *Writer) WriteBytes(p []byte) {
+	w.buf = append(w.buf, p...)
+}
+
+func (w *Writer) End() error {
+	if w.err != nil {
+		return w.err
+	}
+	binary.BigEndian.PutUint32(w.buf[1:5], uint32(len(w.buf)-1))
+	_, w.err = w.w.Write(w.buf)
+	return w.err
+}
+
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = w.w.Flush()
+	return w.err
+}
+
+func (w *Writer) Err() error {
+	return w.err
+}
+
+// WriteRaw writes bytes that are not framed as a message, such as the response to an SSLRequest.
+func (w *Writer) WriteRaw(p []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	if _, w.err = w.w.Write(p); w.err != nil {
+		return w.err
+	}
+	return w.Flush()
+}
+
+func (w *Writer) WriteAuthenticationOk() error {
+	w.Begin(AuthenticationMessage)
+	w.WriteInt32(0)
+	return w.End()
+}
+
+func (w *Writer) WriteAuthenticationMD5Password(salt [4]byte) error {
+	w.Begin(AuthenticationMessage)
+	w.WriteInt32(5)
+	w.WriteBytes(salt[:])
+	return w.End()
+}
+
+// MD5Password returns the hashed password that a frontend sends in response to AuthenticationMD5Password.
+func MD5Password(user string, password string, salt [4]byte) string {
+	h := md5.Sum([]byte(password + user))
+	h = md5.Sum(append([]byte(hex.EncodeToString(h[:])), salt[:]...))
+	return "md5" + hex.EncodeToString(h[:])
+}
+
+func (w *Writer) WriteParameterStatus(name string, value string) error {
+	w.Begin(ParameterStatusMessage)
+	w.WriteString(name)
+	w.WriteString(value)
+	return w.End()
+}
+
+func (w *Writer) WriteBackendKeyData(processID int32, secretKey int32) error {
+	w.Begin(BackendKeyDataMessage)
+	w.WriteInt32(processID)
+	w.WriteInt32(secretKey)
+	return w.End()
+}
+
+func (w *Writer) WriteReadyForQuery(status byte) error {
+	w.Begin(ReadyForQueryMessage)
+	_ = w.WriteByte(status)
+	return w.End()
+}
+
+func (w *Writer) WriteRowDescription(fields []FieldDescription) error {
+	w.Begin(RowDescriptionMessage)
+	w.WriteInt16(int16(len(fields)))
+	for _, f := range fields {
+		w.WriteString(f.Name)
+		w.WriteInt32(f.TableOID)
+		w.WriteInt16(f.ColumnNumber)
+		w.WriteInt32(f.TypeOID)
+		w.WriteInt16(f.TypeSize)
+		w.WriteInt32(f.TypeModifier)
+		w.WriteInt16(f.Format)
+	}
+	return w.End()
+}
+
+// WriteDataRow writes a row. A nil value is written as NULL.
+func (w *Writer) WriteDataRow(values [][]byte) error {
+	w.Begin(DataRowMessage)
+	w.WriteInt16(int16(len(values)))
+	for _, v := range values {
+		if v == nil {
+			w.WriteInt32(-1)
+			continue
+		}
+		w.WriteInt32(int32(len(v)))
+		w.WriteBytes(v)
+	}
+	return w.End()
+}
+
+func (w *Writer) WriteParameterDescription(oids []int32) error {
+	w.Begin(ParameterDescriptionMessage)
+	w.WriteInt16(int16(len(oids)))
+	for _, oid := range oids {
+		w.WriteInt32(oid)
+	}
+	return w.End()
+}
+
+func (w *Writer) WriteCommandComplete(tag string) error {
+	w.Begin(CommandCompleteMessage)
+	w.WriteString(tag)
+	return w.End()
+}
+
+// WriteMessage writes a message that has no body.
+func (w *Writer) WriteMessage(t byte) error {
+	w.Begin(t)
+	return w.End()
+}
+
+// WriteErrorResponse writes an ErrorResponse or a NoticeResponse message.
+func (w *Writer) WriteErrorResponse(t byte, severity string, code string, message string) error {
+	w.Begin(t)
+	_ = w.WriteByte('S')
+	w.WriteString(severity)
+	_ = w.WriteByte('V')
+	w.WriteString(severity)
+	_ = w.WriteByte('C')
+	w.WriteString(code)
+	_ = w.WriteByte('M')
+	w.WriteString(message)
+	_ = w.WriteByte(0)
+	return w.End()
+}
@@ -0,0 +1,123 @@
+package pgwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+var readStartupMessageTests = []struct {
+	Name   string
+	Input  []byte
+	Result *StartupMessage
+	Error  string
+}{
+	{
+		Name:  "Startup Message",
+		Input: []byte("\x00\x00\x00\x13\x00\x03\x00\x00user\x00csvq\x00\x00"),
+		Result: &StartupMessage{
+			Version:    ProtocolVersion,
+			Parameters: map[string]string{"user": "csvq"},
+		},
+	},
+	{
+		Name:  "SSL Request",
+		Input: []byte("\x00\x00\x00\x08\x04\xd2\x16\x2f"),
+		Result: &StartupMessage{
+			Version: SSLRequestCode,
+		},
+	},
+	{
+		Name:  "Cancel Request",
+		Input: []byte("\x00\x00\x00\x10\x04\xd2\x16\x2e\x00\x00\x00\x01\x00\x00\x00\x02"),
+		Result: &StartupMessage{
+			Version:   CancelRequestCode,
+			ProcessID: 1,
+			SecretKey: 2,
+		},
+	},
+	{
+		Name:  "Unsupported Protocol",
+		Input: []byte("\x00\x00\x00\x09\x00\x02\x00\x00\x00"),
+		Error: "unsupported frontend protocol 2.0",
+	},
+	{
+		Name:  "Invalid Length",
+		Input: []byte("\x00\x00\x00\x04"),
+		Error: "invalid message",
+	},
+	{
+		Name:  "Unterminated Parameters",
+		Input: []byte("\x00\x00\x00\x0c\x00\x03\x00\x00user"),
+		Error: "invalid message",
+	},
+}
+
+func TestReadStartupMessage(t *testing.T) {
+	for _, v := range readStartupMessageTests {
+		result, err := ReadStartupMessage(bytes.NewReader(v.Input))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf)
+
+	_ = w.WriteAuthenticationMD5Password([4]byte{1, 2, 3, 4})
+	_ = w.WriteRowDescription([]FieldDescription{{Name: "a", TypeOID: Int8OID, TypeSize: 8, TypeModifier: -1}})
+	_ = w.WriteDataRow([][]byte{[]byte("1"), nil})
+	_ = w.WriteCommandComplete("SELECT 1")
+	_ = w.WriteErrorResponse(ErrorResponseMessage, "ERROR", "42601", "syntax error")
+	_ = w.WriteReadyForQuery(TransactionStatusIdle)
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := []struct {
+		Type byte
+		Body []byte
+	}{
+		{Type: AuthenticationMessage, Body: []byte("\x00\x00\x00\x05\x01\x02\x03\x04")},
+		{Type: RowDescriptionMessage, Body: []byte("\x00\x01a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00\x08\xff\xff\xff\xff\x00\x00")},
+		{Type: DataRowMessage, Body: []byte("\x00\x02\x00\x00\x00\x011\xff\xff\xff\xff")},
+		{Type: CommandCompleteMessage, Body: []byte("SELECT 1\x00")},
+		{Type: ErrorResponseMessage, Body: []byte("SERROR\x00VERROR\x00C42601\x00Msyntax error\x00\x00")},
+		{Type: ReadyForQueryMessage, Body: []byte("I")},
+	}
+
+	for _, e := range expect {
+		typ, body, err := ReadMessage(buf)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		if typ != e.Type || !bytes.Equal(body, e.Body) {
+			t.Errorf("message = %c %q, want %c %q", typ, body, e.Type, e.Body)
+		}
+	}
+	if 0 < buf.Len() {
+		t.Errorf("%d bytes remain, want no data", buf.Len())
+	}
+}
+
+func TestMD5Password(t *testing.T) {
+	result := MD5Password("csvq", "secret", [4]byte{1, 2, 3, 4})
+	expect := "md5ad68a6d887f2cb327a76b580559d08ab"
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
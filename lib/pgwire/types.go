@@ -0,0 +1,458 @@
+package pgwire
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// Type OIDs defined in the pg_type catalog.
+const (
+	UnspecifiedOID int32 = 0
+	BoolOID        int32 = 16
+	ByteaOID       int32 = 17
+	CharOID        int32 = 18
+	NameOID        int32 = 19
+	Int8OID        int32 = 20
+	Int2OID        int32 = 21
+	Int4OID        int32 = 23
+	TextOID        int32 = 25
+	JSONOID        int32 = 114
+	Float4OID      int32 = 700
+	Float8OID      int32 = 701
+	UnknownOID     int32 = 705
+	BpcharOID      int32 = 1042
+	VarcharOID     int32 = 1043
+	DateOID        int32 = 1082
+	TimestampOID   int32 = 1114
+	TimestamptzOID int32 = 1184
+	IntervalOID    int32 = 1186
+	NumericOID     int32 = 1700
+)
+
+const timestamptzLayout = "2006-01-02 15:04:05.999999-07:00"
+
+const (
+	numericPositive = 0x0000
+	numericNegative = 0x4000
+	numericNaN      = 0xC000
+)
+
+var postgresEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var errUnsupportedBinaryFormat = errors.New("binary format is not supported")
+
+// TypeOID returns the OID of the type of p. If p is null, then it returns UnspecifiedOID.
+func TypeOID(p value.Primary) int32 {
+	switch p.(type) {
+	case *value.String:
+		return TextOID
+	case *value.Integer:
+		return Int8OID
+	case *value.Float:
+		return Float8OID
+	case *value.Decimal:
+		return NumericOID
+	case *value.Boolean, *value.Ternary:
+		return BoolOID
+	case *value.Datetime:
+		return TimestamptzOID
+	case *value.Interval:
+		return IntervalOID
+	}
+	return UnspecifiedOID
+}
+
+func TypeSize(oid int32) int16 {
+	switch oid {
+	case BoolOID:
+		return 1
+	case Int2OID:
+		return 2
+	case Int4OID, Float4OID, DateOID:
+		return 4
+	case Int8OID, Float8OID, TimestampOID, TimestamptzOID:
+		return 8
+	case IntervalOID:
+		return 16
+	}
+	return -1
+}
+
+// EncodeValue returns the representation of p in the format of the type. A null is returned as nil.
+func EncodeValue(p value.Primary, oid int32, format int16) ([]byte, error) {
+	if format == BinaryFormat {
+		return encodeBinary(p, oid)
+	}
+
+	s, ok := textOf(p)
+	if !ok {
+		return nil, nil
+	}
+	return []byte(s), nil
+}
+
+func textOf(p value.Primary) (string, bool) {
+	switch v := p.(type) {
+	case *value.Null:
+		return "", false
+	case *value.String:
+		return v.Raw(), true
+	case *value.Integer:
+		return strconv.FormatInt(v.Raw(), 10), true
+	case *value.Float:
+		return formatFloat(v.Raw()), true
+	case *value.Decimal:
+		return v.String(), true
+	case *value.Boolean:
+		if v.Raw() {
+			return "t", true
+		}
+		return "f", true
+	case *value.Ternary:
+		switch v.Ternary() {
+		case ternary.TRUE:
+			return "t", true
+		case ternary.FALSE:
+			return "f", true
+		}
+		return "", false
+	case *value.Datetime:
+		return v.Raw().Format(timestamptzLayout), true
+	case *value.Interval:
+		return formatInterval(v), true
+	}
+	return p.String(), true
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func formatInterval(iv *value.Interval) string {
+	s := make([]string, 0, 4)
+	unit := func(n int64, singular string, plural string) string {
+		if n == 1 || n == -1 {
+			return strconv.FormatInt(n, 10) + " " + singular
+		}
+		return strconv.FormatInt(n, 10) + " " + plural
+	}
+
+	if years := iv.Months() / 12; years != 0 {
+		s = append(s, unit(years, "year", "years"))
+	}
+	if months := iv.Months() % 12; months != 0 {
+		s = append(s, unit(months, "mon", "mons"))
+	}
+	if iv.Days() != 0 {
+		s = append(s, unit(iv.Days(), "day", "days"))
+	}
+	if iv.Nanoseconds() != 0 || len(s) < 1 {
+		lit := value.NewInterval(0, 0, iv.Nanoseconds()/1000*1000).Literal()
+		s = append(s, lit)
+	}
+	return strings.Join(s, " ")
+}
+
+func encodeBinary(p value.Primary, oid int32) ([]byte, error) {
+	if value.IsNull(p) || (oid == BoolOID && value.IsUnknown(p)) {
+		return nil, nil
+	}
+
+	var b []byte
+	switch oid {
+	case Int8OID:
+		i, ok := value.ToInteger(p).(*value.Integer)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be encoded as bigint", p.String())
+		}
+		b = make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(i.Raw()))
+	case Float8OID:
+		f, ok := value.ToFloat(p).(*value.Float)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be encoded as double precision", p.String())
+		}
+		b = make([]byte, 8)
+		binary.BigEndian.PutUint64(b, math.Float64bits(f.Raw()))
+	case BoolOID:
+		b = []byte{0}
+		if p.Ternary() == ternary.TRUE {
+			b[0] = 1
+		}
+	case NumericOID:
+		d, ok := value.ToDecimal(p).(*value.Decimal)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be encoded as numeric", p.String())
+		}
+		b = encodeNumeric(d.String())
+	case TimestamptzOID:
+		dt, ok := p.(*value.Datetime)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be encoded as timestamp with time zone", p.String())
+		}
+		b = make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(dt.Raw().Sub(postgresEpoch)/time.Microsecond))
+	case IntervalOID:
+		iv, ok := p.(*value.Interval)
+		if !ok {
+			return nil, fmt.Errorf("%s cannot be encoded as interval", p.String())
+		}
+		b = make([]byte, 16)
+		binary.BigEndian.PutUint64(b, uint64(iv.Nanoseconds()/1000))
+		binary.BigEndian.PutUint32(b[8:], uint32(iv.Days()))
+		binary.BigEndian.PutUint32(b[12:], uint32(iv.Months()))
+	case TextOID, VarcharOID, BpcharOID, NameOID, CharOID, UnknownOID, JSONOID, UnspecifiedOID:
+		s, _ := textOf(p)
+		b = []byte(s)
+	default:
+		return nil, errUnsupportedBinaryFormat
+	}
+	return b, nil
+}
+
+// encodeNumeric encodes a decimal string in the binary format of the numeric type.
+func encodeNumeric(s string) []byte {
+	sign := numericPositive
+	if s[0] == '-' {
+		sign = numericNegative
+		s = s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); -1 < i {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	dscale := len(fracPart)
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if r := len(intPart) % 4; r != 0 {
+		intPart = strings.Repeat("0", 4-r) + intPart
+	}
+	if r := len(fracPart) % 4; r != 0 {
+		fracPart = fracPart + strings.Repeat("0", 4-r)
+	}
+
+	digits := make([]int16, 0, (len(intPart)+len(fracPart))/4)
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i += 4 {
+			d, _ := strconv.Atoi(part[i : i+4])
+			digits = append(digits, int16(d))
+		}
+	}
+
+	weight := len(intPart)/4 - 1
+	for 0 < len(digits) && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for 0 < len(digits) && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) < 1 {
+		weight = 0
+		sign = numericPositive
+	}
+
+	b := make([]byte, 8+len(digits)*2)
+	binary.BigEndian.PutUint16(b[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(b[2:], uint16(int16(weight)))
+	binary.BigEndian.PutUint16(b[4:], uint16(sign))
+	binary.BigEndian.PutUint16(b[6:], uint16(dscale))
+	for i, d := range digits {
+		binary.BigEndian.PutUint16(b[8+i*2:], uint16(d))
+	}
+	return b
+}
+
+// decodeNumeric decodes the binary format of the numeric type to a decimal string.
+func decodeNumeric(b []byte) (string, error) {
+	buf := NewBuffer(b)
+	ndigits, err := buf.Int16()
+	if err != nil {
+		return "", err
+	}
+	weight, _ := buf.Int16()
+	sign, _ := buf.Int16()
+	dscale, err := buf.Int16()
+	if err != nil {
+		return "", err
+	}
+	if uint16(sign) == numericNaN {
+		return "", errors.New("NaN cannot be converted to a decimal")
+	}
+
+	digits := make([]int16, ndigits)
+	for i := range digits {
+		if digits[i], err = buf.Int16(); err != nil {
+			return "", err
+		}
+	}
+	digit := func(i int) int16 {
+		if 0 <= i && i < len(digits) {
+			return digits[i]
+		}
+		return 0
+	}
+
+	var sb strings.Builder
+	if uint16(sign) == numericNegative {
+		sb.WriteByte('-')
+	}
+	if weight < 0 {
+		sb.WriteByte('0')
+	}
+	for i := 0; i <= int(weight); i++ {
+		if i == 0 {
+			sb.WriteString(strconv.Itoa(int(digit(i))))
+		} else {
+			sb.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+	}
+	if 0 < dscale {
+		var frac strings.Builder
+		for i := int(weight) + 1; frac.Len() < int(dscale); i++ {
+			frac.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+	return sb.String(), nil
+}
+
+// DecodeValue returns the value represented in the format of the type. A nil is decoded as a null.
+func DecodeValue(b []byte, oid int32, format int16) (value.Primary, error) {
+	if b == nil {
+		return value.NewNull(), nil
+	}
+	if format == BinaryFormat {
+		return decodeBinary(b, oid)
+	}
+
+	s := string(b)
+	var p value.Primary
+	switch oid {
+	case Int2OID, Int4OID, Int8OID:
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			p = value.NewInteger(i)
+		}
+	case Float4OID, Float8OID:
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			p = value.NewFloat(f)
+		}
+	case NumericOID:
+		if d := value.ToDecimal(value.NewString(s)); !value.IsNull(d) {
+			p = d
+		}
+	case BoolOID:
+		if t, err := ternary.ConvertFromString(s); err == nil && t != ternary.UNKNOWN {
+			p = value.NewBoolean(t == ternary.TRUE)
+		} else if s == "t" || s == "f" {
+			p = value.NewBoolean(s == "t")
+		}
+	case DateOID, TimestampOID, TimestamptzOID:
+		if t, ok := parseTimestamp(s); ok {
+			p = value.NewDatetime(t)
+		}
+	case IntervalOID:
+		if iv, ok := value.StrToInterval(s); ok {
+			p = iv
+		}
+	default:
+		p = value.NewString(s)
+	}
+
+	if p == nil {
+		return nil, fmt.Errorf("invalid input syntax for type oid %d: %q", oid, s)
+	}
+	return p, nil
+}
+
+// parseTimestamp parses a timestamp in the output format of PostgreSQL such as "2012-02-03 09:18:15.123+09",
+// or in the formats that csvq recognizes.
+func parseTimestamp(s string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02 15:04:05.999999999Z07:00", "2006-01-02 15:04:05.999999999Z07"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.In(cmd.GetLocation()), true
+		}
+	}
+	return value.StrToTime(s, nil)
+}
+
+func decodeBinary(b []byte, oid int32) (value.Primary, error) {
+	buf := NewBuffer(b)
+
+	switch oid {
+	case Int2OID:
+		if i, err := buf.Int16(); err == nil && len(b) == 2 {
+			return value.NewInteger(int64(i)), nil
+		}
+	case Int4OID:
+		if i, err := buf.Int32(); err == nil && len(b) == 4 {
+			return value.NewInteger(int64(i)), nil
+		}
+	case Int8OID:
+		if len(b) == 8 {
+			return value.NewInteger(int64(binary.BigEndian.Uint64(b))), nil
+		}
+	case Float4OID:
+		if len(b) == 4 {
+			return value.NewFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(b)))), nil
+		}
+	case Float8OID:
+		if len(b) == 8 {
+			return value.NewFloat(math.Float64frombits(binary.BigEndian.Uint64(b))), nil
+		}
+	case BoolOID:
+		if len(b) == 1 {
+			return value.NewBoolean(b[0] != 0), nil
+		}
+	case NumericOID:
+		if s, err := decodeNumeric(b); err == nil {
+			return value.NewDecimalFromString(s), nil
+		}
+	case TimestampOID:
+		if len(b) == 8 {
+			t := postgresEpoch.Add(time.Duration(int64(binary.BigEndian.Uint64(b))) * time.Microsecond)
+			return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())), nil
+		}
+	case TimestamptzOID:
+		if len(b) == 8 {
+			t := postgresEpoch.Add(time.Duration(int64(binary.BigEndian.Uint64(b))) * time.Microsecond)
+			return value.NewDatetime(t.In(cmd.GetLocation())), nil
+		}
+	case DateOID:
+		if d, err := buf.Int32(); err == nil && len(b) == 4 {
+			return value.NewDatetime(time.Date(2000, 1, 1+int(d), 0, 0, 0, 0, cmd.GetLocation())), nil
+		}
+	case IntervalOID:
+		if len(b) == 16 {
+			micros := int64(binary.BigEndian.Uint64(b))
+			days := int32(binary.BigEndian.Uint32(b[8:]))
+			months := int32(binary.BigEndian.Uint32(b[12:]))
+			return value.NewInterval(int64(months), int64(days), micros*1000), nil
+		}
+	case TextOID, VarcharOID, BpcharOID, NameOID, CharOID, UnknownOID, JSONOID, UnspecifiedOID:
+		return value.NewString(string(b)), nil
+	default:
+		return nil, errUnsupportedBinaryFormat
+	}
+	return nil, fmt.Errorf("invalid binary data for type oid %d", oid)
+}
@@ -0,0 +1,163 @@
+package pgwire
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var typeOIDTests = []struct {
+	Value  value.Primary
+	Result int32
+}{
+	{Value: value.NewString("a"), Result: TextOID},
+	{Value: value.NewInteger(1), Result: Int8OID},
+	{Value: value.NewFloat(1.5), Result: Float8OID},
+	{Value: value.NewDecimalFromString("1.5"), Result: NumericOID},
+	{Value: value.NewBoolean(true), Result: BoolOID},
+	{Value: value.NewTernary(ternary.UNKNOWN), Result: BoolOID},
+	{Value: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC)), Result: TimestamptzOID},
+	{Value: value.NewInterval(0, 1, 0), Result: IntervalOID},
+	{Value: value.NewNull(), Result: UnspecifiedOID},
+}
+
+func TestTypeOID(t *testing.T) {
+	for _, v := range typeOIDTests {
+		result := TypeOID(v.Value)
+		if result != v.Result {
+			t.Errorf("result = %d, want %d for %s", result, v.Result, v.Value)
+		}
+	}
+}
+
+var encodeValueTests = []struct {
+	Value  value.Primary
+	OID    int32
+	Format int16
+	Result []byte
+	Error  string
+}{
+	{Value: value.NewString("abc"), OID: TextOID, Result: []byte("abc")},
+	{Value: value.NewInteger(-12), OID: Int8OID, Result: []byte("-12")},
+	{Value: value.NewFloat(1.5), OID: Float8OID, Result: []byte("1.5")},
+	{Value: value.NewFloat(math.Inf(-1)), OID: Float8OID, Result: []byte("-Infinity")},
+	{Value: value.NewDecimalFromString("12.340"), OID: NumericOID, Result: []byte("12.340")},
+	{Value: value.NewBoolean(false), OID: BoolOID, Result: []byte("f")},
+	{Value: value.NewTernary(ternary.TRUE), OID: BoolOID, Result: []byte("t")},
+	{Value: value.NewTernary(ternary.UNKNOWN), OID: BoolOID, Result: nil},
+	{Value: value.NewNull(), OID: TextOID, Result: nil},
+	{Value: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, time.FixedZone("", 9*3600))), OID: TimestamptzOID, Result: []byte("2012-02-03 09:18:15.123+09:00")},
+	{Value: value.NewInterval(14, 3, int64(4*time.Hour)), OID: IntervalOID, Result: []byte("1 year 2 mons 3 days 04:00:00")},
+	{Value: value.NewInteger(1), OID: Int8OID, Format: BinaryFormat, Result: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+	{Value: value.NewBoolean(true), OID: BoolOID, Format: BinaryFormat, Result: []byte{1}},
+	{Value: value.NewString("abc"), OID: TextOID, Format: BinaryFormat, Result: []byte("abc")},
+	{Value: value.NewDatetime(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)), OID: TimestamptzOID, Format: BinaryFormat, Result: []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}},
+	{Value: value.NewString("abc"), OID: Int8OID, Format: BinaryFormat, Error: "'abc' cannot be encoded as bigint"},
+	{Value: value.NewString("abc"), OID: ByteaOID, Format: BinaryFormat, Error: "binary format is not supported"},
+}
+
+func TestEncodeValue(t *testing.T) {
+	for _, v := range encodeValueTests {
+		result, err := EncodeValue(v.Value, v.OID, v.Format)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %s", err, v.Value)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %s", err.Error(), v.Error, v.Value)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %s", v.Error, v.Value)
+			continue
+		}
+		if !bytes.Equal(result, v.Result) || (result == nil) != (v.Result == nil) {
+			t.Errorf("result = %q, want %q for %s", result, v.Result, v.Value)
+		}
+	}
+}
+
+var decodeValueTests = []struct {
+	Data   []byte
+	OID    int32
+	Format int16
+	Result value.Primary
+	Error  string
+}{
+	{Data: nil, OID: Int8OID, Result: value.NewNull()},
+	{Data: []byte("abc"), OID: UnspecifiedOID, Result: value.NewString("abc")},
+	{Data: []byte("12"), OID: Int4OID, Result: value.NewInteger(12)},
+	{Data: []byte("1.5"), OID: Float8OID, Result: value.NewFloat(1.5)},
+	{Data: []byte("t"), OID: BoolOID, Result: value.NewBoolean(true)},
+	{Data: []byte("3 days"), OID: IntervalOID, Result: value.NewInterval(0, 3, 0)},
+	{Data: []byte("2012-02-03 09:18:15+00"), OID: TimestamptzOID, Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC))},
+	{Data: []byte("abc"), OID: Int8OID, Error: "invalid input syntax for type oid 20: \"abc\""},
+	{Data: []byte{0, 0, 0, 12}, OID: Int4OID, Format: BinaryFormat, Result: value.NewInteger(12)},
+	{Data: []byte{0, 12}, OID: Int2OID, Format: BinaryFormat, Result: value.NewInteger(12)},
+	{Data: []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, OID: Float8OID, Format: BinaryFormat, Result: value.NewFloat(1.5)},
+	{Data: []byte{0}, OID: BoolOID, Format: BinaryFormat, Result: value.NewBoolean(false)},
+	{Data: []byte("abc"), OID: VarcharOID, Format: BinaryFormat, Result: value.NewString("abc")},
+	{Data: []byte{0, 0, 12}, OID: Int4OID, Format: BinaryFormat, Error: "invalid binary data for type oid 23"},
+}
+
+func TestDecodeValue(t *testing.T) {
+	for _, v := range decodeValueTests {
+		result, err := DecodeValue(v.Data, v.OID, v.Format)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Data)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Data)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Data)
+			continue
+		}
+
+		if dt, ok := result.(*value.Datetime); ok {
+			if !dt.Raw().Equal(v.Result.(*value.Datetime).Raw()) {
+				t.Errorf("result = %s, want %s for %q", result, v.Result, v.Data)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %s, want %s for %q", result, v.Result, v.Data)
+		}
+	}
+}
+
+var numericTests = []struct {
+	Decimal string
+	Binary  []byte
+}{
+	{Decimal: "0", Binary: []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+	{Decimal: "12345.678", Binary: []byte{0, 3, 0, 1, 0, 0, 0, 3, 0, 1, 0x09, 0x29, 0x1a, 0x7c}},
+	{Decimal: "-0.0012", Binary: []byte{0, 1, 0xff, 0xff, 0x40, 0, 0, 4, 0, 12}},
+	{Decimal: "10000", Binary: []byte{0, 1, 0, 1, 0, 0, 0, 0, 0, 1}},
+}
+
+func TestNumeric(t *testing.T) {
+	for _, v := range numericTests {
+		b := encodeNumeric(v.Decimal)
+		if !bytes.Equal(b, v.Binary) {
+			t.Errorf("binary = %v, want %v for %s", b, v.Binary, v.Decimal)
+		}
+
+		s, err := decodeNumeric(v.Binary)
+		if err != nil {
+			t.Errorf("unexpected error %q for %s", err, v.Decimal)
+			continue
+		}
+		if s != v.Decimal {
+			t.Errorf("decimal = %s, want %s", s, v.Decimal)
+		}
+	}
+}
@@ -84,7 +84,14 @@ func Min(list []value.Primary, flags *cmd.Flags) value.Primary {
 	return result
 }
 
-func Sum(list []value.Primary, _ *cmd.Flags) value.Primary {
+func Sum(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if decimals, ok := decimalList(list, flags.NumericMode); ok {
+		if len(decimals) < 1 {
+			return value.NewNull()
+		}
+		return decimalSum(decimals)
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -92,7 +99,15 @@ func Sum(list []value.Primary, _ *cmd.Flags) value.Primary {
 	return value.ParseFloat64(sum(values))
 }
 
-func Avg(list []value.Primary, _ *cmd.Flags) value.Primary {
+func Avg(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if decimals, ok := decimalList(list, flags.NumericMode); ok {
+		if len(decimals) < 1 {
+			return value.NewNull()
+		}
+		avg, _ := decimalSum(decimals).Quo(value.NewDecimalFromInt64(int64(len(decimals))))
+		return avg
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -142,6 +157,36 @@ func floatList(list []value.Primary) []float64 {
 	return values
 }
 
+// decimalList returns the values converted to decimals if any of the values is treated as a decimal.
+func decimalList(list []value.Primary, mode cmd.NumericMode) ([]*value.Decimal, bool) {
+	isDecimal := false
+	for _, v := range list {
+		if !value.IsNull(v) && isDecimalOperand(v, mode) {
+			isDecimal = true
+			break
+		}
+	}
+	if !isDecimal {
+		return nil, false
+	}
+
+	values := make([]*value.Decimal, 0, len(list))
+	for _, v := range list {
+		if d := value.ToDecimal(v); !value.IsNull(d) {
+			values = append(values, d.(*value.Decimal))
+		}
+	}
+	return values, true
+}
+
+func decimalSum(list []*value.Decimal) *value.Decimal {
+	sum := list[0]
+	for _, v := range list[1:] {
+		sum = sum.Add(v)
+	}
+	return sum
+}
+
 func sum(list []float64) float64 {
 	var sum float64
 	for _, v := range list {
@@ -245,3 +290,71 @@ func JsonAgg(list []value.Primary) value.Primary {
 
 	return value.NewString(array.Encode())
 }
+
+func PercentileCont(list []value.Primary, fraction float64) value.Primary {
+	var values []float64
+
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(*value.Float).Raw())
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	low := int(math.Floor(pos))
+	high := int(math.Ceil(pos))
+	return value.ParseFloat64(values[low] + (pos-float64(low))*(values[high]-values[low]))
+}
+
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
+func Mode(list []value.Primary, flags *cmd.Flags) value.Primary {
+	var result value.Primary = value.NewNull()
+	var maxCount int
+
+	counts := make(map[string]int, 40)
+	firsts := make(map[string]value.Primary, 40)
+	buf := GetComparisonKeysBuf()
+
+	for _, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		key := buf.String()
+		if _, ok := firsts[key]; !ok {
+			firsts[key] = v
+		}
+		counts[key]++
+		if maxCount < counts[key] {
+			maxCount = counts[key]
+			result = firsts[key]
+		}
+	}
+
+	PutComparisonkeysBuf(buf)
+	return result
+}
@@ -112,6 +112,15 @@ var sumTests = []aggregateTests{
 		},
 		Result: value.NewInteger(8),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("0.10"),
+			value.NewString("0.2"),
+			value.NewNull(),
+			value.NewInteger(1),
+		},
+		Result: value.NewDecimalFromString("1.30"),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -141,6 +150,14 @@ var avgTests = []aggregateTests{
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("0.10"),
+			value.NewDecimalFromString("0.20"),
+			value.NewNull(),
+		},
+		Result: value.NewDecimalFromString("0.15"),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -413,3 +430,116 @@ func TestJsonAgg(t *testing.T) {
 		}
 	}
 }
+
+var percentileTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Cont     value.Primary
+	Disc     value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewInteger(3),
+		Disc:     value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(8),
+			value.NewInteger(4),
+			value.NewInteger(2),
+			value.NewInteger(1),
+		},
+		Fraction: 0.25,
+		Cont:     value.NewInteger(5),
+		Disc:     value.NewInteger(8),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("1"),
+			value.NewString("2"),
+		},
+		Fraction: 0.3,
+		Cont:     value.NewFloat(1.3),
+		Disc:     value.NewString("1"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+		},
+		Fraction: 0,
+		Cont:     value.NewInteger(1),
+		Disc:     value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewNull(),
+		Disc:     value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileCont(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Cont) {
+			t.Errorf("PercentileCont list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Cont)
+		}
+	}
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileDisc(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Disc) {
+			t.Errorf("PercentileDisc list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Disc)
+		}
+	}
+}
+
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewString("b"),
+			value.NewString("b"),
+			value.NewString("c"),
+			value.NewString("c"),
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("2"),
+			value.NewFloat(2),
+		},
+		Result: value.NewString("2"),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
@@ -2,12 +2,17 @@ package query
 
 import (
 	"context"
+	"errors"
 	"math"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 var AnalyticFunctions = map[string]AnalyticFunction{
@@ -150,7 +155,11 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 				}
 			} else {
 				partition := partitions[partitionMapKeys[i]]
-				frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+				frameSet, e := WindowFrameSet(partition, fn, view.sortValuesInEachRecord)
+				if e != nil {
+					gm.SetError(e)
+					break AnalyzeLoop
+				}
 				valueCache := make(map[int]value.Primary, len(partition))
 
 				udfnArgsExprs := fn.Args[1:]
@@ -223,9 +232,27 @@ type WindowFrame struct {
 	Low     int
 	High    int
 	Records []int
+
+	Current   int
+	PeerLow   int
+	PeerHigh  int
+	Exclusion int
 }
 
-func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFrame {
+// IsExcluded returns true if the record at the position in the partition is removed from the frame by the exclusion clause.
+func (frame WindowFrame) IsExcluded(i int) bool {
+	switch frame.Exclusion {
+	case parser.CURRENT:
+		return i == frame.Current
+	case parser.GROUP:
+		return frame.PeerLow <= i && i <= frame.PeerHigh
+	case parser.TIES:
+		return i != frame.Current && frame.PeerLow <= i && i <= frame.PeerHigh
+	}
+	return false
+}
+
+func WindowFrameSet(partition Partition, expr parser.AnalyticFunction, sortValues []SortValues) ([]WindowFrame, error) {
 	var singleFrameSet = func(partition Partition) []WindowFrame {
 		indices := make([]int, len(partition))
 		for i, idx := range partition {
@@ -234,39 +261,14 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 		return []WindowFrame{{Low: 0, High: len(partition) - 1, Records: indices}}
 	}
 
-	var frameIndex = func(current int, length int, framePosition parser.WindowFramePosition) int {
-		var idx int
-
-		switch framePosition.Direction {
-		case parser.CURRENT:
-			idx = current
-		case parser.PRECEDING:
-			if framePosition.Unbounded {
-				idx = 0
-			} else {
-				idx = current - framePosition.Offset
-			}
-		case parser.FOLLOWING:
-			if framePosition.Unbounded {
-				idx = length - 1
-			} else {
-				idx = current + framePosition.Offset
-			}
-		}
-
-		return idx
-	}
-
 	length := len(partition)
 
-	if expr.OrderByClause == nil {
-		return singleFrameSet(partition)
+	if expr.AnalyticClause.OrderByClause == nil {
+		return singleFrameSet(partition), nil
 	}
 
-	frameSet := make([]WindowFrame, 0, length)
-
 	var windowClause parser.WindowingClause
-	if expr.WindowingClause == nil {
+	if expr.AnalyticClause.WindowingClause == nil {
 		windowClause = parser.WindowingClause{
 			FrameLow: parser.WindowFramePosition{
 				Direction: parser.PRECEDING,
@@ -274,50 +276,370 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 			},
 		}
 	} else {
-		windowClause = expr.WindowingClause.(parser.WindowingClause)
+		windowClause = expr.AnalyticClause.WindowingClause.(parser.WindowingClause)
 	}
+
 	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
+	frameHigh := parser.WindowFramePosition{Direction: parser.CURRENT}
+	if windowClause.FrameHigh != nil {
+		frameHigh = windowClause.FrameHigh.(parser.WindowFramePosition)
+	}
+	exclusion := 0
+	if windowClause.Exclusion != nil {
+		exclusion = windowClause.Exclusion.(parser.WindowExclusion).Type
+	}
+
+	if exclusion == 0 && frameLow.Direction == parser.PRECEDING && frameLow.Unbounded && frameHigh.Direction == parser.FOLLOWING && frameHigh.Unbounded {
+		return singleFrameSet(partition), nil
+	}
 
-	if windowClause.FrameHigh == nil {
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    current,
-				Records: []int{partition[current]},
-			})
+	if windowClause.Unit != parser.RANGE && (frameLow.OffsetValue != nil || frameHigh.OffsetValue != nil) {
+		return nil, NewInvalidWindowFrameError(expr, "offset must be an integer")
+	}
+
+	peers := newWindowPeerGroups(partition, sortValues)
+
+	var frameIndex func(current int, position parser.WindowFramePosition, isLow bool) int
+	switch windowClause.Unit {
+	case parser.GROUPS:
+		frameIndex = peers.groupFrameIndex
+	case parser.RANGE:
+		keys, err := newWindowRangeKeys(partition, sortValues, frameLow, frameHigh)
+		if err != nil {
+			return nil, NewInvalidWindowFrameError(expr, err.Error())
 		}
-	} else {
-		frameHigh := windowClause.FrameHigh.(parser.WindowFramePosition)
-		if frameLow.Direction == parser.PRECEDING && frameLow.Unbounded && frameHigh.Direction == parser.FOLLOWING && frameHigh.Unbounded {
-			return singleFrameSet(partition)
+		frameIndex = func(current int, position parser.WindowFramePosition, isLow bool) int {
+			return keys.frameIndex(peers, current, position, isLow)
+		}
+	default:
+		frameIndex = func(current int, position parser.WindowFramePosition, isLow bool) int {
+			return rowsFrameIndex(length, current, position)
+		}
+	}
+
+	frameSet := make([]WindowFrame, 0, length)
+	for current := 0; current < length; current++ {
+		frameSet = append(frameSet, WindowFrame{
+			Low:       frameIndex(current, frameLow, true),
+			High:      frameIndex(current, frameHigh, false),
+			Records:   []int{partition[current]},
+			Current:   current,
+			PeerLow:   peers.low(current),
+			PeerHigh:  peers.high(current),
+			Exclusion: exclusion,
+		})
+	}
+
+	return frameSet, nil
+}
+
+func rowsFrameIndex(length int, current int, position parser.WindowFramePosition) int {
+	var idx int
+
+	switch position.Direction {
+	case parser.CURRENT:
+		idx = current
+	case parser.PRECEDING:
+		if position.Unbounded {
+			idx = 0
+		} else {
+			idx = current - position.Offset
+		}
+	case parser.FOLLOWING:
+		if position.Unbounded {
+			idx = length - 1
+		} else {
+			idx = current + position.Offset
+		}
+	}
+
+	return idx
+}
+
+// windowPeerGroups holds the ranges of the records that have the same sort values in a partition.
+type windowPeerGroups struct {
+	groupOf []int
+	starts  []int
+	ends    []int
+}
+
+func newWindowPeerGroups(partition Partition, sortValues []SortValues) *windowPeerGroups {
+	peers := &windowPeerGroups{
+		groupOf: make([]int, len(partition)),
+		starts:  make([]int, 0, len(partition)),
+		ends:    make([]int, 0, len(partition)),
+	}
+
+	var currentValues SortValues
+	for i, idx := range partition {
+		if sortValues == nil || !sortValues[idx].EquivalentTo(currentValues) {
+			if 0 < i {
+				peers.ends = append(peers.ends, i-1)
+			}
+			peers.starts = append(peers.starts, i)
+			if sortValues != nil {
+				currentValues = sortValues[idx]
+			}
+		}
+		peers.groupOf[i] = len(peers.starts) - 1
+	}
+	if 0 < len(partition) {
+		peers.ends = append(peers.ends, len(partition)-1)
+	}
+	return peers
+}
+
+func (peers *windowPeerGroups) low(current int) int {
+	return peers.starts[peers.groupOf[current]]
+}
+
+func (peers *windowPeerGroups) high(current int) int {
+	return peers.ends[peers.groupOf[current]]
+}
+
+func (peers *windowPeerGroups) groupFrameIndex(current int, position parser.WindowFramePosition, isLow bool) int {
+	last := len(peers.starts) - 1
+	group := peers.groupOf[current]
+
+	switch position.Direction {
+	case parser.PRECEDING:
+		if position.Unbounded {
+			return 0
+		}
+		group = group - position.Offset
+	case parser.FOLLOWING:
+		if position.Unbounded {
+			return len(peers.groupOf) - 1
+		}
+		group = group + position.Offset
+	}
+
+	switch {
+	case group < 0:
+		if isLow {
+			return 0
+		}
+		return -1
+	case last < group:
+		if isLow {
+			return len(peers.groupOf)
+		}
+		return len(peers.groupOf) - 1
+	}
+
+	if isLow {
+		return peers.starts[group]
+	}
+	return peers.ends[group]
+}
+
+// windowRangeKeys holds the sort keys of a partition to evaluate the offsets of RANGE frames.
+// Numeric keys are compared with numeric offsets, and datetime keys are compared with offsets in seconds or intervals.
+type windowRangeKeys struct {
+	isDatetime bool
+	direction  int
+	nullKeys   []bool
+	floats     []float64
+	datetimes  []int64
+	lowOffset  rangeOffset
+	highOffset rangeOffset
+	nonNullLow int
+	nonNullLen int
+}
+
+type rangeOffset struct {
+	float    float64
+	duration int64
+	interval *value.Interval
+}
+
+func newRangeOffset(position parser.WindowFramePosition) rangeOffset {
+	if position.OffsetValue != nil {
+		if iv, ok := position.OffsetValue.(parser.PrimitiveType).Value.(*value.Interval); ok {
+			if position.Direction == parser.PRECEDING {
+				iv = iv.Neg()
+			}
+			return rangeOffset{interval: iv}
+		}
+	}
+
+	f := float64(position.Offset)
+	if position.OffsetValue != nil {
+		f = position.OffsetValue.(parser.PrimitiveType).Value.(*value.Float).Raw()
+	}
+	if position.Direction == parser.PRECEDING {
+		f = -f
+	}
+	return rangeOffset{
+		float:    f,
+		duration: int64(math.Round(f * 1e9)),
+	}
+}
+
+func (offset rangeOffset) neg() rangeOffset {
+	ret := rangeOffset{float: -offset.float, duration: -offset.duration}
+	if offset.interval != nil {
+		ret.interval = offset.interval.Neg()
+	}
+	return ret
+}
+
+func newWindowRangeKeys(partition Partition, sortValues []SortValues, frameLow parser.WindowFramePosition, frameHigh parser.WindowFramePosition) (*windowRangeKeys, error) {
+	keys := &windowRangeKeys{
+		direction:  1,
+		lowOffset:  newRangeOffset(frameLow),
+		highOffset: newRangeOffset(frameHigh),
+		nonNullLow: -1,
+	}
+
+	needsKeys := (!frameLow.Unbounded && frameLow.Direction != parser.CURRENT) || (!frameHigh.Unbounded && frameHigh.Direction != parser.CURRENT)
+	if !needsKeys || sortValues == nil || len(partition) < 1 {
+		return keys, nil
+	}
+	if len(sortValues[partition[0]]) != 1 {
+		return nil, errors.New("offset requires exactly one order by item")
+	}
+
+	keys.nullKeys = make([]bool, len(partition))
+	keys.floats = make([]float64, len(partition))
+	keys.datetimes = make([]int64, len(partition))
+
+	keyType := NullType
+	for i, idx := range partition {
+		sv := sortValues[idx][0]
+		switch sv.Type {
+		case NullType:
+			keys.nullKeys[i] = true
+			continue
+		case IntegerType, FloatType:
+			keys.floats[i] = sv.Float
+		case DatetimeType:
+			keys.datetimes[i] = sv.Datetime
+		default:
+			return nil, errors.New("offset requires a numeric or datetime order by item")
 		}
 
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    frameIndex(current, length, frameHigh),
-				Records: []int{partition[current]},
-			})
+		if keyType == NullType {
+			keyType = sv.Type
+			if keyType == DatetimeType {
+				keys.isDatetime = true
+			}
+		} else if (keyType == DatetimeType) != (sv.Type == DatetimeType) {
+			return nil, errors.New("offset requires a numeric or datetime order by item")
+		}
+
+		if keys.nonNullLow < 0 {
+			keys.nonNullLow = i
+		}
+		keys.nonNullLen++
+	}
+
+	if 0 < keys.nonNullLen && !keys.isDatetime && (keys.lowOffset.interval != nil || keys.highOffset.interval != nil) {
+		return nil, errors.New("interval offset requires a datetime order by item")
+	}
+
+	if 1 < keys.nonNullLen && keys.compare(keys.nonNullLow+keys.nonNullLen-1, keys.nonNullLow, rangeOffset{}) < 0 {
+		keys.direction = -1
+	}
+	return keys, nil
+}
+
+// compare returns a negative number if the key at i is less than the key at base moved by the offset,
+// and returns a positive number if it is greater.
+func (keys *windowRangeKeys) compare(i int, base int, offset rangeOffset) int {
+	if keys.isDatetime && offset.interval != nil {
+		target := offset.interval.AddTo(time.Unix(0, keys.datetimes[base]).In(cmd.GetLocation())).UnixNano()
+		switch {
+		case keys.datetimes[i] < target:
+			return -1
+		case keys.datetimes[i] > target:
+			return 1
+		}
+		return 0
+	}
+
+	if keys.isDatetime {
+		diff := keys.datetimes[i] - keys.datetimes[base]
+		switch {
+		case diff < offset.duration:
+			return -1
+		case diff > offset.duration:
+			return 1
+		}
+		return 0
+	}
+
+	diff := keys.floats[i] - keys.floats[base]
+	switch {
+	case diff < offset.float:
+		return -1
+	case diff > offset.float:
+		return 1
+	}
+	return 0
+}
+
+func (keys *windowRangeKeys) frameIndex(peers *windowPeerGroups, current int, position parser.WindowFramePosition, isLow bool) int {
+	if position.Unbounded {
+		if isLow {
+			return 0
 		}
+		return len(peers.groupOf) - 1
+	}
+	if position.Direction == parser.CURRENT || keys.nullKeys == nil || keys.nullKeys[current] {
+		if isLow {
+			return peers.low(current)
+		}
+		return peers.high(current)
+	}
+
+	offset := keys.highOffset
+	if isLow {
+		offset = keys.lowOffset
+	}
+	if keys.direction < 0 {
+		offset = offset.neg()
 	}
 
-	return frameSet
+	if isLow {
+		return keys.nonNullLow + sort.Search(keys.nonNullLen, func(i int) bool {
+			return 0 <= keys.compare(keys.nonNullLow+i, current, offset)*keys.direction
+		})
+	}
+	return keys.nonNullLow + sort.Search(keys.nonNullLen, func(i int) bool {
+		return 0 < keys.compare(keys.nonNullLow+i, current, offset)*keys.direction
+	}) - 1
 }
 
 func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame, partition Partition, expr parser.AnalyticFunction, valueCache map[int]value.Primary) ([]value.Primary, error) {
-	values := make([]value.Primary, 0, frame.High-frame.Low+1)
+	size := frame.High - frame.Low + 1
+	if size < 0 {
+		size = 0
+	}
+	values := make([]value.Primary, 0, size)
 
 	anScope := scope.CreateScopeForAnalytics()
 	for i := frame.Low; i <= frame.High; i++ {
-		if i < 0 || len(partition) <= i {
+		if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 			continue
 		}
 
 		recordIdx := partition[i]
 		if v, ok := valueCache[recordIdx]; ok {
-			values = append(values, v)
+			if v != nil {
+				values = append(values, v)
+			}
 		} else {
 			anScope.Records[0].recordIndex = recordIdx
+			ok, e := isFilteredIn(ctx, anScope, expr.Filter)
+			if e != nil {
+				return nil, e
+			}
+			if !ok {
+				valueCache[recordIdx] = nil
+				continue
+			}
+
 			p, e := Evaluate(ctx, anScope, expr.Args[0])
 			if e != nil {
 				return nil, e
@@ -333,6 +655,18 @@ func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame,
 	return values, nil
 }
 
+func isFilteredIn(ctx context.Context, scope *ReferenceScope, filter parser.QueryExpression) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+
+	p, err := Evaluate(ctx, scope, filter.(parser.FilterClause).Condition)
+	if err != nil {
+		return false, err
+	}
+	return p.Ternary() == ternary.TRUE, nil
+}
+
 func CheckArgsLen(expr parser.AnalyticFunction, length []int) error {
 	if len(length) == 1 {
 		if len(expr.Args) != length[0] {
@@ -584,7 +918,10 @@ func (fn NthValue) Execute(ctx context.Context, scope *ReferenceScope, partition
 }
 
 func setNthValue(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction, n int) (map[int]value.Primary, error) {
-	frameSet := WindowFrameSet(partition, expr.AnalyticClause)
+	frameSet, err := WindowFrameSet(partition, expr, scope.Records[0].view.sortValuesInEachRecord)
+	if err != nil {
+		return nil, err
+	}
 	list := make(map[int]value.Primary, len(partition))
 
 	valueCache := make(map[int]value.Primary, len(partition))
@@ -595,7 +932,7 @@ func setNthValue(ctx context.Context, scope *ReferenceScope, partition Partition
 		count := 0
 
 		for i := frame.Low; i <= frame.High; i++ {
-			if i < 0 || len(partition) <= i {
+			if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 				continue
 			}
 
@@ -725,14 +1062,22 @@ func (fn AnalyticListAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 	}
 
 	anScope := scope.CreateScopeForAnalytics()
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
+	values := make([]value.Primary, 0, len(partition))
+	for _, idx := range partition {
 		anScope.Records[0].recordIndex = idx
+		ok, e := isFilteredIn(ctx, anScope, expr.Filter)
+		if e != nil {
+			return nil, e
+		}
+		if !ok {
+			continue
+		}
+
 		val, e := Evaluate(ctx, anScope, expr.Args[0])
 		if e != nil {
 			return nil, e
 		}
-		values[i] = val
+		values = append(values, val)
 	}
 	if expr.IsDistinct() {
 		values = Distinguish(values, scope.Tx.Flags)
@@ -756,14 +1101,22 @@ func (fn AnalyticJsonAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
 
 func (fn AnalyticJsonAgg) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
 	anScope := scope.CreateScopeForAnalytics()
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
+	values := make([]value.Primary, 0, len(partition))
+	for _, idx := range partition {
 		anScope.Records[0].recordIndex = idx
+		ok, e := isFilteredIn(ctx, anScope, expr.Filter)
+		if e != nil {
+			return nil, e
+		}
+		if !ok {
+			continue
+		}
+
 		val, e := Evaluate(ctx, anScope, expr.Args[0])
 		if e != nil {
 			return nil, e
 		}
-		values[i] = val
+		values = append(values, val)
 	}
 	if expr.IsDistinct() {
 		values = Distinguish(values, scope.Tx.Flags)
@@ -314,6 +314,94 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze AggregateFunction with Filter",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where:  "where",
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValueFromString("1"),
+					Operator: "=",
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
 	{
 		Name: "Analyze AggregateFunction with Windowing Clause",
 		View: &View{
@@ -404,6 +492,101 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze AggregateFunction with Exclusion",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Unbounded: true,
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.FOLLOWING,
+						Unbounded: true,
+					},
+					Exclusion: parser.WindowExclusion{Type: parser.CURRENT},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
 	{
 		Name: "Analyze AggregateFunction With Distinct",
 		View: &View{
@@ -1899,6 +2082,224 @@ var nthValueExecuteTests = []analyticFunctionExecuteTests{
 		},
 		Error: "the second argument must be greater than 0 for function nth_value",
 	},
+	{
+		Name:  "NthValue with Range Windowing Clause Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewInteger(200), TestTx.Flags)},
+			4: {NewSortValue(value.NewInteger(300), TestTx.Flags)},
+			5: {NewSortValue(value.NewInteger(500), TestTx.Flags)},
+			6: {NewSortValue(value.NewInteger(800), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "range",
+					Unit: parser.RANGE,
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.PRECEDING,
+						OffsetValue: parser.NewFloatValueFromString("150.5"),
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.CURRENT,
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+			5: value.NewInteger(500),
+			6: value.NewInteger(800),
+		},
+	},
+	{
+		Name:  "NthValue with Range Windowing Clause Interval Offset Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewDatetime(time.Date(2012, 1, 31, 0, 0, 0, 0, GetTestLocation())), TestTx.Flags)},
+			4: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 1, 12, 0, 0, 0, GetTestLocation())), TestTx.Flags)},
+			5: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 2, 0, 0, 0, 0, GetTestLocation())), TestTx.Flags)},
+			6: {NewSortValue(value.NewDatetime(time.Date(2012, 3, 2, 0, 0, 0, 0, GetTestLocation())), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "range",
+					Unit: parser.RANGE,
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.PRECEDING,
+						OffsetValue: parser.NewIntervalValueFromString("1 month"),
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction:   parser.PRECEDING,
+						OffsetValue: parser.NewIntervalValueFromString("12 hours"),
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewNull(),
+			4: value.NewInteger(200),
+			5: value.NewInteger(200),
+			6: value.NewInteger(500),
+		},
+	},
+	{
+		Name:  "NthValue with Range Windowing Clause Interval Offset Error",
+		Items: Partition{3, 4},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewInteger(200), TestTx.Flags)},
+			4: {NewSortValue(value.NewInteger(300), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "range",
+					Unit: parser.RANGE,
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.PRECEDING,
+						OffsetValue: parser.NewIntervalValueFromString("1 day"),
+						Literal:     "INTERVAL '1 day' preceding",
+					},
+				},
+			},
+		},
+		Error: "invalid window frame range INTERVAL '1 day' preceding: interval offset requires a datetime order by item",
+	},
+	{
+		Name:  "NthValue with Groups Windowing Clause and Exclusion Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewString("1"), TestTx.Flags)},
+			4: {NewSortValue(value.NewString("1"), TestTx.Flags)},
+			5: {NewSortValue(value.NewString("2"), TestTx.Flags)},
+			6: {NewSortValue(value.NewString("3"), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "groups",
+					Unit: parser.GROUPS,
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    1,
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.FOLLOWING,
+						Offset:    1,
+					},
+					Exclusion: parser.WindowExclusion{Type: parser.CURRENT},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(300),
+			4: value.NewInteger(200),
+			5: value.NewInteger(200),
+			6: value.NewInteger(500),
+		},
+	},
+	{
+		Name:  "NthValue with Range Windowing Clause Order By Item Error",
+		Items: Partition{0, 1},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewString("a"), TestTx.Flags)},
+			1: {NewSortValue(value.NewString("b"), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "range",
+					Unit: parser.RANGE,
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    1,
+						Literal:   "1 preceding",
+					},
+				},
+			},
+		},
+		Error: "invalid window frame range 1 preceding: offset requires a numeric or datetime order by item",
+	},
+	{
+		Name:  "NthValue with Rows Windowing Clause Float Offset Error",
+		Items: Partition{0, 1},
+		Function: parser.AnalyticFunction{
+			Name: "nth_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(1),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Rows: "rows",
+					Unit: parser.ROWS,
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.PRECEDING,
+						OffsetValue: parser.NewFloatValueFromString("1.5"),
+						Literal:     "1.5 preceding",
+					},
+				},
+			},
+		},
+		Error: "invalid window frame rows 1.5 preceding: offset must be an integer",
+	},
 }
 
 func TestNthValue_Execute(t *testing.T) {
@@ -2139,6 +2540,33 @@ var analyticListAggExecuteTests = []analyticFunctionExecuteTests{
 			4: value.NewString("100200200300"),
 		},
 	},
+	{
+		Name:  "AnalyticListAgg Execute With Filter",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where:  "where",
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewStringValue("b"),
+					Operator: "=",
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("200,300"),
+			1: value.NewString("200,300"),
+			2: value.NewString("200,300"),
+			3: value.NewString("200,300"),
+			4: value.NewString("200,300"),
+		},
+	},
 	{
 		Name:  "AnalyticListAgg Execute With Distinct",
 		Items: Partition{0, 1, 2, 3, 4},
@@ -3,10 +3,26 @@ package query
 import (
 	"math"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
+func Calculate(p1 value.Primary, p2 value.Primary, operator int, flags *cmd.Flags) value.Primary {
+	if isIntervalOperand(p1) || isIntervalOperand(p2) || (operator == '-' && (isDatetimeOperand(p1) || isDatetimeOperand(p2))) {
+		if ret, ok := calculateInterval(p1, p2, operator, flags.DatetimeFormat); ok {
+			return ret
+		}
+	}
+
+	mode := flags.NumericMode
+	if isDecimalOperand(p1, mode) || isDecimalOperand(p2, mode) || (mode == cmd.DecimalMode && operator == '/') {
+		if d1 := value.ToDecimal(p1); !value.IsNull(d1) {
+			if d2 := value.ToDecimal(p2); !value.IsNull(d2) {
+				return calculateDecimal(d1.(*value.Decimal), d2.(*value.Decimal), operator)
+			}
+		}
+	}
+
 	if operator != '/' {
 		if pi1 := value.ToInteger(p1); !value.IsNull(pi1) {
 			if pi2 := value.ToInteger(p2); !value.IsNull(pi2) {
@@ -65,3 +81,121 @@ func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 
 	return value.NewInteger(result)
 }
+
+func calculateDecimal(d1 *value.Decimal, d2 *value.Decimal, operator int) value.Primary {
+	var result *value.Decimal
+	ok := true
+	switch operator {
+	case '+':
+		result = d1.Add(d2)
+	case '-':
+		result = d1.Sub(d2)
+	case '*':
+		result = d1.Mul(d2)
+	case '/':
+		result, ok = d1.Quo(d2)
+	case '%':
+		result, ok = d1.Rem(d2)
+	}
+
+	if !ok {
+		return value.NewNull()
+	}
+	return result
+}
+
+// calculateInterval calculates the values including intervals, or subtracts a datetime from a datetime.
+// If the operation cannot be performed with intervals, then it returns false.
+func calculateInterval(p1 value.Primary, p2 value.Primary, operator int, formats []string) (value.Primary, bool) {
+	iv1, ok1 := p1.(*value.Interval)
+	iv2, ok2 := p2.(*value.Interval)
+
+	if !ok1 && !ok2 {
+		if dt1 := value.ToDatetime(p1, formats); !value.IsNull(dt1) {
+			if dt2 := value.ToDatetime(p2, formats); !value.IsNull(dt2) {
+				return value.NewIntervalBetween(dt1.(*value.Datetime).Raw(), dt2.(*value.Datetime).Raw()), true
+			}
+		}
+		return nil, false
+	}
+
+	switch operator {
+	case '+', '-':
+		if !ok1 {
+			if dt := value.ToDatetime(p1, formats); !value.IsNull(dt) {
+				if operator == '-' {
+					iv2 = iv2.Neg()
+				}
+				return value.NewDatetime(iv2.AddTo(dt.(*value.Datetime).Raw())), true
+			}
+		} else if !ok2 && operator == '+' {
+			if dt := value.ToDatetime(p2, formats); !value.IsNull(dt) {
+				return value.NewDatetime(iv1.AddTo(dt.(*value.Datetime).Raw())), true
+			}
+		}
+
+		i1 := value.ToInterval(p1)
+		i2 := value.ToInterval(p2)
+		if value.IsNull(i1) || value.IsNull(i2) {
+			break
+		}
+		if operator == '-' {
+			return i1.(*value.Interval).Sub(i2.(*value.Interval)), true
+		}
+		return i1.(*value.Interval).Add(i2.(*value.Interval)), true
+	case '*':
+		if ok1 && ok2 {
+			break
+		}
+		iv, p := iv1, p2
+		if !ok1 {
+			iv, p = iv2, p1
+		}
+		if i := value.ToInteger(p); !value.IsNull(i) {
+			ret := iv.Mul(i.(*value.Integer).Raw())
+			value.Discard(i)
+			return ret, true
+		}
+	}
+	return value.NewNull(), true
+}
+
+func isIntervalOperand(p value.Primary) bool {
+	_, ok := p.(*value.Interval)
+	return ok
+}
+
+func isDatetimeOperand(p value.Primary) bool {
+	_, ok := p.(*value.Datetime)
+	return ok
+}
+
+// isDecimalOperand returns true if the value is a decimal,
+// or if the numeric mode is DECIMAL and the value is a number that is not an integer.
+func isDecimalOperand(p value.Primary, mode cmd.NumericMode) bool {
+	switch p.(type) {
+	case *value.Decimal:
+		return true
+	case *value.Float:
+		return mode == cmd.DecimalMode
+	case *value.String:
+		if mode != cmd.DecimalMode {
+			return false
+		}
+		s := cmd.TrimSpace(p.(*value.String).Raw())
+		return !value.MaybeInteger(s) && value.MaybeNumber(s)
+	}
+	return false
+}
+
+// decimalOperand returns the value converted to a decimal if the value is treated as a decimal.
+func decimalOperand(p value.Primary, mode cmd.NumericMode) (*value.Decimal, bool) {
+	if !isDecimalOperand(p, mode) {
+		return nil, false
+	}
+	d := value.ToDecimal(p)
+	if value.IsNull(d) {
+		return nil, false
+	}
+	return d.(*value.Decimal), true
+}
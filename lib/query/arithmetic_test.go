@@ -3,7 +3,9 @@ package query
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -11,6 +13,7 @@ var calculateTests = []struct {
 	LHS      value.Primary
 	RHS      value.Primary
 	Operator int
+	Mode     cmd.NumericMode
 	Result   value.Primary
 }{
 	{
@@ -79,11 +82,125 @@ var calculateTests = []struct {
 		Operator: '%',
 		Result:   value.NewFloat(0.5),
 	},
+	{
+		LHS:      value.NewString("0.1"),
+		RHS:      value.NewString("0.2"),
+		Operator: '+',
+		Mode:     cmd.DecimalMode,
+		Result:   value.NewDecimalFromString("0.3"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.50"),
+		RHS:      value.NewInteger(2),
+		Operator: '*',
+		Result:   value.NewDecimalFromString("3.00"),
+	},
+	{
+		LHS:      value.NewString("10"),
+		RHS:      value.NewString("4"),
+		Operator: '/',
+		Mode:     cmd.DecimalMode,
+		Result:   value.NewDecimalFromString("2.5"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1"),
+		RHS:      value.NewInteger(3),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("0.3333333333333333"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("-7.5"),
+		RHS:      value.NewInteger(2),
+		Operator: '%',
+		Result:   value.NewDecimalFromString("-1.5"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewInteger(0),
+		Operator: '/',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewString("9"),
+		RHS:      value.NewString("2"),
+		Operator: '-',
+		Mode:     cmd.DecimalMode,
+		Result:   value.NewInteger(7),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 1, 31, 9, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInterval(1, 1, int64(2*time.Hour)),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 3, 1, 11, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2020, 1, 31, 0, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInterval(1, 0, 0),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2020, 2, 29, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewInterval(0, 3, 0),
+		RHS:      value.NewDatetime(time.Date(2012, 3, 1, 0, 0, 0, 0, GetTestLocation())),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 3, 4, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 1, 0, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInterval(0, 1, int64(time.Hour)),
+		Operator: '-',
+		Result:   value.NewDatetime(time.Date(2012, 2, 28, 23, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 4, 12, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewDatetime(time.Date(2012, 3, 1, 8, 30, 0, 0, GetTestLocation())),
+		Operator: '-',
+		Result:   value.NewInterval(0, 3, int64(3*time.Hour+30*time.Minute)),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 1, 8, 30, 0, 0, GetTestLocation())),
+		RHS:      value.NewString("2012-03-04 12:00:00"),
+		Operator: '-',
+		Result:   value.NewInterval(0, -3, -int64(3*time.Hour+30*time.Minute)),
+	},
+	{
+		LHS:      value.NewInterval(1, 2, int64(time.Hour)),
+		RHS:      value.NewString("1 day 30 minutes"),
+		Operator: '-',
+		Result:   value.NewInterval(1, 1, int64(30*time.Minute)),
+	},
+	{
+		LHS:      value.NewInteger(3),
+		RHS:      value.NewInterval(0, 1, int64(time.Hour)),
+		Operator: '*',
+		Result:   value.NewInterval(0, 3, int64(3*time.Hour)),
+	},
+	{
+		LHS:      value.NewInterval(0, 1, 0),
+		RHS:      value.NewInterval(0, 1, 0),
+		Operator: '*',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewInterval(0, 1, 0),
+		RHS:      value.NewInteger(1),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 1, 8, 30, 0, 0, GetTestLocation())),
+		RHS:      value.NewInteger(1),
+		Operator: '-',
+		Result:   value.NewNull(),
+	},
 }
 
 func TestCalculate(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
 	for _, v := range calculateTests {
-		r := Calculate(v.LHS, v.RHS, v.Operator)
+		TestTx.Flags.NumericMode = v.Mode
+		r := Calculate(v.LHS, v.RHS, v.Operator, TestTx.Flags)
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(v.Operator), v.RHS)
 		}
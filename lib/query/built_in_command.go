@@ -14,6 +14,7 @@ import (
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/syntax"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
@@ -214,7 +215,7 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 	}
 
 	switch strings.ToUpper(expr.Flag.Name) {
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.NumericModeFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.WriteDelimiterPositionsFlag, cmd.LineBreakFlag, cmd.JsonEscapeFlag:
 		p = value.ToString(v)
@@ -262,7 +263,7 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 			Value:    expr.Value,
 		}
 		return SetFlag(ctx, scope, e)
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.NumericModeFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.WriteDelimiterPositionsFlag, cmd.LineBreakFlag, cmd.JsonEscapeFlag,
 		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
@@ -308,7 +309,7 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 		} else {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.NumericModeFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.WriteDelimiterPositionsFlag, cmd.LineBreakFlag, cmd.JsonEscapeFlag,
 		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
@@ -366,7 +367,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.WriteEncodingFlag:
 		switch tx.Flags.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.JSONL, cmd.PARQUET:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
@@ -387,7 +388,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.WithoutHeaderFlag:
 		switch tx.Flags.Format {
-		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.XLSX, cmd.GFM, cmd.ORG:
 			if tx.Flags.Format == cmd.FIXED && tx.Flags.WriteAsSingleLine {
 				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 			} else {
@@ -411,7 +412,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.JsonEscapeFlag:
 		switch tx.Flags.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.JSONL:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
@@ -432,7 +433,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.DelimiterFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
-	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.FormatFlag:
+	case cmd.TimezoneFlag, cmd.NumericModeFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.FormatFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 	case cmd.LimitRecursion:
 		p := val.(*value.Integer)
@@ -686,10 +687,12 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
-			switch ri {
-			case WorkingDirectory, VersionInformation:
+			switch {
+			case value.IsNull(p):
+				w.WriteColorWithoutLineBreak(p.String(), cmd.NullEffect)
+			case ri == WorkingDirectory || ri == VersionInformation || ri == ErrorMessageInformation:
 				w.WriteColorWithoutLineBreak(p.(*value.String).Raw(), cmd.StringEffect)
-			case UncommittedInformation:
+			case ri == UncommittedInformation:
 				w.WriteColorWithoutLineBreak(p.(*value.Boolean).String(), cmd.BooleanEffect)
 			default:
 				w.WriteColorWithoutLineBreak(p.(*value.Integer).String(), cmd.NumberEffect)
@@ -727,7 +730,7 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 		w.WriteColorWithoutLineBreak("Delimiter Positions: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(dp)
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL:
 		escapeStr := cmd.JsonEscapeTypeToString(info.JsonEscape)
 		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(escapeStr)
@@ -744,6 +747,9 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(xlsx.SheetName(info.Sheet))
 	}
 
 	switch info.Format {
@@ -757,7 +763,7 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.XLSX, cmd.PARQUET:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
 		w.WriteWithoutLineBreak(info.Encoding.String())
@@ -774,13 +780,19 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String(), flags)))
 		w.WriteColorWithoutLineBreak("Pretty Print: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.PrettyPrint))
-	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.XLSX, cmd.GFM, cmd.ORG:
 		if !(info.Format == cmd.FIXED && info.SingleLine) {
 			w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String(), flags)))
 			w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
 			w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 		}
 	}
+
+	if info.Compression != cmd.NoCompression {
+		w.NewLine()
+		w.WriteColor("Compression: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Compression.String())
+	}
 }
 
 func writeFields(w *ObjectWriter, fields []string) {
@@ -904,7 +916,7 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 	}
 
 	w.NewLine()
-	writeFieldList(w, view.Header.TableColumnNames())
+	writeFieldList(w, view.Header.TableColumnNames(), view.FileInfo.Schema)
 
 	w.Title1 = "Fields in"
 	if e, ok := expr.Table.(parser.TableObject); ok {
@@ -916,7 +928,7 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 	return "\n" + w.String() + "\n", nil
 }
 
-func writeFieldList(w *ObjectWriter, fields []string) {
+func writeFieldList(w *ObjectWriter, fields []string, schema TableSchema) {
 	l := len(fields)
 	digits := len(strconv.Itoa(l))
 	fieldNumbers := make([]string, 0, l)
@@ -934,6 +946,10 @@ func writeFieldList(w *ObjectWriter, fields []string) {
 		w.Write(".")
 		w.WriteSpaces(1)
 		w.WriteColorWithoutLineBreak(fields[i], cmd.AttributeEffect)
+		if column, ok := schema.Column(fields[i]); ok {
+			w.WriteSpaces(1)
+			w.WriteColorWithoutLineBreak(column.String(), cmd.ValueEffect)
+		}
 		w.NewLine()
 	}
 }
@@ -217,7 +217,11 @@ var sourceTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("external executable file"),
+				Value: parser.PrimitiveType{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 7, SourceFile: GetTestFilePath("source.sql")}),
+					Literal:  "external executable file",
+					Value:    value.NewString("external executable file"),
+				},
 			},
 		},
 	},
@@ -228,7 +232,11 @@ var sourceTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("external executable file"),
+				Value: parser.PrimitiveType{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 7, SourceFile: GetTestFilePath("source.sql")}),
+					Literal:  "external executable file",
+					Value:    value.NewString("external executable file"),
+				},
 			},
 		},
 	},
@@ -309,7 +317,11 @@ var parseExecuteStatementsTests = []struct {
 		},
 		Result: []parser.Statement{
 			parser.Print{
-				Value: parser.NewStringValue("executable string"),
+				Value: parser.PrimitiveType{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 7, SourceFile: "(L:0 C:0) EXECUTE"}),
+					Literal:  "executable string",
+					Value:    value.NewString("executable string"),
+				},
 			},
 		},
 	},
@@ -409,6 +421,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set NumericMode",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "numeric_mode"},
+			Value: parser.NewStringValue("decimal"),
+		},
+	},
 	{
 		Name: "Set WaitTimeout",
 		Expr: parser.SetFlag{
@@ -917,6 +936,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@ANSI_QUOTES:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show NumericMode",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "numeric_mode"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "numeric_mode"},
+				Value: parser.NewStringValue("decimal"),
+			},
+		},
+		Result: "\033[34;1m@@NUMERIC_MODE:\033[0m \033[32mDECIMAL\033[0m",
+	},
 	{
 		Name: "Show WaitTimeout",
 		Expr: parser.ShowFlag{
@@ -2124,6 +2156,7 @@ var showObjectsTests = []struct {
 			"                  @@TIMEZONE: UTC\n" +
 			"           @@DATETIME_FORMAT: (not set)\n" +
 			"               @@ANSI_QUOTES: false\n" +
+			"              @@NUMERIC_MODE: FLOAT\n" +
 			"              @@WAIT_TIMEOUT: 15\n" +
 			"             @@IMPORT_FORMAT: CSV\n" +
 			"                 @@DELIMITER: ','\n" +
@@ -2165,6 +2198,9 @@ var showObjectsTests = []struct {
 			"     @#LOADED_TABLES: 0\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
+			"        @#ERROR_CODE: NULL\n" +
+			"      @#ERROR_NUMBER: NULL\n" +
+			"     @#ERROR_MESSAGE: NULL\n" +
 			"\n",
 	},
 	{
@@ -80,6 +80,32 @@ func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
 	return ternary.TRUE
 }
 
+func RegExp(p1 value.Primary, p2 value.Primary) (ternary.Value, error) {
+	if value.IsNull(p1) || value.IsNull(p2) {
+		return ternary.UNKNOWN, nil
+	}
+
+	s1 := value.ToString(p1)
+	if value.IsNull(s1) {
+		return ternary.UNKNOWN, nil
+	}
+	str := s1.(*value.String).Raw()
+	value.Discard(s1)
+
+	s2 := value.ToString(p2)
+	if value.IsNull(s2) {
+		return ternary.UNKNOWN, nil
+	}
+	pattern := s2.(*value.String).Raw()
+	value.Discard(s2)
+
+	r, err := RegExps.Get(pattern, "")
+	if err != nil {
+		return ternary.UNKNOWN, err
+	}
+	return ternary.ConvertFromBool(r.MatchString(str)), nil
+}
+
 func stringPattern(pattern []rune, position int) (int, int, string, int) {
 	anyRunesMinLen := 0
 	anyRunesMaxLen := 0
@@ -30,6 +30,7 @@ var statementPrefix = []string{
 	"INSERT",
 	"UPDATE",
 	"REPLACE",
+	"MERGE",
 	"DELETE",
 	"CREATE",
 	"ALTER",
@@ -49,6 +50,9 @@ var statementPrefix = []string{
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
+	"EXPLAIN",
+	"SAVEPOINT",
+	"RELEASE",
 }
 
 var singleCommandStatement = []string{
@@ -79,11 +83,19 @@ var joinCandidates = []string{
 	"NATURAL",
 }
 
+var pivotCandidates = []string{
+	"PIVOT ()",
+	"UNPIVOT ()",
+}
+
 var tableObjectCandidates = []string{
 	"CSV()",
 	"FIXED()",
 	"JSON()",
+	"JSONL()",
 	"LTSV()",
+	"PARQUET()",
+	"XLSX()",
 }
 
 var exportEncodingsCandidates = []string{
@@ -167,7 +179,7 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	completer.funcs = append(completer.funcs, "NOW")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+5)
 	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
@@ -175,6 +187,9 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_CONT")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_DISC")
+	completer.aggFuncs = append(completer.aggFuncs, "MODE")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -405,12 +420,22 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return nil
 	case parser.ROLLBACK:
 		return nil
+	case parser.SAVEPOINT:
+		return nil
+	case parser.RELEASE:
+		if 0 < len(line) && len(c.tokens) == 2 || len(line) < 1 && len(c.tokens) == 1 {
+			return readline.CandidateList{c.candidate("SAVEPOINT", true)}
+		} else {
+			return nil
+		}
 	case parser.EXIT:
 		return nil
 	case parser.PWD:
 		return nil
 	case parser.SYNTAX:
 		return nil
+	case parser.EXPLAIN:
+		return c.ExplainArgs(line, origLine, index)
 	case parser.EOF:
 		return c.GetStatementPrefix(line, origLine, index)
 	default:
@@ -455,6 +480,17 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
 			}
 		}
+	case "PARQUET":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 1:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+			}
+		}
 	default:
 		switch commaCnt {
 		case 0:
@@ -482,6 +518,10 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 				switch strings.ToUpper(c.tokens[0].Literal) {
 				case cmd.CSV.String(), cmd.FIXED.String():
 					cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+				case cmd.XLSX.String():
+					if commaCnt == 3 {
+						cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+					}
 				}
 			}
 		}
@@ -539,7 +579,7 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 						}, false)
 					}
 				}
-			case parser.ROWS:
+			case parser.ROWS, parser.RANGE, parser.GROUPS:
 				if i == c.lastIdx {
 					customList = append(customList, c.candidateList([]string{
 						"UNBOUNDED PRECEDING",
@@ -593,10 +633,14 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(funcName != "LISTAGG" && funcName != "JSON_AGG" && funcName != "PERCENTILE_CONT" && funcName != "PERCENTILE_DISC" && funcName != "MODE" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
-								customList = append(customList, c.candidate("ROWS", true))
+								customList = append(customList, c.candidateList([]string{
+									"ROWS",
+									"RANGE",
+									"GROUPS",
+								}, true)...)
 							}
 						}
 					} else {
@@ -645,6 +689,40 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 	)
 }
 
+func (c *Completer) ExplainArgs(line string, origLine string, index int) readline.CandidateList {
+	var isCompletingAt = func(pos int) bool {
+		return 0 < len(line) && len(c.tokens) == pos+1 || len(line) < 1 && len(c.tokens) == pos
+	}
+
+	queryStart := 1
+	if 1 < len(c.tokens) && c.tokens[1].Token == parser.ANALYZE && !isCompletingAt(1) {
+		queryStart = 2
+	}
+
+	if isCompletingAt(queryStart) {
+		keywords := []string{"SELECT", "WITH"}
+		if queryStart == 1 {
+			keywords = append(keywords, "ANALYZE")
+		}
+		cands := c.candidateList(keywords, true)
+		cands.Sort()
+		return cands
+	}
+
+	if queryStart < len(c.tokens) {
+		c.tokens = c.tokens[queryStart:]
+		c.SetLastIndex(line)
+
+		switch c.tokens[0].Token {
+		case parser.SELECT:
+			return c.SelectArgs(line, origLine, index)
+		case parser.WITH:
+			return c.WithArgs(line, origLine, index)
+		}
+	}
+	return nil
+}
+
 func (c *Completer) WithArgs(line string, origLine string, index int) readline.CandidateList {
 	var searchQuery = func() int {
 		blockLevel := 0
@@ -711,6 +789,7 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 								"INSERT",
 								"UPDATE",
 								"REPLACE",
+								"MERGE",
 								"DELETE",
 							)
 						} else {
@@ -874,7 +953,10 @@ func (c *Completer) fromClause(i int, line string, origLine string, index int) (
 	}
 
 	switch c.tokens[c.lastIdx].Token {
-	case parser.CROSS, parser.INNER, parser.OUTER:
+	case parser.CROSS:
+		customList = append(customList, c.candidateList([]string{"APPLY", "JOIN"}, true)...)
+		restrict = true
+	case parser.INNER, parser.OUTER:
 		customList = append(customList, c.candidate("JOIN", true))
 		restrict = true
 	case parser.LEFT, parser.RIGHT, parser.FULL:
@@ -917,6 +999,7 @@ func (c *Completer) fromClause(i int, line string, origLine string, index int) (
 
 	if c.tokens[c.lastIdx].Token == parser.IDENTIFIER {
 		customList = append(customList, c.candidateList(joinCandidates, true)...)
+		customList = append(customList, c.candidateList(pivotCandidates, true)...)
 
 		if c.tokens[c.lastIdx].Quoted == false {
 			customList = append(customList, c.candidate("AS", true))
@@ -1184,6 +1267,13 @@ func (c *Completer) SelectArgs(line string, origLine string, index int) readline
 					keywords = append(keywords, "BY")
 				} else {
 					customList = append(customList, c.whereClause(line, origLine, index)...)
+					if i == c.lastIdx-1 || c.tokens[c.lastIdx].Token == ',' {
+						customList = append(customList, c.candidateList([]string{
+							"ROLLUP",
+							"CUBE",
+							"GROUPING SETS",
+						}, false)...)
+					}
 					if i < c.lastIdx-1 && c.tokens[c.lastIdx].Token != ',' {
 						customList = append(customList, c.candidateList([]string{
 							"HAVING",
@@ -1518,7 +1608,7 @@ func (c *Completer) CreateArgs(line string, origLine string, index int) readline
 				}
 			case parser.CREATE:
 				if i == c.lastIdx {
-					return []string{"TABLE"}, nil, true
+					return []string{"INDEX", "TABLE"}, nil, true
 				}
 			}
 			return nil, nil, false
@@ -1631,6 +1721,8 @@ func (c *Completer) AlterArgs(line string, origLine string, index int) readline.
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case TableJsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case TableCompression:
+						return nil, c.candidateList(c.compressionList(), false), true
 					case TableHeader, TableEncloseAll, TablePrettyPrint:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					}
@@ -1797,6 +1889,8 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.SearchDirs(line, origLine, index), true
 					case cmd.TimezoneFlag:
 						return nil, c.candidateList([]string{"Local", "UTC"}, false), true
+					case cmd.NumericModeFlag:
+						return nil, c.candidateList(c.numericModeList(), false), true
 					case cmd.ImportFormatFlag:
 						return nil, c.candidateList(c.importFormatList(), false), true
 					case cmd.DelimiterFlag, cmd.WriteDelimiterFlag:
@@ -2007,7 +2101,7 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 
 func (c *Completer) SearchAllTables(line string, origLine string, index int) readline.CandidateList {
 	tableKeys := c.scope.Tx.cachedViews.SortedKeys()
-	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
+	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.XlsxExt, cmd.ParquetExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
 
 	defaultDir := c.scope.Tx.Flags.Repository
 	if len(defaultDir) < 1 {
@@ -2117,6 +2211,7 @@ func (c *Completer) SearchValues(line string, origLine string, index int) readli
 		"IS",
 		"BETWEEN",
 		"LIKE",
+		"REGEXP",
 		"IN",
 		"ANY",
 		"ALL",
@@ -2603,7 +2698,7 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	switch token.Token {
-	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.JSON_TABLE:
+	case parser.CSV, parser.JSON, parser.JSONL, parser.FIXED, parser.LTSV, parser.XLSX, parser.PARQUET, parser.JSON_TABLE:
 		return true
 	}
 	return false
@@ -2750,6 +2845,24 @@ func (c *Completer) lineBreakList() []string {
 	return list
 }
 
+func (c *Completer) compressionList() []string {
+	list := make([]string, 0, len(cmd.CompressionLiteral))
+	for _, v := range cmd.CompressionLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) numericModeList() []string {
+	list := make([]string, 0, len(cmd.NumericModeLiteral))
+	for _, v := range cmd.NumericModeLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
 func (c *Completer) jsonEscapeTypeList() []string {
 	list := make([]string, 0, len(cmd.JsonEscapeTypeLiteral))
 	for _, v := range cmd.JsonEscapeTypeLiteral {
@@ -114,7 +114,7 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.funcs) != len(Functions)+3 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+5 {
 		t.Error("aggregate functions are not set correctly")
 	}
 	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
@@ -137,7 +137,7 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.funcList) != len(Functions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list is not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+5+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list is not set correctly")
 	}
 	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
@@ -241,17 +241,21 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
 			{Name: []rune("PREPARE"), AppendSpace: true},
 			{Name: []rune("PRINT"), AppendSpace: true},
 			{Name: []rune("PRINTF"), AppendSpace: true},
 			{Name: []rune("PWD")},
+			{Name: []rune("RELEASE"), AppendSpace: true},
 			{Name: []rune("RELOAD"), AppendSpace: true},
 			{Name: []rune("REMOVE"), AppendSpace: true},
 			{Name: []rune("REPLACE"), AppendSpace: true},
 			{Name: []rune("ROLLBACK")},
+			{Name: []rune("SAVEPOINT"), AppendSpace: true},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("SET"), AppendSpace: true},
 			{Name: []rune("SHOW"), AppendSpace: true},
@@ -336,6 +340,7 @@ var completerStatementsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -498,6 +503,27 @@ var completerStatementsTests = []completerTest{
 		Index:    14,
 		Expect:   readline.CandidateList(nil),
 	},
+	{
+		Name:     "Statements EXPLAIN",
+		Line:     "",
+		OrigLine: "explain ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANALYZE"), AppendSpace: true},
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN ANALYZE",
+		Line:     "",
+		OrigLine: "explain analyze ",
+		Index:    16,
+		Expect: readline.CandidateList{
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "Statements DISPOSE",
 		Line:     "@",
@@ -837,8 +863,10 @@ var completerFunctionArgs = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("ASC")},
 			{Name: []rune("DESC")},
+			{Name: []rune("GROUPS"), AppendSpace: true},
 			{Name: []rune("NULLS FIRST")},
 			{Name: []rune("NULLS LAST")},
+			{Name: []rune("RANGE"), AppendSpace: true},
 			{Name: []rune("ROWS"), AppendSpace: true},
 		},
 	},
@@ -848,8 +876,10 @@ var completerFunctionArgs = []completerTest{
 		OrigLine: "count(1) over (order by f1 asc ",
 		Index:    31,
 		Expect: readline.CandidateList{
+			{Name: []rune("GROUPS"), AppendSpace: true},
 			{Name: []rune("NULLS FIRST")},
 			{Name: []rune("NULLS LAST")},
+			{Name: []rune("RANGE"), AppendSpace: true},
 			{Name: []rune("ROWS"), AppendSpace: true},
 		},
 	},
@@ -869,6 +899,8 @@ var completerFunctionArgs = []completerTest{
 		OrigLine: "count(1) over (order by f1 asc nulls first ",
 		Index:    43,
 		Expect: readline.CandidateList{
+			{Name: []rune("GROUPS"), AppendSpace: true},
+			{Name: []rune("RANGE"), AppendSpace: true},
 			{Name: []rune("ROWS"), AppendSpace: true},
 		},
 	},
@@ -1062,6 +1094,7 @@ var completerWithArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("REPLACE"), AppendSpace: true},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("UPDATE"), AppendSpace: true},
@@ -1199,6 +1232,7 @@ var completerSelectArgsTests = []completerTest{
 		Index:    14,
 		Expect: readline.CandidateList{
 			{Name: []rune("RANK() OVER ()")},
+			{Name: []rune("REGEXP"), AppendSpace: true},
 		},
 	},
 	{
@@ -1261,8 +1295,11 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1294,8 +1331,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1321,8 +1360,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1335,8 +1376,11 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1368,8 +1412,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1394,8 +1440,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1427,11 +1475,23 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "SelectArgs After CROSS in From Clause",
+		Line:     "",
+		OrigLine: "select 1 from tb1 as t cross ",
+		Index:    29,
+		Expect: readline.CandidateList{
+			{Name: []rune("APPLY"), AppendSpace: true},
+			{Name: []rune("JOIN"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "SelectArgs After INNER in From Clause",
 		Line:     "",
@@ -1484,8 +1544,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1610,7 +1672,10 @@ var completerSelectArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("@var1")},
 			{Name: []rune("@var2")},
+			{Name: []rune("CUBE")},
+			{Name: []rune("GROUPING SETS")},
 			{Name: []rune("JSON_ROW()")},
+			{Name: []rune("ROLLUP")},
 		},
 	},
 	{
@@ -1938,7 +2003,10 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2023,7 +2091,10 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2107,7 +2178,10 @@ var completerReplaceArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2216,8 +2290,11 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2245,8 +2322,11 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2269,7 +2349,9 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JOIN"), AppendSpace: true},
 			{Name: []rune("LEFT"), AppendSpace: true},
 			{Name: []rune("NATURAL"), AppendSpace: true},
+			{Name: []rune("PIVOT ()"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
+			{Name: []rune("UNPIVOT ()"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -2297,6 +2379,7 @@ var completerCreateArgsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -2396,7 +2479,10 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2538,6 +2624,7 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set ",
 		Index:    31,
 		Expect: readline.CandidateList{
+			{Name: []rune("COMPRESSION"), AppendSpace: true},
 			{Name: []rune("DELIMITER"), AppendSpace: true},
 			{Name: []rune("DELIMITER_POSITIONS"), AppendSpace: true},
 			{Name: []rune("ENCLOSE_ALL"), AppendSpace: true},
@@ -2589,10 +2676,13 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -2633,6 +2723,18 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("HEXALL")},
 		},
 	},
+	{
+		Name:     "AlterArgs Set Compression Values",
+		Line:     "",
+		OrigLine: "alter table `newtable.csv` set compression to ",
+		Index:    45,
+		Expect: readline.CandidateList{
+			{Name: []rune("BZIP2")},
+			{Name: []rune("GZIP")},
+			{Name: []rune("NONE")},
+			{Name: []rune("ZSTD")},
+		},
+	},
 	{
 		Name:     "AlterArgs Set Header Values",
 		Line:     "",
@@ -2947,8 +3049,11 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -3025,10 +3130,13 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -3359,7 +3467,10 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
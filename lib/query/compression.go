@@ -0,0 +1,65 @@
+package query
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	csvqbzip2 "github.com/mithrandie/csvq/lib/bzip2"
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// NewCompressionWriter returns a writer that compresses data written to it and writes them to w.
+// Close must be called to flush the compressed data.
+func NewCompressionWriter(w io.Writer, compression cmd.Compression) (io.WriteCloser, error) {
+	switch compression {
+	case cmd.GZIP:
+		return gzip.NewWriter(w), nil
+	case cmd.BZIP2:
+		return csvqbzip2.NewWriter(w), nil
+	case cmd.ZSTD:
+		return zstd.NewWriter(w)
+	}
+	return nopWriteCloser{w}, nil
+}
+
+// NewDecompressionReader returns a reader that decompresses data read from r.
+func NewDecompressionReader(r io.Reader, compression cmd.Compression) (io.ReadCloser, error) {
+	switch compression {
+	case cmd.GZIP:
+		return gzip.NewReader(r)
+	case cmd.BZIP2:
+		return ioutil.NopCloser(bzip2.NewReader(r)), nil
+	case cmd.ZSTD:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+	return ioutil.NopCloser(r), nil
+}
+
+func decompressFile(fp io.Reader, compression cmd.Compression) (io.ReadSeeker, error) {
+	r, err := NewDecompressionReader(fp, compression)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, appendCompositeError(err, r.Close())
+	}
+	return bytes.NewReader(data), r.Close()
+}
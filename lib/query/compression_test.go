@@ -0,0 +1,71 @@
+package query
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+var compressionTests = []struct {
+	Name        string
+	Compression cmd.Compression
+}{
+	{
+		Name:        "No Compression",
+		Compression: cmd.NoCompression,
+	},
+	{
+		Name:        "GZIP",
+		Compression: cmd.GZIP,
+	},
+	{
+		Name:        "BZIP2",
+		Compression: cmd.BZIP2,
+	},
+	{
+		Name:        "ZSTD",
+		Compression: cmd.ZSTD,
+	},
+}
+
+func TestNewCompressionWriter(t *testing.T) {
+	data := []byte("column1,column2\n1,str1\n2,str2\n3,str3\n")
+
+	for _, v := range compressionTests {
+		buf := &bytes.Buffer{}
+		w, err := NewCompressionWriter(buf, v.Compression)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if _, err = w.Write(data); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if err = w.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		if v.Compression != cmd.NoCompression && bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("%s: data is not compressed", v.Name)
+		}
+
+		r, err := NewDecompressionReader(buf, v.Compression)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		result, err := ioutil.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !bytes.Equal(result, data) {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, data)
+		}
+	}
+}
@@ -6,11 +6,14 @@ import (
 	"context"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
@@ -36,9 +39,15 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, fileInfo *FileInf
 	case cmd.FIXED:
 		return "", encodeFixedLengthFormat(ctx, fp, view, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.SingleLine)
 	case cmd.JSON:
-		return "", encodeJson(ctx, fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint, tx)
+		return "", encodeJson(ctx, fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint, false, tx)
+	case cmd.JSONL:
+		return "", encodeJson(ctx, fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, false, true, tx)
 	case cmd.LTSV:
 		return "", encodeLTSV(ctx, fp, view, fileInfo.LineBreak, fileInfo.Encoding)
+	case cmd.XLSX:
+		return "", encodeXLSX(ctx, fp, view, fileInfo.Sheet, fileInfo.NoHeader)
+	case cmd.PARQUET:
+		return "", encodeParquet(ctx, fp, view)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
 		return encodeText(ctx, fp, view, fileInfo.Format, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, tx)
 	case cmd.TSV:
@@ -49,23 +58,93 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, fileInfo *FileInf
 	}
 }
 
-func encodeCSV(ctx context.Context, fp io.Writer, view *View, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool) error {
+// encodeFile writes the view to fp compressed in the compression type of the file.
+func encodeFile(ctx context.Context, fp io.Writer, view *View, fileInfo *FileInfo, tx *Transaction) error {
+	w, err := NewCompressionWriter(fp, fileInfo.Compression)
+	if err != nil {
+		return err
+	}
+	if _, err = EncodeView(ctx, w, view, fileInfo, tx); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+type recordEncoder interface {
+	Write(record Record) error
+	Flush() error
+}
+
+func newRecordEncoder(fp io.Writer, header Header, fileInfo *FileInfo, tx *Transaction) (recordEncoder, error) {
+	switch fileInfo.Format {
+	case cmd.JSON:
+		return newJsonEncoder(fp, header, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint, false, tx)
+	case cmd.JSONL:
+		return newJsonEncoder(fp, header, fileInfo.LineBreak, fileInfo.JsonEscape, false, true, tx)
+	case cmd.TSV:
+		return newCSVEncoder(fp, header, '\t', fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll)
+	default: // cmd.CSV
+		return newCSVEncoder(fp, header, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll)
+	}
+}
+
+type csvEncoder struct {
+	w          *csv.Writer
+	fields     []csv.Field
+	encloseAll bool
+}
+
+func newCSVEncoder(fp io.Writer, header Header, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool) (*csvEncoder, error) {
 	w, err := csv.NewWriter(fp, lineBreak, encoding)
 	if err != nil {
-		return NewDataEncodingError(err.Error())
+		return nil, NewDataEncodingError(err.Error())
 	}
 	w.Delimiter = delimiter
 
-	fields := make([]csv.Field, view.FieldLen())
+	e := &csvEncoder{
+		w:          w,
+		fields:     make([]csv.Field, header.Len()),
+		encloseAll: encloseAll,
+	}
 
 	if !withoutHeader {
-		for i := range view.Header {
-			fields[i] = csv.NewField(view.Header[i].Column, encloseAll)
+		for i := range header {
+			e.fields[i] = csv.NewField(header[i].Column, encloseAll)
 		}
-		if err := w.Write(fields); err != nil {
-			return NewSystemError(err.Error())
+		if err := w.Write(e.fields); err != nil {
+			return nil, NewSystemError(err.Error())
 		}
 	}
+	return e, nil
+}
+
+func (e *csvEncoder) Write(record Record) error {
+	for i := range record {
+		str, effect, _ := ConvertFieldContents(record[i][0], false)
+		quote := false
+		if e.encloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
+			quote = true
+		}
+		e.fields[i] = csv.NewField(str, quote)
+	}
+	if err := e.w.Write(e.fields); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (e *csvEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeCSV(ctx context.Context, fp io.Writer, view *View, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool) error {
+	e, err := newCSVEncoder(fp, view.Header, delimiter, lineBreak, withoutHeader, encoding, encloseAll)
+	if err != nil {
+		return err
+	}
 
 	for i := range view.RecordSet {
 		if i&15 == 0 && ctx.Err() != nil {
@@ -73,22 +152,14 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, delimiter rune, li
 			break
 		}
 
-		for j := range view.RecordSet[i] {
-			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
-			quote := false
-			if encloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
-				quote = true
-			}
-			fields[j] = csv.NewField(str, quote)
-		}
-		if err := w.Write(fields); err != nil {
-			return NewSystemError(err.Error())
+		if err := e.Write(view.RecordSet[i]); err != nil {
+			return err
 		}
 	}
-	if err = w.Flush(); err != nil {
-		return NewSystemError(err.Error())
+	if e := e.Flush(); e != nil {
+		return e
 	}
-	return nil
+	return err
 }
 
 func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, positions []int, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, singleLine bool) error {
@@ -185,27 +256,19 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, posi
 	return nil
 }
 
-func encodeJson(ctx context.Context, fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool, tx *Transaction) error {
-	header := view.Header.TableColumnNames()
-	records := make([][]value.Primary, view.RecordLen())
-	for i := range view.RecordSet {
-		if i&15 == 0 && ctx.Err() != nil {
-			return ConvertContextError(ctx.Err())
-		}
-
-		row := make([]value.Primary, view.FieldLen())
-		for j := range view.RecordSet[i] {
-			row[j] = view.RecordSet[i][j][0]
-		}
-		records[i] = row
-	}
+type jsonEncoder struct {
+	w             *bufio.Writer
+	e             *txjson.Encoder
+	pathes        []json.PathExpression
+	lineBreak     string
+	lineDelimited bool
+	count         int
+}
 
-	data, err := json.ConvertTableValueToJsonStructure(ctx, header, records)
+func newJsonEncoder(fp io.Writer, header Header, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool, lineDelimited bool, tx *Transaction) (*jsonEncoder, error) {
+	pathes, err := json.ParsePathes(header.TableColumnNames())
 	if err != nil {
-		if ctx.Err() != nil {
-			return ConvertContextError(ctx.Err())
-		}
-		return NewDataEncodingError(err.Error())
+		return nil, NewDataEncodingError(err.Error())
 	}
 
 	e := txjson.NewEncoder()
@@ -215,20 +278,100 @@ func encodeJson(ctx context.Context, fp io.Writer, view *View, lineBreak text.Li
 	if prettyPrint && tx.Flags.Color {
 		e.Palette = tx.Palette
 	}
-	defer tx.UseColor(tx.Flags.Color)
 
-	s := e.Encode(data)
+	enc := &jsonEncoder{
+		w:             bufio.NewWriter(fp),
+		e:             e,
+		pathes:        pathes,
+		lineDelimited: lineDelimited,
+	}
+	if prettyPrint || lineDelimited {
+		enc.lineBreak = lineBreak.Value()
+	}
+	return enc, nil
+}
 
-	w := bufio.NewWriter(fp)
-	if _, err = w.WriteString(s); err != nil {
+// Write encodes a record as an element of a JSON array.
+// Each record is encoded in an array with a single element, then the brackets are trimmed
+// so that the indentation is the same as when the whole array is encoded at once.
+// If the encoder is line-delimited, each record is encoded as a single line instead.
+func (e *jsonEncoder) Write(record Record) error {
+	row := make([]value.Primary, len(record))
+	for i := range record {
+		row[i] = record[i][0]
+	}
+
+	structure, err := json.ConvertRecordValueToJsonStructure(e.pathes, row)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+
+	if e.lineDelimited {
+		s := e.e.Encode(structure)
+		if 0 < e.count {
+			s = e.lineBreak + s
+		}
+		e.count++
+
+		if _, err = e.w.WriteString(s); err != nil {
+			return NewSystemError(err.Error())
+		}
+		return nil
+	}
+
+	s := e.e.Encode(txjson.Array{structure})
+	s = strings.TrimSuffix(s[1:len(s)-1], e.lineBreak)
+
+	if e.count < 1 {
+		s = "[" + s
+	} else {
+		s = "," + s
+	}
+	e.count++
+
+	if _, err = e.w.WriteString(s); err != nil {
 		return NewSystemError(err.Error())
 	}
-	if err = w.Flush(); err != nil {
+	return nil
+}
+
+func (e *jsonEncoder) Flush() error {
+	if !e.lineDelimited {
+		s := e.lineBreak + "]"
+		if e.count < 1 {
+			s = "[]"
+		}
+
+		if _, err := e.w.WriteString(s); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err := e.w.Flush(); err != nil {
 		return NewSystemError(err.Error())
 	}
 	return nil
 }
 
+func encodeJson(ctx context.Context, fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool, lineDelimited bool, tx *Transaction) error {
+	defer tx.UseColor(tx.Flags.Color)
+
+	e, err := newJsonEncoder(fp, view.Header, lineBreak, escapeType, prettyPrint, lineDelimited, tx)
+	if err != nil {
+		return err
+	}
+
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		if err = e.Write(view.RecordSet[i]); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
+
 func encodeText(ctx context.Context, fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, tx *Transaction) (string, error) {
 	isPlainTable := false
 
@@ -370,6 +513,92 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, lineBreak text.Li
 	return nil
 }
 
+func encodeXLSX(ctx context.Context, fp io.Writer, view *View, sheet string, withoutHeader bool) error {
+	var header []string
+	if !withoutHeader {
+		header = make([]string, view.FieldLen())
+		for i := range view.Header {
+			header[i] = view.Header[i].Column
+		}
+	}
+
+	rows, err := nativeRows(ctx, view)
+	if err != nil {
+		return err
+	}
+
+	if err := xlsx.Write(fp, sheet, header, rows); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeParquet(ctx context.Context, fp io.Writer, view *View) error {
+	header := make([]string, view.FieldLen())
+	for i := range view.Header {
+		header[i] = view.Header[i].Column
+	}
+
+	rows, err := nativeRows(ctx, view)
+	if err != nil {
+		return err
+	}
+
+	fields := parquet.InferFields(header, rows)
+	for i := range view.RecordSet {
+		for j := range fields {
+			if fields[j].Type != parquet.StringField || rows[i][j] == nil {
+				continue
+			}
+			if _, ok := rows[i][j].(string); !ok {
+				rows[i][j], _, _ = ConvertFieldContents(view.RecordSet[i][j][0], false)
+			}
+		}
+	}
+
+	if err := parquet.Write(fp, fields, rows); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+// nativeRows returns the values in the view as nil, string, int64, float64, bool or time.Time.
+// Decimal values are converted to float64, and interval values are converted to strings.
+func nativeRows(ctx context.Context, view *View) ([][]interface{}, error) {
+	rows := make([][]interface{}, view.RecordLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		row := make([]interface{}, view.FieldLen())
+		for j := range view.RecordSet[i] {
+			switch p := view.RecordSet[i][j][0].(type) {
+			case *value.String:
+				row[j] = p.Raw()
+			case *value.Integer:
+				row[j] = p.Raw()
+			case *value.Float:
+				row[j] = p.Raw()
+			case *value.Decimal:
+				row[j] = p.Float64()
+			case *value.Boolean:
+				row[j] = p.Raw()
+			case *value.Ternary:
+				if p.Ternary() != ternary.UNKNOWN {
+					row[j] = p.Ternary().ParseBool()
+				}
+			case *value.Datetime:
+				row[j] = p.Raw()
+			case *value.Interval:
+				row[j] = p.Literal()
+			}
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
@@ -387,6 +616,10 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 		s = val.(*value.Float).String()
 		effect = cmd.NumberEffect
 		align = text.RightAligned
+	case *value.Decimal:
+		s = val.(*value.Decimal).String()
+		effect = cmd.NumberEffect
+		align = text.RightAligned
 	case *value.Boolean:
 		s = val.(*value.Boolean).String()
 		effect = cmd.BooleanEffect
@@ -405,6 +638,9 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 	case *value.Datetime:
 		s = val.(*value.Datetime).Format(time.RFC3339Nano)
 		effect = cmd.DatetimeEffect
+	case *value.Interval:
+		s = val.(*value.Interval).Literal()
+		effect = cmd.DatetimeEffect
 	case *value.Null:
 		if forTextTable {
 			s = "NULL"
@@ -3,9 +3,12 @@ package query
 import (
 	"bytes"
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
@@ -336,6 +339,46 @@ var encodeViewTests = []struct {
 			"  }\n" +
 			"]",
 	},
+	{
+		Name: "JSONL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.sub"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull()}),
+			},
+		},
+		Format:      cmd.JSONL,
+		LineBreak:   text.CRLF,
+		PrettyPrint: true,
+		Result: "{\"c1\":-1,\"c2\":{\"sub\":\"a\"}}\r\n" +
+			"{\"c1\":2.0123,\"c2\":{\"sub\":null}}",
+	},
+	{
+		Name: "CSV with Intervals",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInterval(0, 3, int64(4*time.Hour))}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewInterval(14, 0, 0)}),
+			},
+		},
+		Format: cmd.CSV,
+		Result: "c1,c2\n" +
+			"1,3 days 04:00:00\n" +
+			"2,1 year 2 months",
+	},
+	{
+		Name: "JSONL with Intervals",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInterval(0, 0, -int64(90*time.Minute))}),
+			},
+		},
+		Format: cmd.JSONL,
+		Result: "{\"c1\":1,\"c2\":\"-01:30:00\"}",
+	},
 	{
 		Name: "LTSV",
 		View: &View{
@@ -474,3 +517,72 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeView_XLSX(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2", "c3", "c4", "c5"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewNull(), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewTernary(ternary.UNKNOWN), value.NewString(""), value.NewBoolean(true), value.NewNull()}),
+		},
+	}
+	fileInfo := &FileInfo{
+		Format: cmd.XLSX,
+		Sheet:  "test",
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := EncodeView(context.Background(), buf, view, fileInfo, TestTx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err := loadViewFromXLSXFile(buf, &FileInfo{Path: "test.xlsx", Sheet: "test"}, false, parser.Identifier{Literal: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{value.NewInteger(-1), value.NewBoolean(false), value.NewString("abc"), value.NewNull(), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+		NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString(""), value.NewBoolean(true), value.NewNull()}),
+	}
+	if !reflect.DeepEqual(result.Header, view.Header) {
+		t.Errorf("header = %v, want %v", result.Header, view.Header)
+	}
+	if !reflect.DeepEqual(result.RecordSet, expect) {
+		t.Errorf("records = %s, want %s", result.RecordSet, expect)
+	}
+}
+
+func TestEncodeView_Parquet(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2", "c3", "c4", "c5"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewInteger(1), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewTernary(ternary.UNKNOWN), value.NewNull(), value.NewString("def"), value.NewNull()}),
+		},
+	}
+	fileInfo := &FileInfo{
+		Format: cmd.PARQUET,
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := EncodeView(context.Background(), buf, view, fileInfo, TestTx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err := loadViewFromParquetFile(context.Background(), buf, &FileInfo{Path: "test.parquet"}, false, parser.Identifier{Literal: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{value.NewFloat(-1), value.NewBoolean(false), value.NewString("abc"), value.NewString("1"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+		NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewNull(), value.NewString("def"), value.NewNull()}),
+	}
+	if !reflect.DeepEqual(result.Header, view.Header) {
+		t.Errorf("header = %v, want %v", result.Header, view.Header)
+	}
+	if !reflect.DeepEqual(result.RecordSet, expect) {
+		t.Errorf("records = %s, want %s", result.RecordSet, expect)
+	}
+}
@@ -126,6 +126,24 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgInvalidRegExp                        = "invalid regular expression: %s"
+	ErrMsgMergeRecordAmbiguous                 = "record in %s to be modified matches multiple records of the source"
+	ErrMsgInvalidColumnType                    = "%s is not a valid column type"
+	ErrMsgColumnTypeMismatch                   = "value %s cannot be stored in field %s of type %s"
+	ErrMsgColumnNotNullViolation               = "field %s does not allow null"
+	ErrMsgInvalidTableSchema                   = "invalid table schema %s: %s"
+	ErrMsgIndexAlreadyExist                    = "index %s already exists on %s"
+	ErrMsgIndexNotExist                        = "index %s does not exist on %s"
+	ErrMsgIndexNotSupported                    = "index cannot be created on %s: %s"
+	ErrMsgInvalidIndexName                     = "%s is not a valid index name"
+	ErrMsgInvalidWindowFrame                   = "invalid window frame %s: %s"
+	ErrMsgInvalidLateralJoin                   = "invalid lateral join: %s"
+	ErrMsgUndeclaredSavepoint                  = "savepoint %s is undeclared"
+	ErrMsgGlobTableHeaderNotMatch              = "header of file %s does not match header of file %s"
+	ErrMsgGlobTableReadOnly                    = "table %s consists of multiple files and cannot be modified by this statement"
+	ErrMsgSchemaNotExist                       = "schema %s does not exist"
+	ErrMsgSchemaTableNotExist                  = "table %s does not exist in schema %s"
+	ErrMsgOperationNotAllowed                  = "%s is not allowed in this session"
 )
 
 type Error interface {
@@ -1440,6 +1458,186 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type InvalidRegExpError struct {
+	*BaseError
+}
+
+func NewInvalidRegExpError(expr parser.QueryExpression, message string) error {
+	return &InvalidRegExpError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidRegExp, message), ReturnCodeApplicationError, ErrorInvalidRegExp),
+	}
+}
+
+type MergeRecordAmbiguousError struct {
+	*BaseError
+}
+
+func NewMergeRecordAmbiguousError(clause parser.MergeWhenClause, table parser.Identifier) error {
+	return &MergeRecordAmbiguousError{
+		NewBaseError(clause, fmt.Sprintf(ErrMsgMergeRecordAmbiguous, table), ReturnCodeApplicationError, ErrorMergeRecordAmbiguous),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, fmt.Sprintf(ErrMsgInvalidColumnType, columnType), ReturnCodeApplicationError, ErrorInvalidColumnType),
+	}
+}
+
+type ColumnTypeMismatchError struct {
+	*BaseError
+}
+
+func NewColumnTypeMismatchError(expr parser.QueryExpression, p value.Primary, field string, columnType ColumnType) error {
+	return &ColumnTypeMismatchError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnTypeMismatch, p.String(), field, columnType), ReturnCodeApplicationError, ErrorColumnTypeMismatch),
+	}
+}
+
+type ColumnNotNullViolationError struct {
+	*BaseError
+}
+
+func NewColumnNotNullViolationError(expr parser.QueryExpression, field string) error {
+	return &ColumnNotNullViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnNotNullViolation, field), ReturnCodeApplicationError, ErrorColumnNotNullViolation),
+	}
+}
+
+type InvalidTableSchemaError struct {
+	*BaseError
+}
+
+func NewInvalidTableSchemaError(expr parser.QueryExpression, path string, message string) error {
+	return &InvalidTableSchemaError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidTableSchema, path, message), ReturnCodeApplicationError, ErrorInvalidTableSchema),
+	}
+}
+
+type IndexAlreadyExistError struct {
+	*BaseError
+}
+
+func NewIndexAlreadyExistError(name parser.Identifier, path string) error {
+	return &IndexAlreadyExistError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgIndexAlreadyExist, name, path), ReturnCodeApplicationError, ErrorIndexAlreadyExist),
+	}
+}
+
+type IndexNotExistError struct {
+	*BaseError
+}
+
+func NewIndexNotExistError(name parser.Identifier, path string) error {
+	return &IndexNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgIndexNotExist, name, path), ReturnCodeApplicationError, ErrorIndexNotExist),
+	}
+}
+
+type IndexNotSupportedError struct {
+	*BaseError
+}
+
+func NewIndexNotSupportedError(expr parser.QueryExpression, path string, message string) error {
+	return &IndexNotSupportedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgIndexNotSupported, path, message), ReturnCodeApplicationError, ErrorIndexNotSupported),
+	}
+}
+
+type InvalidIndexNameError struct {
+	*BaseError
+}
+
+func NewInvalidIndexNameError(name parser.Identifier) error {
+	return &InvalidIndexNameError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgInvalidIndexName, name), ReturnCodeApplicationError, ErrorInvalidIndexName),
+	}
+}
+
+type InvalidWindowFrameError struct {
+	*BaseError
+}
+
+func NewInvalidWindowFrameError(expr parser.AnalyticFunction, message string) error {
+	return &InvalidWindowFrameError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidWindowFrame, expr.AnalyticClause.WindowingClause, message), ReturnCodeApplicationError, ErrorInvalidWindowFrame),
+	}
+}
+
+type InvalidLateralJoinError struct {
+	*BaseError
+}
+
+func NewInvalidLateralJoinError(join parser.Join, message string) error {
+	return &InvalidLateralJoinError{
+		NewBaseError(join, fmt.Sprintf(ErrMsgInvalidLateralJoin, message), ReturnCodeApplicationError, ErrorInvalidLateralJoin),
+	}
+}
+
+type UndeclaredSavepointError struct {
+	*BaseError
+}
+
+func NewUndeclaredSavepointError(name parser.Identifier) error {
+	return &UndeclaredSavepointError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgUndeclaredSavepoint, name.Literal), ReturnCodeApplicationError, ErrorUndeclaredSavepoint),
+	}
+}
+
+type GlobTableHeaderNotMatchError struct {
+	*BaseError
+}
+
+func NewGlobTableHeaderNotMatchError(expr parser.QueryExpression, fpath string, basePath string) error {
+	return &GlobTableHeaderNotMatchError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgGlobTableHeaderNotMatch, fpath, basePath), ReturnCodeApplicationError, ErrorGlobTableHeaderNotMatch),
+	}
+}
+
+type GlobTableReadOnlyError struct {
+	*BaseError
+}
+
+func NewGlobTableReadOnlyError(expr parser.QueryExpression) error {
+	return &GlobTableReadOnlyError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgGlobTableReadOnly, expr), ReturnCodeApplicationError, ErrorGlobTableReadOnly),
+	}
+}
+
+type SchemaNotExistError struct {
+	*BaseError
+}
+
+func NewSchemaNotExistError(schema parser.Identifier) error {
+	return &SchemaNotExistError{
+		NewBaseError(schema, fmt.Sprintf(ErrMsgSchemaNotExist, schema), ReturnCodeApplicationError, ErrorSchemaNotExist),
+	}
+}
+
+type SchemaTableNotExistError struct {
+	*BaseError
+}
+
+func NewSchemaTableNotExistError(table parser.SchemaTable) error {
+	return &SchemaTableNotExistError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgSchemaTableNotExist, table.Table, table.Schema), ReturnCodeApplicationError, ErrorSchemaTableNotExist),
+	}
+}
+
+type OperationNotAllowedError struct {
+	*BaseError
+}
+
+func NewOperationNotAllowedError(expr parser.Expression, operation string) error {
+	return &OperationNotAllowedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgOperationNotAllowed, operation), ReturnCodeApplicationError, ErrorOperationNotAllowed),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
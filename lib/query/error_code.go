@@ -106,6 +106,24 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorInvalidRegExp                        = 14101
+	ErrorMergeRecordAmbiguous                 = 14201
+	ErrorInvalidColumnType                    = 14301
+	ErrorColumnTypeMismatch                   = 14302
+	ErrorColumnNotNullViolation               = 14303
+	ErrorInvalidTableSchema                   = 14304
+	ErrorIndexAlreadyExist                    = 14401
+	ErrorIndexNotExist                        = 14402
+	ErrorIndexNotSupported                    = 14403
+	ErrorInvalidIndexName                     = 14404
+	ErrorInvalidWindowFrame                   = 14501
+	ErrorInvalidLateralJoin                   = 14601
+	ErrorUndeclaredSavepoint                  = 14701
+	ErrorGlobTableHeaderNotMatch              = 14801
+	ErrorGlobTableReadOnly                    = 14802
+	ErrorSchemaNotExist                       = 14901
+	ErrorSchemaTableNotExist                  = 14902
+	ErrorOperationNotAllowed                  = 15001
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
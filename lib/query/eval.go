@@ -25,7 +25,7 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 
 	switch expr.(type) {
 	case parser.PrimitiveType:
-		val = expr.(parser.PrimitiveType).Value
+		val = evalPrimitiveType(expr.(parser.PrimitiveType), scope.Tx.Flags.NumericMode)
 	case parser.FieldReference, parser.ColumnNumber:
 		val, err = evalFieldReference(expr, scope)
 	case parser.Parentheses:
@@ -44,6 +44,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalBetween(ctx, scope, expr.(parser.Between))
 	case parser.Like:
 		val, err = evalLike(ctx, scope, expr.(parser.Like))
+	case parser.RegExp:
+		val, err = evalRegExp(ctx, scope, expr.(parser.RegExp))
 	case parser.In:
 		val, err = evalIn(ctx, scope, expr.(parser.In))
 	case parser.Any:
@@ -180,6 +182,15 @@ func evalFieldReference(expr parser.QueryExpression, scope *ReferenceScope) (val
 	return p, nil
 }
 
+func evalPrimitiveType(expr parser.PrimitiveType, mode cmd.NumericMode) value.Primary {
+	if mode == cmd.DecimalMode && 0 < len(expr.Literal) {
+		if _, ok := expr.Value.(*value.Float); ok {
+			return value.NewDecimalFromString(expr.Literal)
+		}
+	}
+	return expr.Value
+}
+
 func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arithmetic) (value.Primary, error) {
 	lhs, err := Evaluate(ctx, scope, expr.LHS)
 	if err != nil {
@@ -194,7 +205,7 @@ func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arit
 		return nil, err
 	}
 
-	return Calculate(lhs, rhs, expr.Operator), nil
+	return Calculate(lhs, rhs, expr.Operator, scope.Tx.Flags), nil
 }
 
 func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.UnaryArithmetic) (value.Primary, error) {
@@ -203,6 +214,20 @@ func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser
 		return nil, err
 	}
 
+	if iv, ok := ope.(*value.Interval); ok {
+		if expr.Operator.Token == '-' {
+			return iv.Neg(), nil
+		}
+		return iv, nil
+	}
+
+	if d, ok := decimalOperand(ope, scope.Tx.Flags.NumericMode); ok {
+		if expr.Operator.Token == '-' {
+			return d.Neg(), nil
+		}
+		return d, nil
+	}
+
 	if pi := value.ToInteger(ope); !value.IsNull(pi) {
 		val := pi.(*value.Integer).Raw()
 		value.Discard(pi)
@@ -494,8 +519,28 @@ func evalLike(ctx context.Context, scope *ReferenceScope, expr parser.Like) (val
 	return value.NewTernary(t), nil
 }
 
+func evalRegExp(ctx context.Context, scope *ReferenceScope, expr parser.RegExp) (value.Primary, error) {
+	lhs, err := Evaluate(ctx, scope, expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := Evaluate(ctx, scope, expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := RegExp(lhs, pattern)
+	if err != nil {
+		return nil, NewInvalidRegExpError(expr, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists) (value.Primary, error) {
-	view, err := Select(ctx, scope, expr.Query.Query)
+	view, err := selectSubquery(ctx, scope, expr.Query.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -506,7 +551,7 @@ func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists)
 }
 
 func evalSubqueryForValue(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) (value.Primary, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := selectSubquery(ctx, scope, expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -534,7 +579,7 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	var ok bool
 	var err error
 
-	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" {
+	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" && name != "GROUPING" {
 		udfn, err = scope.GetFunction(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -556,6 +601,9 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	if name == "JSON_OBJECT" {
 		return JsonObject(ctx, scope, expr)
 	}
+	if name == "GROUPING" {
+		return Grouping(scope, expr)
+	}
 
 	args := make([]value.Primary, len(expr.Args))
 	for i, v := range expr.Args {
@@ -567,6 +615,9 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	}
 
 	if name == "CALL" {
+		if scope.Tx.Sandboxed {
+			return nil, NewOperationNotAllowedError(expr, "CALL function")
+		}
 		return Call(ctx, expr, args)
 	} else if name == "NOW" {
 		return Now(scope, expr, args)
@@ -613,11 +664,11 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			listExpr = parser.NewIntegerValue(1)
 		}
 
-		if uname == "COUNT" {
+		if uname == "COUNT" && expr.Filter == nil {
 			if pt, ok := listExpr.(parser.PrimitiveType); ok {
 				v := pt.Value
 				if !value.IsNull(v) && !value.IsUnknown(v) && scope.Records[0].IsInRange() {
-					return value.NewInteger(int64(scope.Records[0].view.RecordSet[scope.Records[0].recordIndex].GroupLen())), nil
+					return value.NewInteger(int64(scope.Records[0].view.groupLen(scope.Records[0].recordIndex))), nil
 				} else {
 					return value.NewInteger(0), nil
 				}
@@ -629,6 +680,11 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			if err != nil {
 				return nil, err
 			}
+			if expr.Filter != nil {
+				if err = view.filter(ctx, scope, expr.Filter.(parser.FilterClause).Condition); err != nil {
+					return nil, err
+				}
+			}
 			list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
 			if err != nil {
 				return nil, err
@@ -654,11 +710,19 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 
 func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var fraction float64
 	var err error
 
-	switch strings.ToUpper(expr.Name) {
+	var listExpr parser.QueryExpression
+
+	uname := strings.ToUpper(expr.Name)
+	switch uname {
 	case "JSON_AGG":
 		err = checkArgsForJsonAgg(expr)
+	case "PERCENTILE_CONT", "PERCENTILE_DISC", "MODE":
+		if fraction, err = checkArgsForOrderedSetFunction(ctx, scope, expr); err == nil {
+			listExpr = expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+		}
 	default: // LISTAGG
 		separator, err = checkArgsForListFunction(ctx, scope, expr)
 	}
@@ -666,6 +730,9 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 	if err != nil {
 		return nil, err
 	}
+	if listExpr == nil {
+		listExpr = expr.Args[0]
+	}
 
 	var list []value.Primary
 	if 0 < len(scope.Records) {
@@ -677,6 +744,11 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 		if err != nil {
 			return nil, err
 		}
+		if expr.Filter != nil {
+			if err := view.filter(ctx, scope, expr.Filter.(parser.FilterClause).Condition); err != nil {
+				return nil, err
+			}
+		}
 		if expr.OrderBy != nil {
 			err := view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause))
 			if err != nil {
@@ -684,15 +756,21 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 			}
 		}
 
-		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[0], expr.IsDistinct())
+		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	switch strings.ToUpper(expr.Name) {
+	switch uname {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "PERCENTILE_CONT":
+		return PercentileCont(list, fraction), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
+	case "MODE":
+		return Mode(list, scope.Tx.Flags), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -726,6 +804,37 @@ func checkArgsForJsonAgg(expr parser.ListFunction) error {
 	return nil
 }
 
+func checkArgsForOrderedSetFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (float64, error) {
+	if expr.OrderBy == nil {
+		return 0, NewFunctionInvalidArgumentError(expr, expr.Name, "WITHIN GROUP clause is required")
+	}
+	if len(expr.OrderBy.(parser.OrderByClause).Items) != 1 {
+		return 0, NewFunctionInvalidArgumentError(expr, expr.Name, "WITHIN GROUP clause must have exactly one sort key")
+	}
+
+	if strings.EqualFold(expr.Name, "MODE") {
+		if 0 < len(expr.Args) {
+			return 0, NewFunctionArgumentLengthError(expr, expr.Name, []int{0})
+		}
+		return 0, nil
+	}
+
+	if len(expr.Args) != 1 {
+		return 0, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+	}
+	p, err := Evaluate(ctx, scope, expr.Args[0])
+	if err != nil {
+		return 0, err
+	}
+	f := value.ToFloat(p)
+	if value.IsNull(f) || f.(*value.Float).Raw() < 0 || 1 < f.(*value.Float).Raw() {
+		return 0, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a number between 0 and 1")
+	}
+	fraction := f.(*value.Float).Raw()
+	value.Discard(f)
+	return fraction, nil
+}
+
 func evalCaseExpr(ctx context.Context, scope *ReferenceScope, expr parser.CaseExpr) (value.Primary, error) {
 	var val value.Primary
 	var err error
@@ -958,7 +1067,7 @@ func evalArray(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpr
 }
 
 func evalSubqueryForRowValue(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) (value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := selectSubquery(ctx, scope, expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,7 +1132,7 @@ func evalValueList(ctx context.Context, scope *ReferenceScope, expr parser.Value
 }
 
 func evalSubqueryForRowValueList(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) ([]value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := selectSubquery(ctx, scope, expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,7 +1181,7 @@ func evalJsonQueryForRowValueList(ctx context.Context, scope *ReferenceScope, ex
 }
 
 func evalSubqueryForArray(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) ([]value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := selectSubquery(ctx, scope, expr.Query)
 	if err != nil {
 		return nil, err
 	}
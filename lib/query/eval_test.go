@@ -2297,6 +2297,47 @@ var evaluateTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "RegExp",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abc123"),
+			Pattern: parser.NewStringValue("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExp Negation",
+		Expr: parser.RegExp{
+			LHS:      parser.NewStringValue("abc123"),
+			Pattern:  parser.NewStringValue("^[a-z]+\\d+$"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExp LHS Error",
+		Expr: parser.RegExp{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("^a"),
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Pattern Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Invalid Pattern Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("a(b"),
+		},
+		Error: "invalid regular expression: error parsing regexp: missing closing ): `a(b`",
+	},
 	{
 		Name: "Exists",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -2668,6 +2709,57 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		Name: "Aggregate Function with Filter",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where:  "where",
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: ">",
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
 	{
 		Name: "Aggregate Function Argument Length Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -3320,6 +3412,294 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewString("str1,str2"),
 	},
+	{
+		Name: "ListAgg Function with Filter",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			Filter: parser.FilterClause{
+				Filter: "filter",
+				Where:  "where",
+				Condition: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: ">",
+				},
+			},
+		},
+		Result: value.NewString("str2"),
+	},
+	{
+		Name: "Percentile_cont Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValueFromString("0.5"),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Result: value.NewFloat(2.5),
+	},
+	{
+		Name: "Percentile_disc Function with Descending Order",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValueFromString("0.5"),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Mode Function with Filter",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name:        "mode",
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+			Filter: parser.FilterClause{
+				Filter:    "filter",
+				Where:     "where",
+				Condition: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: value.NewString("str2"),
+	},
+	{
+		Name: "Percentile_cont Function Invalid Fraction Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("2"),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Error: "the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name: "Percentile_cont Function Within Group Required Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValueFromString("0.5"),
+			},
+		},
+		Error: "WITHIN GROUP clause is required for function percentile_cont",
+	},
 	{
 		Name: "ListAgg Function Null",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -0,0 +1,480 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	planScan               = "Scan"
+	planIndexScan          = "Index Scan"
+	planTemporaryTableScan = "Temporary Table Scan"
+	planInlineTableScan    = "Inline Table Scan"
+	planStdinScan          = "Stdin Scan"
+	planJsonTableScan      = "JSON Table Scan"
+	planTableFunctionScan  = "Table Function Scan"
+	planSchemaTableScan    = "Schema Table Scan"
+	planSubqueryScan       = "Subquery Scan"
+	planDual               = "Dual"
+	planCrossJoin          = "Cross Join"
+	planHashJoin           = "Hash Join"
+	planNestedLoopJoin     = "Nested Loop Join"
+	planLateralJoin        = "Lateral Join"
+	planPivot              = "Pivot"
+	planUnpivot            = "Unpivot"
+	planFilter             = "Filter"
+	planGroup              = "Group"
+	planAggregate          = "Aggregate"
+	planHaving             = "Having"
+	planProject            = "Project"
+	planWindow             = "Window"
+	planSort               = "Sort"
+	planOffset             = "Offset"
+	planLimit              = "Limit"
+	planInlineTable        = "Inline Table"
+	planSubquery           = "Subquery"
+	planRecursion          = "Recursion"
+)
+
+type PlanNode struct {
+	Operation string
+	Detail    string
+	Children  []*PlanNode
+
+	Loops   int
+	Records int
+	Time    time.Duration
+
+	parent *PlanNode
+	start  time.Time
+	mtx    *sync.Mutex
+}
+
+func NewPlanNode(operation string, detail string) *PlanNode {
+	return &PlanNode{
+		Operation: operation,
+		Detail:    detail,
+		Children:  make([]*PlanNode, 0, 2),
+		mtx:       &sync.Mutex{},
+	}
+}
+
+func ContextForExecutionPlan(ctx context.Context, node *PlanNode) context.Context {
+	return context.WithValue(ctx, ExecutionPlanContextKey, node)
+}
+
+// ContextForPlanOnly returns a context in which the records of tables are not loaded
+// so that a plan is built without executing the query.
+func ContextForPlanOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, PlanOnlyContextKey, true)
+}
+
+func isPlanOnly(ctx context.Context) bool {
+	b, ok := ctx.Value(PlanOnlyContextKey).(bool)
+	return ok && b
+}
+
+func beginPlanNode(ctx context.Context) (context.Context, *PlanNode) {
+	parent, ok := ctx.Value(ExecutionPlanContextKey).(*PlanNode)
+	if !ok {
+		return ctx, nil
+	}
+
+	node := NewPlanNode("", "")
+	node.parent = parent
+	node.start = time.Now()
+	return ContextForExecutionPlan(ctx, node), node
+}
+
+func (node *PlanNode) describe(operation string, detail string) {
+	if node == nil {
+		return
+	}
+	node.Operation = operation
+	node.Detail = detail
+}
+
+func (node *PlanNode) finish(view *View, operation string, expr parser.QueryExpression) {
+	if node == nil {
+		return
+	}
+
+	node.Time = time.Since(node.start)
+	node.Loops = 1
+	if view != nil {
+		node.Records = view.RecordLen()
+	}
+	if len(node.Operation) < 1 {
+		node.describe(operation, planDetail(expr))
+	}
+	node.parent.attach(node)
+}
+
+func (node *PlanNode) finishStep(view *View, operation string, expr parser.QueryExpression) {
+	if node == nil {
+		return
+	}
+
+	if input := node.parent.detachLast(); input != nil {
+		node.mtx.Lock()
+		node.Children = append([]*PlanNode{input}, node.Children...)
+		node.mtx.Unlock()
+		node.start = node.start.Add(-input.Time)
+	}
+	node.finish(view, operation, expr)
+}
+
+func (node *PlanNode) attach(child *PlanNode) {
+	node.mtx.Lock()
+	defer node.mtx.Unlock()
+
+	if isRepeatedPlan(child) {
+		for _, c := range node.Children {
+			if c.Operation == child.Operation && c.Detail == child.Detail {
+				c.merge(child)
+				return
+			}
+		}
+	}
+	node.Children = append(node.Children, child)
+}
+
+func (node *PlanNode) detachLast() *PlanNode {
+	node.mtx.Lock()
+	defer node.mtx.Unlock()
+
+	if len(node.Children) < 1 {
+		return nil
+	}
+	last := node.Children[len(node.Children)-1]
+	node.Children = node.Children[:len(node.Children)-1]
+	return last
+}
+
+func (node *PlanNode) merge(src *PlanNode) {
+	node.Loops += src.Loops
+	node.Records += src.Records
+	node.Time += src.Time
+
+	for i, child := range src.Children {
+		if i < len(node.Children) && node.Children[i].Operation == child.Operation && node.Children[i].Detail == child.Detail {
+			node.Children[i].merge(child)
+		} else {
+			node.Children = append(node.Children, child)
+		}
+	}
+}
+
+func isRepeatedPlan(node *PlanNode) bool {
+	switch node.Operation {
+	case planSubquery, planWindow, planAggregate, planRecursion:
+		return true
+	}
+	return false
+}
+
+func planDetail(expr parser.QueryExpression) string {
+	switch expr.(type) {
+	case nil:
+		return ""
+	case parser.LimitClause:
+		limitClause := expr.(parser.LimitClause)
+		limitClause.OffsetClause = nil
+		return limitClause.String()
+	}
+	return expr.String()
+}
+
+func setOperationName(set parser.SelectSet) string {
+	var s string
+	switch set.Operator.Token {
+	case parser.UNION:
+		s = "Union"
+	case parser.EXCEPT:
+		s = "Except"
+	default:
+		s = "Intersect"
+	}
+	if !set.All.IsEmpty() {
+		s = s + " All"
+	}
+	return s
+}
+
+func joinType(join parser.Join) int {
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			return parser.INNER
+		}
+		return parser.OUTER
+	}
+	return join.JoinType.Token
+}
+
+func joinDetail(join parser.Join) string {
+	s := make([]string, 0, 4)
+	if !join.Natural.IsEmpty() {
+		s = append(s, "NATURAL")
+	}
+
+	switch joinType(join) {
+	case parser.CROSS:
+		s = append(s, "CROSS")
+	case parser.INNER:
+		s = append(s, "INNER")
+	case parser.OUTER:
+		if join.Direction.IsEmpty() {
+			s = append(s, "LEFT")
+		} else {
+			s = append(s, strings.ToUpper(join.Direction.Literal))
+		}
+		s = append(s, "OUTER")
+	}
+
+	if join.Condition != nil {
+		s = append(s, join.Condition.String())
+	}
+	return strings.Join(s, " ")
+}
+
+func (node *PlanNode) describeJoin(join parser.Join, condition parser.QueryExpression, view *View, joinView *View) {
+	if node == nil {
+		return
+	}
+
+	if join.IsLateral() {
+		node.describe(planLateralJoin, joinDetail(join))
+		return
+	}
+
+	operation := planCrossJoin
+	if joinType(join) != parser.CROSS && condition != nil {
+		operation = planNestedLoopJoin
+		if leftIndices, _ := extractEquiJoinKeys(condition, view.Header.Merge(joinView.Header), view.FieldLen()); 0 < len(leftIndices) {
+			operation = planHashJoin
+		}
+	}
+	node.describe(operation, joinDetail(join))
+}
+
+func (node *PlanNode) describeScan(scope *ReferenceScope, table parser.Table, view *View) {
+	if node == nil || 0 < len(node.Operation) {
+		return
+	}
+
+	switch table.Object.(type) {
+	case parser.Dual:
+		node.describe(planDual, "")
+	case parser.Identifier:
+		ident := table.Object.(parser.Identifier)
+		switch {
+		case scope.RecursiveTable != nil && strings.EqualFold(ident.Literal, scope.RecursiveTable.Name.Literal):
+			node.describe(planInlineTableScan, table.String())
+		case scope.InlineTableExists(ident):
+			node.describe(planInlineTableScan, table.String())
+		case scope.TemporaryTableExists(ident.Literal):
+			node.describe(planTemporaryTableScan, table.String())
+		default:
+			node.describe(planScan, fileScanDetail(view.FileInfo, table))
+		}
+	case parser.TableObject:
+		if _, ok := table.Object.(parser.TableObject).Path.(parser.Stdin); ok {
+			node.describe(planStdinScan, table.String())
+		} else {
+			node.describe(planScan, fileScanDetail(view.FileInfo, table))
+		}
+	case parser.Stdin:
+		node.describe(planStdinScan, table.String())
+	case parser.JsonQuery:
+		node.describe(planJsonTableScan, table.String())
+	case parser.TableFunction:
+		node.describe(planTableFunctionScan, table.String())
+	case parser.SchemaTable:
+		node.describe(planSchemaTableScan, table.String())
+	case parser.Subquery:
+		node.describe(planSubqueryScan, subqueryScanDetail(table))
+	case parser.PivotTable:
+		node.describe(planPivot, pivotDetail(table.Object.(parser.PivotTable), table.Alias))
+	case parser.UnpivotTable:
+		node.describe(planUnpivot, unpivotDetail(table.Object.(parser.UnpivotTable), table.Alias))
+	}
+}
+
+func pivotDetail(pivot parser.PivotTable, alias parser.QueryExpression) string {
+	values := []string{"ANY"}
+	if !pivot.IsDynamic() {
+		values = expressionStrings(pivot.Values)
+	}
+	s := pivot.Aggregate.String() + " FOR " + pivot.Column.String() + " IN (" + strings.Join(values, ", ") + ")"
+	return appendAliasDetail(s, alias)
+}
+
+func unpivotDetail(unpivot parser.UnpivotTable, alias parser.QueryExpression) string {
+	s := unpivot.Value.String() + " FOR " + unpivot.Name.String() + " IN (" + strings.Join(expressionStrings(unpivot.Columns), ", ") + ")"
+	return appendAliasDetail(s, alias)
+}
+
+func appendAliasDetail(s string, alias parser.QueryExpression) string {
+	if alias != nil {
+		s = s + " AS " + alias.String()
+	}
+	return s
+}
+
+func expressionStrings(exprs []parser.QueryExpression) []string {
+	s := make([]string, len(exprs))
+	for i, e := range exprs {
+		s[i] = e.String()
+	}
+	return s
+}
+
+func fileScanDetail(fileInfo *FileInfo, table parser.Table) string {
+	if fileInfo == nil {
+		return table.String()
+	}
+
+	s := fileInfo.Path + " (" + fileInfo.Format.String()
+	if fileInfo.IsGlob() {
+		s = s + ", " + FormatCount(len(fileInfo.GlobFiles), "file")
+	}
+	s = s + ")"
+	if table.Alias != nil {
+		s = s + " AS " + table.Alias.String()
+	}
+	return s
+}
+
+func subqueryScanDetail(table parser.Table) string {
+	if table.Alias == nil {
+		return ""
+	}
+	return table.Alias.String()
+}
+
+func selectSubquery(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery) (*View, error) {
+	ctx, node := beginPlanNode(ctx)
+	view, err := Select(ctx, scope, query)
+	if err == nil {
+		node.finish(view, planSubquery, query)
+	}
+	return view, err
+}
+
+// planSubqueries adds the plans of the subqueries in the expressions when building a plan without executing the query,
+// because the subqueries are not evaluated if the view has no records.
+func planSubqueries(ctx context.Context, scope *ReferenceScope, view *View, exprs ...parser.QueryExpression) error {
+	if !isPlanOnly(ctx) || 0 < view.RecordLen() {
+		return nil
+	}
+
+	var err error
+	for _, expr := range exprs {
+		walkQueryExpression(expr, func(e parser.QueryExpression) bool {
+			if subquery, ok := e.(parser.Subquery); ok && err == nil {
+				_, err = selectSubquery(ctx, scope, subquery.Query)
+				return false
+			}
+			return err == nil
+		})
+	}
+	return err
+}
+
+func containsAggregateFunction(scope *ReferenceScope, exprs ...parser.QueryExpression) bool {
+	found := false
+	for _, expr := range exprs {
+		walkQueryExpression(expr, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.Subquery:
+				return false
+			case parser.AggregateFunction, parser.ListFunction:
+				found = true
+			case parser.Function:
+				if fn, err := scope.GetFunction(e, e.(parser.Function).Name); err == nil && fn.IsAggregate {
+					found = true
+				}
+			}
+			return !found
+		})
+		if found {
+			break
+		}
+	}
+	return found
+}
+
+func Explain(ctx context.Context, scope *ReferenceScope, expr parser.Explain) (string, error) {
+	plan := NewPlanNode("", "")
+	ctx = ContextForExecutionPlan(ctx, plan)
+	if !expr.Analyze {
+		ctx = ContextForPlanOnly(ctx)
+	}
+	start := time.Now()
+	if _, err := Select(ctx, scope, expr.Query); err != nil {
+		return "", err
+	}
+	elapsed := time.Since(start)
+
+	w := NewObjectWriter(scope.Tx)
+	for _, node := range plan.Children {
+		writePlanNode(w, node, expr.Analyze)
+	}
+	if expr.Analyze {
+		w.NewLine()
+		w.WriteColorWithoutLineBreak("Query Execution Time: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(formatPlanTime(elapsed) + " seconds")
+	}
+	w.Title1 = "Query Plan"
+	if expr.Analyze {
+		w.Title2 = "(Analyzed)"
+		w.Title2Effect = cmd.EmphasisEffect
+	}
+
+	return "\n" + w.String() + "\n", nil
+}
+
+func writePlanNode(w *ObjectWriter, node *PlanNode, analyze bool) {
+	w.WriteColorWithoutLineBreak(node.Operation, cmd.LableEffect)
+	words := make([]string, 0, 10)
+	if 0 < len(node.Detail) {
+		w.WriteWithoutLineBreak(": ")
+		words = append(words, strings.Split(node.Detail, " ")...)
+	} else if analyze {
+		w.WriteWithoutLineBreak(" ")
+	}
+	if analyze {
+		words = append(words, fmt.Sprintf("(records: %d, loops: %d, time: %s seconds)", node.Records, node.Loops, formatPlanTime(node.Time)))
+	}
+
+	w.BeginSubBlock()
+	for i, word := range words {
+		if 0 < i {
+			if w.FitInLine(" " + word) {
+				word = " " + word
+			} else {
+				w.NewLine()
+			}
+		}
+		w.WriteWithoutLineBreak(word)
+	}
+	w.EndSubBlock()
+	w.NewLine()
+
+	for _, child := range node.Children {
+		w.BeginBlock()
+		w.WriteWithoutLineBreak("-> ")
+		writePlanNode(w, child, analyze)
+		w.EndBlock()
+	}
+}
+
+func formatPlanTime(d time.Duration) string {
+	return cmd.FormatNumber(d.Seconds(), 6, ".", ",", "")
+}
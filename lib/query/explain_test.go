@@ -0,0 +1,312 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var explainTests = []struct {
+	Name   string
+	Expr   parser.Explain
+	Result string
+	Error  string
+}{
+	{
+		Name: "Explain",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Select: "SELECT",
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Where: "WHERE",
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValueFromString("1"),
+							Operator: ">",
+						},
+					},
+				},
+				OrderByClause: parser.OrderByClause{
+					OrderBy: "ORDER BY",
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"                         Query Plan\n" +
+			"------------------------------------------------------------\n" +
+			" Sort: ORDER BY column1\n" +
+			"     -> Project: SELECT column1\n" +
+			"         -> Filter: WHERE column1 > 1\n" +
+			"             -> Scan: " + GetTestFilePath("table1.csv") + " (CSV)\n" +
+			"\n",
+	},
+	{
+		Name: "Explain Join and Subquery",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Select: "SELECT",
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Join{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t1"}},
+								JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t2"}},
+								Condition: parser.JoinCondition{
+									Literal: "ON",
+									On: parser.Comparison{
+										LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column1"}},
+										RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column3"}},
+										Operator: "=",
+									},
+								},
+							}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Where: "WHERE",
+						Filter: parser.Exists{
+							Exists: "EXISTS",
+							Query: parser.Subquery{
+								Query: parser.SelectQuery{
+									SelectEntity: parser.SelectEntity{
+										SelectClause: parser.SelectClause{
+											Select: "SELECT",
+											Fields: []parser.QueryExpression{
+												parser.Field{Object: parser.NewIntegerValueFromString("1")},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"                            Query Plan\n" +
+			"------------------------------------------------------------------\n" +
+			" Project: SELECT *\n" +
+			"     -> Filter: WHERE EXISTS (SELECT 1)\n" +
+			"         -> Hash Join: INNER ON t1.column1 = t2.column3\n" +
+			"             -> Scan: " + GetTestFilePath("table1.csv") + " (CSV) AS t1\n" +
+			"             -> Scan: " + GetTestFilePath("table2.csv") + " (CSV) AS t2\n" +
+			"         -> Subquery: SELECT 1\n" +
+			"             -> Project: SELECT 1\n" +
+			"                 -> Dual\n" +
+			"\n",
+	},
+	{
+		Name: "Explain Pivot",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Select: "SELECT",
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{
+								Object: parser.PivotTable{
+									Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+									Aggregate: parser.AggregateFunction{
+										Name: "COUNT",
+										Args: []parser.QueryExpression{parser.AllColumns{}},
+									},
+									Column: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									Any:    "ANY",
+								},
+								Alias: parser.Identifier{Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"                       Query Plan\n" +
+			"--------------------------------------------------------\n" +
+			" Project: SELECT *\n" +
+			"     -> Pivot: COUNT(*) FOR column2 IN (ANY) AS p\n" +
+			"         -> Scan: " + GetTestFilePath("table1.csv") + " (CSV)\n" +
+			"\n",
+	},
+	{
+		Name: "Explain File Not Exist Error",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Select: "SELECT",
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}), Literal: "notexist"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:1 C:1] file notexist does not exist",
+	},
+}
+
+func TestExplain(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range explainTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		result, err := Explain(ctx, NewReferenceScope(TestTx), v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+		if 0 < TestTx.cachedViews.Len() {
+			t.Errorf("%s: tables are loaded, want to build the plan without loading tables", v.Name)
+		}
+	}
+}
+
+func clearPlanTime(node *PlanNode) {
+	node.Time = 0
+	for _, child := range node.Children {
+		clearPlanTime(child)
+	}
+}
+
+func TestExplain_Analyze(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	query := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Select: "SELECT",
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
+						Condition: parser.JoinCondition{
+							Literal: "ON",
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: "=",
+							},
+						},
+					}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Where: "WHERE",
+				Filter: parser.Comparison{
+					LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS: parser.Subquery{
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Select: "SELECT",
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValueFromString("1")},
+									},
+								},
+							},
+						},
+					},
+					Operator: ">",
+				},
+			},
+		},
+		LimitClause: parser.LimitClause{
+			Type:  parser.Token{Token: parser.LIMIT, Literal: "LIMIT"},
+			Value: parser.NewIntegerValueFromString("1"),
+		},
+	}
+
+	expect := "" +
+		" Limit: LIMIT 1 (records: 1, loops: 1, time: 0.000000 seconds)\n" +
+		"     -> Project: SELECT column1, column4\n" +
+		"                 (records: 2, loops: 1, time: 0.000000 seconds)\n" +
+		"         -> Filter: WHERE column1 > (SELECT 1)\n" +
+		"                    (records: 2, loops: 1, time: 0.000000 seconds)\n" +
+		"             -> Hash Join: LEFT OUTER ON column1 = column3\n" +
+		"                           (records: 3, loops: 1, time: 0.000000 seconds)\n" +
+		"                 -> Scan: " + GetTestFilePath("table1.csv") + " (CSV)\n" +
+		"                          (records: 3, loops: 1, time: 0.000000 seconds)\n" +
+		"                 -> Scan: " + GetTestFilePath("table2.csv") + " (CSV)\n" +
+		"                          (records: 3, loops: 1, time: 0.000000 seconds)\n" +
+		"             -> Subquery: SELECT 1\n" +
+		"                          (records: 3, loops: 3, time: 0.000000 seconds)\n" +
+		"                 -> Project: SELECT 1\n" +
+		"                             (records: 3, loops: 3, time: 0.000000 seconds)\n" +
+		"                     -> Dual (records: 3, loops: 3, time: 0.000000 seconds)\n"
+
+	plan := NewPlanNode("", "")
+	if _, err := Select(ContextForExecutionPlan(context.Background(), plan), NewReferenceScope(TestTx), query); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	clearPlanTime(plan)
+
+	w := NewObjectWriter(TestTx)
+	for _, node := range plan.Children {
+		writePlanNode(w, node, true)
+	}
+	if w.String() != expect {
+		t.Errorf("result = %s, want %s", w.String(), expect)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -27,8 +28,15 @@ const (
 	TableEncloseAll         = "ENCLOSE_ALL"
 	TableJsonEscape         = "JSON_ESCAPE"
 	TablePrettyPrint        = "PRETTY_PRINT"
+	TableCompression        = "COMPRESSION"
 )
 
+var compressionExtensions = []string{
+	cmd.GzipExt,
+	cmd.Bzip2Ext,
+	cmd.ZstdExt,
+}
+
 type ViewType int
 
 const (
@@ -47,6 +55,7 @@ var FileAttributeList = []string{
 	TableEncloseAll,
 	TableJsonEscape,
 	TablePrettyPrint,
+	TableCompression,
 }
 
 type TableAttributeUnchangedError struct {
@@ -72,22 +81,33 @@ type FileInfo struct {
 	Delimiter          rune
 	DelimiterPositions fixedlen.DelimiterPositions
 	JsonQuery          string
+	Sheet              string
+	HeaderRow          int
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
 	NoHeader           bool
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        cmd.Compression
+
+	Schema        TableSchema
+	schemaUpdated bool
 
 	SingleLine bool
 
 	Handler *file.Handler
 
+	GlobFiles []string
+
 	ForUpdate bool
 	ViewType  ViewType
 
 	restorePointHeader    Header
 	restorePointRecordSet RecordSet
+
+	loadedModTime time.Time
+	loadedSize    int64
 }
 
 func NewFileInfo(
@@ -106,15 +126,24 @@ func NewFileInfo(
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.XLSX, cmd.PARQUET:
 		encoding = text.UTF8
 	}
 
+	compression, _ := cmd.CompressionOfPath(fpath)
+
+	schema, err := LoadTableSchema(fpath)
+	if err != nil {
+		return nil, NewInvalidTableSchemaError(filename, SchemaFilePath(fpath), err.Error())
+	}
+
 	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
+		Path:        fpath,
+		Format:      format,
+		Delimiter:   delimiter,
+		Encoding:    encoding,
+		Compression: compression,
+		Schema:      schema,
 	}, nil
 }
 
@@ -178,7 +207,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.XLSX, cmd.PARQUET:
 		encoding = text.UTF8
 	}
 
@@ -196,7 +225,7 @@ func (f *FileInfo) SetEncoding(s string) error {
 	}
 
 	switch f.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL:
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
@@ -262,6 +291,24 @@ func (f *FileInfo) SetPrettyPrint(b bool) error {
 	return nil
 }
 
+func (f *FileInfo) SetCompression(s string) error {
+	compression, err := cmd.ParseCompression(s)
+	if err != nil {
+		return err
+	}
+
+	if compression == f.Compression {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+	if fpath := cmd.PathWithCompression(f.Path, compression); fpath != f.Path {
+		if _, err := os.Stat(fpath); err == nil {
+			return errors.New(fmt.Sprintf("file %s already exists", fpath))
+		}
+	}
+	f.Compression = compression
+	return nil
+}
+
 func (f *FileInfo) IsFile() bool {
 	return f.ViewType == ViewTypeFile
 }
@@ -274,6 +321,30 @@ func (f *FileInfo) IsStdin() bool {
 	return f.ViewType == ViewTypeStdin
 }
 
+func (f *FileInfo) IsGlob() bool {
+	return 0 < len(f.GlobFiles)
+}
+
+func (f *FileInfo) setLoadedStat(fp *os.File) {
+	if stat, err := fp.Stat(); err == nil {
+		f.loadedModTime = stat.ModTime()
+		f.loadedSize = stat.Size()
+	}
+}
+
+// isModified reports whether the file has been modified since the view was loaded.
+// Views whose loaded file status is not recorded are never treated as modified.
+func (f *FileInfo) isModified() bool {
+	if f.loadedModTime.IsZero() {
+		return false
+	}
+	stat, err := os.Stat(f.Path)
+	if err != nil {
+		return true
+	}
+	return !stat.ModTime().Equal(f.loadedModTime) || stat.Size() != f.loadedSize
+}
+
 func SearchFilePath(filename parser.Identifier, repository string, format cmd.Format, flags *cmd.Flags) (string, cmd.Format, error) {
 	var fpath string
 	var err error
@@ -283,21 +354,34 @@ func SearchFilePath(filename parser.Identifier, repository string, format cmd.Fo
 		fpath, err = SearchCSVFilePath(filename, repository)
 	case cmd.JSON:
 		fpath, err = SearchJsonFilePath(filename, repository)
+	case cmd.JSONL:
+		fpath, err = SearchJsonLinesFilePath(filename, repository)
 	case cmd.FIXED:
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.XLSX:
+		fpath, err = SearchXLSXFilePath(filename, repository)
+	case cmd.PARQUET:
+		fpath, err = SearchParquetFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
+			_, uncompressedPath := cmd.CompressionOfPath(fpath)
+			switch strings.ToLower(filepath.Ext(uncompressedPath)) {
 			case cmd.CsvExt:
 				format = cmd.CSV
 			case cmd.TsvExt:
 				format = cmd.TSV
 			case cmd.JsonExt:
 				format = cmd.JSON
+			case cmd.JsonlExt:
+				format = cmd.JSONL
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.XlsxExt:
+				format = cmd.XLSX
+			case cmd.ParquetExt:
+				format = cmd.PARQUET
 			default:
 				format = flags.ImportFormat
 			}
@@ -315,6 +399,10 @@ func SearchJsonFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonExt})
 }
 
+func SearchJsonLinesFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonlExt})
+}
+
 func SearchFixedLengthFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.TextExt})
 }
@@ -323,8 +411,16 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt, cmd.TextExt})
 }
 
+func SearchXLSXFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XlsxExt})
+}
+
+func SearchParquetFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.ParquetExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.JsonlExt, cmd.LtsvExt, cmd.XlsxExt, cmd.ParquetExt, cmd.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -340,11 +436,24 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 	var err error
 
 	if info, err = os.Stat(fpath); err != nil {
+		candidates := make([]string, 0, len(extTypes)*(len(compressionExtensions)+1))
+		for _, ext := range extTypes {
+			candidates = append(candidates, fpath+ext)
+			for _, cext := range compressionExtensions {
+				candidates = append(candidates, fpath+ext+cext)
+			}
+		}
+		if InStrSliceWithCaseInsensitive(filepath.Ext(fpath), extTypes) {
+			for _, cext := range compressionExtensions {
+				candidates = append(candidates, fpath+cext)
+			}
+		}
+
 		pathes := make([]string, 0, len(extTypes))
 		infoList := make([]os.FileInfo, 0, len(extTypes))
-		for _, ext := range extTypes {
-			if i, err := os.Stat(fpath + ext); err == nil {
-				pathes = append(pathes, fpath+ext)
+		for _, p := range candidates {
+			if i, err := os.Stat(p); err == nil {
+				pathes = append(pathes, p)
 				infoList = append(infoList, i)
 			}
 		}
@@ -370,22 +479,71 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 	return fpath, nil
 }
 
+// SearchGlobFilePaths returns the absolute pattern and the files matching the pattern if the filename is a glob pattern.
+// If the filename is not a glob pattern, a file with the name exists, or no file matches the pattern,
+// then it returns no files.
+func SearchGlobFilePaths(filename parser.Identifier, repository string) (string, []string) {
+	pattern := filename.Literal
+	if !strings.ContainsAny(pattern, "*?[") {
+		return "", nil
+	}
+
+	if !filepath.IsAbs(pattern) {
+		if len(repository) < 1 {
+			repository, _ = os.Getwd()
+		}
+		pattern = filepath.Join(repository, pattern)
+	}
+	if _, err := os.Stat(pattern); err == nil {
+		return "", nil
+	}
+
+	pattern, err := filepath.Abs(pattern)
+	if err != nil {
+		return "", nil
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return "", nil
+	}
+
+	var fpaths []string
+	for _, fpath := range matches {
+		if info, err := os.Stat(fpath); err == nil && !info.IsDir() {
+			fpaths = append(fpaths, fpath)
+		}
+	}
+	return pattern, fpaths
+}
+
 func NewFileInfoForCreate(filename parser.Identifier, repository string, delimiter rune, encoding text.Encoding) (*FileInfo, error) {
 	fpath, err := CreateFilePath(filename, repository)
 	if err != nil {
 		return nil, NewIOError(filename, err.Error())
 	}
 
+	compression, uncompressedPath := cmd.CompressionOfPath(fpath)
+
 	var format cmd.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(uncompressedPath)) {
 	case cmd.TsvExt:
 		delimiter = '\t'
 		format = cmd.TSV
 	case cmd.JsonExt:
 		encoding = text.UTF8
 		format = cmd.JSON
+	case cmd.JsonlExt:
+		encoding = text.UTF8
+		format = cmd.JSONL
 	case cmd.LtsvExt:
 		format = cmd.LTSV
+	case cmd.XlsxExt:
+		encoding = text.UTF8
+		format = cmd.XLSX
+	case cmd.ParquetExt:
+		encoding = text.UTF8
+		format = cmd.PARQUET
 	case cmd.GfmExt:
 		format = cmd.GFM
 	case cmd.OrgExt:
@@ -395,10 +553,11 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: compression,
 	}, nil
 }
 
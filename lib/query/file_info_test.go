@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -106,6 +107,34 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "JSONL",
+		FilePath:   parser.Identifier{Literal: "table_l"},
+		Repository: TestDir,
+		Format:     cmd.JSONL,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table_l.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "JSONL with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table_l"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table_l.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:       "LTSV",
 		FilePath:   parser.Identifier{Literal: "table6"},
@@ -134,6 +163,64 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "XLSX with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table7.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Parquet with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table8.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "CSV Gzip Compressed with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table_gzip"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table_gzip.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: cmd.GZIP,
+		},
+	},
+	{
+		Name:       "JSON Zstd Compressed",
+		FilePath:   parser.Identifier{Literal: "table_zstd.json"},
+		Repository: TestDir,
+		Format:     cmd.JSON,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table_zstd.json.zst",
+			Delimiter:   ',',
+			Format:      cmd.JSON,
+			Encoding:    text.UTF8,
+			Compression: cmd.ZSTD,
+		},
+	},
 	{
 		Name:       "Fixed-Length",
 		FilePath:   parser.Identifier{Literal: "fixed_length.txt"},
@@ -217,6 +304,9 @@ func TestNewFileInfo(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
@@ -265,6 +355,18 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "JSONL",
+		FilePath:  parser.Identifier{Literal: "table1.jsonl"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:      "LTSV",
 		FilePath:  parser.Identifier{Literal: "table1.ltsv"},
@@ -301,6 +403,19 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "CSV Bzip2 Compressed",
+		FilePath:  parser.Identifier{Literal: "table1.csv.bz2"},
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1.csv.bz2",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: cmd.BZIP2,
+		},
+	},
 }
 
 func TestNewFileInfoForCreate(t *testing.T) {
@@ -335,5 +450,59 @@ func TestNewFileInfoForCreate(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
+	}
+}
+
+var searchGlobFilePathsTests = []struct {
+	Name     string
+	FilePath parser.Identifier
+	Pattern  string
+	Result   []string
+}{
+	{
+		Name:     "SearchGlobFilePaths",
+		FilePath: parser.Identifier{Literal: "glob/log_*.csv"},
+		Pattern:  GetTestFilePath("glob/log_*.csv"),
+		Result: []string{
+			GetTestFilePath("glob/log_1.csv"),
+			GetTestFilePath("glob/log_2.csv"),
+		},
+	},
+	{
+		Name:     "SearchGlobFilePaths Absolute Path",
+		FilePath: parser.Identifier{Literal: GetTestFilePath("glob/*_1.csv")},
+		Pattern:  GetTestFilePath("glob/*_1.csv"),
+		Result: []string{
+			GetTestFilePath("glob/log_1.csv"),
+			GetTestFilePath("glob/other_1.csv"),
+		},
+	},
+	{
+		Name:     "SearchGlobFilePaths Not Glob Pattern",
+		FilePath: parser.Identifier{Literal: "table1.csv"},
+	},
+	{
+		Name:     "SearchGlobFilePaths No Matches",
+		FilePath: parser.Identifier{Literal: "glob/notexist_*.csv"},
+	},
+	{
+		Name:     "SearchGlobFilePaths Directories",
+		FilePath: parser.Identifier{Literal: "gl*"},
+	},
+}
+
+func TestSearchGlobFilePaths(t *testing.T) {
+	for _, v := range searchGlobFilePathsTests {
+		pattern, result := SearchGlobFilePaths(v.FilePath, TestDir)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+			continue
+		}
+		if result != nil && pattern != v.Pattern {
+			t.Errorf("%s: pattern = %q, want %q", v.Name, pattern, v.Pattern)
+		}
 	}
 }
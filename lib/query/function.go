@@ -12,6 +12,7 @@ import (
 	"hash"
 	"math"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
@@ -82,6 +84,11 @@ var Functions = map[string]BuiltInFunction{
 	"INSTR":            Instr,
 	"LIST_ELEM":        ListElem,
 	"REPLACE":          ReplaceFn,
+	"REGEXP_MATCH":     RegExpMatch,
+	"REGEXP_FIND":      RegExpFind,
+	"REGEXP_FIND_ALL":  RegExpFindAll,
+	"REGEXP_REPLACE":   RegExpReplace,
+	"REGEXP_SPLIT":     RegExpSplit,
 	"FORMAT":           Format,
 	"JSON_VALUE":       JsonValue,
 	"MD5":              Md5,
@@ -133,6 +140,7 @@ var Functions = map[string]BuiltInFunction{
 	"STRING":           String,
 	"INTEGER":          Integer,
 	"FLOAT":            Float,
+	"DECIMAL":          Decimal,
 	"BOOLEAN":          Boolean,
 	"TERNARY":          Ternary,
 	"DATETIME":         Datetime,
@@ -225,7 +233,7 @@ func roundParams(args []value.Primary) (number float64, place float64, isnull bo
 	return
 }
 
-func Ceil(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func Ceil(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	number, place, isnull, argsErr := roundParams(args)
 	if argsErr {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
@@ -233,13 +241,16 @@ func Ceil(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary
 	if isnull {
 		return value.NewNull(), nil
 	}
+	if d, ok := decimalOperand(args[0], flags.NumericMode); ok {
+		return d.Ceil(int(place)), nil
+	}
 
 	pow := math.Pow(10, place)
 	r := math.Ceil(pow*number) / pow
 	return value.ParseFloat64(r), nil
 }
 
-func Floor(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func Floor(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	number, place, isnull, argsErr := roundParams(args)
 	if argsErr {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
@@ -247,6 +258,9 @@ func Floor(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	if isnull {
 		return value.NewNull(), nil
 	}
+	if d, ok := decimalOperand(args[0], flags.NumericMode); ok {
+		return d.Floor(int(place)), nil
+	}
 
 	pow := math.Pow(10, place)
 	r := math.Floor(pow*number) / pow
@@ -264,7 +278,7 @@ func round(f float64, place float64) float64 {
 	return r
 }
 
-func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func Round(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	number, place, isnull, argsErr := roundParams(args)
 	if argsErr {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
@@ -272,6 +286,9 @@ func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	if isnull {
 		return value.NewNull(), nil
 	}
+	if d, ok := decimalOperand(args[0], flags.NumericMode); ok {
+		return d.Round(int(place)), nil
+	}
 
 	return value.ParseFloat64(round(number, place)), nil
 }
@@ -484,11 +501,12 @@ func Enotation(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return value.NewString(s), nil
 }
 
-func NumberFormat(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func NumberFormat(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	if len(args) < 1 || 5 < len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2, 3, 4, 5})
 	}
 
+	d, isDecimal := decimalOperand(args[0], flags.NumericMode)
 	p := value.ToFloat(args[0])
 	if value.IsNull(p) {
 		return value.NewNull(), nil
@@ -528,7 +546,15 @@ func NumberFormat(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value
 		}
 	}
 
-	s := cmd.FormatNumber(p.(*value.Float).Raw(), precision, decimalPoint, thousandsSeparator, decimalSeparator)
+	var s string
+	if isDecimal {
+		if -1 < precision {
+			d = d.Rescale(precision)
+		}
+		s = cmd.FormatNumberString(d.String(), decimalPoint, thousandsSeparator, decimalSeparator)
+	} else {
+		s = cmd.FormatNumber(p.(*value.Float).Raw(), precision, decimalPoint, thousandsSeparator, decimalSeparator)
+	}
 	value.Discard(p)
 
 	return value.NewString(s), nil
@@ -1058,6 +1084,128 @@ func ReplaceFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return value.NewString(r), nil
 }
 
+func prepareRegExp(fn parser.Function, args []value.Primary, requiredLen int) (string, *regexp.Regexp, bool, error) {
+	if len(args) < requiredLen || requiredLen+1 < len(args) {
+		return "", nil, false, NewFunctionArgumentLengthError(fn, fn.Name, []int{requiredLen, requiredLen + 1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return "", nil, false, nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	p := value.ToString(args[1])
+	if value.IsNull(p) {
+		return "", nil, false, nil
+	}
+	pattern := p.(*value.String).Raw()
+	value.Discard(p)
+
+	flags := ""
+	if requiredLen < len(args) {
+		f := value.ToString(args[requiredLen])
+		if !value.IsNull(f) {
+			flags = f.(*value.String).Raw()
+			value.Discard(f)
+		}
+	}
+
+	r, err := RegExps.Get(pattern, flags)
+	if err != nil {
+		return "", nil, false, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return str, r, true, nil
+}
+
+func RegExpMatch(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, r, ok, err := prepareRegExp(fn, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	return value.NewTernary(ternary.ConvertFromBool(r.MatchString(str))), nil
+}
+
+func RegExpFind(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, r, ok, err := prepareRegExp(fn, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	loc := r.FindStringIndex(str)
+	if loc == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(str[loc[0]:loc[1]]), nil
+}
+
+func RegExpFindAll(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, r, ok, err := prepareRegExp(fn, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(encodeStringsToJsonArray(r.FindAllString(str, -1))), nil
+}
+
+func RegExpReplace(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 3 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3, 4})
+	}
+
+	regExpArgs := []value.Primary{args[0], args[1]}
+	if 3 < len(args) {
+		regExpArgs = append(regExpArgs, args[3])
+	}
+	str, r, ok, err := prepareRegExp(fn, regExpArgs, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	repl := value.ToString(args[2])
+	if value.IsNull(repl) {
+		return value.NewNull(), nil
+	}
+	replStr := repl.(*value.String).Raw()
+	value.Discard(repl)
+
+	return value.NewString(r.ReplaceAllString(str, replStr)), nil
+}
+
+func RegExpSplit(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, r, ok, err := prepareRegExp(fn, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(encodeStringsToJsonArray(r.Split(str, -1))), nil
+}
+
+func encodeStringsToJsonArray(list []string) string {
+	array := make(txjson.Array, 0, len(list))
+	for _, s := range list {
+		array = append(array, txjson.String(s))
+	}
+	return array.Encode()
+}
+
 func Format(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -1580,6 +1728,11 @@ func Integer(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prim
 	switch args[0].(type) {
 	case *value.Float:
 		return value.NewInteger(int64(round(args[0].(*value.Float).Raw(), 0))), nil
+	case *value.Decimal:
+		if i, ok := args[0].(*value.Decimal).Round(0).Int64(); ok {
+			return value.NewInteger(i), nil
+		}
+		return value.NewNull(), nil
 	case *value.Datetime:
 		return value.NewInteger(args[0].(*value.Datetime).Raw().Unix()), nil
 	default:
@@ -1605,6 +1758,39 @@ func Float(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	}
 }
 
+func Decimal(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	var p value.Primary
+	switch args[0].(type) {
+	case *value.Datetime:
+		t := args[0].(*value.Datetime).Raw()
+		d := value.NewDecimalFromInt64(t.Unix())
+		if t.Nanosecond() > 0 {
+			d = d.Add(value.NewDecimalFromString(strconv.Itoa(t.Nanosecond()) + "e-9"))
+		}
+		p = d
+	default:
+		p = value.ToDecimal(args[0])
+	}
+	if value.IsNull(p) {
+		return p, nil
+	}
+	d := p.(*value.Decimal)
+
+	if len(args) == 2 {
+		i := value.ToInteger(args[1])
+		if value.IsNull(i) || i.(*value.Integer).Raw() < 0 {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a non-negative integer")
+		}
+		d = d.Rescale(int(i.(*value.Integer).Raw()))
+		value.Discard(i)
+	}
+	return d, nil
+}
+
 func Boolean(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1716,3 +1902,37 @@ func JsonObject(ctx context.Context, scope *ReferenceScope, fn parser.Function)
 	structure, _ := json.ConvertRecordValueToJsonStructure(pathes, record)
 	return value.NewString(structure.Encode()), nil
 }
+
+func Grouping(scope *ReferenceScope, fn parser.Function) (value.Primary, error) {
+	if len(fn.Args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+	if len(scope.Records) < 1 || !scope.Records[0].view.isGrouped {
+		return nil, NewNotGroupingRecordsError(fn, fn.Name)
+	}
+
+	switch fn.Args[0].(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+	default:
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a field reference")
+	}
+
+	view := scope.Records[0].view
+	idx, err := view.FieldIndex(fn.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	if !view.Header[idx].IsGroupKey {
+		return nil, NewFieldNotGroupKeyError(fn.Args[0])
+	}
+	if !scope.Records[0].IsInRange() {
+		return value.NewNull(), nil
+	}
+
+	if gIdx, ok := view.Header.groupingColumnIndex(idx); ok {
+		if view.RecordSet[scope.Records[0].recordIndex][gIdx][0].Ternary() == ternary.TRUE {
+			return value.NewInteger(1), nil
+		}
+	}
+	return value.NewInteger(0), nil
+}
@@ -304,6 +304,17 @@ var roundTests = []functionTest{
 		},
 		Result: value.NewFloat(-2.46),
 	},
+	{
+		Name: "Round Decimal",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("2.455"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("2.46"),
+	},
 	{
 		Name: "Round Null",
 		Function: parser.Function{
@@ -951,6 +962,28 @@ var numberFormatTests = []functionTest{
 		},
 		Result: value.NewString("123 456,789 1"),
 	},
+	{
+		Name: "NumberFormat Negative Number",
+		Function: parser.Function{
+			Name: "number_format",
+		},
+		Args: []value.Primary{
+			value.NewFloat(-123456.789),
+			value.NewInteger(2),
+		},
+		Result: value.NewString("-123,456.79"),
+	},
+	{
+		Name: "NumberFormat Decimal",
+		Function: parser.Function{
+			Name: "number_format",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("1234567.125"),
+			value.NewInteger(2),
+		},
+		Result: value.NewString("1,234,567.13"),
+	},
 	{
 		Name: "NumberFormat Null",
 		Function: parser.Function{
@@ -1908,6 +1941,296 @@ func TestReplaceFn(t *testing.T) {
 	testFunction(t, ReplaceFn, replaceFnTests)
 }
 
+var regExpMatchTests = []functionTest{
+	{
+		Name: "RegExpMatch",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch Not Matched",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExpMatch with Flags",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+			value.NewString("i"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch String is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args:  []value.Primary{},
+		Error: "function regexp_match takes 2 or 3 arguments",
+	},
+	{
+		Name: "RegExpMatch Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("a(b"),
+		},
+		Error: "error parsing regexp: missing closing ): `a(b` for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Invalid Flags Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("abc"),
+			value.NewString("x"),
+		},
+		Error: "error parsing regexp: invalid or unsupported Perl syntax: `(?x` for function regexp_match",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	testFunction(t, RegExpMatch, regExpMatchTests)
+}
+
+var regExpFindTests = []functionTest{
+	{
+		Name: "RegExpFind",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123, code: 456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("123"),
+	},
+	{
+		Name: "RegExpFind Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: abc"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123"),
+		},
+		Error: "function regexp_find takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFind(t *testing.T) {
+	testFunction(t, RegExpFind, regExpFindTests)
+}
+
+var regExpFindAllTests = []functionTest{
+	{
+		Name: "RegExpFindAll",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123, code: 456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("[\"123\",\"456\"]"),
+	},
+	{
+		Name: "RegExpFindAll Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("id: abc"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("[]"),
+	},
+	{
+		Name: "RegExpFindAll String is Null",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFindAll Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args:  []value.Primary{},
+		Error: "function regexp_find_all takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFindAll(t *testing.T) {
+	testFunction(t, RegExpFindAll, regExpFindAllTests)
+}
+
+var regExpReplaceTests = []functionTest{
+	{
+		Name: "RegExpReplace",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString("(\\d+)-(\\d+)-(\\d+)"),
+			value.NewString("$3/$2/$1"),
+		},
+		Result: value.NewString("03/02/2012"),
+	},
+	{
+		Name: "RegExpReplace with Flags",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc ABC"),
+			value.NewString("b"),
+			value.NewString("-"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("a-c A-C"),
+	},
+	{
+		Name: "RegExpReplace Replacement is Null",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpReplace Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+		},
+		Error: "function regexp_replace takes 3 or 4 arguments",
+	},
+	{
+		Name: "RegExpReplace Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("a(b"),
+			value.NewString("-"),
+		},
+		Error: "error parsing regexp: missing closing ): `a(b` for function regexp_replace",
+	},
+}
+
+func TestRegExpReplace(t *testing.T) {
+	testFunction(t, RegExpReplace, regExpReplaceTests)
+}
+
+var regExpSplitTests = []functionTest{
+	{
+		Name: "RegExpSplit",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("a, b;c"),
+			value.NewString("[,;]\\s*"),
+		},
+		Result: value.NewString("[\"a\",\"b\",\"c\"]"),
+	},
+	{
+		Name: "RegExpSplit String is Null",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(","),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpSplit Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b"),
+			value.NewString(","),
+			value.NewString("i"),
+			value.NewString("s"),
+		},
+		Error: "function regexp_split takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpSplit(t *testing.T) {
+	testFunction(t, RegExpSplit, regExpSplitTests)
+}
+
 var formatTests = []functionTest{
 	{
 		Name: "Format",
@@ -3127,6 +3450,16 @@ var integerTests = []functionTest{
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		Name: "Integer from Decimal",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("-2.5"),
+		},
+		Result: value.NewInteger(-3),
+	},
 	{
 		Name: "Integer from String",
 		Function: parser.Function{
@@ -3226,6 +3559,73 @@ func TestFloat(t *testing.T) {
 	testFunction(t, Float, floatTests)
 }
 
+var decimalTests = []functionTest{
+	{
+		Name: "Decimal from String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("0.30"),
+		},
+		Result: value.NewDecimalFromString("0.30"),
+	},
+	{
+		Name: "Decimal from Float with Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewFloat(1.005),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("1.01"),
+	},
+	{
+		Name: "Decimal from Datetime",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+		},
+		Result: value.NewDecimalFromString("1328260695.123450000"),
+	},
+	{
+		Name: "Decimal Null",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Decimal Scale Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+			value.NewInteger(-1),
+		},
+		Error: "the second argument must be a non-negative integer for function decimal",
+	},
+	{
+		Name: "Decimal Arguments Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args:  []value.Primary{},
+		Error: "function decimal takes 1 or 2 arguments",
+	},
+}
+
+func TestDecimal(t *testing.T) {
+	testFunction(t, Decimal, decimalTests)
+}
+
 var booleanTests = []functionTest{
 	{
 		Name: "Boolean from String",
@@ -3570,3 +3970,133 @@ func TestJsonObject(t *testing.T) {
 		}
 	}
 }
+
+var groupingTestView = &View{
+	Header: []HeaderField{
+		{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+		{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+		{Column: groupingColumnName(1)},
+	},
+	RecordSet: []Record{
+		{
+			NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("a")}),
+			NewCell(value.NewTernary(ternary.FALSE)),
+		},
+		{
+			NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			NewGroupCell([]value.Primary{value.NewNull(), value.NewNull()}),
+			NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("a"), value.NewString("a")}),
+		},
+	},
+	isGrouped: true,
+}
+
+var groupingTests = []struct {
+	Name        string
+	Function    parser.Function
+	View        *View
+	RecordIndex int
+	Result      value.Primary
+	Error       string
+}{
+	{
+		Name: "Grouping",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		View:        groupingTestView,
+		RecordIndex: 0,
+		Result:      value.NewInteger(0),
+	},
+	{
+		Name: "Grouping Rolled Up Column",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		View:        groupingTestView,
+		RecordIndex: 1,
+		Result:      value.NewInteger(1),
+	},
+	{
+		Name: "Grouping Not Group Key Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		View:  groupingTestView,
+		Error: "field column1 is not a group key",
+	},
+	{
+		Name: "Grouping Not Grouped Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		View: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Error: "function grouping cannot aggregate not grouping records",
+	},
+	{
+		Name: "Grouping Invalid Argument Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+			},
+		},
+		View:  groupingTestView,
+		Error: "the first argument must be a field reference for function grouping",
+	},
+	{
+		Name: "Grouping Arguments Error",
+		Function: parser.Function{
+			Name: "grouping",
+		},
+		View:  groupingTestView,
+		Error: "function grouping takes exactly 1 argument",
+	},
+}
+
+func TestGrouping(t *testing.T) {
+	for _, v := range groupingTests {
+		scope := GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view:        v.View,
+				recordIndex: v.RecordIndex,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		})
+
+		result, err := Grouping(scope, v.Function)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
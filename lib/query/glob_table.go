@@ -0,0 +1,191 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+func cacheViewFromGlob(
+	ctx context.Context,
+	scope *ReferenceScope,
+	tableIdentifier parser.Identifier,
+	pattern string,
+	fpaths []string,
+	forUpdate bool,
+	importFormat cmd.Format,
+	delimiter rune,
+	delimiterPositions []int,
+	singleLine bool,
+	jsonQuery string,
+	sheet string,
+	headerRow int,
+	encoding text.Encoding,
+	lineBreak text.LineBreak,
+	noHeader bool,
+	encloseAll bool,
+	jsonEscape txjson.EscapeType,
+	withoutNull bool,
+) error {
+	views := make([]*View, 0, len(fpaths))
+	files := make([]string, 0, len(fpaths))
+	for _, fpath := range fpaths {
+		ident := parser.Identifier{BaseExpr: tableIdentifier.BaseExpr, Literal: fpath}
+		p, err := cacheViewFromFile(ctx, scope, ident, forUpdate, importFormat, delimiter, delimiterPositions, singleLine, jsonQuery, sheet, headerRow, encoding, lineBreak, noHeader, encloseAll, jsonEscape, withoutNull)
+		if err != nil {
+			return err
+		}
+
+		view, ok := scope.Tx.cachedViews.Load(p)
+		if !ok {
+			return NewTableNotLoadedError(ident)
+		}
+		views = append(views, view)
+		files = append(files, view.FileInfo.Path)
+	}
+
+	columns := views[0].Header.TableColumnNames()
+	columnIndices := make([][]int, len(views))
+	for i, view := range views {
+		indices, ok := alignColumnNames(columns, view.Header.TableColumnNames())
+		if !ok {
+			return NewGlobTableHeaderNotMatchError(tableIdentifier, view.FileInfo.Path, views[0].FileInfo.Path)
+		}
+		columnIndices[i] = indices
+	}
+
+	viewName := parser.FormatTableName(pattern)
+	header := NewHeader(viewName, columns)
+	header = append(header, HeaderField{View: viewName, Column: parser.FileColumn})
+
+	recordLen := 0
+	for _, view := range views {
+		recordLen += view.RecordLen()
+	}
+
+	records := make(RecordSet, 0, recordLen)
+	for i, view := range views {
+		fileCell := NewCell(value.NewString(view.FileInfo.Path))
+		for _, record := range view.RecordSet {
+			r := make(Record, len(record)+1)
+			for j, idx := range columnIndices[i] {
+				r[j] = record[idx]
+			}
+			r[len(record)] = fileCell
+			records = append(records, r)
+		}
+	}
+
+	info := views[0].FileInfo
+	scope.Tx.cachedViews.Set(&View{
+		Header:    header,
+		RecordSet: records,
+		FileInfo: &FileInfo{
+			Path:               pattern,
+			Format:             info.Format,
+			Delimiter:          info.Delimiter,
+			DelimiterPositions: info.DelimiterPositions,
+			JsonQuery:          info.JsonQuery,
+			Sheet:              info.Sheet,
+			HeaderRow:          info.HeaderRow,
+			Encoding:           info.Encoding,
+			LineBreak:          info.LineBreak,
+			NoHeader:           info.NoHeader,
+			EncloseAll:         info.EncloseAll,
+			JsonEscape:         info.JsonEscape,
+			PrettyPrint:        info.PrettyPrint,
+			Compression:        info.Compression,
+			SingleLine:         info.SingleLine,
+			GlobFiles:          files,
+			ForUpdate:          forUpdate,
+			ViewType:           ViewTypeFile,
+		},
+	})
+	return nil
+}
+
+// alignColumnNames returns the indices of the columns in names in the order of columns.
+// If names do not consist of the same columns as columns, then it returns false.
+func alignColumnNames(columns []string, names []string) ([]int, bool) {
+	if len(columns) != len(names) {
+		return nil, false
+	}
+
+	indices := make([]int, len(columns))
+	used := make([]bool, len(names))
+	for i, column := range columns {
+		idx := -1
+		for j, name := range names {
+			if !used[j] && strings.EqualFold(column, name) {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, false
+		}
+		used[idx] = true
+		indices[i] = idx
+	}
+	return indices, true
+}
+
+// splitGlobView returns the views of the files that a view loaded with a glob pattern consists of.
+// The records are distributed by the values of the column FileColumn.
+func splitGlobView(scope *ReferenceScope, view *View) ([]*View, error) {
+	fileIdx := view.Header.Len() - 1
+
+	columns := make([]string, fileIdx)
+	for i := range columns {
+		columns[i] = view.Header[i].Column
+	}
+
+	views := make([]*View, len(view.FileInfo.GlobFiles))
+	columnIndices := make([][]int, len(view.FileInfo.GlobFiles))
+	viewIndex := make(map[string]int, len(view.FileInfo.GlobFiles))
+	for i, fpath := range view.FileInfo.GlobFiles {
+		base, ok := scope.Tx.cachedViews.Load(fpath)
+		if !ok {
+			return nil, NewTableNotLoadedError(parser.Identifier{Literal: fpath})
+		}
+		indices, ok := alignColumnNames(columns, base.Header.TableColumnNames())
+		if !ok {
+			return nil, NewGlobTableHeaderNotMatchError(parser.Identifier{Literal: view.FileInfo.Path}, fpath, view.FileInfo.GlobFiles[0])
+		}
+		views[i] = &View{
+			Header:    base.Header.Copy(),
+			RecordSet: make(RecordSet, 0, base.RecordLen()),
+			FileInfo:  base.FileInfo,
+		}
+		columnIndices[i] = indices
+		viewIndex[fpath] = i
+	}
+
+	for _, record := range view.RecordSet {
+		i := viewIndex[record[fileIdx][0].(*value.String).Raw()]
+		r := make(Record, fileIdx)
+		for j, idx := range columnIndices[i] {
+			r[idx] = record[j]
+		}
+		views[i].RecordSet = append(views[i].RecordSet, r)
+	}
+	return views, nil
+}
+
+// countGlobFileRecords returns the number of the records specified by the indices for each file
+// that a view loaded with a glob pattern consists of.
+func countGlobFileRecords(view *View, indices []int) map[string]int {
+	fileIdx := view.Header.Len() - 1
+
+	counts := make(map[string]int, len(view.FileInfo.GlobFiles))
+	for _, i := range indices {
+		counts[view.RecordSet[i][fileIdx][0].(*value.String).Raw()]++
+	}
+	return counts
+}
@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func TestCacheViewFromGlob_ColumnOrder(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	TestTx.Flags.Repository = TestDir
+	scope := NewReferenceScope(TestTx)
+
+	view, err := LoadView(context.Background(), scope, []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "swapped_*.csv"}}}, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expectHeader := append(NewHeader("swapped_*", []string{"column1", "column2"}), HeaderField{View: "swapped_*", Column: parser.FileColumn})
+	if !reflect.DeepEqual(view.Header.TableColumnNames(), expectHeader.TableColumnNames()) {
+		t.Errorf("header = %v, want %v", view.Header.TableColumnNames(), expectHeader.TableColumnNames())
+	}
+
+	expectRecords := RecordSet{
+		NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1"), value.NewString(GetTestFilePath("swapped_1.csv"))}),
+		NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2"), value.NewString(GetTestFilePath("swapped_1.csv"))}),
+		NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3"), value.NewString(GetTestFilePath("swapped_1.csv"))}),
+		NewRecord([]value.Primary{value.NewString("4"), value.NewString("str4"), value.NewString(GetTestFilePath("swapped_2.csv"))}),
+		NewRecord([]value.Primary{value.NewString("5"), value.NewString("str5"), value.NewString(GetTestFilePath("swapped_2.csv"))}),
+	}
+	if !reflect.DeepEqual(view.RecordSet, expectRecords) {
+		t.Errorf("records = %v, want %v", view.RecordSet, expectRecords)
+	}
+
+	cached, _ := TestTx.cachedViews.Load(GetTestFilePath("swapped_*.csv"))
+	views, err := splitGlobView(scope, cached)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expectSplit := RecordSet{
+		NewRecord([]value.Primary{value.NewString("str4"), value.NewString("4")}),
+		NewRecord([]value.Primary{value.NewString("str5"), value.NewString("5")}),
+	}
+	if !reflect.DeepEqual(views[1].RecordSet, expectSplit) {
+		t.Errorf("records of %s = %v, want %v", views[1].FileInfo.Path, views[1].RecordSet, expectSplit)
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 const InternalIdColumn = "@__internal_id"
+const groupingColumnPrefix = "@__grouping_"
 
 type HeaderField struct {
 	View         string
@@ -221,6 +222,20 @@ func (h Header) ContainsInternalId(viewName string) (int, error) {
 	return h.SearchIndex(fieldRef)
 }
 
+func groupingColumnName(fieldIdx int) string {
+	return groupingColumnPrefix + strconv.Itoa(fieldIdx)
+}
+
+func (h Header) groupingColumnIndex(fieldIdx int) (int, bool) {
+	column := groupingColumnName(fieldIdx)
+	for i := len(h) - 1; 0 <= i; i-- {
+		if !h[i].IsFromTable && h[i].Column == column {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
 func (h Header) Update(reference string, fields []parser.QueryExpression) error {
 	if fields != nil {
 		if len(fields) != h.Len() {
@@ -0,0 +1,557 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+	"github.com/mithrandie/ternary"
+)
+
+type indexedFile struct {
+	FileInfo *FileInfo
+	Indexes  []*TableIndex
+}
+
+type indexPredicate struct {
+	Index    *TableIndex
+	Operator string
+	Values   []parser.QueryExpression
+}
+
+type fieldPredicate struct {
+	Field    parser.QueryExpression
+	Operator string
+	Values   []parser.QueryExpression
+}
+
+const (
+	indexPredicateBetween = "BETWEEN"
+	indexPredicateIn      = "IN"
+)
+
+// loadViewUsingIndex loads only the records that can satisfy the conditions in the where clause
+// by using the indexes of the table.
+// If no index is available, then it returns nil without loading any records.
+func loadViewUsingIndex(ctx context.Context, scope *ReferenceScope, tables []parser.QueryExpression, whereClause parser.QueryExpression, forUpdate bool) (*View, error) {
+	if forUpdate || whereClause == nil || len(tables) != 1 {
+		return nil, nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok {
+		return nil, nil
+	}
+	target, predicates, ok := indexScanTarget(scope, table, whereClause)
+	if !ok {
+		return nil, nil
+	}
+
+	ctx, node := beginPlanNode(ctx)
+
+	var offsets []int64
+	if !isPlanOnly(ctx) {
+		var err error
+		if offsets, err = indexOffsets(ctx, scope, target, predicates); err != nil {
+			return nil, err
+		}
+	}
+	view, err := loadViewFromIndexedFile(ctx, scope, table, target, offsets)
+	if err != nil || view == nil {
+		return nil, err
+	}
+
+	node.describe(planIndexScan, indexScanDetail(target.FileInfo, table, predicates))
+	node.finish(view, "", nil)
+	return view, nil
+}
+
+// indexScanTarget returns the indexed file of the table and the predicates in the where clause
+// that can be evaluated by using its indexes.
+func indexScanTarget(scope *ReferenceScope, table parser.Table, whereClause parser.QueryExpression) (*indexedFile, []indexPredicate, bool) {
+	if whereClause == nil {
+		return nil, nil, false
+	}
+	target, ok := indexedFileInfo(scope, table)
+	if !ok {
+		return nil, nil, false
+	}
+	predicates := extractIndexPredicates(whereClause.(parser.WhereClause).Filter, table.Name(), target.Indexes)
+	if len(predicates) < 1 {
+		return nil, nil, false
+	}
+	return target, predicates, true
+}
+
+// loadJoinViewUsingIndex loads only the records of the joined table that have the same values
+// as the records of the view in the join key.
+// If no index is available, then it returns nil without loading any records.
+func loadJoinViewUsingIndex(ctx context.Context, scope *ReferenceScope, join parser.Join, view *View, forUpdate bool) (*View, error) {
+	if forUpdate {
+		return nil, nil
+	}
+
+	var searchLeft = func(expr parser.QueryExpression) (int, bool) {
+		switch expr.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			idx, err := view.Header.SearchIndex(expr)
+			return idx, err == nil
+		}
+		return -1, false
+	}
+
+	table, target, predicate, leftIdx, ok := indexJoinTarget(scope, join, searchLeft)
+	if !ok {
+		return nil, nil
+	}
+	ctx, node := beginPlanNode(ctx)
+
+	var offsets []int64
+	if !isPlanOnly(ctx) {
+		keys := make(map[string]bool, view.RecordLen())
+		for _, record := range view.RecordSet {
+			if key, ok := serializeJoinKey(record, []int{leftIdx}, scope.Tx.Flags); ok {
+				keys[key] = true
+			}
+		}
+		offsets = predicate.Index.Offsets(target.FileInfo.Schema, scope.Tx.Flags.WithoutNull, scope.Tx.Flags.DatetimeFormat, func(p value.Primary) bool {
+			key, ok := serializeJoinKey(Record{NewCell(p)}, []int{0}, scope.Tx.Flags)
+			return ok && keys[key]
+		})
+	}
+
+	joinView, err := loadViewFromIndexedFile(ctx, scope, table, target, offsets)
+	if err != nil || joinView == nil {
+		return nil, err
+	}
+
+	node.describe(planIndexScan, indexScanDetail(target.FileInfo, table, []indexPredicate{predicate}))
+	node.finish(joinView, "", nil)
+	return joinView, nil
+}
+
+// indexJoinTarget returns the joined table and the predicate on its index if the join condition
+// includes an equality between an indexed field of the joined table and a field searched by searchLeft.
+func indexJoinTarget(scope *ReferenceScope, join parser.Join, searchLeft func(parser.QueryExpression) (int, bool)) (parser.Table, *indexedFile, indexPredicate, int, bool) {
+	if !join.Natural.IsEmpty() || join.Condition == nil {
+		return parser.Table{}, nil, indexPredicate{}, -1, false
+	}
+	switch joinType(join) {
+	case parser.INNER:
+	case parser.OUTER:
+		if join.Direction.Token != parser.LEFT {
+			return parser.Table{}, nil, indexPredicate{}, -1, false
+		}
+	default:
+		return parser.Table{}, nil, indexPredicate{}, -1, false
+	}
+
+	on := join.Condition.(parser.JoinCondition).On
+	if on == nil {
+		return parser.Table{}, nil, indexPredicate{}, -1, false
+	}
+	table, ok := join.JoinTable.(parser.Table)
+	if !ok {
+		return parser.Table{}, nil, indexPredicate{}, -1, false
+	}
+	target, ok := indexedFileInfo(scope, table)
+	if !ok {
+		return parser.Table{}, nil, indexPredicate{}, -1, false
+	}
+
+	for _, expr := range splitConjuncts(on) {
+		comp, ok := expr.(parser.Comparison)
+		if !ok || comp.Operator != "=" {
+			continue
+		}
+		for _, pair := range [][2]parser.QueryExpression{{comp.LHS, comp.RHS}, {comp.RHS, comp.LHS}} {
+			idx, ok := searchIndexByField(pair[0], table.Name(), target.Indexes)
+			if !ok {
+				continue
+			}
+			if _, ok := searchLeft(pair[0]); ok {
+				continue
+			}
+			if leftIdx, ok := searchLeft(pair[1]); ok {
+				predicate := indexPredicate{Index: idx, Operator: comp.Operator, Values: []parser.QueryExpression{pair[1]}}
+				return table, target, predicate, leftIdx, true
+			}
+		}
+	}
+	return parser.Table{}, nil, indexPredicate{}, -1, false
+}
+
+// indexedFileInfo returns the information and the valid indexes of a file that has not been loaded in the transaction.
+func indexedFileInfo(scope *ReferenceScope, table parser.Table) (*indexedFile, bool) {
+	fileInfo, ok := unloadedFileInfo(scope, table)
+	if !ok {
+		return nil, false
+	}
+
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+	default:
+		return nil, false
+	}
+	if fileInfo.Compression != cmd.NoCompression {
+		return nil, false
+	}
+
+	names, err := TableIndexNames(fileInfo.Path)
+	if err != nil || len(names) < 1 {
+		return nil, false
+	}
+
+	indexes := make([]*TableIndex, 0, len(names))
+	for _, name := range names {
+		idx, err := LoadTableIndex(fileInfo.Path, name)
+		if err != nil {
+			continue
+		}
+		if idx.Delimiter != fileInfo.Delimiter || idx.NoHeader != fileInfo.NoHeader || !idx.IsValid(fileInfo.Path) {
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	if len(indexes) < 1 {
+		return nil, false
+	}
+
+	return &indexedFile{
+		FileInfo: fileInfo,
+		Indexes:  indexes,
+	}, true
+}
+
+// unloadedFileInfo returns the information of the file referred by the table
+// if the table is a file that has not been loaded in the transaction.
+func unloadedFileInfo(scope *ReferenceScope, table parser.Table) (*FileInfo, bool) {
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil, false
+	}
+	if scope.RecursiveTable != nil && strings.EqualFold(scope.RecursiveTable.Name.Literal, tableIdentifier.Literal) {
+		return nil, false
+	}
+	if scope.InlineTableExists(tableIdentifier) || scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return nil, false
+	}
+
+	filePath, ok := scope.LoadFilePath(tableIdentifier.Literal)
+	if !ok {
+		p, err := CreateFilePath(tableIdentifier, scope.Tx.Flags.Repository)
+		if err != nil {
+			return nil, false
+		}
+		filePath = p
+	}
+	if _, ok := scope.Tx.cachedViews.Load(filePath); ok {
+		return nil, false
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, cmd.AutoSelect, scope.Tx.Flags.Delimiter, scope.Tx.Flags.Encoding, scope.Tx.Flags)
+	if err != nil {
+		return nil, false
+	}
+	if _, ok := scope.Tx.cachedViews.Load(fileInfo.Path); ok {
+		return nil, false
+	}
+	fileInfo.LineBreak = scope.Tx.Flags.LineBreak
+	fileInfo.NoHeader = scope.Tx.Flags.NoHeader
+
+	return fileInfo, true
+}
+
+func splitConjuncts(expr parser.QueryExpression) []parser.QueryExpression {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return splitConjuncts(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token == parser.AND {
+			return append(splitConjuncts(logic.LHS), splitConjuncts(logic.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+// extractIndexPredicates returns the conditions in the filter that can be evaluated with the indexes.
+func extractIndexPredicates(filter parser.QueryExpression, tableName parser.Identifier, indexes []*TableIndex) []indexPredicate {
+	fieldPredicates := extractFieldPredicates(filter, func(expr parser.QueryExpression) bool {
+		_, ok := searchIndexByField(expr, tableName, indexes)
+		return ok
+	})
+
+	predicates := make([]indexPredicate, 0, len(fieldPredicates))
+	for _, p := range fieldPredicates {
+		idx, _ := searchIndexByField(p.Field, tableName, indexes)
+		predicates = append(predicates, indexPredicate{Index: idx, Operator: p.Operator, Values: p.Values})
+	}
+	return predicates
+}
+
+// extractFieldPredicates returns the conditions in the filter that compare a field accepted by isTarget with constant values.
+func extractFieldPredicates(filter parser.QueryExpression, isTarget func(parser.QueryExpression) bool) []fieldPredicate {
+	predicates := make([]fieldPredicate, 0, 2)
+
+	for _, expr := range splitConjuncts(filter) {
+		switch expr.(type) {
+		case parser.Comparison:
+			comp := expr.(parser.Comparison)
+			operator := comp.Operator
+			lhs, rhs := comp.LHS, comp.RHS
+			if !isTarget(lhs) {
+				lhs, rhs = rhs, lhs
+				switch operator {
+				case "<":
+					operator = ">"
+				case "<=":
+					operator = ">="
+				case ">":
+					operator = "<"
+				case ">=":
+					operator = "<="
+				}
+			}
+			switch operator {
+			case "=", "==", "<", "<=", ">", ">=":
+			default:
+				continue
+			}
+			if isTarget(lhs) && isIndexConstant(rhs) {
+				predicates = append(predicates, fieldPredicate{Field: lhs, Operator: operator, Values: []parser.QueryExpression{rhs}})
+			}
+		case parser.Between:
+			between := expr.(parser.Between)
+			if between.IsNegated() || !isIndexConstant(between.Low) || !isIndexConstant(between.High) {
+				continue
+			}
+			if isTarget(between.LHS) {
+				predicates = append(predicates, fieldPredicate{Field: between.LHS, Operator: indexPredicateBetween, Values: []parser.QueryExpression{between.Low, between.High}})
+			}
+		case parser.In:
+			in := expr.(parser.In)
+			if in.IsNegated() {
+				continue
+			}
+			rowValue, ok := in.Values.(parser.RowValue)
+			if !ok {
+				continue
+			}
+			list, ok := rowValue.Value.(parser.ValueList)
+			if !ok {
+				continue
+			}
+			constant := true
+			for _, v := range list.Values {
+				if !isIndexConstant(v) {
+					constant = false
+					break
+				}
+			}
+			if !constant {
+				continue
+			}
+			if isTarget(in.LHS) {
+				predicates = append(predicates, fieldPredicate{Field: in.LHS, Operator: indexPredicateIn, Values: list.Values})
+			}
+		}
+	}
+	return predicates
+}
+
+func searchIndexByField(expr parser.QueryExpression, tableName parser.Identifier, indexes []*TableIndex) (*TableIndex, bool) {
+	fieldRef, ok := expr.(parser.FieldReference)
+	if !ok {
+		return nil, false
+	}
+	if 0 < len(fieldRef.View.Literal) && !strings.EqualFold(fieldRef.View.Literal, tableName.Literal) {
+		return nil, false
+	}
+	for _, idx := range indexes {
+		if strings.EqualFold(fieldRef.Column.Literal, idx.Column) {
+			return idx, true
+		}
+	}
+	return nil, false
+}
+
+func isIndexConstant(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.Placeholder, parser.Variable:
+		return true
+	case parser.Parentheses:
+		return isIndexConstant(expr.(parser.Parentheses).Expr)
+	case parser.UnaryArithmetic:
+		return isIndexConstant(expr.(parser.UnaryArithmetic).Operand)
+	}
+	return false
+}
+
+func indexOffsets(ctx context.Context, scope *ReferenceScope, target *indexedFile, predicates []indexPredicate) ([]int64, error) {
+	var offsets []int64
+
+	for i, predicate := range predicates {
+		values := make([]value.Primary, len(predicate.Values))
+		for j, v := range predicate.Values {
+			p, err := Evaluate(ctx, scope, v)
+			if err != nil {
+				return nil, err
+			}
+			values[j] = p
+		}
+
+		operator := predicate.Operator
+		formats := scope.Tx.Flags.DatetimeFormat
+		matched := predicate.Index.Offsets(target.FileInfo.Schema, scope.Tx.Flags.WithoutNull, formats, func(p value.Primary) bool {
+			switch operator {
+			case indexPredicateBetween:
+				return value.GreaterOrEqual(p, values[0], formats) == ternary.TRUE && value.LessOrEqual(p, values[1], formats) == ternary.TRUE
+			case indexPredicateIn:
+				for _, v := range values {
+					if value.Compare(p, v, "=", formats) == ternary.TRUE {
+						return true
+					}
+				}
+				return false
+			}
+			return value.Compare(p, values[0], operator, formats) == ternary.TRUE
+		})
+
+		if i == 0 {
+			offsets = matched
+		} else {
+			offsets = intersectOffsets(offsets, matched)
+		}
+	}
+	return offsets, nil
+}
+
+func intersectOffsets(offsets1 []int64, offsets2 []int64) []int64 {
+	ret := make([]int64, 0, len(offsets1))
+	for i, j := 0, 0; i < len(offsets1) && j < len(offsets2); {
+		switch {
+		case offsets1[i] < offsets2[j]:
+			i++
+		case offsets2[j] < offsets1[i]:
+			j++
+		default:
+			ret = append(ret, offsets1[i])
+			i++
+			j++
+		}
+	}
+	return ret
+}
+
+// loadViewFromIndexedFile reads the records at the offsets from the file.
+// The loaded view is not cached in the transaction.
+func loadViewFromIndexedFile(ctx context.Context, scope *ReferenceScope, table parser.Table, target *indexedFile, offsets []int64) (view *View, err error) {
+	tableIdentifier := table.Object.(parser.Identifier)
+	fileInfo := target.FileInfo
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		tableIdentifier.Literal = fileInfo.Path
+		return nil, ConvertFileHandlerError(err, tableIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+	fp := h.File()
+
+	var dataParsingError = func(err error) error {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	reader, header, err := newCSVReader(fp, fileInfo, scope.Tx.Flags.WithoutNull, tableIdentifier)
+	if err != nil {
+		return nil, dataParsingError(err)
+	}
+	switch fileInfo.Encoding {
+	case text.UTF8, text.UTF8M:
+	default:
+		return nil, nil
+	}
+	if header == nil {
+		record, err := reader.Read()
+		if err != nil && err != io.EOF {
+			return nil, dataParsingError(err)
+		}
+		header = autofillHeader(len(record))
+	}
+
+	records := make(RecordSet, 0, len(offsets))
+	for i, offset := range offsets {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		if _, err = fp.Seek(offset, io.SeekStart); err != nil {
+			return nil, dataParsingError(err)
+		}
+		r, err := csv.NewReader(fp, text.UTF8)
+		if err != nil {
+			return nil, dataParsingError(err)
+		}
+		r.Delimiter = fileInfo.Delimiter
+		r.WithoutNull = scope.Tx.Flags.WithoutNull
+
+		row, err := r.Read()
+		if err != nil {
+			return nil, dataParsingError(err)
+		}
+		if len(row) != len(header) {
+			return nil, dataParsingError(errors.New(fmt.Sprintf("wrong number of fields in the record at offset %d", offset)))
+		}
+		records = append(records, newRecordFromRawText(row))
+	}
+
+	view = NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+
+	if fileInfo.Schema != nil {
+		if err = view.applyTableSchema(ctx, scope.Tx.Flags, tableIdentifier, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if !strings.EqualFold(parser.FormatTableName(fileInfo.Path), table.Name().Literal) {
+		if err = view.Header.Update(table.Name().Literal, nil); err != nil {
+			return nil, err
+		}
+	}
+	return view, nil
+}
+
+func indexScanDetail(fileInfo *FileInfo, table parser.Table, predicates []indexPredicate) string {
+	names := make([]string, 0, len(predicates))
+	for _, p := range predicates {
+		exists := false
+		for _, name := range names {
+			if name == p.Index.Name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			names = append(names, p.Index.Name)
+		}
+	}
+	sort.Strings(names)
+	return fileScanDetail(fileInfo, table) + " USING " + strings.Join(names, ", ")
+}
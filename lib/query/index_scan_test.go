@@ -0,0 +1,261 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var indexScanTests = []struct {
+	Name      string
+	Query     parser.SelectQuery
+	IndexScan bool
+	Result    RecordSet
+	Error     string
+}{
+	{
+		Name: "Index Scan with Equality",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_index"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+						RHS:      parser.NewIntegerValueFromString("2"),
+						Operator: "=",
+					},
+				},
+			},
+		},
+		IndexScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("str,2"), value.NewString("b")}),
+		},
+	},
+	{
+		Name: "Index Scan with Reversed Comparison",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "id"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_index"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.NewIntegerValueFromString("3"),
+						RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "id"}},
+						Operator: "<=",
+					},
+				},
+			},
+		},
+		IndexScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("3")}),
+			NewRecord([]value.Primary{value.NewString("4")}),
+		},
+	},
+	{
+		Name: "Index Scan with Between and In",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "name"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_index"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Logic{
+						LHS: parser.Between{
+							LHS:  parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+							Low:  parser.NewIntegerValueFromString("1"),
+							High: parser.NewIntegerValueFromString("3"),
+						},
+						RHS: parser.In{
+							LHS: parser.FieldReference{Column: parser.Identifier{Literal: "grp"}},
+							Values: parser.RowValue{
+								Value: parser.ValueList{
+									Values: []parser.QueryExpression{
+										parser.NewStringValue("a"),
+									},
+								},
+							},
+						},
+						Operator: parser.Token{Token: parser.AND, Literal: "and"},
+					},
+				},
+			},
+		},
+		IndexScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("str1")}),
+			NewRecord([]value.Primary{value.NewString("str3")}),
+		},
+	},
+	{
+		Name: "Index Scan Not Used with Or Condition",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "id"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_index"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Logic{
+						LHS: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+							RHS:      parser.NewIntegerValueFromString("1"),
+							Operator: "=",
+						},
+						RHS: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "grp"}},
+							RHS:      parser.NewStringValue("b"),
+							Operator: "=",
+						},
+						Operator: parser.Token{Token: parser.OR, Literal: "or"},
+					},
+				},
+			},
+		},
+		IndexScan: false,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewString("2")}),
+			NewRecord([]value.Primary{value.NewString("4")}),
+		},
+	},
+	{
+		Name: "Index Scan on Join",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}}},
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "i"}, Column: parser.Identifier{Literal: "grp"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Join{
+							Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+							JoinTable: parser.Table{Object: parser.Identifier{Literal: "table_index"}, Alias: parser.Identifier{Literal: "i"}},
+							JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+							Condition: parser.JoinCondition{
+								On: parser.Comparison{
+									LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.FieldReference{View: parser.Identifier{Literal: "i"}, Column: parser.Identifier{Literal: "id"}},
+									Operator: "=",
+								},
+							},
+						}},
+					},
+				},
+			},
+		},
+		IndexScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("str1"), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewString("str2"), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("str3"), value.NewString("a")}),
+		},
+	},
+}
+
+func TestIndexScan(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	fpath := GetTestFilePath("table_index.csv")
+	for _, column := range []string{"id", "grp"} {
+		if _, err := CreateIndex(ctx, scope, parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx_" + column},
+			Table:  parser.Identifier{Literal: "table_index"},
+			Column: parser.Identifier{Literal: column},
+		}); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	defer func() {
+		_ = RemoveTableIndex(fpath, "idx_id")
+		_ = RemoveTableIndex(fpath, "idx_grp")
+	}()
+
+	for _, v := range indexScanTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		result, err := Select(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+		if _, ok := TestTx.cachedViews.Load(fpath); ok == v.IndexScan {
+			t.Errorf("%s: index scan = %t, want %t", v.Name, !ok, v.IndexScan)
+		}
+
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		plan, err := Explain(ctx, scope, parser.Explain{Query: v.Query})
+		if err != nil {
+			t.Errorf("%s: unexpected error %q in explain", v.Name, err)
+		} else if strings.Contains(plan, planIndexScan) != v.IndexScan {
+			t.Errorf("%s: index scan in explain = %t, want %t", v.Name, !v.IndexScan, v.IndexScan)
+		}
+
+		if v.IndexScan {
+			_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+			streamed, _ := StreamSelect(ctx, scope, v.Query, &bytes.Buffer{}, &FileInfo{Format: cmd.CSV, Delimiter: ',', Encoding: text.UTF8, LineBreak: text.LF})
+			if streamed {
+				t.Errorf("%s: query is streamed, want to use the index", v.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,432 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const InformationSchema = "INFORMATION_SCHEMA"
+
+const (
+	InformationSchemaTables    = "TABLES"
+	InformationSchemaColumns   = "COLUMNS"
+	InformationSchemaViews     = "VIEWS"
+	InformationSchemaCursors   = "CURSORS"
+	InformationSchemaFunctions = "FUNCTIONS"
+	InformationSchemaFlags     = "FLAGS"
+)
+
+var InformationSchemaTableList = []string{
+	InformationSchemaTables,
+	InformationSchemaColumns,
+	InformationSchemaViews,
+	InformationSchemaCursors,
+	InformationSchemaFunctions,
+	InformationSchemaFlags,
+}
+
+const (
+	// informationSchemaMaxDepth is the maximum depth of the subdirectories searched for data files.
+	informationSchemaMaxDepth = 3
+	// informationSchemaMaxFiles is the maximum number of data files searched in the repository.
+	informationSchemaMaxFiles = 1000
+)
+
+var errTooManyFiles = errors.New("too many files")
+
+var dataFileExtensions = []string{
+	cmd.CsvExt,
+	cmd.TsvExt,
+	cmd.JsonExt,
+	cmd.JsonlExt,
+	cmd.LtsvExt,
+	cmd.XlsxExt,
+	cmd.ParquetExt,
+	cmd.TextExt,
+}
+
+func loadViewFromSchemaTable(ctx context.Context, scope *ReferenceScope, table parser.SchemaTable, tableName string) (*View, error) {
+	if !strings.EqualFold(table.Schema.Literal, InformationSchema) {
+		return nil, NewSchemaNotExistError(table.Schema)
+	}
+
+	switch strings.ToUpper(table.Table.Literal) {
+	case InformationSchemaTables:
+		return informationSchemaTables(scope, tableName), nil
+	case InformationSchemaColumns:
+		return informationSchemaColumns(ctx, scope, tableName), nil
+	case InformationSchemaViews:
+		return informationSchemaViews(scope, tableName), nil
+	case InformationSchemaCursors:
+		return informationSchemaCursors(scope, tableName), nil
+	case InformationSchemaFunctions:
+		return informationSchemaFunctions(scope, tableName), nil
+	case InformationSchemaFlags:
+		return informationSchemaFlags(scope, tableName), nil
+	}
+	return nil, NewSchemaTableNotExistError(table)
+}
+
+// searchDataFiles returns the data files in the repository and the files of the loaded tables.
+// The search is limited by informationSchemaMaxDepth and informationSchemaMaxFiles.
+func searchDataFiles(scope *ReferenceScope, repository string) ([]string, map[string]os.FileInfo) {
+	files := make(map[string]os.FileInfo)
+	_ = filepath.Walk(repository, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil || fpath == repository {
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			if rel, err := filepath.Rel(repository, fpath); err != nil || informationSchemaMaxDepth <= strings.Count(rel, string(filepath.Separator)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isDataFile(fpath) {
+			if informationSchemaMaxFiles <= len(files) {
+				return errTooManyFiles
+			}
+			files[fpath] = info
+		}
+		return nil
+	})
+
+	for _, key := range scope.Tx.cachedViews.Keys() {
+		if view, ok := scope.Tx.cachedViews.Load(key); ok && !view.FileInfo.IsGlob() {
+			if _, ok := files[view.FileInfo.Path]; !ok {
+				info, _ := os.Stat(view.FileInfo.Path)
+				files[view.FileInfo.Path] = info
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(files))
+	for fpath := range files {
+		paths = append(paths, fpath)
+	}
+	sort.Strings(paths)
+
+	return paths, files
+}
+
+func informationSchemaTables(scope *ReferenceScope, tableName string) *View {
+	repository := absRepository(scope.Tx.Flags.Repository)
+	paths, files := searchDataFiles(scope, repository)
+
+	createdFiles, updatedFiles := scope.Tx.uncommittedViews.UncommittedFiles()
+
+	view := &View{
+		Header:    NewHeader(tableName, []string{"TABLE_NAME", "PATH", "FORMAT", "DELIMITER", "ENCODING", "SIZE", "MODIFIED", "LOADED", "UNCOMMITTED"}),
+		RecordSet: make(RecordSet, 0, len(paths)),
+	}
+
+	for _, fpath := range paths {
+		var fileInfo *FileInfo
+		v, loaded := scope.Tx.cachedViews.Load(fpath)
+		if loaded {
+			fileInfo = v.FileInfo
+		} else {
+			fi, err := NewFileInfo(parser.Identifier{Literal: fpath}, repository, cmd.AutoSelect, scope.Tx.Flags.Delimiter, scope.Tx.Flags.Encoding, scope.Tx.Flags)
+			if err != nil {
+				continue
+			}
+			fileInfo = fi
+		}
+
+		var delimiter value.Primary = value.NewNull()
+		switch fileInfo.Format {
+		case cmd.CSV, cmd.TSV:
+			delimiter = value.NewString(string(fileInfo.Delimiter))
+		}
+
+		var size value.Primary = value.NewNull()
+		var modified value.Primary = value.NewNull()
+		if info := files[fpath]; info != nil {
+			size = value.NewInteger(info.Size())
+			modified = value.NewDatetime(info.ModTime().In(cmd.GetLocation()))
+		}
+
+		ufpath := strings.ToUpper(fpath)
+		_, created := createdFiles[ufpath]
+		_, updated := updatedFiles[ufpath]
+
+		view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+			value.NewString(relativeTableName(repository, fpath)),
+			value.NewString(fpath),
+			value.NewString(fileInfo.Format.String()),
+			delimiter,
+			value.NewString(fileInfo.Encoding.String()),
+			size,
+			modified,
+			value.NewBoolean(loaded),
+			value.NewBoolean(created || updated),
+		}))
+	}
+	return view
+}
+
+func absRepository(repository string) string {
+	if len(repository) < 1 {
+		repository, _ = os.Getwd()
+	}
+	repository, _ = filepath.Abs(repository)
+	return repository
+}
+
+func relativeTableName(repository string, fpath string) string {
+	if rel, err := filepath.Rel(repository, fpath); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return fpath
+}
+
+func isDataFile(fpath string) bool {
+	if strings.HasSuffix(strings.ToLower(fpath), SchemaFileExtension) {
+		return false
+	}
+	_, uncompressedPath := cmd.CompressionOfPath(fpath)
+	ext := strings.ToLower(filepath.Ext(uncompressedPath))
+	for _, e := range dataFileExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+func informationSchemaColumns(ctx context.Context, scope *ReferenceScope, tableName string) *View {
+	view := &View{
+		Header:    NewHeader(tableName, []string{"TABLE_NAME", "TABLE_TYPE", "COLUMN_NAME", "ORDINAL_POSITION", "DATA_TYPE", "NOT_NULL"}),
+		RecordSet: RecordSet{},
+	}
+
+	var appendColumns = func(name string, tableType string, columns []string, schema TableSchema) {
+		for i, column := range columns {
+			var dataType value.Primary = value.NewNull()
+			notNull := false
+			if c, ok := schema.Column(column); ok {
+				if c.Type != UntypedColumn {
+					dataType = value.NewString(c.Type.String())
+				}
+				notNull = c.NotNull
+			}
+
+			view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+				value.NewString(name),
+				value.NewString(tableType),
+				value.NewString(column),
+				value.NewInteger(int64(i + 1)),
+				dataType,
+				value.NewBoolean(notNull),
+			}))
+		}
+	}
+
+	repository := absRepository(scope.Tx.Flags.Repository)
+	paths, _ := searchDataFiles(scope, repository)
+
+	for _, fpath := range paths {
+		if v, ok := scope.Tx.cachedViews.Load(fpath); ok {
+			appendColumns(relativeTableName(repository, fpath), "TABLE", v.Header.TableColumnNames(), v.FileInfo.Schema)
+		} else if columns, schema, ok := loadTableColumns(ctx, scope, repository, fpath); ok {
+			appendColumns(relativeTableName(repository, fpath), "TABLE", columns, schema)
+		}
+	}
+
+	views := scope.AllTemporaryTables()
+	for _, key := range views.SortedKeys() {
+		if v, ok := views.Load(key); ok {
+			appendColumns(v.FileInfo.Path, "VIEW", v.Header.TableColumnNames(), nil)
+		}
+	}
+	return view
+}
+
+// loadTableColumns returns the column names and the schema of a file that has not been loaded.
+// The column names are read from the header of csv and tsv files, and from the schema file for other formats.
+func loadTableColumns(ctx context.Context, scope *ReferenceScope, repository string, fpath string) ([]string, TableSchema, bool) {
+	fileInfo, err := NewFileInfo(parser.Identifier{Literal: fpath}, repository, cmd.AutoSelect, scope.Tx.Flags.Delimiter, scope.Tx.Flags.Encoding, scope.Tx.Flags)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+		if fileInfo.Compression == cmd.NoCompression {
+			fileInfo.NoHeader = scope.Tx.Flags.NoHeader
+			columns, err := readCSVColumns(ctx, scope, fileInfo)
+			return columns, fileInfo.Schema, err == nil
+		}
+	}
+
+	if len(fileInfo.Schema) < 1 {
+		return nil, nil, false
+	}
+	columns := make([]string, 0, len(fileInfo.Schema))
+	for _, c := range fileInfo.Schema {
+		columns = append(columns, c.Name)
+	}
+	return columns, fileInfo.Schema, true
+}
+
+func readCSVColumns(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo) (columns []string, err error) {
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	reader, columns, err := newCSVReader(h.File(), fileInfo, scope.Tx.Flags.WithoutNull, parser.Identifier{Literal: fileInfo.Path})
+	if err != nil {
+		return nil, err
+	}
+	if columns == nil {
+		if _, err = readRecords(ctx, reader, 1); err != nil {
+			return nil, err
+		}
+		columns = autofillHeader(reader.FieldsPerRecord)
+	}
+	return columns, nil
+}
+
+func informationSchemaViews(scope *ReferenceScope, tableName string) *View {
+	views := scope.AllTemporaryTables()
+	updatedViews := scope.Tx.uncommittedViews.UncommittedTempViews()
+
+	view := &View{
+		Header:    NewHeader(tableName, []string{"VIEW_NAME", "COLUMN_COUNT", "RECORD_COUNT", "UNCOMMITTED"}),
+		RecordSet: make(RecordSet, 0, views.Len()),
+	}
+
+	for _, key := range views.SortedKeys() {
+		if v, ok := views.Load(key); ok {
+			_, updated := updatedViews[strings.ToUpper(v.FileInfo.Path)]
+
+			view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+				value.NewString(v.FileInfo.Path),
+				value.NewInteger(int64(len(v.Header.TableColumnNames()))),
+				value.NewInteger(int64(v.RecordLen())),
+				value.NewBoolean(updated),
+			}))
+		}
+	}
+	return view
+}
+
+func informationSchemaCursors(scope *ReferenceScope, tableName string) *View {
+	cursors := scope.AllCursors()
+
+	view := &View{
+		Header:    NewHeader(tableName, []string{"CURSOR_NAME", "STATUS", "ROW_COUNT", "POSITION", "QUERY", "STATEMENT"}),
+		RecordSet: make(RecordSet, 0, cursors.Len()),
+	}
+
+	for _, key := range cursors.SortedKeys() {
+		if cur, ok := cursors.Load(key); ok {
+			status := "CLOSED"
+			var rowCount value.Primary = value.NewNull()
+			var position value.Primary = value.NewNull()
+			if cur.IsOpen() == ternary.TRUE {
+				status = "OPEN"
+				nor, _ := cur.Count()
+				rowCount = value.NewInteger(int64(nor))
+				if inRange, _ := cur.IsInRange(); inRange == ternary.TRUE {
+					pos, _ := cur.Pointer()
+					position = value.NewInteger(int64(pos))
+				}
+			}
+
+			var query value.Primary = value.NewNull()
+			var statement value.Primary = value.NewNull()
+			if cur.query.SelectEntity != nil {
+				query = value.NewString(cur.query.String())
+			} else {
+				statement = value.NewString(cur.statement.Literal)
+			}
+
+			view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+				value.NewString(cur.name),
+				value.NewString(status),
+				rowCount,
+				position,
+				query,
+				statement,
+			}))
+		}
+	}
+	return view
+}
+
+func informationSchemaFunctions(scope *ReferenceScope, tableName string) *View {
+	view := &View{
+		Header:    NewHeader(tableName, []string{"FUNCTION_NAME", "FUNCTION_TYPE", "PARAMETERS", "REQUIRED_ARGS"}),
+		RecordSet: RecordSet{},
+	}
+
+	var appendFunctions = func(funcs UserDefinedFunctionMap, functionType string) {
+		for _, key := range funcs.SortedKeys() {
+			if fn, ok := funcs.Load(key); ok {
+				params := make([]string, 0, len(fn.Parameters)+1)
+				if fn.IsAggregate {
+					params = append(params, fn.Cursor.String())
+				}
+				for _, p := range fn.Parameters {
+					if def, ok := fn.Defaults[p.Name]; ok {
+						params = append(params, p.String()+" = "+def.String())
+					} else {
+						params = append(params, p.String())
+					}
+				}
+
+				view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+					value.NewString(fn.Name.Literal),
+					value.NewString(functionType),
+					value.NewString(strings.Join(params, ", ")),
+					value.NewInteger(int64(fn.RequiredArgs)),
+				}))
+			}
+		}
+	}
+
+	scalars, aggs := scope.AllFunctions()
+	appendFunctions(scalars, "SCALAR")
+	appendFunctions(aggs, "AGGREGATE")
+	return view
+}
+
+func informationSchemaFlags(scope *ReferenceScope, tableName string) *View {
+	view := &View{
+		Header:    NewHeader(tableName, []string{"FLAG_NAME", "VALUE"}),
+		RecordSet: make(RecordSet, 0, len(cmd.FlagList)),
+	}
+
+	for _, flag := range cmd.FlagList {
+		val, ok := scope.Tx.GetFlag(flag)
+		if !ok {
+			continue
+		}
+		view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+			value.NewString(cmd.FlagSymbol(flag)),
+			val,
+		}))
+	}
+	return view
+}
@@ -0,0 +1,268 @@
+package query
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var loadViewFromSchemaTableTests = []struct {
+	Name   string
+	Table  parser.SchemaTable
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Load Views",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "information_schema"},
+			Table:  parser.Identifier{Literal: "views"},
+		},
+		Result: &View{
+			Header: NewHeader("s", []string{"VIEW_NAME", "COLUMN_COUNT", "RECORD_COUNT", "UNCOMMITTED"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("tmpview"), value.NewInteger(2), value.NewInteger(1), value.NewBoolean(false)}),
+			},
+		},
+	},
+	{
+		Name: "Load Columns",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "information_schema"},
+			Table:  parser.Identifier{Literal: "columns"},
+		},
+		Result: &View{
+			Header: NewHeader("s", []string{"TABLE_NAME", "TABLE_TYPE", "COLUMN_NAME", "ORDINAL_POSITION", "DATA_TYPE", "NOT_NULL"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("tmpview"), value.NewString("VIEW"), value.NewString("column1"), value.NewInteger(1), value.NewNull(), value.NewBoolean(false)}),
+				NewRecord([]value.Primary{value.NewString("tmpview"), value.NewString("VIEW"), value.NewString("column2"), value.NewInteger(2), value.NewNull(), value.NewBoolean(false)}),
+			},
+		},
+	},
+	{
+		Name: "Load Cursors",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "information_schema"},
+			Table:  parser.Identifier{Literal: "cursors"},
+		},
+		Result: &View{
+			Header: NewHeader("s", []string{"CURSOR_NAME", "STATUS", "ROW_COUNT", "POSITION", "QUERY", "STATEMENT"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("cur"), value.NewString("OPEN"), value.NewInteger(2), value.NewInteger(0), value.NewString("select 1"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("stmtcur"), value.NewString("CLOSED"), value.NewNull(), value.NewNull(), value.NewNull(), value.NewString("stmt")}),
+			},
+		},
+	},
+	{
+		Name: "Load Functions",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "information_schema"},
+			Table:  parser.Identifier{Literal: "functions"},
+		},
+		Result: &View{
+			Header: NewHeader("s", []string{"FUNCTION_NAME", "FUNCTION_TYPE", "PARAMETERS", "REQUIRED_ARGS"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("userfunc"), value.NewString("SCALAR"), value.NewString("@arg1, @arg2 = 1"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("useraggfunc"), value.NewString("AGGREGATE"), value.NewString("list"), value.NewInteger(0)}),
+			},
+		},
+	},
+	{
+		Name: "Schema Does Not Exist Error",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "notexist"},
+			Table:  parser.Identifier{Literal: "tables"},
+		},
+		Error: "schema notexist does not exist",
+	},
+	{
+		Name: "Table Does Not Exist Error",
+		Table: parser.SchemaTable{
+			Schema: parser.Identifier{Literal: "information_schema"},
+			Table:  parser.Identifier{Literal: "notexist"},
+		},
+		Error: "table notexist does not exist in schema information_schema",
+	},
+}
+
+func TestLoadViewFromSchemaTable(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+	}()
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
+		{
+			scopeNameTempTables: {
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+					},
+					FileInfo: &FileInfo{
+						Path:     "tmpview",
+						ViewType: ViewTypeTemporaryTable,
+					},
+				},
+			},
+			scopeNameCursors: {
+				"CUR": &Cursor{
+					name: "cur",
+					query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Select: "select",
+								Fields: []parser.QueryExpression{parser.Field{Object: parser.NewIntegerValueFromString("1")}},
+							},
+						},
+					},
+					view: &View{
+						Header: NewHeader("", []string{"c1"}),
+						RecordSet: RecordSet{
+							NewRecord([]value.Primary{value.NewInteger(1)}),
+							NewRecord([]value.Primary{value.NewInteger(2)}),
+						},
+					},
+					index:   0,
+					fetched: true,
+					mtx:     &sync.Mutex{},
+				},
+				"STMTCUR": &Cursor{
+					name:      "stmtcur",
+					statement: parser.Identifier{Literal: "stmt"},
+					mtx:       &sync.Mutex{},
+				},
+			},
+			scopeNameFunctions: {
+				"USERFUNC": &UserDefinedFunction{
+					Name: parser.Identifier{Literal: "userfunc"},
+					Parameters: []parser.Variable{
+						{Name: "arg1"},
+						{Name: "arg2"},
+					},
+					Defaults: map[string]parser.QueryExpression{
+						"arg2": parser.NewIntegerValueFromString("1"),
+					},
+					RequiredArgs: 1,
+				},
+				"USERAGGFUNC": &UserDefinedFunction{
+					Name:        parser.Identifier{Literal: "useraggfunc"},
+					IsAggregate: true,
+					Cursor:      parser.Identifier{Literal: "list"},
+				},
+			},
+		},
+	}, nil, time.Time{}, nil)
+
+	for _, v := range loadViewFromSchemaTableTests {
+		result, err := loadViewFromSchemaTable(context.Background(), scope, v.Table, "s")
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestInformationSchemaTables(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	TestTx.Flags.Repository = GlobTestDir
+	scope := NewReferenceScope(TestTx)
+
+	if _, err := cacheViewFromFile(context.Background(), scope, parser.Identifier{Literal: "log_2.csv"}, false, 0, ',', nil, false, "", "", 0, TestTx.Flags.Encoding, TestTx.Flags.LineBreak, false, false, TestTx.Flags.JsonEscape, false); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := []struct {
+		TableName string
+		Path      string
+		Size      int64
+		Loaded    bool
+	}{
+		{TableName: "log_1.csv", Path: GetTestFilePath("glob/log_1.csv"), Size: 37, Loaded: false},
+		{TableName: "log_2.csv", Path: GetTestFilePath("glob/log_2.csv"), Size: 37, Loaded: true},
+		{TableName: "other_1.csv", Path: GetTestFilePath("glob/other_1.csv"), Size: 48, Loaded: false},
+	}
+
+	view := informationSchemaTables(scope, "tables")
+	if view.RecordLen() != len(expect) {
+		t.Fatalf("record length = %d, want %d", view.RecordLen(), len(expect))
+	}
+	for i, e := range expect {
+		record := view.RecordSet[i]
+		if s := record[0][0].(*value.String).Raw(); s != e.TableName {
+			t.Errorf("table name = %q, want %q", s, e.TableName)
+		}
+		if s := record[1][0].(*value.String).Raw(); s != e.Path {
+			t.Errorf("path = %q, want %q", s, e.Path)
+		}
+		if s := record[2][0].(*value.String).Raw(); s != "CSV" {
+			t.Errorf("format = %q, want %q for %s", s, "CSV", e.TableName)
+		}
+		if s := record[3][0].(*value.String).Raw(); s != "," {
+			t.Errorf("delimiter = %q, want %q for %s", s, ",", e.TableName)
+		}
+		if i := record[5][0].(*value.Integer).Raw(); i != e.Size {
+			t.Errorf("size = %d, want %d for %s", i, e.Size, e.TableName)
+		}
+		if _, ok := record[6][0].(*value.Datetime); !ok {
+			t.Errorf("modified = %s, want datetime for %s", record[6][0], e.TableName)
+		}
+		if b := record[7][0].(*value.Boolean).Raw(); b != e.Loaded {
+			t.Errorf("loaded = %t, want %t for %s", b, e.Loaded, e.TableName)
+		}
+	}
+}
+
+func TestInformationSchemaColumns(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	TestTx.Flags.Repository = CompletionTestDir
+	scope := NewReferenceScope(TestTx)
+
+	expect := &View{
+		Header: NewHeader("columns", []string{"TABLE_NAME", "TABLE_TYPE", "COLUMN_NAME", "ORDINAL_POSITION", "DATA_TYPE", "NOT_NULL"}),
+		RecordSet: RecordSet{
+			NewRecord([]value.Primary{value.NewString(filepath.Join("sub", "table2.csv")), value.NewString("TABLE"), value.NewString("column3"), value.NewInteger(1), value.NewNull(), value.NewBoolean(false)}),
+			NewRecord([]value.Primary{value.NewString(filepath.Join("sub", "table2.csv")), value.NewString("TABLE"), value.NewString("column4"), value.NewInteger(2), value.NewNull(), value.NewBoolean(false)}),
+			NewRecord([]value.Primary{value.NewString("table1.csv"), value.NewString("TABLE"), value.NewString("column1"), value.NewInteger(1), value.NewNull(), value.NewBoolean(false)}),
+			NewRecord([]value.Primary{value.NewString("table1.csv"), value.NewString("TABLE"), value.NewString("column2"), value.NewInteger(2), value.NewNull(), value.NewBoolean(false)}),
+		},
+	}
+
+	view := informationSchemaColumns(context.Background(), scope, "columns")
+	if !reflect.DeepEqual(view, expect) {
+		t.Errorf("result = %v, want %v", view, expect)
+	}
+	if _, ok := TestTx.cachedViews.Load(filepath.Join(CompletionTestDir, "table1.csv")); ok {
+		t.Error("table1.csv is loaded, want not to be loaded")
+	}
+}
@@ -23,11 +23,13 @@ func (it InlineTableMap) Set(ctx context.Context, scope *ReferenceScope, inlineT
 		scope.RecursiveTable = &inlineTable
 	}
 
+	ctx, node := beginPlanNode(ctx)
 	view, err := Select(ctx, scope, inlineTable.Query)
 	scope.CloseCurrentNode()
 	if err != nil {
 		return err
 	}
+	node.finish(view, planInlineTable, inlineTable.Name)
 
 	err = view.Header.Update(inlineTable.Name.Literal, inlineTable.Fields)
 	if err != nil {
@@ -3,8 +3,10 @@ package query
 import (
 	"context"
 	"math"
+	"strings"
 	"sync"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -138,6 +140,12 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	mergedHeader := view.Header.Merge(joinView.Header)
 
+	hashTable, err := newJoinHashTable(ctx, scope, view, joinView, mergedHeader, condition, true)
+	if err != nil {
+		return err
+	}
+	joinViewIndices := joinCandidateIndices(hashTable, joinView)
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
 	recordsList := make([]RecordSet, gm.Number)
 
@@ -155,7 +163,12 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	InnerJoinLoop:
 		for i := start; i < end; i++ {
-			for j := 0; j < joinView.RecordLen(); j++ {
+			candidates := joinViewIndices
+			if hashTable != nil {
+				candidates = hashTable.candidates(view.RecordSet[i], scope.Tx.Flags)
+			}
+
+			for _, j := range candidates {
 				if gm.HasError() {
 					break InnerJoinLoop
 				}
@@ -229,6 +242,12 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 		view, joinView = joinView, view
 	}
 
+	hashTable, err := newJoinHashTable(ctx, scope, view, joinView, mergedHeader, condition, direction != parser.RIGHT)
+	if err != nil {
+		return err
+	}
+	joinViewIndices := joinCandidateIndices(hashTable, joinView)
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
 
 	recordsList := make([]RecordSet, gm.Number+1)
@@ -256,8 +275,13 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	OuterJoinLoop:
 		for i := start; i < end; i++ {
+			candidates := joinViewIndices
+			if hashTable != nil {
+				candidates = hashTable.candidates(view.RecordSet[i], scope.Tx.Flags)
+			}
+
 			match := false
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for _, j := range candidates {
 				if gm.HasError() {
 					break OuterJoinLoop
 				}
@@ -383,6 +407,283 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	return nil
 }
 
+type joinHashTable struct {
+	probeIndices []int
+	table        map[string][]int
+}
+
+func LateralJoin(ctx context.Context, scope *ReferenceScope, view *View, join parser.Join) ([]parser.FieldReference, []parser.FieldReference, error) {
+	if join.Direction.Token == parser.RIGHT {
+		return nil, nil, NewInvalidLateralJoinError(join, "right outer join is not supported")
+	}
+
+	outerView := view
+	if view.RecordLen() < 1 {
+		outerView = &View{
+			Header:    view.Header,
+			RecordSet: RecordSet{NewEmptyRecord(view.FieldLen())},
+		}
+	}
+
+	joinViews := make([]*View, outerView.RecordLen())
+	for i := range outerView.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, nil, ConvertContextError(ctx.Err())
+		}
+
+		joinView, err := loadView(ctx, scope.CreateScopeForRecordEvaluation(outerView, i), join.JoinTable, false, false)
+		if err != nil {
+			if _, ok := err.(*EmptyJsonTableError); !ok {
+				return nil, nil, err
+			}
+			joinView = &View{Header: NewEmptyHeader(0), RecordSet: RecordSet{}}
+		}
+		joinViews[i] = joinView
+	}
+
+	header := lateralJoinHeader(joinViews)
+	condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, &View{Header: header})
+	if err != nil {
+		return nil, nil, err
+	}
+	if condition == nil && joinType(join) == parser.OUTER {
+		condition = parser.NewTernaryValue(ternary.TRUE)
+	}
+
+	records := make(RecordSet, 0, view.RecordLen())
+	for i := range view.RecordSet {
+		leftView := &View{
+			Header:    view.Header,
+			RecordSet: RecordSet{view.RecordSet[i]},
+		}
+		rightView := &View{
+			Header:    header,
+			RecordSet: alignLateralRecords(joinViews[i], header),
+		}
+
+		switch joinType(join) {
+		case parser.CROSS:
+			err = CrossJoin(ctx, scope, leftView, rightView)
+		case parser.INNER:
+			err = InnerJoin(ctx, scope, leftView, rightView, condition)
+		case parser.OUTER:
+			err = OuterJoin(ctx, scope, leftView, rightView, condition, parser.LEFT)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, leftView.RecordSet...)
+	}
+
+	view.Header = view.Header.Merge(header)
+	view.RecordSet = records
+	return includeFields, excludeFields, nil
+}
+
+func lateralJoinHeader(joinViews []*View) Header {
+	header := joinViews[0].Header.Copy()
+	for _, joinView := range joinViews[1:] {
+		for _, field := range joinView.Header {
+			if _, ok := lateralFieldIndex(header, field); !ok {
+				header = append(header, field)
+			}
+		}
+	}
+	for i := range header {
+		header[i].Number = i + 1
+	}
+	return header
+}
+
+func lateralFieldIndex(header Header, field HeaderField) (int, bool) {
+	for i := range header {
+		if strings.EqualFold(header[i].View, field.View) && strings.EqualFold(header[i].Column, field.Column) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func alignLateralRecords(joinView *View, header Header) RecordSet {
+	indices := make([]int, joinView.FieldLen())
+	for i := range joinView.Header {
+		if i < header.Len() && strings.EqualFold(header[i].View, joinView.Header[i].View) && strings.EqualFold(header[i].Column, joinView.Header[i].Column) {
+			indices[i] = i
+		} else {
+			indices[i], _ = lateralFieldIndex(header, joinView.Header[i])
+		}
+	}
+
+	records := make(RecordSet, joinView.RecordLen())
+	for i := range joinView.RecordSet {
+		record := NewEmptyRecord(header.Len())
+		for j, idx := range indices {
+			record[idx] = joinView.RecordSet[i][j]
+		}
+		records[i] = record
+	}
+	return records
+}
+
+func newJoinHashTable(ctx context.Context, scope *ReferenceScope, view *View, joinView *View, mergedHeader Header, condition parser.QueryExpression, viewIsLeft bool) (*joinHashTable, error) {
+	leftFieldLen := view.FieldLen()
+	if !viewIsLeft {
+		leftFieldLen = joinView.FieldLen()
+	}
+
+	leftIndices, rightIndices := extractEquiJoinKeys(condition, mergedHeader, leftFieldLen)
+	if len(leftIndices) < 1 {
+		return nil, nil
+	}
+	for i := range rightIndices {
+		rightIndices[i] = rightIndices[i] - leftFieldLen
+	}
+
+	probeIndices, buildIndices := leftIndices, rightIndices
+	if !viewIsLeft {
+		probeIndices, buildIndices = rightIndices, leftIndices
+	}
+
+	gm := NewGoroutineTaskManager(joinView.RecordLen(), -1, scope.Tx.Flags.CPU)
+	tableList := make([]map[string][]int, gm.Number)
+
+	var buildFn = func(thIdx int) {
+		start, end := gm.RecordRange(thIdx)
+		table := make(map[string][]int, end-start)
+
+	BuildLoop:
+		for i := start; i < end; i++ {
+			if i&15 == 0 && ctx.Err() != nil {
+				break BuildLoop
+			}
+
+			if key, ok := serializeJoinKey(joinView.RecordSet[i], buildIndices, scope.Tx.Flags); ok {
+				table[key] = append(table[key], i)
+			}
+		}
+
+		tableList[thIdx] = table
+
+		if 1 < gm.Number {
+			gm.Done()
+		}
+	}
+
+	if 1 < gm.Number {
+		for i := 0; i < gm.Number; i++ {
+			gm.Add()
+			go buildFn(i)
+		}
+		gm.Wait()
+	} else {
+		buildFn(0)
+	}
+
+	if ctx.Err() != nil {
+		return nil, ConvertContextError(ctx.Err())
+	}
+
+	table := tableList[0]
+	for i := 1; i < len(tableList); i++ {
+		for k, indices := range tableList[i] {
+			table[k] = append(table[k], indices...)
+		}
+	}
+
+	return &joinHashTable{
+		probeIndices: probeIndices,
+		table:        table,
+	}, nil
+}
+
+func joinCandidateIndices(hashTable *joinHashTable, joinView *View) []int {
+	if hashTable != nil {
+		return nil
+	}
+
+	indices := make([]int, joinView.RecordLen())
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+func (t *joinHashTable) candidates(record Record, flags *cmd.Flags) []int {
+	if key, ok := serializeJoinKey(record, t.probeIndices, flags); ok {
+		return t.table[key]
+	}
+	return nil
+}
+
+func serializeJoinKey(record Record, indices []int, flags *cmd.Flags) (string, bool) {
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	for i, idx := range indices {
+		val := record[idx][0]
+		if value.IsNull(val) {
+			return "", false
+		}
+		if 0 < i {
+			buf.WriteByte(58)
+		}
+		SerializeKey(buf, val, flags)
+	}
+	return buf.String(), true
+}
+
+func extractEquiJoinKeys(condition parser.QueryExpression, mergedHeader Header, leftFieldLen int) ([]int, []int) {
+	var leftIndices []int
+	var rightIndices []int
+
+	var extract func(parser.QueryExpression)
+	extract = func(expr parser.QueryExpression) {
+		switch expr.(type) {
+		case parser.Parentheses:
+			extract(expr.(parser.Parentheses).Expr)
+		case parser.Logic:
+			logic := expr.(parser.Logic)
+			if logic.Operator.Token == parser.AND {
+				extract(logic.LHS)
+				extract(logic.RHS)
+			}
+		case parser.Comparison:
+			comp := expr.(parser.Comparison)
+			if comp.Operator != "=" && comp.Operator != "==" {
+				return
+			}
+			lidx, ok := searchJoinKeyField(comp.LHS, mergedHeader)
+			if !ok {
+				return
+			}
+			ridx, ok := searchJoinKeyField(comp.RHS, mergedHeader)
+			if !ok {
+				return
+			}
+			if leftFieldLen <= lidx {
+				lidx, ridx = ridx, lidx
+			}
+			if leftFieldLen <= lidx || ridx < leftFieldLen {
+				return
+			}
+			leftIndices = append(leftIndices, lidx)
+			rightIndices = append(rightIndices, ridx)
+		}
+	}
+	extract(condition)
+
+	return leftIndices, rightIndices
+}
+
+func searchJoinKeyField(expr parser.QueryExpression, mergedHeader Header) (int, bool) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		idx, err := mergedHeader.SearchIndex(expr)
+		return idx, err == nil
+	}
+	return -1, false
+}
+
 func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	if i1 < 1 || i2 < 1 {
 		return defaultMinimumRequired
@@ -523,6 +523,110 @@ var innerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Inner Join with Equality Conditions Conjunction",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str3"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("STR1"),
+				}),
+				NewRecordWithId(5, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str4"),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					Operator: "=",
+				},
+			},
+			RHS: parser.Logic{
+				LHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+					Operator: "=",
+				},
+				RHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+					RHS:      parser.NewStringValue("str4"),
+					Operator: "<>",
+				},
+				Operator: parser.Token{Token: parser.AND, Literal: "and"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(4),
+					value.NewInteger(1),
+					value.NewString("STR1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(2),
+					value.NewString("str3"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str3"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Inner Join Filter Error",
 		View: &View{
@@ -685,6 +789,89 @@ var outerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Right Outer Join with Equality Conditions Conjunction",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.NewStringValue("str2"),
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Direction: parser.RIGHT,
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Right Outer Join",
 		View: &View{
@@ -1003,6 +1190,227 @@ func TestOuterJoin(t *testing.T) {
 	}
 }
 
+func lateralJoinTestView() *View {
+	return &View{
+		Header: NewHeader("t1", []string{"id", "tags"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewNull()}),
+		},
+	}
+}
+
+var lateralJoinTestTable = parser.Table{
+	Object: parser.TableFunction{
+		Name: "STRING_SPLIT",
+		Args: []parser.QueryExpression{
+			parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "tags"}},
+			parser.NewStringValue(";"),
+		},
+	},
+	Alias: parser.Identifier{Literal: "s"},
+}
+
+var lateralJoinTests = []struct {
+	Name   string
+	Join   parser.Join
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Cross Apply",
+		Join: parser.Join{
+			Join:      "APPLY",
+			JoinTable: lateralJoinTestTable,
+			JoinType:  parser.Token{Token: parser.CROSS, Literal: "CROSS"},
+		},
+		Result: &View{
+			Header: NewHeader("t1", []string{"id", "tags"}).Merge(NewHeader("s", []string{"value", "ordinal"})),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("b"), value.NewInteger(2)}),
+			},
+		},
+	},
+	{
+		Name: "Outer Apply",
+		Join: parser.Join{
+			Join:      "APPLY",
+			JoinTable: lateralJoinTestTable,
+			JoinType:  parser.Token{Token: parser.OUTER, Literal: "OUTER"},
+		},
+		Result: &View{
+			Header: NewHeader("t1", []string{"id", "tags"}).Merge(NewHeader("s", []string{"value", "ordinal"})),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("b"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewNull(), value.NewNull()}),
+			},
+		},
+	},
+	{
+		Name: "Comma Lateral",
+		Join: parser.Join{
+			Join:      ",",
+			JoinTable: lateralJoinTestTable,
+			JoinType:  parser.Token{Token: parser.CROSS},
+			Lateral:   parser.Token{Token: parser.LATERAL, Literal: "LATERAL"},
+		},
+		Result: &View{
+			Header: NewHeader("t1", []string{"id", "tags"}).Merge(NewHeader("s", []string{"value", "ordinal"})),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("b"), value.NewInteger(2)}),
+			},
+		},
+	},
+	{
+		Name: "Left Join Lateral with Condition",
+		Join: parser.Join{
+			Join:      "JOIN",
+			JoinTable: lateralJoinTestTable,
+			Direction: parser.Token{Token: parser.LEFT, Literal: "LEFT"},
+			Lateral:   parser.Token{Token: parser.LATERAL, Literal: "LATERAL"},
+			Condition: parser.JoinCondition{
+				On: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "value"}},
+					RHS:      parser.NewStringValue("b"),
+					Operator: "=",
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t1", []string{"id", "tags"}).Merge(NewHeader("s", []string{"value", "ordinal"})),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a;b"), value.NewString("b"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewNull(), value.NewNull()}),
+			},
+		},
+	},
+	{
+		Name: "Right Join Lateral Error",
+		Join: parser.Join{
+			Join:      "JOIN",
+			JoinTable: lateralJoinTestTable,
+			Direction: parser.Token{Token: parser.RIGHT, Literal: "RIGHT"},
+			Lateral:   parser.Token{Token: parser.LATERAL, Literal: "LATERAL"},
+			Condition: parser.JoinCondition{
+				On: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Error: "invalid lateral join: right outer join is not supported",
+	},
+}
+
+func TestLateralJoin(t *testing.T) {
+	for _, v := range lateralJoinTests {
+		view := lateralJoinTestView()
+		_, _, err := LateralJoin(context.Background(), NewReferenceScope(TestTx), view, v.Join)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(view, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, view, v.Result)
+		}
+	}
+}
+
+var extractEquiJoinKeysTests = []struct {
+	Name         string
+	Condition    parser.QueryExpression
+	LeftIndices  []int
+	RightIndices []int
+}{
+	{
+		Name: "Equality Conditions",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			RHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column4"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					Operator: "==",
+				},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		LeftIndices:  []int{1, 2},
+		RightIndices: []int{4, 5},
+	},
+	{
+		Name: "Disjunction",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column4"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.OR, Literal: "or"},
+		},
+	},
+	{
+		Name: "Not Equality Conditions",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "<",
+			},
+			RHS: parser.Logic{
+				LHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					Operator: "=",
+				},
+				RHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: "=",
+				},
+				Operator: parser.Token{Token: parser.AND, Literal: "and"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+	},
+	{
+		Name: "Field Does Not Exist",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "notexist"}},
+			Operator: "=",
+		},
+	},
+}
+
+func TestExtractEquiJoinKeys(t *testing.T) {
+	header := NewHeaderWithId("table1", []string{"column1", "column2"}).Merge(NewHeaderWithId("table2", []string{"column3", "column4"}))
+
+	for _, v := range extractEquiJoinKeysTests {
+		leftIndices, rightIndices := extractEquiJoinKeys(v.Condition, header, 3)
+		if !reflect.DeepEqual(leftIndices, v.LeftIndices) || !reflect.DeepEqual(rightIndices, v.RightIndices) {
+			t.Errorf("%s: indices = %v, %v, want %v, %v", v.Name, leftIndices, rightIndices, v.LeftIndices, v.RightIndices)
+		}
+	}
+}
+
 var calcMinimumRequiredTests = []struct {
 	Int1    int
 	Int2    int
@@ -13,6 +13,7 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mitchellh/go-homedir"
@@ -87,6 +88,7 @@ var TestDir = filepath.Join(tempdir, "csvq_query_test")
 var TestDataDir string
 var CompletionTestDir = filepath.Join(TestDir, "completion")
 var CompletionTestSubDir = filepath.Join(TestDir, "completion", "sub")
+var GlobTestDir = filepath.Join(TestDir, "glob")
 var TestLocation = "UTC"
 var NowForTest = time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())
 var HomeDir string
@@ -155,10 +157,20 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "table.json"), filepath.Join(TestDataDir, "table.json"))
 	_ = copyfile(filepath.Join(TestDir, "table_h.json"), filepath.Join(TestDataDir, "table_h.json"))
 	_ = copyfile(filepath.Join(TestDir, "table_a.json"), filepath.Join(TestDataDir, "table_a.json"))
+	_ = copyfile(filepath.Join(TestDir, "table_l.jsonl"), filepath.Join(TestDataDir, "table_l.jsonl"))
+	_ = copyfile(filepath.Join(TestDir, "table_schema.csv"), filepath.Join(TestDataDir, "table_schema.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_schema.csv.schema.json"), filepath.Join(TestDataDir, "table_schema.csv.schema.json"))
+	_ = copyfile(filepath.Join(TestDir, "table_index.csv"), filepath.Join(TestDataDir, "table_index.csv"))
+
+	_ = copyfile(filepath.Join(TestDir, "table_gzip.csv.gz"), filepath.Join(TestDataDir, "table_gzip.csv.gz"))
+	_ = copyfile(filepath.Join(TestDir, "table_zstd.json.zst"), filepath.Join(TestDataDir, "table_zstd.json.zst"))
 
 	_ = copyfile(filepath.Join(TestDir, "table6.ltsv"), filepath.Join(TestDataDir, "table6.ltsv"))
 	_ = copyfile(filepath.Join(TestDir, "table6_bom.ltsv"), filepath.Join(TestDataDir, "table6_bom.ltsv"))
 
+	_ = copyfile(filepath.Join(TestDir, "table7.xlsx"), filepath.Join(TestDataDir, "table7.xlsx"))
+	_ = copyfile(filepath.Join(TestDir, "table8.parquet"), filepath.Join(TestDataDir, "table8.parquet"))
+
 	_ = copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_bom.txt"), filepath.Join(TestDataDir, "fixed_length_bom.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_sl.txt"), filepath.Join(TestDataDir, "fixed_length_sl.txt"))
@@ -181,6 +193,15 @@ func setup() {
 	_ = copyfile(filepath.Join(CompletionTestDir, "source.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source.sql"))
 	_ = copyfile(filepath.Join(CompletionTestSubDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
 
+	if _, err := os.Stat(GlobTestDir); os.IsNotExist(err) {
+		_ = os.Mkdir(GlobTestDir, 0755)
+	}
+	_ = copyfile(filepath.Join(GlobTestDir, "log_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(GlobTestDir, "log_2.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(GlobTestDir, "other_1.csv"), filepath.Join(TestDataDir, "table1b.csv"))
+	_ = copyfile(filepath.Join(TestDir, "swapped_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "swapped_2.csv"), filepath.Join(TestDataDir, "table1_swapped.csv"))
+
 	Version = "v1.0.0"
 	HomeDir, _ = homedir.Dir()
 	TestTx.Session.SetStdout(NewDiscard())
@@ -203,6 +224,7 @@ func initFlag(flags *cmd.Flags) {
 	flags.Location = TestLocation
 	flags.DatetimeFormat = []string{}
 	flags.AnsiQuotes = false
+	flags.NumericMode = cmd.FloatMode
 	flags.WaitTimeout = 15
 	flags.ImportFormat = cmd.CSV
 	flags.Delimiter = ','
@@ -262,6 +284,14 @@ const (
 	scopeNameAliases      = "a"
 )
 
+func ParseTestStatement(src string) parser.Statement {
+	statements, _, err := parser.Parse(src, "", nil, false, false)
+	if err != nil {
+		panic(err)
+	}
+	return statements[0]
+}
+
 func GenerateReferenceScope(blocks []map[string]map[string]interface{}, nodes []map[string]map[string]interface{}, now time.Time, records []ReferenceRecord) *ReferenceScope {
 	rs := NewReferenceScope(TestTx)
 	for i := 1; i < len(blocks); i++ {
@@ -0,0 +1,299 @@
+package query
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parquet"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+type parquetPredicate struct {
+	Column   int
+	Operator string
+	Values   []value.Primary
+}
+
+// loadViewUsingParquetScan loads only the columns referenced in the query from a parquet file,
+// and skips the row groups in which the statistics show that no record satisfies the where clause.
+// If the table is not a parquet file or the whole file is required, then it returns nil without loading any records.
+func loadViewUsingParquetScan(ctx context.Context, scope *ReferenceScope, entity parser.SelectEntity, orderByClause parser.QueryExpression, forUpdate bool) (view *View, err error) {
+	if forUpdate {
+		return nil, nil
+	}
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil, nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok {
+		return nil, nil
+	}
+	fileInfo, ok := unloadedFileInfo(scope, table)
+	if !ok || fileInfo.Format != cmd.PARQUET || fileInfo.Compression != cmd.NoCompression || fileInfo.Schema != nil {
+		return nil, nil
+	}
+	tableIdentifier := table.Object.(parser.Identifier)
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		tableIdentifier.Literal = fileInfo.Path
+		return nil, ConvertFileHandlerError(err, tableIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	var dataParsingError = func(err error) error {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	pf, err := openParquetFile(h.File(), tableIdentifier)
+	if err != nil {
+		return nil, dataParsingError(err)
+	}
+
+	names := pf.ColumnNames()
+	columns := referencedParquetColumns(names, entity, orderByClause)
+
+	var predicates []parquetPredicate
+	if entity.WhereClause != nil && !scope.Tx.Flags.WithoutNull {
+		predicates = extractParquetPredicates(ctx, scope, entity.WhereClause.(parser.WhereClause).Filter, table.Name(), names)
+	}
+	rowGroups := make([]int, 0, pf.NumRowGroups())
+	for i := 0; i < pf.NumRowGroups(); i++ {
+		if parquetRowGroupMayMatch(pf, i, predicates, scope.Tx.Flags.DatetimeFormat) {
+			rowGroups = append(rowGroups, i)
+		}
+	}
+
+	if len(columns) == len(names) && len(rowGroups) == pf.NumRowGroups() {
+		return nil, nil
+	}
+
+	ctx, node := beginPlanNode(ctx)
+
+	loadRowGroups := rowGroups
+	if isPlanOnly(ctx) {
+		loadRowGroups = nil
+	}
+	view, err = loadViewFromParquetRowGroups(ctx, pf, fileInfo, columns, loadRowGroups, scope.Tx.Flags.WithoutNull)
+	if err != nil {
+		return nil, dataParsingError(err)
+	}
+
+	if !strings.EqualFold(parser.FormatTableName(fileInfo.Path), table.Name().Literal) {
+		if err = view.Header.Update(table.Name().Literal, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	node.describe(planScan, parquetScanDetail(fileInfo, table, view.Header, len(rowGroups), pf.NumRowGroups()))
+	node.finish(view, "", nil)
+	return view, nil
+}
+
+// referencedParquetColumns returns the indices of the columns that can be referred in the expressions.
+// If no column is referred, then the first column is returned to keep the number of records.
+func referencedParquetColumns(names []string, exprs ...parser.QueryExpression) []int {
+	all := false
+	referred := make(map[string]bool)
+
+	var walk func(parser.QueryExpression, bool)
+	walk = func(expr parser.QueryExpression, inSubquery bool) {
+		walkQueryExpression(expr, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.FieldReference:
+				referred[strings.ToUpper(e.(parser.FieldReference).Column.Literal)] = true
+			case parser.ColumnNumber:
+				all = true
+			case parser.AllColumns:
+				all = all || !inSubquery
+			case parser.Function:
+				fn := e.(parser.Function)
+				if !inSubquery && strings.EqualFold(fn.Name, "JSON_OBJECT") && len(fn.Args) < 1 {
+					all = true
+				}
+			case parser.AggregateFunction:
+				fn := e.(parser.AggregateFunction)
+				walkFunctionArgs(fn.Args, walk, inSubquery)
+				walk(fn.Filter, inSubquery)
+				return false
+			case parser.AnalyticFunction:
+				fn := e.(parser.AnalyticFunction)
+				walkFunctionArgs(fn.Args, walk, inSubquery)
+				walk(fn.Filter, inSubquery)
+				walk(fn.AnalyticClause, inSubquery)
+				return false
+			case parser.Subquery:
+				walk(e.(parser.Subquery).Query, true)
+				return false
+			}
+			return !all
+		})
+	}
+	for _, expr := range exprs {
+		walk(expr, false)
+	}
+
+	columns := make([]int, 0, len(names))
+	for i, name := range names {
+		if all || referred[strings.ToUpper(name)] {
+			columns = append(columns, i)
+		}
+	}
+	if len(columns) < 1 && 0 < len(names) {
+		columns = append(columns, 0)
+	}
+	return columns
+}
+
+func walkFunctionArgs(args []parser.QueryExpression, walk func(parser.QueryExpression, bool), inSubquery bool) {
+	for _, arg := range args {
+		if _, ok := arg.(parser.AllColumns); ok {
+			continue
+		}
+		walk(arg, inSubquery)
+	}
+}
+
+// extractParquetPredicates returns the conditions in the filter that can be evaluated with the statistics of the columns.
+// Conditions with values that cannot be evaluated are ignored.
+func extractParquetPredicates(ctx context.Context, scope *ReferenceScope, filter parser.QueryExpression, tableName parser.Identifier, names []string) []parquetPredicate {
+	var search = func(expr parser.QueryExpression) (int, bool) {
+		fieldRef, ok := expr.(parser.FieldReference)
+		if !ok {
+			return -1, false
+		}
+		if 0 < len(fieldRef.View.Literal) && !strings.EqualFold(fieldRef.View.Literal, tableName.Literal) {
+			return -1, false
+		}
+		for i, name := range names {
+			if strings.EqualFold(fieldRef.Column.Literal, name) {
+				return i, true
+			}
+		}
+		return -1, false
+	}
+
+	fieldPredicates := extractFieldPredicates(filter, func(expr parser.QueryExpression) bool {
+		_, ok := search(expr)
+		return ok
+	})
+
+	predicates := make([]parquetPredicate, 0, len(fieldPredicates))
+	for _, p := range fieldPredicates {
+		values := make([]value.Primary, 0, len(p.Values))
+		for _, v := range p.Values {
+			pv, err := Evaluate(ctx, scope, v)
+			if err != nil {
+				break
+			}
+			values = append(values, pv)
+		}
+		if len(values) < len(p.Values) {
+			continue
+		}
+
+		col, _ := search(p.Field)
+		predicates = append(predicates, parquetPredicate{Column: col, Operator: p.Operator, Values: values})
+	}
+	return predicates
+}
+
+// parquetRowGroupMayMatch returns false if the statistics of the row group show that no record satisfies the predicates.
+func parquetRowGroupMayMatch(pf *parquet.File, rowGroup int, predicates []parquetPredicate, formats []string) bool {
+	for _, p := range predicates {
+		stats := pf.ColumnStatistics(rowGroup, p.Column, cmd.GetLocation())
+		if !parquetStatisticsMayMatch(stats, pf.RowGroupNumRows(rowGroup), p.Operator, p.Values, formats) {
+			return false
+		}
+	}
+	return true
+}
+
+func parquetStatisticsMayMatch(stats parquet.Statistics, numRows int64, operator string, values []value.Primary, formats []string) bool {
+	if stats.HasNullCount && stats.NullCount == numRows {
+		return false
+	}
+	if !stats.HasMinMax {
+		return true
+	}
+
+	// Values are compared after conversions in the same order as the comparison operators,
+	// so that only the statistics of numbers and datetimes are comparable with the values.
+	switch stats.Min.(type) {
+	case int64:
+	case float64:
+		switch operator {
+		case ">", ">=":
+			// NaN is greater than any number in the comparison.
+			return true
+		}
+		if math.MaxInt64 <= math.Abs(stats.Min.(float64)) || math.MaxInt64 <= math.Abs(stats.Max.(float64)) {
+			return true
+		}
+	case time.Time:
+	default:
+		return true
+	}
+	for _, v := range values {
+		var p value.Primary
+		if _, ok := stats.Min.(time.Time); ok {
+			p = value.ToDatetime(v, formats)
+		} else {
+			p = value.ToFloat(v)
+		}
+		if value.IsNull(p) {
+			return true
+		}
+		value.Discard(p)
+	}
+
+	min := convertNativeValue(stats.Min, false)
+	max := convertNativeValue(stats.Max, false)
+	var isTrue = func(p1 value.Primary, operator string, p2 value.Primary) bool {
+		return value.Compare(p1, p2, operator, formats) == ternary.TRUE
+	}
+	var mayEqual = func(v value.Primary) bool {
+		return !isTrue(v, "<", min) && !isTrue(v, ">", max)
+	}
+
+	switch operator {
+	case "=", "==":
+		return mayEqual(values[0])
+	case "<", "<=":
+		return isTrue(min, operator, values[0])
+	case ">", ">=":
+		return isTrue(max, operator, values[0])
+	case indexPredicateBetween:
+		return isTrue(max, ">=", values[0]) && isTrue(min, "<=", values[1])
+	case indexPredicateIn:
+		for _, v := range values {
+			if mayEqual(v) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func parquetScanDetail(fileInfo *FileInfo, table parser.Table, header Header, rowGroups int, total int) string {
+	columns := make([]string, len(header))
+	for i := range header {
+		columns[i] = header[i].Column
+	}
+	return fileScanDetail(fileInfo, table) + " COLUMNS (" + strings.Join(columns, ", ") + ") ROW GROUPS " + strconv.Itoa(rowGroups) + " OF " + strconv.Itoa(total)
+}
@@ -0,0 +1,313 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parquet"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parquetScanTests = []struct {
+	Name        string
+	Query       parser.SelectQuery
+	ParquetScan bool
+	Result      RecordSet
+	Error       string
+}{
+	{
+		Name: "Parquet Scan with Referenced Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "name"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+						RHS:      parser.NewIntegerValueFromString("5"),
+						Operator: ">=",
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "score"}}},
+				},
+			},
+		},
+		ParquetScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("elderberry")}),
+			NewRecord([]value.Primary{value.NewString("fig")}),
+		},
+	},
+	{
+		Name: "Parquet Scan with Reversed Comparison and Alias",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.NewStringValue("2021-02-01 09:00:00"),
+						RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "updated"}},
+						Operator: ">",
+					},
+				},
+			},
+		},
+		ParquetScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(2)}),
+		},
+	},
+	{
+		Name: "Parquet Scan with Between and In",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "id"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Logic{
+						LHS: parser.Between{
+							LHS:  parser.FieldReference{Column: parser.Identifier{Literal: "score"}},
+							Low:  parser.NewIntegerValueFromString("3"),
+							High: parser.NewIntegerValueFromString("5"),
+						},
+						RHS: parser.In{
+							LHS: parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+							Values: parser.RowValue{
+								Value: parser.ValueList{
+									Values: []parser.QueryExpression{
+										parser.NewIntegerValueFromString("3"),
+										parser.NewIntegerValueFromString("4"),
+										parser.NewIntegerValueFromString("5"),
+									},
+								},
+							},
+						},
+						Operator: parser.Token{Token: parser.AND, Literal: "and"},
+					},
+				},
+			},
+		},
+		ParquetScan: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(3)}),
+			NewRecord([]value.Primary{value.NewInteger(4)}),
+		},
+	},
+	{
+		Name: "Parquet Scan Not Used for All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "score"}},
+						RHS:      parser.NewIntegerValueFromString("6"),
+						Operator: ">",
+					},
+				},
+			},
+		},
+		ParquetScan: false,
+		Result: RecordSet{
+			NewRecord([]value.Primary{
+				value.NewInteger(6),
+				value.NewString("fig"),
+				value.NewFloat(6.5),
+				value.NewBoolean(true),
+				value.NewDatetime(time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+			}),
+		},
+	},
+	{
+		Name: "Parquet Scan Field Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8"}},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestParquetScan(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	fpath := GetTestFilePath("table8.parquet")
+	for _, v := range parquetScanTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		result, err := Select(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+		if _, ok := TestTx.cachedViews.Load(fpath); ok == v.ParquetScan {
+			t.Errorf("%s: parquet scan = %t, want %t", v.Name, !ok, v.ParquetScan)
+		}
+	}
+}
+
+var parquetStatisticsMayMatchTests = []struct {
+	Name     string
+	Stats    parquet.Statistics
+	NumRows  int64
+	Operator string
+	Values   []value.Primary
+	Result   bool
+}{
+	{
+		Name:     "Integer Out of Range",
+		Stats:    parquet.Statistics{Min: int64(1), Max: int64(5), HasMinMax: true},
+		NumRows:  5,
+		Operator: "=",
+		Values:   []value.Primary{value.NewInteger(6)},
+		Result:   false,
+	},
+	{
+		Name:     "Integer in Range",
+		Stats:    parquet.Statistics{Min: int64(1), Max: int64(5), HasMinMax: true},
+		NumRows:  5,
+		Operator: "<=",
+		Values:   []value.Primary{value.NewString("1")},
+		Result:   true,
+	},
+	{
+		Name:     "Integer Compared with Non-Numeric String",
+		Stats:    parquet.Statistics{Min: int64(1), Max: int64(5), HasMinMax: true},
+		NumRows:  5,
+		Operator: ">",
+		Values:   []value.Primary{value.NewString("abc")},
+		Result:   true,
+	},
+	{
+		Name:     "Float Less Than",
+		Stats:    parquet.Statistics{Min: 1.5, Max: 2.5, HasMinMax: true},
+		NumRows:  5,
+		Operator: "<",
+		Values:   []value.Primary{value.NewFloat(1.5)},
+		Result:   false,
+	},
+	{
+		Name:     "Float Greater Than",
+		Stats:    parquet.Statistics{Min: 1.5, Max: 2.5, HasMinMax: true},
+		NumRows:  5,
+		Operator: ">",
+		Values:   []value.Primary{value.NewFloat(3)},
+		Result:   true,
+	},
+	{
+		Name:     "Datetime Between",
+		Stats:    parquet.Statistics{Min: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), Max: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), HasMinMax: true},
+		NumRows:  5,
+		Operator: indexPredicateBetween,
+		Values:   []value.Primary{value.NewString("2021-03-01T00:00:00Z"), value.NewString("2021-04-01T00:00:00Z")},
+		Result:   false,
+	},
+	{
+		Name:     "In",
+		Stats:    parquet.Statistics{Min: int64(1), Max: int64(5), HasMinMax: true},
+		NumRows:  5,
+		Operator: indexPredicateIn,
+		Values:   []value.Primary{value.NewInteger(0), value.NewInteger(3)},
+		Result:   true,
+	},
+	{
+		Name:     "String Statistics",
+		Stats:    parquet.Statistics{Min: "a", Max: "b", HasMinMax: true},
+		NumRows:  5,
+		Operator: "=",
+		Values:   []value.Primary{value.NewString("c")},
+		Result:   true,
+	},
+	{
+		Name:     "All Nulls",
+		Stats:    parquet.Statistics{NullCount: 5, HasNullCount: true},
+		NumRows:  5,
+		Operator: "=",
+		Values:   []value.Primary{value.NewInteger(1)},
+		Result:   false,
+	},
+	{
+		Name:     "No Statistics",
+		Stats:    parquet.Statistics{},
+		NumRows:  5,
+		Operator: "=",
+		Values:   []value.Primary{value.NewInteger(1)},
+		Result:   true,
+	},
+}
+
+func TestParquetStatisticsMayMatch(t *testing.T) {
+	for _, v := range parquetStatisticsMayMatchTests {
+		result := parquetStatisticsMayMatch(v.Stats, v.NumRows, v.Operator, v.Values, nil)
+		if result != v.Result {
+			t.Errorf("%s: result = %t, want %t", v.Name, result, v.Result)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func Pivot(ctx context.Context, scope *ReferenceScope, view *View, pivot parser.PivotTable) (*View, error) {
+	keyIdx, err := view.FieldIndex(pivot.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate := pivot.Aggregate.(parser.AggregateFunction)
+	if len(aggregate.Args) < 1 {
+		return nil, NewFunctionArgumentLengthError(aggregate, aggregate.Name, []int{1})
+	}
+
+	usedFields := []int{keyIdx}
+	walkQueryExpression(aggregate, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.Subquery:
+			return false
+		case parser.FieldReference, parser.ColumnNumber:
+			if idx, err := view.Header.SearchIndex(e); err == nil && !InIntSlice(idx, usedFields) {
+				usedFields = append(usedFields, idx)
+			}
+		}
+		return true
+	})
+
+	var groupItems []parser.QueryExpression
+	fields := make([]parser.QueryExpression, 0, view.FieldLen())
+	for i := range view.Header {
+		if !view.Header[i].IsFromTable || InIntSlice(i, usedFields) {
+			continue
+		}
+		fieldRef := parser.FieldReference{Column: parser.Identifier{Literal: view.Header[i].Column}}
+		if 0 < len(view.Header[i].View) {
+			fieldRef.View = parser.Identifier{Literal: view.Header[i].View}
+		}
+		groupItems = append(groupItems, fieldRef)
+		fields = append(fields, parser.Field{Object: fieldRef})
+	}
+
+	var pivotValues []value.Primary
+	var labels []string
+	if pivot.IsDynamic() {
+		keys := make(map[string]bool, 20)
+		buf := GetComparisonKeysBuf()
+		for i := range view.RecordSet {
+			if i&255 == 0 && ctx.Err() != nil {
+				PutComparisonkeysBuf(buf)
+				return nil, ConvertContextError(ctx.Err())
+			}
+
+			p := view.RecordSet[i][keyIdx][0]
+			if value.IsNull(p) {
+				continue
+			}
+			buf.Reset()
+			SerializeKey(buf, p, scope.Tx.Flags)
+			if keys[buf.String()] {
+				continue
+			}
+			keys[buf.String()] = true
+			pivotValues = append(pivotValues, p)
+			labels = append(labels, parser.FormatFieldIdentifier(parser.PrimitiveType{Value: p}))
+		}
+		PutComparisonkeysBuf(buf)
+	} else {
+		pivotValues = make([]value.Primary, len(pivot.Values))
+		labels = make([]string, len(pivot.Values))
+		for i, v := range pivot.Values {
+			field := v.(parser.Field)
+			p, err := Evaluate(ctx, scope, field.Object)
+			if err != nil {
+				return nil, err
+			}
+			pivotValues[i] = p
+			if field.Alias != nil {
+				labels[i] = field.Alias.(parser.Identifier).Literal
+			} else {
+				labels[i] = parser.FormatFieldIdentifier(parser.PrimitiveType{Value: p})
+			}
+		}
+	}
+
+	listExpr := aggregate.Args[0]
+	if _, ok := listExpr.(parser.AllColumns); ok {
+		listExpr = parser.NewIntegerValue(1)
+	}
+
+	for i, p := range pivotValues {
+		args := make([]parser.QueryExpression, len(aggregate.Args))
+		copy(args, aggregate.Args)
+		args[0] = parser.CaseExpr{
+			BaseExpr: aggregate.BaseExpr,
+			Case:     "CASE",
+			End:      "END",
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					When: "WHEN",
+					Then: "THEN",
+					Condition: parser.Comparison{
+						LHS:      pivot.Column,
+						Operator: "=",
+						RHS:      parser.PrimitiveType{Literal: labels[i], Value: p},
+					},
+					Result: listExpr,
+				},
+			},
+		}
+
+		fields = append(fields, parser.Field{
+			Object: parser.AggregateFunction{
+				BaseExpr: aggregate.BaseExpr,
+				Name:     aggregate.Name,
+				Distinct: aggregate.Distinct,
+				Args:     args,
+				Filter:   aggregate.Filter,
+			},
+			Alias: parser.Identifier{Literal: labels[i]},
+		})
+	}
+
+	if err := view.group(ctx, scope, groupItems); err != nil {
+		return nil, err
+	}
+	if err := view.Select(ctx, scope, parser.SelectClause{Fields: fields}); err != nil {
+		return nil, err
+	}
+	if err := view.Fix(ctx, scope.Tx.Flags); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func Unpivot(ctx context.Context, view *View, unpivot parser.UnpivotTable) (*View, error) {
+	columnIndices := make([]int, len(unpivot.Columns))
+	labels := make([]value.Primary, len(unpivot.Columns))
+	for i, v := range unpivot.Columns {
+		field := v.(parser.Field)
+		idx, err := view.FieldIndex(field.Object)
+		if err != nil {
+			return nil, err
+		}
+		columnIndices[i] = idx
+		if field.Alias != nil {
+			labels[i] = value.NewString(field.Alias.(parser.Identifier).Literal)
+		} else {
+			labels[i] = value.NewString(view.Header[idx].Column)
+		}
+	}
+
+	keptIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if view.Header[i].IsFromTable && !InIntSlice(i, columnIndices) {
+			keptIndices = append(keptIndices, i)
+		}
+	}
+
+	nameIdx := len(keptIndices)
+	valueIdx := nameIdx + 1
+	header := NewEmptyHeader(valueIdx + 1)
+	for i, idx := range keptIndices {
+		header[i] = view.Header[idx]
+		header[i].Number = i + 1
+	}
+	for i, ident := range []parser.Identifier{unpivot.Name.(parser.Identifier), unpivot.Value.(parser.Identifier)} {
+		for j := 0; j < nameIdx+i; j++ {
+			if strings.EqualFold(header[j].Column, ident.Literal) {
+				return nil, NewDuplicateFieldNameError(ident)
+			}
+		}
+		header[nameIdx+i] = HeaderField{Column: ident.Literal, Number: nameIdx + i + 1, IsFromTable: true}
+	}
+
+	records := make(RecordSet, 0, view.RecordLen()*len(columnIndices))
+	for i := range view.RecordSet {
+		if i&255 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for j, idx := range columnIndices {
+			p := view.RecordSet[i][idx][0]
+			if value.IsNull(p) {
+				continue
+			}
+
+			record := make(Record, len(header))
+			for k, keptIdx := range keptIndices {
+				record[k] = view.RecordSet[i][keptIdx]
+			}
+			record[nameIdx] = NewCell(labels[j])
+			record[valueIdx] = NewCell(p)
+			records = append(records, record)
+		}
+	}
+
+	view.Header = header
+	view.RecordSet = records
+	return view, nil
+}
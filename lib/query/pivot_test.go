@@ -0,0 +1,178 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func pivotTestView() *View {
+	return &View{
+		Header: NewHeader("sales", []string{"region", "month", "amount"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("jan"), value.NewInteger(10)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("feb"), value.NewInteger(5)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewString("jan"), value.NewInteger(7)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("jan"), value.NewInteger(20)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewNull(), value.NewInteger(1)}),
+		},
+	}
+}
+
+var pivotTests = []struct {
+	Name    string
+	Pivot   parser.PivotTable
+	Columns []string
+	Result  RecordSet
+	Error   string
+}{
+	{
+		Name: "Pivot",
+		Pivot: parser.PivotTable{
+			Aggregate: parser.AggregateFunction{
+				Name: "sum",
+				Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("jan")},
+				parser.Field{Object: parser.NewStringValue("feb"), Alias: parser.Identifier{Literal: "february"}},
+			},
+		},
+		Columns: []string{"region", "jan", "february"},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("E"), value.NewInteger(30), value.NewInteger(5)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewInteger(7), value.NewNull()}),
+		},
+	},
+	{
+		Name: "Pivot Dynamic Values",
+		Pivot: parser.PivotTable{
+			Aggregate: parser.AggregateFunction{
+				Name: "count",
+				Args: []parser.QueryExpression{parser.AllColumns{}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+			Any:    "ANY",
+		},
+		Columns: []string{"region", "amount", "jan", "feb"},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("E"), value.NewInteger(10), value.NewInteger(1), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewInteger(5), value.NewInteger(0), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewInteger(7), value.NewInteger(1), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewInteger(20), value.NewInteger(1), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewInteger(1), value.NewInteger(0), value.NewInteger(0)}),
+		},
+	},
+	{
+		Name: "Pivot Field Not Exist Error",
+		Pivot: parser.PivotTable{
+			Aggregate: parser.AggregateFunction{
+				Name: "sum",
+				Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Any:    "ANY",
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestPivot(t *testing.T) {
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range pivotTests {
+		result, err := Pivot(ctx, scope, pivotTestView(), v.Pivot)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header.TableColumnNames(), v.Columns) {
+			t.Errorf("%s: columns = %v, want %v", v.Name, result.Header.TableColumnNames(), v.Columns)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+}
+
+var unpivotTests = []struct {
+	Name    string
+	Unpivot parser.UnpivotTable
+	Columns []string
+	Result  RecordSet
+	Error   string
+}{
+	{
+		Name: "Unpivot",
+		Unpivot: parser.UnpivotTable{
+			Value: parser.Identifier{Literal: "val"},
+			Name:  parser.Identifier{Literal: "attr"},
+			Columns: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "month"}}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}, Alias: parser.Identifier{Literal: "amt"}},
+			},
+		},
+		Columns: []string{"region", "attr", "val"},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("month"), value.NewString("jan")}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("amt"), value.NewInteger(10)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("month"), value.NewString("feb")}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("amt"), value.NewInteger(5)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewString("month"), value.NewString("jan")}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewString("amt"), value.NewInteger(7)}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("month"), value.NewString("jan")}),
+			NewRecord([]value.Primary{value.NewString("E"), value.NewString("amt"), value.NewInteger(20)}),
+			NewRecord([]value.Primary{value.NewString("W"), value.NewString("amt"), value.NewInteger(1)}),
+		},
+	},
+	{
+		Name: "Unpivot Duplicate Field Name Error",
+		Unpivot: parser.UnpivotTable{
+			Value: parser.Identifier{Literal: "region"},
+			Name:  parser.Identifier{Literal: "attr"},
+			Columns: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+		},
+		Error: "field name region is a duplicate",
+	},
+}
+
+func TestUnpivot(t *testing.T) {
+	ctx := context.Background()
+
+	for _, v := range unpivotTests {
+		result, err := Unpivot(ctx, pivotTestView(), v.Unpivot)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header.TableColumnNames(), v.Columns) {
+			t.Errorf("%s: columns = %v, want %v", v.Name, result.Header.TableColumnNames(), v.Columns)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+}
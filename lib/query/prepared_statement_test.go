@@ -29,7 +29,11 @@ func TestPreparedStatementMap_Prepare(t *testing.T) {
 							Select:   "select",
 							Fields: []parser.QueryExpression{
 								parser.Field{
-									Object: parser.NewIntegerValueFromString("1"),
+									Object: parser.PrimitiveType{
+										BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "stmt"}),
+										Literal:  "1",
+										Value:    value.NewIntegerFromString("1"),
+									},
 								},
 							},
 						},
@@ -86,7 +90,11 @@ func TestPreparedStatementMap_Get(t *testing.T) {
 							Select:   "select",
 							Fields: []parser.QueryExpression{
 								parser.Field{
-									Object: parser.NewIntegerValueFromString("1"),
+									Object: parser.PrimitiveType{
+										BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "stmt"}),
+										Literal:  "1",
+										Value:    value.NewIntegerFromString("1"),
+									},
 								},
 							},
 						},
@@ -107,7 +115,11 @@ func TestPreparedStatementMap_Get(t *testing.T) {
 						Select:   "select",
 						Fields: []parser.QueryExpression{
 							parser.Field{
-								Object: parser.NewIntegerValueFromString("1"),
+								Object: parser.PrimitiveType{
+									BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "stmt"}),
+									Literal:  "1",
+									Value:    value.NewIntegerFromString("1"),
+								},
 							},
 						},
 					},
@@ -148,7 +160,11 @@ func TestPreparedStatementMap_Dispose(t *testing.T) {
 							Select:   "select",
 							Fields: []parser.QueryExpression{
 								parser.Field{
-									Object: parser.NewIntegerValueFromString("1"),
+									Object: parser.PrimitiveType{
+										BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "stmt"}),
+										Literal:  "1",
+										Value:    value.NewIntegerFromString("1"),
+									},
 								},
 							},
 						},
@@ -198,7 +214,11 @@ func TestNewPreparedStatement(t *testing.T) {
 						Select:   "select",
 						Fields: []parser.QueryExpression{
 							parser.Field{
-								Object: parser.NewIntegerValueFromString("1"),
+								Object: parser.PrimitiveType{
+									BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "stmt"}),
+									Literal:  "1",
+									Value:    value.NewIntegerFromString("1"),
+								},
 							},
 						},
 					},
@@ -29,6 +29,8 @@ const (
 
 const StoringResultsContextKey = "sqr"
 const StatementReplaceValuesContextKey = "rv"
+const ExecutionPlanContextKey = "ep"
+const PlanOnlyContextKey = "po"
 
 func ContextForStoringResults(ctx context.Context) context.Context {
 	return context.WithValue(ctx, StoringResultsContextKey, true)
@@ -113,10 +115,39 @@ func (proc *Processor) executeChild(ctx context.Context, statements []parser.Sta
 	return flow, err
 }
 
+func (proc *Processor) outputEnabled() bool {
+	_, ok := proc.Tx.Session.Stdout().(*Discard)
+	return !ok || proc.Tx.Session.OutFile() != nil
+}
+
+func (proc *Processor) outputWriter() io.Writer {
+	if proc.Tx.Session.OutFile() != nil {
+		return proc.Tx.Session.OutFile()
+	}
+	return proc.Tx.Session.Stdout()
+}
+
+func (proc *Processor) outputFileInfo() *FileInfo {
+	return &FileInfo{
+		Format:             proc.Tx.Flags.Format,
+		Delimiter:          proc.Tx.Flags.WriteDelimiter,
+		DelimiterPositions: proc.Tx.Flags.WriteDelimiterPositions,
+		Encoding:           proc.Tx.Flags.WriteEncoding,
+		LineBreak:          proc.Tx.Flags.LineBreak,
+		NoHeader:           proc.Tx.Flags.WithoutHeader,
+		EncloseAll:         proc.Tx.Flags.EncloseAll,
+		PrettyPrint:        proc.Tx.Flags.PrettyPrint,
+		SingleLine:         proc.Tx.Flags.WriteAsSingleLine,
+	}
+}
+
 func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Statement) (StatementFlow, error) {
 	if ctx.Err() != nil {
 		return TerminateWithError, ConvertContextError(ctx.Err())
 	}
+	if err := proc.checkRestrictions(stmt); err != nil {
+		return TerminateWithError, err
+	}
 
 	flow := Terminate
 
@@ -186,55 +217,53 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				proc.measurementStart = time.Now()
 			}
 
-			view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
-			if e == nil {
-				var warnmsg string
-
+			streamed := false
+			if !proc.storeResults && proc.outputEnabled() {
 				proc.Tx.Session.mtx.Lock()
-
-				if proc.storeResults {
-					proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
+				writer := proc.outputWriter()
+				streamed, err = StreamSelect(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery), writer, proc.outputFileInfo())
+				if streamed && err == nil {
+					_, err = writer.Write([]byte(proc.Tx.Flags.LineBreak.Value()))
 				}
+				proc.Tx.Session.mtx.Unlock()
+			}
 
-				if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
-					fileInfo := &FileInfo{
-						Format:             proc.Tx.Flags.Format,
-						Delimiter:          proc.Tx.Flags.WriteDelimiter,
-						DelimiterPositions: proc.Tx.Flags.WriteDelimiterPositions,
-						Encoding:           proc.Tx.Flags.WriteEncoding,
-						LineBreak:          proc.Tx.Flags.LineBreak,
-						NoHeader:           proc.Tx.Flags.WithoutHeader,
-						EncloseAll:         proc.Tx.Flags.EncloseAll,
-						PrettyPrint:        proc.Tx.Flags.PrettyPrint,
-						SingleLine:         proc.Tx.Flags.WriteAsSingleLine,
-					}
+			if !streamed {
+				view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
+				if e == nil {
+					var warnmsg string
+
+					proc.Tx.Session.mtx.Lock()
 
-					var writer io.Writer
-					if proc.Tx.Session.OutFile() != nil {
-						writer = proc.Tx.Session.OutFile()
-					} else {
-						writer = proc.Tx.Session.Stdout()
+					if proc.storeResults {
+						proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
 					}
-					warn, e := EncodeView(ctx, writer, view, fileInfo, proc.Tx)
 
-					if e != nil {
-						if _, ok := e.(*EmptyResultSetError); !ok {
-							err = e
-						} else if 0 < len(warn) {
-							warnmsg = warn
+					if proc.outputEnabled() {
+						fileInfo := proc.outputFileInfo()
+						writer := proc.outputWriter()
+						warn, e := EncodeView(ctx, writer, view, fileInfo, proc.Tx)
+
+						if e != nil {
+							if _, ok := e.(*EmptyResultSetError); !ok {
+								err = e
+							} else if 0 < len(warn) {
+								warnmsg = warn
+							}
+						} else if !(proc.Tx.Session.OutFile() != nil && fileInfo.Format == cmd.FIXED && fileInfo.SingleLine) && fileInfo.Format != cmd.XLSX && fileInfo.Format != cmd.PARQUET {
+							_, err = writer.Write([]byte(proc.Tx.Flags.LineBreak.Value()))
 						}
-					} else if !(proc.Tx.Session.OutFile() != nil && fileInfo.Format == cmd.FIXED && fileInfo.SingleLine) {
-						_, err = writer.Write([]byte(proc.Tx.Flags.LineBreak.Value()))
 					}
-				}
 
-				proc.Tx.Session.mtx.Unlock()
+					proc.Tx.Session.mtx.Unlock()
 
-				if 0 < len(warnmsg) {
-					proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+					if 0 < len(warnmsg) {
+						proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+					}
+				} else {
+					err = e
 				}
-			} else {
-				err = e
+
 			}
 
 			if proc.Tx.Flags.Stats {
@@ -330,6 +359,27 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			err = e
 		}
 
+		if proc.Tx.Flags.Stats {
+			proc.showExecutionTime(ctx)
+		}
+	case parser.MergeQuery:
+		if proc.Tx.Flags.Stats {
+			proc.measurementStart = time.Now()
+		}
+
+		fileInfo, cnt, e := Merge(ctx, proc.ReferenceScope, stmt.(parser.MergeQuery))
+		if e == nil {
+			if 0 < cnt {
+				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+			}
+			proc.Log(fmt.Sprintf("%s merged on %q.", FormatCount(cnt, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
+			if proc.storeResults {
+				proc.Tx.AffectedRows = cnt
+			}
+		} else {
+			err = e
+		}
+
 		if proc.Tx.Flags.Stats {
 			proc.showExecutionTime(ctx)
 		}
@@ -341,6 +391,22 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		} else {
 			err = e
 		}
+	case parser.CreateIndex:
+		expr := stmt.(parser.CreateIndex)
+		info, e := CreateIndex(ctx, proc.ReferenceScope, expr)
+		if e == nil {
+			proc.Log(fmt.Sprintf("index %s is created on %q.", expr.Name.Literal, info.Path), proc.Tx.Flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.DropIndex:
+		expr := stmt.(parser.DropIndex)
+		info, e := DropIndex(ctx, proc.ReferenceScope, expr)
+		if e == nil {
+			proc.Log(fmt.Sprintf("index %s is dropped on %q.", expr.Name.Literal, info.Path), proc.Tx.Flags.Quiet)
+		} else {
+			err = e
+		}
 	case parser.AddColumns:
 		info, cnt, e := AddColumns(ctx, proc.ReferenceScope, stmt.(parser.AddColumns))
 		if e == nil {
@@ -385,6 +451,12 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		case parser.ROLLBACK:
 			err = proc.Rollback(stmt.(parser.Expression))
 		}
+	case parser.Savepoint:
+		proc.Tx.Savepoint(proc.ReferenceScope, stmt.(parser.Savepoint))
+	case parser.RollbackToSavepoint:
+		err = proc.Tx.RollbackToSavepoint(proc.ReferenceScope, stmt.(parser.RollbackToSavepoint))
+	case parser.ReleaseSavepoint:
+		err = proc.Tx.ReleaseSavepoint(stmt.(parser.ReleaseSavepoint))
 	case parser.FlowControl:
 		switch stmt.(parser.FlowControl).Token {
 		case parser.CONTINUE:
@@ -418,6 +490,8 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		flow, err = proc.While(ctx, stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(ctx, stmt.(parser.WhileInCursor))
+	case parser.ExceptionBlock:
+		flow, err = proc.ExceptionBlock(ctx, stmt.(parser.ExceptionBlock))
 	case parser.Echo:
 		if printstr, err = Echo(ctx, proc.ReferenceScope, stmt.(parser.Echo)); err == nil {
 			proc.Log(printstr, false)
@@ -454,6 +528,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		if printstr, err = ShowObjects(proc.ReferenceScope, stmt.(parser.ShowObjects)); err == nil {
 			proc.Log(printstr, false)
 		}
+	case parser.Explain:
+		if printstr, err = Explain(ctx, proc.ReferenceScope, stmt.(parser.Explain)); err == nil {
+			proc.Log(printstr, false)
+		}
 	case parser.ShowFields:
 		if printstr, err = ShowFields(ctx, proc.ReferenceScope, stmt.(parser.ShowFields)); err == nil {
 			proc.Log(printstr, false)
@@ -499,6 +577,38 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 	return flow, err
 }
 
+// checkRestrictions returns an error if the statement is refused by the restrictions of the transaction.
+func (proc *Processor) checkRestrictions(stmt parser.Statement) error {
+	if proc.Tx.Sandboxed {
+		switch stmt.(type) {
+		case parser.ExternalCommand:
+			return NewOperationNotAllowedError(stmt.(parser.ExternalCommand), "external command")
+		case parser.Source:
+			return NewOperationNotAllowedError(stmt.(parser.Source), "SOURCE")
+		case parser.Chdir:
+			return NewOperationNotAllowedError(stmt.(parser.Chdir), "CHDIR")
+		case parser.SetEnvVar:
+			return NewOperationNotAllowedError(stmt.(parser.SetEnvVar).EnvVar, "setting environment variable")
+		case parser.UnsetEnvVar:
+			return NewOperationNotAllowedError(stmt.(parser.UnsetEnvVar).EnvVar, "unsetting environment variable")
+		case parser.Reload:
+			return NewOperationNotAllowedError(stmt.(parser.Reload), "RELOAD")
+		}
+	}
+
+	if proc.Tx.ReadOnly {
+		switch stmt.(type) {
+		case parser.CreateTable:
+			return NewOperationNotAllowedError(stmt.(parser.CreateTable).Table, "CREATE TABLE")
+		case parser.CreateIndex:
+			return NewOperationNotAllowedError(stmt.(parser.CreateIndex).Table, "CREATE INDEX")
+		case parser.DropIndex:
+			return NewOperationNotAllowedError(stmt.(parser.DropIndex).Table, "DROP INDEX")
+		}
+	}
+	return nil
+}
+
 func (proc *Processor) IfStmt(ctx context.Context, stmt parser.If) (StatementFlow, error) {
 	stmts := make([]parser.ElseIf, 0, len(stmt.ElseIf)+1)
 	stmts = append(stmts, parser.ElseIf{
@@ -640,6 +750,53 @@ func (proc *Processor) WhileInCursor(ctx context.Context, stmt parser.WhileInCur
 	return Terminate, nil
 }
 
+func (proc *Processor) ExceptionBlock(ctx context.Context, stmt parser.ExceptionBlock) (StatementFlow, error) {
+	flow, err := proc.executeChild(ctx, stmt.Statements)
+	if err == nil || ctx.Err() != nil {
+		return flow, err
+	}
+
+	switch err.(type) {
+	case *ForcedExit, *SignalReceived, *ContextDone:
+		return flow, err
+	}
+
+	appErr, ok := err.(Error)
+	if !ok {
+		appErr = NewSystemError(err.Error()).(Error)
+	}
+
+	for _, handler := range stmt.Handlers {
+		if !exceptionHandlerMatches(handler, appErr) {
+			continue
+		}
+
+		caught := proc.Tx.caughtError
+		proc.Tx.caughtError = appErr
+		flow, err = proc.executeChild(ctx, handler.Statements)
+		proc.Tx.caughtError = caught
+		return flow, err
+	}
+	return flow, err
+}
+
+func exceptionHandlerMatches(handler parser.ExceptionHandler, err Error) bool {
+	if handler.Codes == nil {
+		return true
+	}
+
+	number := err.Number()
+	if _, ok := err.(*UserTriggeredError); ok {
+		number = err.Code()
+	}
+	for _, c := range handler.Codes {
+		if int(c.(*value.Integer).Raw()) == number {
+			return true
+		}
+	}
+	return false
+}
+
 func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.ExternalCommand) error {
 	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
 	var argStrs = make([]string, 0, 8)
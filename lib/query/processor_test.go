@@ -678,6 +678,38 @@ var processorExecuteStatementTests = []struct {
 		},
 		Logs: fmt.Sprintf("1 record deleted on %q.\n", GetTestFilePath("table1.csv")),
 	},
+	{
+		Input: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		UncommittedViews: UncommittedViews{
+			mtx:     &sync.RWMutex{},
+			Created: map[string]*FileInfo{},
+			Updated: map[string]*FileInfo{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): {
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+			},
+		},
+		Logs: fmt.Sprintf("2 records merged on %q.\n", GetTestFilePath("table1.csv")),
+	},
 	{
 		Input: parser.CreateTable{
 			Table: parser.Identifier{Literal: "newtable.csv"},
@@ -1348,6 +1380,241 @@ func TestProcessor_Case(t *testing.T) {
 	}
 }
 
+var processorExceptionBlockTests = []struct {
+	Name       string
+	Stmt       parser.ExceptionBlock
+	ResultFlow StatementFlow
+	Result     string
+	Error      string
+}{
+	{
+		Name: "ExceptionBlock",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("1")},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("2")},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'1'\n",
+	},
+	{
+		Name: "ExceptionBlock Catch Error",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("1")},
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}, Message: parser.NewStringValue("user error"), Code: value.NewInteger(3)},
+				parser.Print{Value: parser.NewStringValue("2")},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Codes: []value.Primary{value.NewInteger(ErrorUserTriggered), value.NewInteger(ErrorFieldNotExist)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("3")},
+					},
+				},
+				{
+					Codes: []value.Primary{value.NewInteger(3)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_CODE"}},
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_NUMBER"}},
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+					},
+				},
+				{
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("4")},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'1'\n3\n90650\n'user error'\n",
+	},
+	{
+		Name: "ExceptionBlock Catch Error by Error Number",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Codes: []value.Primary{value.NewInteger(ReturnCodeApplicationError)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("1")},
+					},
+				},
+				{
+					Codes: []value.Primary{value.NewInteger(ErrorFieldNotExist)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_NUMBER"}},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "10102\n",
+	},
+	{
+		Name: "ExceptionBlock Catch Triggered Error by Exit Code",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}, Code: value.NewInteger(200)},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Codes: []value.Primary{value.NewInteger(200)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_CODE"}},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "200\n",
+	},
+	{
+		Name: "ExceptionBlock Catch Any Error",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Codes: []value.Primary{value.NewInteger(ErrorFileNotExist)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("1")},
+					},
+				},
+				{
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_CODE"}},
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_NUMBER"}},
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+					},
+				},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n10102\n'field notexist does not exist'\n",
+	},
+	{
+		Name: "ExceptionBlock Flow in Handler",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Statements: []parser.Statement{
+						parser.FlowControl{Token: parser.CONTINUE},
+					},
+				},
+			},
+		},
+		ResultFlow: Continue,
+		Result:     "",
+	},
+	{
+		Name: "ExceptionBlock Error Not Caught",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}, Message: parser.NewStringValue("user error"), Code: value.NewInteger(3)},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Codes: []value.Primary{value.NewInteger(4), value.NewInteger(ErrorUserTriggered)},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("1")},
+					},
+				},
+			},
+		},
+		ResultFlow: TerminateWithError,
+		Error:      "user error",
+	},
+	{
+		Name: "ExceptionBlock Exit Not Caught",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Exit{Code: value.NewInteger(1)},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.NewStringValue("1")},
+					},
+				},
+			},
+		},
+		ResultFlow: TerminateWithError,
+		Error:      ExitMessage,
+	},
+	{
+		Name: "ExceptionBlock Error in Handler",
+		Stmt: parser.ExceptionBlock{
+			Statements: []parser.Statement{
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}},
+			},
+			Handlers: []parser.ExceptionHandler{
+				{
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+			},
+		},
+		ResultFlow: TerminateWithError,
+		Error:      "field notexist does not exist",
+	},
+}
+
+func TestProcessor_ExceptionBlock(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(true)
+	tx := TestTx
+	proc := NewProcessor(tx)
+
+	for _, v := range processorExceptionBlockTests {
+		out := NewOutput()
+		tx.Session.SetStdout(out)
+		flow, err := proc.ExceptionBlock(context.Background(), v.Stmt)
+		log := out.String()
+
+		if tx.caughtError != nil {
+			t.Errorf("%s: caught error is not cleared", v.Name)
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
+
 var processorWhileTests = []struct {
 	Name        string
 	Stmt        parser.While
@@ -9,6 +9,9 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(ctx context.Context, scope *ReferenceScope, name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable) (bool, error) {
@@ -123,33 +126,39 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 		}
 	}
 
-	view, err := selectEntity(ctx, queryScope, query.SelectEntity, query.ForUpdate)
+	view, err := selectEntity(ctx, queryScope, query.SelectEntity, query.OrderByClause, query.ForUpdate)
 	if err != nil {
 		queryScope.CloseCurrentNode()
 		return nil, err
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(ctx, queryScope, query.OrderByClause.(parser.OrderByClause)); err != nil {
+		stepCtx, node := beginPlanNode(ctx)
+		if err := view.OrderBy(stepCtx, queryScope, query.OrderByClause.(parser.OrderByClause)); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
+		node.finishStep(view, planSort, query.OrderByClause)
 	}
 
 	if query.LimitClause != nil {
 		limitClause := query.LimitClause.(parser.LimitClause)
 		if limitClause.OffsetClause != nil {
-			if err := view.Offset(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+			stepCtx, node := beginPlanNode(ctx)
+			if err := view.Offset(stepCtx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
+			node.finishStep(view, planOffset, limitClause.OffsetClause)
 		}
 
 		if !limitClause.Type.IsEmpty() {
-			if err := view.Limit(ctx, queryScope, limitClause); err != nil {
+			stepCtx, node := beginPlanNode(ctx)
+			if err := view.Limit(stepCtx, queryScope, limitClause); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
+			node.finishStep(view, planLimit, limitClause)
 		}
 	}
 
@@ -181,7 +190,7 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	return view, err
 }
 
-func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, forUpdate bool) (*View, error) {
+func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, orderByClause parser.QueryExpression, forUpdate bool) (*View, error) {
 	entity, ok := expr.(parser.SelectEntity)
 	if !ok {
 		return selectSet(ctx, scope, expr.(parser.SelectSet), forUpdate)
@@ -190,32 +199,56 @@ func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryE
 	if entity.FromClause == nil {
 		entity.FromClause = parser.FromClause{}
 	}
-	view, err := LoadView(ctx, scope, entity.FromClause.(parser.FromClause).Tables, forUpdate, false)
+	tables := entity.FromClause.(parser.FromClause).Tables
+	view, err := loadViewUsingIndex(ctx, scope, tables, entity.WhereClause, forUpdate)
+	if err == nil && view == nil {
+		view, err = loadViewUsingParquetScan(ctx, scope, entity, orderByClause, forUpdate)
+	}
+	if err == nil && view == nil {
+		view, err = LoadView(ctx, scope, tables, forUpdate, false)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	if entity.WhereClause != nil {
-		if err := view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
+		stepCtx, node := beginPlanNode(ctx)
+		if err := planSubqueries(stepCtx, scope, view, entity.WhereClause); err != nil {
+			return nil, err
+		}
+		if err := view.Where(stepCtx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
 			return nil, err
 		}
+		node.finishStep(view, planFilter, entity.WhereClause)
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(ctx, scope, entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		stepCtx, node := beginPlanNode(ctx)
+		if err := view.GroupBy(stepCtx, scope, entity.GroupByClause.(parser.GroupByClause)); err != nil {
 			return nil, err
 		}
+		node.finishStep(view, planGroup, entity.GroupByClause)
 	}
 
 	if entity.HavingClause != nil {
-		if err := view.Having(ctx, scope, entity.HavingClause.(parser.HavingClause)); err != nil {
+		stepCtx, node := beginPlanNode(ctx)
+		if err := planSubqueries(stepCtx, scope, view, entity.HavingClause); err != nil {
+			return nil, err
+		}
+		if err := view.Having(stepCtx, scope, entity.HavingClause.(parser.HavingClause)); err != nil {
 			return nil, err
 		}
+		node.finishStep(view, planHaving, entity.HavingClause)
 	}
 
-	if err := view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+	stepCtx, node := beginPlanNode(ctx)
+	if err := planSubqueries(stepCtx, scope, view, entity.SelectClause); err != nil {
 		return nil, err
 	}
+	if err := view.Select(stepCtx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+		return nil, err
+	}
+	node.finishStep(view, planProject, entity.SelectClause)
 
 	return view, nil
 }
@@ -225,7 +258,7 @@ func selectSetEntity(ctx context.Context, scope *ReferenceScope, expr parser.Que
 		return Select(ctx, scope, subquery.Query)
 	}
 
-	view, err := selectEntity(ctx, scope, expr, forUpdate)
+	view, err := selectEntity(ctx, scope, expr, nil, forUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +267,8 @@ func selectSetEntity(ctx context.Context, scope *ReferenceScope, expr parser.Que
 }
 
 func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet, forUpdate bool) (*View, error) {
+	ctx, node := beginPlanNode(ctx)
+
 	lview, err := selectSetEntity(ctx, scope, set.LHS, forUpdate)
 	if err != nil {
 		return nil, err
@@ -272,6 +307,9 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 	}
 
 	err = lview.SelectAllColumns(ctx, scope)
+	if err == nil {
+		node.finish(lview, setOperationName(set), nil)
+	}
 	return lview, err
 }
 
@@ -301,12 +339,14 @@ func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *Vie
 	}
 
 	queryScope := scope.CreateNode()
-	rview, err := selectSetEntity(ctx, queryScope, set.RHS, forUpdate)
+	recursionCtx, node := beginPlanNode(ctx)
+	rview, err := selectSetEntity(recursionCtx, queryScope, set.RHS, forUpdate)
 	queryScope.CloseCurrentNode()
 	queryScope = nil
 	if err != nil {
 		return err
 	}
+	node.finish(rview, planRecursion, nil)
 	if view.FieldLen() != rview.FieldLen() {
 		return NewCombinedSetFieldLengthError(set.RHS, view.FieldLen())
 	}
@@ -361,6 +401,9 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 	if err != nil {
 		return nil, insertRecords, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, insertRecords, NewGlobTableReadOnlyError(query.Table)
+	}
 
 	fields := query.Fields
 	if fields == nil {
@@ -475,6 +518,9 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			}
 
 			fieldIdx, _ := viewsToUpdate[viewref].Header.SearchIndex(uset.Field)
+			if !viewsToUpdate[viewref].Header[fieldIdx].IsFromTable {
+				return nil, nil, NewUpdateFieldNotExistError(uset.Field)
+			}
 			if _, ok := updatesList[viewref]; !ok {
 				updatesList[viewref] = make(map[int]*UintPool)
 			}
@@ -486,6 +532,9 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId].Add(uint(fieldIdx))
+			if val, err = viewsToUpdate[viewref].conformToSchema(uset.Value, fieldIdx, val, queryScope.Tx.Flags); err != nil {
+				return nil, nil, err
+			}
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
@@ -497,6 +546,25 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			return nil, nil, err
 		}
 
+		if v.FileInfo.IsGlob() {
+			ids := make([]int, 0, len(updatesList[k]))
+			for id := range updatesList[k] {
+				ids = append(ids, id)
+			}
+			counts := countGlobFileRecords(v, ids)
+
+			views, err := splitGlobView(scope, v)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, fv := range views {
+				scope.Tx.cachedViews.Set(fv)
+				fileInfos = append(fileInfos, fv.FileInfo)
+				updateRecords = append(updateRecords, counts[fv.FileInfo.Path])
+			}
+			continue
+		}
+
 		if !v.FileInfo.IsFile() {
 			scope.ReplaceTemporaryTable(v)
 		} else {
@@ -533,6 +601,9 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 	if err != nil {
 		return nil, replaceRecords, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, replaceRecords, NewGlobTableReadOnlyError(query.Table)
+	}
 
 	fields := query.Fields
 	if fields == nil {
@@ -644,6 +715,15 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 			return nil, nil, ConvertContextError(ctx.Err())
 		}
 
+		var counts map[string]int
+		if v.FileInfo.IsGlob() {
+			ids := make([]int, 0, len(deletedIndices[k]))
+			for id := range deletedIndices[k] {
+				ids = append(ids, id)
+			}
+			counts = countGlobFileRecords(v, ids)
+		}
+
 		records := make(RecordSet, 0, v.RecordLen()-len(deletedIndices[k]))
 		for i, record := range v.RecordSet {
 			if !deletedIndices[k][i] {
@@ -656,6 +736,19 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 			return nil, nil, err
 		}
 
+		if v.FileInfo.IsGlob() {
+			views, err := splitGlobView(scope, v)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, fv := range views {
+				scope.Tx.cachedViews.Set(fv)
+				fileInfos = append(fileInfos, fv.FileInfo)
+				deletedCounts = append(deletedCounts, counts[fv.FileInfo.Path])
+			}
+			continue
+		}
+
 		if !v.FileInfo.IsFile() {
 			scope.ReplaceTemporaryTable(v)
 		} else {
@@ -669,6 +762,249 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 	return fileInfos, deletedCounts, nil
 }
 
+func Merge(ctx context.Context, scope *ReferenceScope, query parser.MergeQuery) (*FileInfo, int, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	if query.WithClause != nil {
+		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+
+	view, err := LoadView(ctx, queryScope, []parser.QueryExpression{query.Table}, true, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	if view.FileInfo.IsGlob() {
+		return nil, 0, NewGlobTableReadOnlyError(query.Table)
+	}
+
+	tableName := query.Table.Name()
+	fpath, err := queryScope.GetAlias(tableName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var viewToMerge *View
+	if queryScope.TemporaryTableExists(fpath) {
+		viewToMerge, _ = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
+	} else {
+		viewToMerge, _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
+	}
+	if err = viewToMerge.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, 0, err
+	}
+
+	sourceView, err := LoadView(ctx, queryScope, []parser.QueryExpression{query.Source}, false, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	sourceName := query.Source.(parser.Table).Name().Literal
+	if err = addInternalId(ctx, sourceView, sourceName, queryScope.Tx.Flags); err != nil {
+		return nil, 0, err
+	}
+
+	joinedView := view.Copy()
+	if err = InnerJoin(ctx, queryScope, joinedView, sourceView, query.Condition); err != nil {
+		return nil, 0, err
+	}
+
+	matchedTargets := make(map[int]bool)
+	matchedSources := make(map[int]bool)
+	modifiedTargets := make(map[int]bool)
+	deletedTargets := make(map[int]bool)
+
+	var modify = func(seqScope *ReferenceScope, clause *parser.MergeWhenClause, internalId int) error {
+		if modifiedTargets[internalId] {
+			return NewMergeRecordAmbiguousError(*clause, tableName)
+		}
+		modifiedTargets[internalId] = true
+
+		if clause.Operation.Token == parser.DELETE {
+			deletedTargets[internalId] = true
+			return nil
+		}
+
+		updatedFields := make(map[int]bool, len(clause.SetList))
+		for _, uset := range clause.SetList {
+			fieldIdx, err := viewToMerge.Header.SearchIndex(uset.Field)
+			if err != nil {
+				return NewUpdateFieldNotExistError(uset.Field)
+			}
+			if updatedFields[fieldIdx] {
+				return NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+			}
+			updatedFields[fieldIdx] = true
+
+			val, err := Evaluate(ctx, seqScope, uset.Value)
+			if err != nil {
+				return err
+			}
+			if val, err = viewToMerge.conformToSchema(uset.Value, fieldIdx, val, queryScope.Tx.Flags); err != nil {
+				return err
+			}
+			viewToMerge.RecordSet[internalId][fieldIdx] = NewCell(val)
+		}
+		return nil
+	}
+
+	seqScope := queryScope.CreateScopeForSequentialEvaluation(joinedView)
+	for i := range joinedView.RecordSet {
+		if ctx.Err() != nil {
+			return nil, 0, ConvertContextError(ctx.Err())
+		}
+
+		seqScope.Records[0].recordIndex = i
+		internalId, err := joinedView.InternalRecordId(tableName.Literal, i)
+		if err != nil {
+			return nil, 0, err
+		}
+		sourceId, err := joinedView.InternalRecordId(sourceName, i)
+		if err != nil {
+			return nil, 0, err
+		}
+		matchedTargets[internalId] = true
+		matchedSources[sourceId] = true
+
+		clause, err := searchMergeWhenClause(ctx, seqScope, query.WhenClauses, true, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		if clause != nil {
+			if err = modify(seqScope, clause, internalId); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	if hasMergeWhenClause(query.WhenClauses, false, true) {
+		seqScope = queryScope.CreateScopeForSequentialEvaluation(view)
+		for i := range view.RecordSet {
+			if ctx.Err() != nil {
+				return nil, 0, ConvertContextError(ctx.Err())
+			}
+
+			internalId, err := view.InternalRecordId(tableName.Literal, i)
+			if err != nil {
+				return nil, 0, err
+			}
+			if matchedTargets[internalId] {
+				continue
+			}
+
+			seqScope.Records[0].recordIndex = i
+			clause, err := searchMergeWhenClause(ctx, seqScope, query.WhenClauses, false, true)
+			if err != nil {
+				return nil, 0, err
+			}
+			if clause != nil {
+				if err = modify(seqScope, clause, internalId); err != nil {
+					return nil, 0, err
+				}
+			}
+		}
+	}
+
+	insertRecords := make(RecordSet, 0)
+	if hasMergeWhenClause(query.WhenClauses, false, false) {
+		seqScope = queryScope.CreateScopeForSequentialEvaluation(sourceView)
+		for i := range sourceView.RecordSet {
+			if ctx.Err() != nil {
+				return nil, 0, ConvertContextError(ctx.Err())
+			}
+			if matchedSources[i] {
+				continue
+			}
+
+			seqScope.Records[0].recordIndex = i
+			clause, err := searchMergeWhenClause(ctx, seqScope, query.WhenClauses, false, false)
+			if err != nil {
+				return nil, 0, err
+			}
+			if clause == nil {
+				continue
+			}
+
+			fields := clause.Fields
+			if fields == nil {
+				fields = viewToMerge.Header.TableColumns()
+			}
+
+			values, err := EvalRowValue(ctx, seqScope, clause.Values)
+			if err != nil {
+				return nil, 0, err
+			}
+			if len(values) != len(fields) {
+				return nil, 0, NewInsertRowValueLengthError(clause.Values.(parser.RowValue), len(fields))
+			}
+
+			records, err := viewToMerge.convertRecordValuesToRecordSet(ctx, queryScope.Tx.Flags, fields, [][]value.Primary{values}, func(int) parser.QueryExpression { return clause.Values })
+			if err != nil {
+				return nil, 0, err
+			}
+			insertRecords = append(insertRecords, records...)
+		}
+	}
+
+	if 0 < len(deletedTargets) {
+		records := make(RecordSet, 0, viewToMerge.RecordLen()-len(deletedTargets))
+		for i, record := range viewToMerge.RecordSet {
+			if !deletedTargets[i] {
+				records = append(records, record)
+			}
+		}
+		viewToMerge.RecordSet = records
+	}
+	if 0 < len(insertRecords) {
+		viewToMerge.RecordSet = viewToMerge.RecordSet.Merge(insertRecords)
+	}
+
+	if err = viewToMerge.RestoreHeaderReferences(); err != nil {
+		return nil, 0, err
+	}
+
+	if !viewToMerge.FileInfo.IsFile() {
+		scope.ReplaceTemporaryTable(viewToMerge)
+	} else {
+		scope.Tx.cachedViews.Set(viewToMerge)
+	}
+
+	return viewToMerge.FileInfo, len(modifiedTargets) + len(insertRecords), nil
+}
+
+func hasMergeWhenClause(clauses []parser.MergeWhenClause, matched bool, bySource bool) bool {
+	for _, clause := range clauses {
+		if clause.Matched == matched && clause.BySource == bySource {
+			return true
+		}
+	}
+	return false
+}
+
+func searchMergeWhenClause(ctx context.Context, scope *ReferenceScope, clauses []parser.MergeWhenClause, matched bool, bySource bool) (*parser.MergeWhenClause, error) {
+	for i := range clauses {
+		if clauses[i].Matched != matched || clauses[i].BySource != bySource {
+			continue
+		}
+
+		if clauses[i].Condition != nil {
+			p, err := Evaluate(ctx, scope, clauses[i].Condition)
+			if err != nil {
+				return nil, err
+			}
+			if p.Ternary() != ternary.TRUE {
+				continue
+			}
+		}
+		return &clauses[i], nil
+	}
+	return nil, nil
+}
+
 func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.CreateTable) (*FileInfo, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
@@ -693,26 +1029,47 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 	fileInfo.PrettyPrint = flags.PrettyPrint
 	fileInfo.ForUpdate = true
 
+	var columns []parser.QueryExpression
+	if query.Fields != nil {
+		columns = make([]parser.QueryExpression, len(query.Fields))
+		for i := range query.Fields {
+			if def, ok := query.Fields[i].(parser.ColumnDefinition); ok {
+				columnSchema := ColumnSchema{Name: def.Column.Literal, NotNull: def.NotNull}
+				if 0 < len(def.Type.Literal) {
+					t, ok := ParseColumnType(def.Type.Literal)
+					if !ok {
+						return nil, appendCompositeError(NewInvalidColumnTypeError(def.Type), queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+					}
+					columnSchema.Type = t
+				}
+				fileInfo.Schema = append(fileInfo.Schema, columnSchema)
+				columns[i] = def.Column
+			} else {
+				columns[i] = query.Fields[i]
+			}
+		}
+	}
+
 	if query.Query != nil {
 		view, err = Select(ctx, queryScope, query.Query.(parser.SelectQuery))
 		if err != nil {
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
 
-		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), query.Fields); err != nil {
+		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), columns); err != nil {
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
-				err = NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
+				err = NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(columns))
 			}
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
 	} else {
-		fields := make([]string, len(query.Fields))
-		fieldsMap := make(map[string]bool, len(query.Fields))
-		for i := range query.Fields {
-			lit := query.Fields[i].(parser.Identifier).Literal
+		fields := make([]string, len(columns))
+		fieldsMap := make(map[string]bool, len(columns))
+		for i := range columns {
+			lit := columns[i].(parser.Identifier).Literal
 			ulit := strings.ToUpper(lit)
 			if _, ok := fieldsMap[ulit]; ok {
-				err = NewDuplicateFieldNameError(query.Fields[i].(parser.Identifier))
+				err = NewDuplicateFieldNameError(columns[i].(parser.Identifier))
 				return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
 			fields[i] = lit
@@ -727,6 +1084,12 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 
 	view.FileInfo = fileInfo
 
+	if fileInfo.Schema != nil {
+		if err = view.applyTableSchema(ctx, flags, query.Table, query.Query); err != nil {
+			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+		}
+	}
+
 	scope.Tx.cachedViews.Set(view)
 
 	return view.FileInfo, nil
@@ -749,6 +1112,9 @@ func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColu
 	if err != nil {
 		return nil, 0, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, 0, NewGlobTableReadOnlyError(query.Table)
+	}
 
 	var insertPos int
 	pos, _ := query.Position.(parser.ColumnPosition)
@@ -862,6 +1228,9 @@ func DropColumns(ctx context.Context, scope *ReferenceScope, query parser.DropCo
 	if err != nil {
 		return nil, 0, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, 0, NewGlobTableReadOnlyError(query.Table)
+	}
 
 	dropIndices := NewUintPool(len(query.Columns), LimitToUseUintSlicePool)
 	for _, v := range query.Columns {
@@ -881,10 +1250,22 @@ func DropColumns(ctx context.Context, scope *ReferenceScope, query parser.DropCo
 		}
 	}
 
+	droppedColumns := make([]string, 0, dropIndices.Len())
+	for i := 0; i < view.FieldLen(); i++ {
+		if dropIndices.Exists(uint(i)) {
+			droppedColumns = append(droppedColumns, view.Header[i].Column)
+		}
+	}
+
 	if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
 		return nil, 0, err
 	}
 
+	if view.FileInfo.Schema != nil {
+		view.FileInfo.Schema = view.FileInfo.Schema.Drop(droppedColumns)
+		view.FileInfo.schemaUpdated = true
+	}
+
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
 	} else {
@@ -906,6 +1287,9 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 	if err != nil {
 		return nil, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, NewGlobTableReadOnlyError(query.Table)
+	}
 
 	columnNames := view.Header.TableColumnNames()
 	columnNamesMap := make(map[string]bool, len(columnNames))
@@ -921,6 +1305,10 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 		return nil, err
 	}
 
+	if view.FileInfo.Schema != nil {
+		view.FileInfo.Schema = view.FileInfo.Schema.Rename(view.Header[idx].Column, query.New.Literal)
+		view.FileInfo.schemaUpdated = true
+	}
 	view.Header[idx].Column = query.New.Literal
 
 	if !view.FileInfo.IsFile() {
@@ -945,6 +1333,9 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	if err != nil {
 		return nil, log, err
 	}
+	if view.FileInfo.IsGlob() {
+		return nil, log, NewGlobTableReadOnlyError(query.Table)
+	}
 	if !view.FileInfo.IsFile() {
 		return nil, log, NewNotTableError(query.Table)
 	}
@@ -962,7 +1353,7 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
 	switch attr {
-	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
+	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape, TableCompression:
 		s := value.ToString(p)
 		if value.IsNull(s) {
 			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
@@ -980,6 +1371,8 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 			err = fileInfo.SetLineBreak(s.(*value.String).Raw())
 		case TableJsonEscape:
 			err = fileInfo.SetJsonEscape(s.(*value.String).Raw())
+		case TableCompression:
+			err = fileInfo.SetCompression(s.(*value.String).Raw())
 		}
 		value.Discard(s)
 	case TableHeader, TableEncloseAll, TablePrettyPrint:
@@ -1027,3 +1420,74 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	scope.Tx.cachedViews.Set(view)
 	return view.FileInfo, log, err
 }
+
+func CreateIndex(ctx context.Context, scope *ReferenceScope, query parser.CreateIndex) (fileInfo *FileInfo, err error) {
+	if !ValidIndexName(query.Name.Literal) {
+		return nil, NewInvalidIndexNameError(query.Name)
+	}
+
+	flags := scope.Tx.Flags
+	fileInfo, err = NewFileInfo(query.Table, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo.NoHeader = flags.NoHeader
+
+	if file.Exists(IndexFilePath(fileInfo.Path, query.Name.Literal)) {
+		return nil, NewIndexAlreadyExistError(query.Name, fileInfo.Path)
+	}
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		query.Table.Literal = fileInfo.Path
+		return nil, ConvertFileHandlerError(err, query.Table)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	enc, err := text.DetectInSpecifiedEncoding(h.File(), fileInfo.Encoding)
+	if err != nil {
+		return nil, NewCannotDetectFileEncodingError(query.Table)
+	}
+	fileInfo.Encoding = enc
+
+	if reason := indexUnsupportedReason(fileInfo); 0 < len(reason) {
+		return nil, NewIndexNotSupportedError(query.Table, fileInfo.Path, reason)
+	}
+
+	idx, err := BuildTableIndex(ctx, h.File(), fileInfo, query.Name.Literal, query.Column.Literal)
+	if err != nil {
+		if err == errIndexFieldNotExist {
+			return nil, NewFieldNotExistError(query.Column)
+		}
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(query.Table, fileInfo.Path, err.Error())
+		}
+		return nil, err
+	}
+	if err = idx.Write(fileInfo.Path); err != nil {
+		return nil, NewIOError(query.Name, err.Error())
+	}
+	return fileInfo, nil
+}
+
+func DropIndex(ctx context.Context, scope *ReferenceScope, query parser.DropIndex) (*FileInfo, error) {
+	if !ValidIndexName(query.Name.Literal) {
+		return nil, NewInvalidIndexNameError(query.Name)
+	}
+
+	flags := scope.Tx.Flags
+	fileInfo, err := NewFileInfo(query.Table, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	if !file.Exists(IndexFilePath(fileInfo.Path, query.Name.Literal)) {
+		return nil, NewIndexNotExistError(query.Name, fileInfo.Path)
+	}
+	if err = RemoveTableIndex(fileInfo.Path, query.Name.Literal); err != nil {
+		return nil, NewIOError(query.Name, err.Error())
+	}
+	return fileInfo, nil
+}
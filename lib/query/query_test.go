@@ -507,6 +507,44 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Select Rollup Without Records",
+		Query: ParseTestStatement("SELECT column1, COUNT(*), GROUPING(column1) FROM group_table WHERE column1 > 3 GROUP BY ROLLUP(column1)").(parser.SelectQuery),
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "COUNT(*)",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column:      "GROUPING(column1)",
+					Number:      3,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(0),
+					value.NewInteger(1),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Select Replace Fields",
 		Query: parser.SelectQuery{
@@ -1650,6 +1688,16 @@ var insertTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name:  "Insert Query Column Type Mismatch Error",
+		Query: ParseTestStatement("INSERT INTO table_schema (id, name) VALUES ('abc', 'str3')").(parser.InsertQuery),
+		Error: "[L:1 C:45] value 'abc' cannot be stored in field id of type INTEGER",
+	},
+	{
+		Name:  "Insert Query Not Null Violation Error",
+		Query: ParseTestStatement("INSERT INTO table_schema (name) VALUES ('str3')").(parser.InsertQuery),
+		Error: "[L:1 C:40] field id does not allow null",
+	},
 	{
 		Name: "Insert Select Query",
 		Query: parser.InsertQuery{
@@ -1709,6 +1757,23 @@ var insertTests = []struct {
 		},
 		Error: "select query should return exactly 1 field",
 	},
+	{
+		Name: "Insert Query Glob Pattern Error",
+		Query: parser.InsertQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "glob/log_*.csv"}},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+							parser.NewStringValue("str4"),
+						},
+					},
+				},
+			},
+		},
+		Error: "table glob/log_*.csv consists of multiple files and cannot be modified by this statement",
+	},
 }
 
 func TestInsert(t *testing.T) {
@@ -2158,6 +2223,21 @@ var updateTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Update Query Column Type Mismatch Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table_schema"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "created"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+		},
+		Error: "value 'update' cannot be stored in field created of type DATETIME",
+	},
 	{
 		Name: "Update Query Record Is Ambiguous Error",
 		Query: parser.UpdateQuery{
@@ -2188,6 +2268,61 @@ var updateTests = []struct {
 		},
 		Error: "value column4 to set in the field column2 is ambiguous",
 	},
+	{
+		Name: "Update Query For Glob Pattern",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "glob/log_*.csv"}, Alias: parser.Identifier{Literal: "l"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "l"}, Column: parser.Identifier{Literal: parser.FileColumn}},
+					RHS:      parser.NewStringValue(GetTestFilePath("glob/log_2.csv")),
+					Operator: "=",
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("glob/log_1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+			{
+				Path:      GetTestFilePath("glob/log_2.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{0, 3},
+	},
+	{
+		Name: "Update Query File Pseudo-Column Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "glob/log_*.csv"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: parser.FileColumn}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+		},
+		Error: "field @__file does not exist in the tables to update",
+	},
 }
 
 func TestUpdate(t *testing.T) {
@@ -2699,82 +2834,81 @@ func TestReplace(t *testing.T) {
 	}
 }
 
-var deleteTests = []struct {
+var mergeTests = []struct {
 	Name         string
-	Query        parser.DeleteQuery
-	ResultFiles  []*FileInfo
-	UpdateCounts []int
+	Query        parser.MergeQuery
+	ResultFile   *FileInfo
+	UpdateCount  int
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
 }{
 	{
-		Name: "Delete Query",
-		Query: parser.DeleteQuery{
-			WithClause: parser.WithClause{
-				With: "with",
-				InlineTables: []parser.QueryExpression{
-					parser.InlineTable{
-						Name: parser.Identifier{Literal: "it"},
-						Fields: []parser.QueryExpression{
-							parser.Identifier{Literal: "c1"},
-						},
-						As: "as",
-						Query: parser.SelectQuery{
-							SelectEntity: parser.SelectEntity{
-								SelectClause: parser.SelectClause{
-									Select: "select",
-									Fields: []parser.QueryExpression{
-										parser.Field{Object: parser.NewIntegerValueFromString("2")},
-									},
-								},
-							},
+		Name: "Merge Query",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "s"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Matched: true,
+					Condition: parser.Comparison{
+						LHS:      parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "column3"}},
+						RHS:      parser.NewIntegerValueFromString("2"),
+						Operator: "=",
+					},
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							Value: parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "column4"}},
 						},
 					},
 				},
-			},
-			FromClause: parser.FromClause{
-				Tables: []parser.QueryExpression{
-					parser.Table{
-						Object: parser.Identifier{Literal: "table1"},
-					},
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
 				},
-			},
-			WhereClause: parser.WhereClause{
-				Filter: parser.Comparison{
-					LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					RHS: parser.Subquery{
-						Query: parser.SelectQuery{
-							SelectEntity: parser.SelectEntity{
-								SelectClause: parser.SelectClause{
-									Select: "select",
-									Fields: []parser.QueryExpression{
-										parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "it"}, Column: parser.Identifier{Literal: "c1"}}},
-									},
-								},
-								FromClause: parser.FromClause{
-									Tables: []parser.QueryExpression{
-										parser.Table{Object: parser.Identifier{Literal: "it"}},
-									},
-								},
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Fields: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "column3"}},
+								parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "column4"}},
 							},
 						},
 					},
-					Operator: "=",
+				},
+				{
+					BySource:  true,
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							Value: parser.NewStringValue("orphan"),
+						},
+					},
 				},
 			},
 		},
-		ResultFiles: []*FileInfo{
-			{
-				Path:      GetTestFilePath("table1.csv"),
-				Delimiter: ',',
-				NoHeader:  false,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ForUpdate: true,
-			},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
 		},
-		UpdateCounts: []int{1},
+		UpdateCount: 4,
 		ViewCache: GenerateViewMap([]*View{
 			{
 				FileInfo: &FileInfo{
@@ -2789,43 +2923,96 @@ var deleteTests = []struct {
 				RecordSet: []Record{
 					NewRecord([]value.Primary{
 						value.NewString("1"),
-						value.NewString("str1"),
+						value.NewString("orphan"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table2.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+				Header: NewHeader("table2", []string{"column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
 					}),
 					NewRecord([]value.Primary{
 						value.NewString("3"),
-						value.NewString("str3"),
+						value.NewString("str33"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
 					}),
 				},
 			},
 		}),
 	},
 	{
-		Name: "Delete Query For Temporary View",
-		Query: parser.DeleteQuery{
-			FromClause: parser.FromClause{
-				Tables: []parser.QueryExpression{
-					parser.Table{
-						Object: parser.Identifier{Literal: "tmpview"},
-						Alias:  parser.Identifier{Literal: "t1"},
+		Name: "Merge Query For Temporary View",
+		Query: parser.MergeQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+			Source: parser.Table{
+				Object: parser.Subquery{
+					Query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.NewIntegerValueFromString("3"), Alias: parser.Identifier{Literal: "id"}},
+								},
+							},
+						},
 					},
 				},
+				Alias: parser.Identifier{Literal: "s"},
 			},
-			WhereClause: parser.WhereClause{
-				Filter: parser.Comparison{
-					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					RHS:      parser.NewIntegerValueFromString("2"),
-					Operator: "=",
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+								parser.NewStringValue("str3"),
+							},
+						},
+					},
+				},
+				{
+					BySource: true,
+					Condition: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: "=",
+					},
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
 				},
 			},
 		},
-		ResultFiles: []*FileInfo{
-			{
-				Path:      "tmpview",
-				Delimiter: ',',
-				ViewType:  ViewTypeTemporaryTable,
-			},
+		ResultFile: &FileInfo{
+			Path:      "tmpview",
+			Delimiter: ',',
+			ViewType:  ViewTypeTemporaryTable,
 		},
-		UpdateCounts: []int{1},
+		UpdateCount: 2,
 		ResultScopes: GenerateReferenceScope([]map[string]map[string]interface{}{
 			{
 				scopeNameTempTables: {
@@ -2833,8 +3020,12 @@ var deleteTests = []struct {
 						Header: NewHeader("tmpview", []string{"column1", "column2"}),
 						RecordSet: []Record{
 							NewRecord([]value.Primary{
-								value.NewString("1"),
-								value.NewString("str1"),
+								value.NewString("2"),
+								value.NewString("str2"),
+							}),
+							NewRecord([]value.Primary{
+								value.NewInteger(3),
+								value.NewString("str3"),
 							}),
 						},
 						FileInfo: &FileInfo{
@@ -2848,11 +3039,317 @@ var deleteTests = []struct {
 		}, nil, time.Time{}, nil),
 	},
 	{
-		Name: "Delete Query Multiple Table",
-		Query: parser.DeleteQuery{
-			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "t1"}},
-			},
+		Name: "Merge Query Record Ambiguous Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("2"),
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					BaseExpr:  parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+					Matched:   true,
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		Error: "[L:1 C:1] record in table1 to be modified matches multiple records of the source",
+	},
+	{
+		Name: "Merge Query Update Field Does Not Exist Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}), Column: parser.Identifier{Literal: "column4"}},
+							Value: parser.NewStringValue("update"),
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:1 C:1] field column4 does not exist in the tables to update",
+	},
+	{
+		Name: "Merge Query Insert Value Length Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:1 C:1] row value should contain exactly 2 values",
+	},
+}
+
+func TestMerge(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.Quiet = false
+
+	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
+		{
+			scopeNameTempTables: {
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("2"),
+							value.NewString("str2"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:      "tmpview",
+						Delimiter: ',',
+						ViewType:  ViewTypeTemporaryTable,
+					},
+				},
+			},
+		},
+	}, nil, time.Time{}, nil)
+
+	ctx := context.Background()
+	for _, v := range mergeTests {
+		_ = TestTx.ReleaseResources()
+		result, cnt, err := Merge(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		TestTx.cachedViews.Range(func(key, value interface{}) bool {
+			view := value.(*View)
+			if view.FileInfo.Handler != nil {
+				if view.FileInfo.Path != view.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", view.FileInfo.Handler.Path(), view.FileInfo.Path, v.Name)
+				}
+				_ = TestTx.FileContainer.Close(view.FileInfo.Handler)
+				view.FileInfo.Handler = nil
+			}
+			return true
+		})
+
+		if !reflect.DeepEqual(result, v.ResultFile) {
+			t.Errorf("%s: fileinfo = %v, want %v", v.Name, result, v.ResultFile)
+		}
+
+		if !reflect.DeepEqual(cnt, v.UpdateCount) {
+			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
+		}
+
+		if v.ViewCache.SyncMap != nil {
+			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
+				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
+			}
+		}
+		if v.ResultScopes != nil {
+			if !SyncMapEqual(scope.blocks[0].temporaryTables, v.ResultScopes.blocks[0].temporaryTables) {
+				t.Errorf("%s: temporary views list = %v, want %v", v.Name, scope.blocks[0].temporaryTables, v.ResultScopes.blocks[0].temporaryTables)
+			}
+		}
+	}
+}
+
+var deleteTests = []struct {
+	Name         string
+	Query        parser.DeleteQuery
+	ResultFiles  []*FileInfo
+	UpdateCounts []int
+	ViewCache    ViewMap
+	ResultScopes *ReferenceScope
+	Error        string
+}{
+	{
+		Name: "Delete Query",
+		Query: parser.DeleteQuery{
+			WithClause: parser.WithClause{
+				With: "with",
+				InlineTables: []parser.QueryExpression{
+					parser.InlineTable{
+						Name: parser.Identifier{Literal: "it"},
+						Fields: []parser.QueryExpression{
+							parser.Identifier{Literal: "c1"},
+						},
+						As: "as",
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Select: "select",
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValueFromString("2")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{
+						Object: parser.Identifier{Literal: "table1"},
+					},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS: parser.Subquery{
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Select: "select",
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "it"}, Column: parser.Identifier{Literal: "c1"}}},
+									},
+								},
+								FromClause: parser.FromClause{
+									Tables: []parser.QueryExpression{
+										parser.Table{Object: parser.Identifier{Literal: "it"}},
+									},
+								},
+							},
+						},
+					},
+					Operator: "=",
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Delete Query For Temporary View",
+		Query: parser.DeleteQuery{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{
+						Object: parser.Identifier{Literal: "tmpview"},
+						Alias:  parser.Identifier{Literal: "t1"},
+					},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: "=",
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      "tmpview",
+				Delimiter: ',',
+				ViewType:  ViewTypeTemporaryTable,
+			},
+		},
+		UpdateCounts: []int{1},
+		ResultScopes: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"TMPVIEW": &View{
+						Header: NewHeader("tmpview", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewString("1"),
+								value.NewString("str1"),
+							}),
+						},
+						FileInfo: &FileInfo{
+							Path:      "tmpview",
+							Delimiter: ',',
+							ViewType:  ViewTypeTemporaryTable,
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, nil),
+	},
+	{
+		Name: "Delete Query Multiple Table",
+		Query: parser.DeleteQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+			},
 			FromClause: parser.FromClause{
 				Tables: []parser.QueryExpression{
 					parser.Table{Object: parser.Join{
@@ -2984,6 +3481,42 @@ var deleteTests = []struct {
 		},
 		Error: "table notexist is not loaded",
 	},
+	{
+		Name: "Delete Query For Glob Pattern",
+		Query: parser.DeleteQuery{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "glob/log_*.csv"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("1"),
+					Operator: "=",
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("glob/log_1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+			{
+				Path:      GetTestFilePath("glob/log_2.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1, 1},
+	},
 }
 
 func TestDelete(t *testing.T) {
@@ -3157,6 +3690,81 @@ var createTableTests = []struct {
 			},
 		}),
 	},
+	{
+		Name: "Create Table With Column Definitions",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column:  parser.Identifier{Literal: "column1"},
+					Type:    parser.Identifier{Literal: "integer"},
+					NotNull: true,
+				},
+				parser.Identifier{Literal: "column2"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("1")},
+							parser.Field{Object: parser.NewStringValue("2")},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+			Schema: TableSchema{
+				{Name: "column1", Type: IntegerColumn, NotNull: true},
+			},
+		},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+					Schema: TableSchema{
+						{Name: "column1", Type: IntegerColumn, NotNull: true},
+					},
+				},
+				Header: NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewString("2"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Create Table Invalid Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column: parser.Identifier{Literal: "column1"},
+					Type:   parser.Identifier{Literal: "varchar"},
+				},
+			},
+		},
+		Error: "varchar is not a valid column type",
+	},
+	{
+		Name:  "Create Table From Select Query Not Null Violation Error",
+		Query: ParseTestStatement("CREATE TABLE `create_table_1.csv` (column1, column2 NOT NULL) AS SELECT 1, NULL").(parser.CreateTable),
+		Error: "[L:1 C:76] field column2 does not allow null",
+	},
 	{
 		Name: "Create Table File Already Exist Error",
 		Query: parser.CreateTable{
@@ -4299,7 +4907,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|GFM|ORG|TEXT",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -4463,6 +5071,32 @@ var setTableAttributeTests = []struct {
 			ForUpdate:   true,
 		},
 	},
+	{
+		Name: "Set Compression to GZIP",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "compression"},
+			Value:     parser.NewStringValue("gzip"),
+		},
+		Expect: &FileInfo{
+			Path:        GetTestFilePath("table1.csv"),
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			LineBreak:   text.LF,
+			Compression: cmd.GZIP,
+			ForUpdate:   true,
+		},
+	},
+	{
+		Name: "Set Compression Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "compression"},
+			Value:     parser.NewStringValue("invalid"),
+		},
+		Error: "compression must be one of NONE|GZIP|BZIP2|ZSTD",
+	},
 	{
 		Name: "Not Exist Table Error",
 		Query: parser.SetTableAttribute{
@@ -4580,3 +5214,162 @@ func TestSetTableAttribute(t *testing.T) {
 		}
 	}
 }
+
+var createIndexTests = []struct {
+	Name   string
+	Query  parser.CreateIndex
+	Result []string
+	Error  string
+}{
+	{
+		Name: "Create Index",
+		Query: parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx1"},
+			Table:  parser.Identifier{Literal: "table_index"},
+			Column: parser.Identifier{Literal: "grp"},
+		},
+		Result: []string{"idx1"},
+	},
+	{
+		Name: "Create Index Already Exist Error",
+		Query: parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx1"},
+			Table:  parser.Identifier{Literal: "table_index"},
+			Column: parser.Identifier{Literal: "id"},
+		},
+		Error: fmt.Sprintf("index idx1 already exists on %s", GetTestFilePath("table_index.csv")),
+	},
+	{
+		Name: "Create Index Field Not Exist Error",
+		Query: parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx2"},
+			Table:  parser.Identifier{Literal: "table_index"},
+			Column: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "Create Index Invalid Name Error",
+		Query: parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx-2"},
+			Table:  parser.Identifier{Literal: "table_index"},
+			Column: parser.Identifier{Literal: "id"},
+		},
+		Error: "idx-2 is not a valid index name",
+	},
+	{
+		Name: "Create Index Not Supported Error",
+		Query: parser.CreateIndex{
+			Name:   parser.Identifier{Literal: "idx2"},
+			Table:  parser.Identifier{Literal: "table.json"},
+			Column: parser.Identifier{Literal: "item1"},
+		},
+		Error: fmt.Sprintf("index cannot be created on %s: format JSON is not supported", GetTestFilePath("table.json")),
+	},
+}
+
+func TestCreateIndex(t *testing.T) {
+	defer func() {
+		_ = RemoveTableIndex(GetTestFilePath("table_index.csv"), "idx1")
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	scope := NewReferenceScope(TestTx)
+	ctx := context.Background()
+	for _, v := range createIndexTests {
+		result, err := CreateIndex(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		names, _ := TableIndexNames(result.Path)
+		if !reflect.DeepEqual(names, v.Result) {
+			t.Errorf("%s: indexes = %v, want %v", v.Name, names, v.Result)
+		}
+	}
+}
+
+var dropIndexTests = []struct {
+	Name   string
+	Query  parser.DropIndex
+	Result []string
+	Error  string
+}{
+	{
+		Name: "Drop Index",
+		Query: parser.DropIndex{
+			Name:  parser.Identifier{Literal: "idx1"},
+			Table: parser.Identifier{Literal: "table_index"},
+		},
+		Result: []string{},
+	},
+	{
+		Name: "Drop Index Not Exist Error",
+		Query: parser.DropIndex{
+			Name:  parser.Identifier{Literal: "idx1"},
+			Table: parser.Identifier{Literal: "table_index"},
+		},
+		Error: fmt.Sprintf("index idx1 does not exist on %s", GetTestFilePath("table_index.csv")),
+	},
+	{
+		Name: "Drop Index Invalid Name Error",
+		Query: parser.DropIndex{
+			Name:  parser.Identifier{Literal: "idx.1"},
+			Table: parser.Identifier{Literal: "table_index"},
+		},
+		Error: "idx.1 is not a valid index name",
+	},
+}
+
+func TestDropIndex(t *testing.T) {
+	defer func() {
+		_ = RemoveTableIndex(GetTestFilePath("table_index.csv"), "idx1")
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	scope := NewReferenceScope(TestTx)
+	ctx := context.Background()
+	if _, err := CreateIndex(ctx, scope, parser.CreateIndex{
+		Name:   parser.Identifier{Literal: "idx1"},
+		Table:  parser.Identifier{Literal: "table_index"},
+		Column: parser.Identifier{Literal: "id"},
+	}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for _, v := range dropIndexTests {
+		result, err := DropIndex(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		names, _ := TableIndexNames(result.Path)
+		if !reflect.DeepEqual(names, v.Result) {
+			t.Errorf("%s: indexes = %v, want %v", v.Name, names, v.Result)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var RegExps = NewRegExpMap()
+
+type RegExpMap struct {
+	m *sync.Map
+}
+
+func NewRegExpMap() *RegExpMap {
+	return &RegExpMap{
+		m: &sync.Map{},
+	}
+}
+
+func (rmap *RegExpMap) Store(key string, value *regexp.Regexp) {
+	rmap.m.Store(key, value)
+}
+
+func (rmap *RegExpMap) Load(key string) (*regexp.Regexp, bool) {
+	v, ok := rmap.m.Load(key)
+	if ok && v != nil {
+		return v.(*regexp.Regexp), ok
+	}
+	return nil, ok
+}
+
+func (rmap *RegExpMap) Get(pattern string, flags string) (*regexp.Regexp, error) {
+	if 0 < len(flags) {
+		pattern = "(?" + strings.ToLower(flags) + ")" + pattern
+	}
+
+	if r, ok := rmap.Load(pattern); ok {
+		return r, nil
+	}
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	rmap.Store(pattern, r)
+	return r, nil
+}
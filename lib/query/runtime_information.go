@@ -16,6 +16,9 @@ const (
 	LoadedTablesInformation = "LOADED_TABLES"
 	WorkingDirectory        = "WORKING_DIRECTORY"
 	VersionInformation      = "VERSION"
+	ErrorCodeInformation    = "ERROR_CODE"
+	ErrorNumberInformation  = "ERROR_NUMBER"
+	ErrorMessageInformation = "ERROR_MESSAGE"
 )
 
 var RuntimeInformatinList = []string{
@@ -26,6 +29,9 @@ var RuntimeInformatinList = []string{
 	LoadedTablesInformation,
 	WorkingDirectory,
 	VersionInformation,
+	ErrorCodeInformation,
+	ErrorNumberInformation,
+	ErrorMessageInformation,
 }
 
 func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (value.Primary, error) {
@@ -50,6 +56,24 @@ func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (val
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case ErrorCodeInformation:
+		if tx.caughtError == nil {
+			p = value.NewNull()
+		} else {
+			p = value.NewInteger(int64(tx.caughtError.Code()))
+		}
+	case ErrorNumberInformation:
+		if tx.caughtError == nil {
+			p = value.NewNull()
+		} else {
+			p = value.NewInteger(int64(tx.caughtError.Number()))
+		}
+	case ErrorMessageInformation:
+		if tx.caughtError == nil {
+			p = value.NewNull()
+		} else {
+			p = value.NewString(tx.caughtError.Message())
+		}
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
@@ -42,6 +42,14 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "version"},
 		Expect: value.NewString("v1.0.0"),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_code"},
+		Expect: value.NewNull(),
+	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_message"},
+		Expect: value.NewNull(),
+	},
 	{
 		Input: parser.RuntimeInformation{Name: "invalid"},
 		Error: "@#invalid is an unknown runtime information",
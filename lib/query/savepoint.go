@@ -0,0 +1,166 @@
+package query
+
+import (
+	"strings"
+)
+
+type temporaryTableSnapshot struct {
+	tables ViewMap
+	views  map[string]*View
+}
+
+// Savepoint holds references to the views at the time it was created.
+// Views stored in a ViewMap are replaced rather than modified by update operations,
+// so the references are enough to restore them.
+type Savepoint struct {
+	Name string
+
+	cachedViews     map[string]*View
+	created         map[string]*FileInfo
+	updated         map[string]*FileInfo
+	fileInfo        map[*FileInfo]FileInfo
+	temporaryTables []temporaryTableSnapshot
+}
+
+func NewSavepoint(name string, tx *Transaction, scope *ReferenceScope) *Savepoint {
+	sp := &Savepoint{
+		Name:        name,
+		cachedViews: make(map[string]*View),
+		created:     make(map[string]*FileInfo),
+		updated:     make(map[string]*FileInfo),
+		fileInfo:    make(map[*FileInfo]FileInfo),
+	}
+
+	tx.cachedViews.Range(func(key, value interface{}) bool {
+		sp.storeView(sp.cachedViews, key.(string), value.(*View))
+		return true
+	})
+
+	tx.uncommittedViews.mtx.RLock()
+	for k, v := range tx.uncommittedViews.Created {
+		sp.created[k] = v
+	}
+	for k, v := range tx.uncommittedViews.Updated {
+		sp.updated[k] = v
+	}
+	tx.uncommittedViews.mtx.RUnlock()
+
+	if scope != nil {
+		for i := range scope.blocks {
+			snapshot := temporaryTableSnapshot{
+				tables: scope.blocks[i].temporaryTables,
+				views:  make(map[string]*View),
+			}
+			scope.blocks[i].temporaryTables.Range(func(key, value interface{}) bool {
+				sp.storeView(snapshot.views, key.(string), value.(*View))
+				return true
+			})
+			sp.temporaryTables = append(sp.temporaryTables, snapshot)
+		}
+	}
+	return sp
+}
+
+func (sp *Savepoint) storeView(views map[string]*View, key string, view *View) {
+	views[key] = view
+	if view.FileInfo != nil {
+		if _, ok := sp.fileInfo[view.FileInfo]; !ok {
+			sp.fileInfo[view.FileInfo] = *view.FileInfo
+		}
+	}
+}
+
+// Restore puts the views back to the state at the time the savepoint was created,
+// and returns the number of restored views.
+// Tables changed after they were loaded following the savepoint are disposed so that they are reloaded from the files,
+// and temporary tables declared after the savepoint are restored to their last restore points.
+// File handlers and lock states are not restored.
+func (sp *Savepoint) Restore(tx *Transaction, scope *ReferenceScope) (int, error) {
+	cnt := 0
+	uncommitted := func(fileInfo *FileInfo) bool {
+		ukey := strings.ToUpper(fileInfo.Path)
+		if _, ok := tx.uncommittedViews.Created[ukey]; ok {
+			return true
+		}
+		_, ok := tx.uncommittedViews.Updated[ukey]
+		return ok
+	}
+
+	for _, key := range tx.cachedViews.Keys() {
+		view, _ := tx.cachedViews.Load(key)
+		if old, ok := sp.cachedViews[key]; ok {
+			if old != view {
+				tx.cachedViews.Store(key, old)
+				cnt++
+			}
+			continue
+		}
+
+		if uncommitted(view.FileInfo) {
+			if err := tx.cachedViews.Dispose(tx.FileContainer, key); err != nil {
+				return cnt, err
+			}
+			cnt++
+		}
+	}
+
+	if scope != nil {
+		for i := range scope.blocks {
+			tables := scope.blocks[i].temporaryTables
+			var snapshot map[string]*View
+			for _, ts := range sp.temporaryTables {
+				if ts.tables.SyncMap == tables.SyncMap {
+					snapshot = ts.views
+					break
+				}
+			}
+
+			for _, key := range tables.Keys() {
+				view, _ := tables.Load(key)
+				if old, ok := snapshot[key]; ok {
+					if old != view {
+						tables.Store(key, old)
+						cnt++
+					}
+					continue
+				}
+
+				if uncommitted(view.FileInfo) && !view.FileInfo.IsStdin() {
+					restored := &View{FileInfo: view.FileInfo}
+					restored.Restore()
+					tables.Store(key, restored)
+					cnt++
+				}
+			}
+		}
+	}
+
+	for fileInfo, saved := range sp.fileInfo {
+		handler, forUpdate := fileInfo.Handler, fileInfo.ForUpdate
+		*fileInfo = saved
+		fileInfo.Handler, fileInfo.ForUpdate = handler, forUpdate
+	}
+
+	tx.uncommittedViews.Clean()
+	tx.uncommittedViews.mtx.Lock()
+	for k, v := range sp.created {
+		tx.uncommittedViews.Created[k] = v
+	}
+	for k, v := range sp.updated {
+		tx.uncommittedViews.Updated[k] = v
+	}
+	tx.uncommittedViews.mtx.Unlock()
+
+	return cnt, nil
+}
+
+type Savepoints []*Savepoint
+
+func (list Savepoints) Index(name string) int {
+	for i := len(list) - 1; 0 <= i; i-- {
+		if strings.EqualFold(list[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
@@ -19,6 +19,7 @@ const (
 	IntegerType
 	FloatType
 	DatetimeType
+	IntervalType
 	BooleanType
 	StringType
 )
@@ -82,6 +83,8 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 			serializeFloat(buf, val.Float)
 		case DatetimeType:
 			serializeDatetimeFromUnixNano(buf, val.Datetime)
+		case IntervalType:
+			serializeInterval(buf, val.Interval)
 		case StringType:
 			serializeString(buf, val.String)
 		}
@@ -94,6 +97,7 @@ type SortValue struct {
 	Integer  int64
 	Float    float64
 	Datetime int64
+	Interval *value.Interval
 	String   string
 }
 
@@ -122,6 +126,9 @@ func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
 		sortValue.Type = DatetimeType
 		sortValue.Datetime = t.UnixNano()
 		value.Discard(dt)
+	} else if iv, ok := val.(*value.Interval); ok {
+		sortValue.Type = IntervalType
+		sortValue.Interval = iv
 	} else if b := value.ToBoolean(val); !value.IsNull(b) {
 		sortValue.Type = BooleanType
 		if b.(*value.Boolean).Raw() {
@@ -171,6 +178,15 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			}
 			return ternary.ConvertFromBool(v.Datetime < compareValue.Datetime)
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			c := v.Interval.Cmp(compareValue.Interval)
+			if c == 0 {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(c < 0)
+		}
 	case StringType:
 		switch compareValue.Type {
 		case IntegerType, FloatType, StringType:
@@ -201,6 +217,11 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 		case DatetimeType:
 			return v.Datetime == compareValue.Datetime
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			return v.Interval.Cmp(compareValue.Interval) == 0
+		}
 	case BooleanType:
 		switch compareValue.Type {
 		case BooleanType, IntegerType:
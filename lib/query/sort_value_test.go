@@ -103,6 +103,18 @@ var sortValueLessTests = []struct {
 		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), TestTx.Flags),
 		Result:       ternary.UNKNOWN,
 	},
+	{
+		Name:         "SortValue Less Interval",
+		SortValue:    NewSortValue(value.NewInterval(0, 29, 0), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInterval(1, 0, 0), TestTx.Flags),
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "SortValue Less Interval Equal",
+		SortValue:    NewSortValue(value.NewInterval(0, 1, 0), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInterval(0, 0, int64(24*time.Hour)), TestTx.Flags),
+		Result:       ternary.UNKNOWN,
+	},
 	{
 		Name:         "SortValue Less String",
 		SortValue:    NewSortValue(value.NewString("aaa"), TestTx.Flags),
@@ -180,6 +192,12 @@ var sortValueEquivalentToTests = []struct {
 		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), TestTx.Flags),
 		Result:       true,
 	},
+	{
+		Name:         "SortValue EquivalentTo Interval",
+		SortValue:    NewSortValue(value.NewInterval(0, 1, 0), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInterval(0, 0, int64(24*time.Hour)), TestTx.Flags),
+		Result:       true,
+	},
 	{
 		Name:         "SortValue EquivalentTo Boolean",
 		SortValue:    NewSortValue(value.NewBoolean(true), TestTx.Flags),
@@ -0,0 +1,327 @@
+package query
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const streamingBatchSize = 300
+
+// StreamSelect executes a select query by reading records from a file sequentially and
+// writing the results to fp without loading the whole file into memory.
+// If the query cannot be executed in streaming mode, it returns false without executing the query.
+func StreamSelect(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery, fp io.Writer, fileInfo *FileInfo) (bool, error) {
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV, cmd.JSON, cmd.JSONL:
+	default:
+		return false, nil
+	}
+
+	entity, table, inputInfo, ok := streamingTarget(scope, query)
+	if !ok {
+		return false, nil
+	}
+
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	if query.WithClause != nil {
+		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
+			return true, err
+		}
+	}
+
+	return true, streamSelect(ctx, queryScope, query, entity, table, inputInfo, fp, fileInfo)
+}
+
+func streamSelect(
+	ctx context.Context,
+	scope *ReferenceScope,
+	query parser.SelectQuery,
+	entity parser.SelectEntity,
+	table parser.Table,
+	inputInfo *FileInfo,
+	fp io.Writer,
+	fileInfo *FileInfo,
+) (err error) {
+	offset := 0
+	limit := -1
+	if query.LimitClause != nil {
+		limitClause := query.LimitClause.(parser.LimitClause)
+		if limitClause.OffsetClause != nil {
+			if offset, err = evalOffset(ctx, scope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+				return err
+			}
+		}
+		if !limitClause.Type.IsEmpty() {
+			if limit, err = evalLimit(ctx, scope, limitClause); err != nil {
+				return err
+			}
+		}
+	}
+
+	tableIdentifier := table.Object.(parser.Identifier)
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, inputInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		tableIdentifier.Literal = inputInfo.Path
+		return ConvertFileHandlerError(err, tableIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	var dataParsingError = func(err error) error {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tableIdentifier, inputInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	reader, columns, err := newCSVReader(h.File(), inputInfo, scope.Tx.Flags.WithoutNull, tableIdentifier)
+	if err != nil {
+		return dataParsingError(err)
+	}
+
+	if fileInfo.Format == cmd.JSON {
+		defer scope.Tx.UseColor(scope.Tx.Flags.Color)
+	}
+
+	var header Header
+	var enc recordEncoder
+	cnt := 0
+
+	for {
+		records, err := readRecords(ctx, reader, streamingBatchSize)
+		if err != nil {
+			return dataParsingError(err)
+		}
+
+		if header == nil {
+			if columns == nil {
+				columns = autofillHeader(reader.FieldsPerRecord)
+			}
+			header = NewHeader(parser.FormatTableName(inputInfo.Path), columns)
+			if !strings.EqualFold(parser.FormatTableName(inputInfo.Path), table.Name().Literal) {
+				if err = header.Update(table.Name().Literal, nil); err != nil {
+					return err
+				}
+			}
+		}
+
+		view := NewView()
+		view.Header = header.Copy()
+		view.RecordSet = records
+		view.FileInfo = inputInfo
+
+		if entity.WhereClause != nil {
+			if err = view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
+				return err
+			}
+		}
+		if err = view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+			return err
+		}
+		if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
+			return err
+		}
+
+		if enc == nil {
+			if enc, err = newRecordEncoder(fp, view.Header, fileInfo, scope.Tx); err != nil {
+				return err
+			}
+		}
+
+		for _, record := range view.RecordSet {
+			if cnt == offset+limit && -1 < limit {
+				break
+			}
+			if offset <= cnt {
+				if err = enc.Write(record); err != nil {
+					return err
+				}
+			}
+			cnt++
+		}
+
+		if len(records) < streamingBatchSize || (-1 < limit && cnt == offset+limit) {
+			break
+		}
+	}
+
+	return enc.Flush()
+}
+
+// streamingTarget returns the select entity, the table and the information of the file to read
+// if the query can be executed by evaluating each record of the file independently.
+func streamingTarget(scope *ReferenceScope, query parser.SelectQuery) (parser.SelectEntity, parser.Table, *FileInfo, bool) {
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || query.ForUpdate || query.OrderByClause != nil {
+		return entity, parser.Table{}, nil, false
+	}
+	if entity.IntoClause != nil || entity.GroupByClause != nil || entity.HavingClause != nil || entity.FromClause == nil {
+		return entity, parser.Table{}, nil, false
+	}
+	if query.LimitClause != nil && query.LimitClause.(parser.LimitClause).Percentage() {
+		return entity, parser.Table{}, nil, false
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return entity, parser.Table{}, nil, false
+	}
+	fields := make([]parser.QueryExpression, len(selectClause.Fields))
+	for i, f := range selectClause.Fields {
+		fields[i] = f.(parser.Field).Object
+	}
+	if containsAggregateFunction(scope, fields...) || containsAnalyticFunction(fields...) {
+		return entity, parser.Table{}, nil, false
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return entity, parser.Table{}, nil, false
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok {
+		return entity, parser.Table{}, nil, false
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return entity, parser.Table{}, nil, false
+	}
+
+	if query.WithClause != nil {
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			if strings.EqualFold(v.(parser.InlineTable).Name.Literal, tableIdentifier.Literal) {
+				return entity, parser.Table{}, nil, false
+			}
+		}
+	}
+	if scope.RecursiveTable != nil && strings.EqualFold(scope.RecursiveTable.Name.Literal, tableIdentifier.Literal) {
+		return entity, parser.Table{}, nil, false
+	}
+	if scope.InlineTableExists(tableIdentifier) || scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return entity, parser.Table{}, nil, false
+	}
+
+	inputInfo, ok := streamingFileInfo(scope, tableIdentifier)
+	if !ok {
+		return entity, parser.Table{}, nil, false
+	}
+
+	// The file is kept open while streaming, so the other references to the file must be read
+	// from the cached view in the normal path.
+	if referToFile(scope, inputInfo.Path, query.WithClause, entity.SelectClause, entity.WhereClause, query.LimitClause) {
+		return entity, parser.Table{}, nil, false
+	}
+	if _, _, ok := indexScanTarget(scope, table, entity.WhereClause); ok {
+		return entity, parser.Table{}, nil, false
+	}
+
+	return entity, table, inputInfo, true
+}
+
+// streamingFileInfo returns the information of a file that has not been loaded in the transaction.
+func streamingFileInfo(scope *ReferenceScope, tableIdentifier parser.Identifier) (*FileInfo, bool) {
+	filePath, ok := scope.LoadFilePath(tableIdentifier.Literal)
+	if !ok {
+		p, err := CreateFilePath(tableIdentifier, scope.Tx.Flags.Repository)
+		if err != nil {
+			return nil, false
+		}
+		filePath = p
+	}
+	if _, ok := scope.Tx.cachedViews.Load(filePath); ok {
+		return nil, false
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, cmd.AutoSelect, scope.Tx.Flags.Delimiter, scope.Tx.Flags.Encoding, scope.Tx.Flags)
+	if err != nil {
+		return nil, false
+	}
+	if _, ok := scope.Tx.cachedViews.Load(fileInfo.Path); ok {
+		return nil, false
+	}
+
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+	default:
+		return nil, false
+	}
+	if fileInfo.Compression != cmd.NoCompression || fileInfo.Schema != nil {
+		return nil, false
+	}
+
+	fileInfo.LineBreak = scope.Tx.Flags.LineBreak
+	fileInfo.NoHeader = scope.Tx.Flags.NoHeader
+	return fileInfo, true
+}
+
+// referToFile returns whether the expressions include tables that can be loaded from the file,
+// or user defined functions that can load any file.
+func referToFile(scope *ReferenceScope, fpath string, exprs ...parser.QueryExpression) bool {
+	found := false
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		walkQueryExpression(expr, func(e parser.QueryExpression) bool {
+			if found {
+				return false
+			}
+			switch e.(type) {
+			case parser.Table:
+				var path parser.QueryExpression
+				switch obj := e.(parser.Table).Object.(type) {
+				case parser.Identifier:
+					path = obj
+				case parser.TableObject:
+					path = obj.Path
+				}
+				if ident, ok := path.(parser.Identifier); ok {
+					p, ok := scope.LoadFilePath(ident.Literal)
+					if !ok {
+						p, _ = SearchFilePathFromAllTypes(ident, scope.Tx.Flags.Repository)
+					}
+					if p == fpath {
+						found = true
+					}
+				}
+			case parser.Function:
+				if _, err := scope.GetFunction(e, e.(parser.Function).Name); err == nil {
+					found = true
+				}
+			}
+			return !found
+		})
+		if found {
+			break
+		}
+	}
+	return found
+}
+
+func containsAnalyticFunction(exprs ...parser.QueryExpression) bool {
+	found := false
+	for _, expr := range exprs {
+		walkQueryExpression(expr, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.Subquery:
+				return false
+			case parser.AnalyticFunction:
+				found = true
+			}
+			return !found
+		})
+		if found {
+			break
+		}
+	}
+	return found
+}
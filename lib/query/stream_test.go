@@ -0,0 +1,302 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+)
+
+var streamSelectTests = []struct {
+	Name     string
+	Query    parser.SelectQuery
+	FileInfo *FileInfo
+	Streamed bool
+	Result   string
+	Error    string
+}{
+	{
+		Name: "Stream Select",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Alias: parser.Identifier{Literal: "id"}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: ">",
+					},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: true,
+		Result: "column2,id\n" +
+			"str2,2\n" +
+			"str3,3",
+	},
+	{
+		Name: "Stream Select with Offset and Limit",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("1"),
+				OffsetClause: parser.OffsetClause{
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:      cmd.JSON,
+			LineBreak:   text.LF,
+			PrettyPrint: true,
+		},
+		Streamed: true,
+		Result: "[\n" +
+			"  {\n" +
+			"    \"column1\": \"2\",\n" +
+			"    \"column2\": \"str2\"\n" +
+			"  }\n" +
+			"]",
+	},
+	{
+		Name: "Stream Select Empty Result",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.NewTernaryValueFromString("false"),
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.JSON,
+			LineBreak: text.LF,
+		},
+		Streamed: true,
+		Result:   "[]",
+	},
+	{
+		Name: "Stream Select Not Streamable Query",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Not Streamable Subquery on the Same Table",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.In{
+						LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Values: parser.Subquery{
+							Query: parser.SelectQuery{
+								SelectEntity: parser.SelectEntity{
+									SelectClause: parser.SelectClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+										},
+									},
+									FromClause: parser.FromClause{
+										Tables: []parser.QueryExpression{
+											parser.Table{Object: parser.Identifier{Literal: "table1.csv"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Not Streamable Function",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Not Streamable Format",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.TEXT,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Field Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}), Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		Streamed: true,
+		Error:    "[L:1 C:1] field notexist does not exist",
+	},
+}
+
+func TestStreamSelect(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range streamSelectTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		buf := &bytes.Buffer{}
+		streamed, err := StreamSelect(ctx, NewReferenceScope(TestTx), v.Query, buf, v.FileInfo)
+		if streamed != v.Streamed {
+			t.Errorf("%s: streamed = %t, want %t", v.Name, streamed, v.Streamed)
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+		if _, ok := TestTx.cachedViews.Load(GetTestFilePath("table1.csv")); ok && streamed {
+			t.Errorf("%s: file is loaded in streaming mode", v.Name)
+		}
+	}
+}
@@ -161,6 +161,8 @@ func (f *StringFormatter) Format(format string, values []value.Primary) (string,
 				s = values[placeholderOrder].(*value.String).Raw()
 			case *value.Datetime:
 				s = values[placeholderOrder].(*value.Datetime).Format(time.RFC3339Nano)
+			case *value.Interval:
+				s = values[placeholderOrder].(*value.Interval).Literal()
 			default:
 				s = values[placeholderOrder].String()
 			}
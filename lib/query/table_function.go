@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func loadViewFromTableFunction(ctx context.Context, scope *ReferenceScope, fn parser.TableFunction, tableName string) (*View, error) {
+	args := make([]value.Primary, len(fn.Args))
+	for i, v := range fn.Args {
+		p, err := Evaluate(ctx, scope, v)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = p
+	}
+
+	switch strings.ToUpper(fn.Name) {
+	case "STRING_SPLIT":
+		return StringSplit(fn, args, tableName)
+	}
+	return nil, NewFunctionNotExistError(fn, fn.Name)
+}
+
+func StringSplit(fn parser.TableFunction, args []value.Primary, tableName string) (*View, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a string")
+	}
+	separator := sep.(*value.String).Raw()
+	value.Discard(sep)
+	if len(separator) < 1 {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the separator must not be empty")
+	}
+
+	view := &View{
+		Header:    NewHeader(tableName, []string{"value", "ordinal"}),
+		RecordSet: RecordSet{},
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return view, nil
+	}
+	list := strings.Split(s.(*value.String).Raw(), separator)
+	value.Discard(s)
+
+	view.RecordSet = make(RecordSet, len(list))
+	for i := range list {
+		view.RecordSet[i] = NewRecord([]value.Primary{
+			value.NewString(list[i]),
+			value.NewInteger(int64(i + 1)),
+		})
+	}
+	return view, nil
+}
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var stringSplitTests = []struct {
+	Name   string
+	Args   []value.Primary
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name: "StringSplit",
+		Args: []value.Primary{value.NewString("a,,b"), value.NewString(",")},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString(""), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(3)}),
+		},
+	},
+	{
+		Name:   "StringSplit Null",
+		Args:   []value.Primary{value.NewNull(), value.NewString(",")},
+		Result: RecordSet{},
+	},
+	{
+		Name:  "StringSplit Arguments Error",
+		Args:  []value.Primary{value.NewString("a,b")},
+		Error: "function string_split takes exactly 2 arguments",
+	},
+	{
+		Name:  "StringSplit Empty Separator Error",
+		Args:  []value.Primary{value.NewString("a,b"), value.NewString("")},
+		Error: "the separator must not be empty for function string_split",
+	},
+}
+
+func TestStringSplit(t *testing.T) {
+	fn := parser.TableFunction{Name: "string_split"}
+	for _, v := range stringSplitTests {
+		result, err := StringSplit(fn, v.Args, "s")
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header, NewHeader("s", []string{"value", "ordinal"})) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, NewHeader("s", []string{"value", "ordinal"}))
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+const IndexFileExtension = ".idx"
+
+var errIndexFieldNotExist = errors.New("field does not exist")
+
+var utf8BOM = []byte{0xef, 0xbb, 0xbf}
+
+// TableIndex holds the byte offsets of the records in a file grouped by the values of a column.
+// FileSize and ModTime are used to detect modifications of the file that were made after the index was built.
+type TableIndex struct {
+	Name      string
+	Column    string
+	Delimiter rune
+	NoHeader  bool
+	FileSize  int64
+	ModTime   int64
+	Keys      []IndexKey
+}
+
+type IndexKey struct {
+	Text    string
+	Null    bool
+	Offsets []int64
+}
+
+func IndexFilePath(fpath string, name string) string {
+	return fpath + "." + name + IndexFileExtension
+}
+
+func ValidIndexName(name string) bool {
+	if len(name) < 1 {
+		return false
+	}
+	for _, r := range name {
+		if !(('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// TableIndexNames returns the names of the indexes placed next to the file.
+func TableIndexNames(fpath string) ([]string, error) {
+	dir, base := filepath.Split(fpath)
+	if len(dir) < 1 {
+		dir = "."
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := base + "."
+	names := make([]string, 0, 2)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), prefix) || !strings.HasSuffix(f.Name(), IndexFileExtension) {
+			continue
+		}
+		name := f.Name()[len(prefix) : len(f.Name())-len(IndexFileExtension)]
+		if ValidIndexName(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func LoadTableIndex(fpath string, name string) (*TableIndex, error) {
+	fp, err := os.Open(IndexFilePath(fpath, name))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	idx := &TableIndex{}
+	if err = gob.NewDecoder(bufio.NewReader(fp)).Decode(idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func (idx *TableIndex) Write(fpath string) error {
+	fp, err := os.Create(IndexFilePath(fpath, idx.Name))
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(fp)
+	if err = gob.NewEncoder(w).Encode(idx); err == nil {
+		err = w.Flush()
+	}
+	return appendCompositeError(err, fp.Close())
+}
+
+func RemoveTableIndex(fpath string, name string) error {
+	if err := os.Remove(IndexFilePath(fpath, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// IsValid returns true if the file has not been modified since the index was built.
+func (idx *TableIndex) IsValid(fpath string) bool {
+	fi, err := os.Stat(fpath)
+	if err != nil {
+		return false
+	}
+	return fi.Size() == idx.FileSize && fi.ModTime().UnixNano() == idx.ModTime
+}
+
+// Offsets returns the sorted offsets of the records that have the keys satisfying the condition.
+func (idx *TableIndex) Offsets(schema TableSchema, withoutNull bool, datetimeFormats []string, fn func(value.Primary) bool) []int64 {
+	columnType := UntypedColumn
+	if c, ok := schema.Column(idx.Column); ok {
+		columnType = c.Type
+	}
+
+	offsets := make([]int64, 0, 16)
+	for _, key := range idx.Keys {
+		var p value.Primary
+		if key.Null {
+			if withoutNull {
+				p = value.NewString("")
+			} else {
+				p = value.NewNull()
+			}
+		} else {
+			p = value.NewString(key.Text)
+		}
+		if columnType != UntypedColumn {
+			p, _ = ConvertToColumnType(p, columnType, datetimeFormats)
+		}
+
+		if fn(p) {
+			offsets = append(offsets, key.Offsets...)
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets
+}
+
+func indexUnsupportedReason(fileInfo *FileInfo) string {
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+	default:
+		return fmt.Sprintf("format %s is not supported", fileInfo.Format)
+	}
+	if fileInfo.Compression != cmd.NoCompression {
+		return "compressed files are not supported"
+	}
+	switch fileInfo.Delimiter {
+	case '"', '\r', '\n':
+		return fmt.Sprintf("delimiter %s is not supported", cmd.QuoteString(string(fileInfo.Delimiter)))
+	}
+	if 0x80 <= fileInfo.Delimiter {
+		return fmt.Sprintf("delimiter %s is not supported", cmd.QuoteString(string(fileInfo.Delimiter)))
+	}
+	switch fileInfo.Encoding {
+	case text.UTF8, text.UTF8M:
+	default:
+		return fmt.Sprintf("encoding %s is not supported", fileInfo.Encoding)
+	}
+	return ""
+}
+
+// BuildTableIndex reads the file and builds an index on the column.
+// The encoding of the file must be detected before calling this function.
+func BuildTableIndex(ctx context.Context, fp *os.File, fileInfo *FileInfo, name string, column string) (*TableIndex, error) {
+	if reason := indexUnsupportedReason(fileInfo); 0 < len(reason) {
+		return nil, errors.New(reason)
+	}
+
+	fi, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	scanner := newIndexScanner(fp, byte(fileInfo.Delimiter))
+	if fileInfo.Encoding == text.UTF8M {
+		if err = scanner.skipBOM(); err != nil {
+			return nil, err
+		}
+	}
+
+	columnIdx := -1
+	if !fileInfo.NoHeader {
+		_, header, err := scanner.scanRecord()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		for i, v := range header {
+			if strings.EqualFold(string(v), column) {
+				columnIdx = i
+				break
+			}
+		}
+	} else if strings.HasPrefix(strings.ToLower(column), "c") {
+		if n, err := strconv.Atoi(column[1:]); err == nil && 0 < n {
+			columnIdx = n - 1
+		}
+	}
+	if columnIdx < 0 {
+		return nil, errIndexFieldNotExist
+	}
+
+	keys := make(map[string]*IndexKey, 1000)
+	nullKey := &IndexKey{Null: true}
+
+	for i := 0; ; i++ {
+		if i&1023 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		offset, fields, err := scanner.scanRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) <= columnIdx {
+			if fileInfo.NoHeader && i == 0 {
+				return nil, errIndexFieldNotExist
+			}
+			return nil, errors.New(fmt.Sprintf("wrong number of fields in the record at offset %d", offset))
+		}
+
+		field := fields[columnIdx]
+		if field == nil {
+			nullKey.Offsets = append(nullKey.Offsets, offset)
+			continue
+		}
+		if key, ok := keys[string(field)]; ok {
+			key.Offsets = append(key.Offsets, offset)
+		} else {
+			keys[string(field)] = &IndexKey{Text: string(field), Offsets: []int64{offset}}
+		}
+	}
+
+	idx := &TableIndex{
+		Name:      name,
+		Column:    column,
+		Delimiter: fileInfo.Delimiter,
+		NoHeader:  fileInfo.NoHeader,
+		FileSize:  fi.Size(),
+		ModTime:   fi.ModTime().UnixNano(),
+		Keys:      make([]IndexKey, 0, len(keys)+1),
+	}
+	if 0 < len(nullKey.Offsets) {
+		idx.Keys = append(idx.Keys, *nullKey)
+	}
+	for _, key := range keys {
+		idx.Keys = append(idx.Keys, *key)
+	}
+	sort.Slice(idx.Keys, func(i, j int) bool {
+		if idx.Keys[i].Null != idx.Keys[j].Null {
+			return idx.Keys[i].Null
+		}
+		return idx.Keys[i].Text < idx.Keys[j].Text
+	})
+	return idx, nil
+}
+
+// indexScanner splits a CSV file into records and keeps the byte offset of each record.
+type indexScanner struct {
+	reader    *bufio.Reader
+	delimiter byte
+	offset    int64
+}
+
+func newIndexScanner(r io.Reader, delimiter byte) *indexScanner {
+	return &indexScanner{
+		reader:    bufio.NewReader(r),
+		delimiter: delimiter,
+	}
+}
+
+func (s *indexScanner) skipBOM() error {
+	b, err := s.reader.Peek(len(utf8BOM))
+	if err == nil && bytes.Equal(b, utf8BOM) {
+		n, err := s.reader.Discard(len(utf8BOM))
+		s.offset += int64(n)
+		return err
+	}
+	return nil
+}
+
+func (s *indexScanner) readByte() (byte, error) {
+	b, err := s.reader.ReadByte()
+	if err == nil {
+		s.offset++
+	}
+	return b, err
+}
+
+func (s *indexScanner) skipLineFeed() error {
+	b, err := s.reader.Peek(1)
+	if err == nil && b[0] == '\n' {
+		_, err = s.readByte()
+		return err
+	}
+	return nil
+}
+
+// scanRecord returns the offset at the beginning of the next record and the fields of the record.
+// Empty fields that are not enclosed in double quotes are returned as nil.
+func (s *indexScanner) scanRecord() (int64, [][]byte, error) {
+	offset := s.offset
+	if _, err := s.reader.Peek(1); err != nil {
+		return offset, nil, err
+	}
+
+	fields := make([][]byte, 0, 8)
+	for {
+		field, last, err := s.scanField()
+		if err != nil {
+			return offset, nil, err
+		}
+		fields = append(fields, field)
+		if last {
+			break
+		}
+	}
+	return offset, fields, nil
+}
+
+func (s *indexScanner) scanField() ([]byte, bool, error) {
+	b, err := s.readByte()
+	if err != nil {
+		if err == io.EOF {
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+
+	if b == '"' {
+		field := make([]byte, 0, 16)
+		for {
+			if b, err = s.readByte(); err != nil {
+				if err == io.EOF {
+					return nil, false, errors.New(fmt.Sprintf("unterminated quoted field at offset %d", s.offset))
+				}
+				return nil, false, err
+			}
+			if b == '"' {
+				if next, err := s.reader.Peek(1); err == nil && next[0] == '"' {
+					_, _ = s.readByte()
+				} else {
+					break
+				}
+			}
+			field = append(field, b)
+		}
+
+		b, err = s.readByte()
+		switch {
+		case err == io.EOF:
+			return field, true, nil
+		case err != nil:
+			return nil, false, err
+		case b == s.delimiter:
+			return field, false, nil
+		case b == '\r':
+			return field, true, s.skipLineFeed()
+		case b == '\n':
+			return field, true, nil
+		}
+		return nil, false, errors.New(fmt.Sprintf("unexpected character after quoted field at offset %d", s.offset-1))
+	}
+
+	var field []byte
+	for {
+		switch b {
+		case s.delimiter:
+			return field, false, nil
+		case '\r':
+			return field, true, s.skipLineFeed()
+		case '\n':
+			return field, true, nil
+		}
+		field = append(field, b)
+
+		if b, err = s.readByte(); err != nil {
+			if err == io.EOF {
+				return field, true, nil
+			}
+			return nil, false, err
+		}
+	}
+}
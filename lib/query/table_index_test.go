@@ -0,0 +1,306 @@
+package query
+
+import (
+	"context"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+var indexScannerTests = []struct {
+	Name      string
+	Input     string
+	Delimiter byte
+	SkipBOM   bool
+	Offsets   []int64
+	Records   [][][]byte
+	Error     string
+}{
+	{
+		Name:      "Scan Records",
+		Input:     "a,b\n1,\"x,\"\"y\"\"\"\n,\"\"\n",
+		Delimiter: ',',
+		Offsets:   []int64{0, 4, 16},
+		Records: [][][]byte{
+			{[]byte("a"), []byte("b")},
+			{[]byte("1"), []byte("x,\"y\"")},
+			{nil, {}},
+		},
+	},
+	{
+		Name:      "Scan Records with CRLF and Line Break in Quoted Field",
+		Input:     "a\tb\r\n\"1\r\n2\"\t3\r\n4\t",
+		Delimiter: '\t',
+		Offsets:   []int64{0, 5, 15},
+		Records: [][][]byte{
+			{[]byte("a"), []byte("b")},
+			{[]byte("1\r\n2"), []byte("3")},
+			{[]byte("4"), nil},
+		},
+	},
+	{
+		Name:      "Scan Records with BOM",
+		Input:     "\xef\xbb\xbfa,b\n1,2",
+		Delimiter: ',',
+		SkipBOM:   true,
+		Offsets:   []int64{3, 7},
+		Records: [][][]byte{
+			{[]byte("a"), []byte("b")},
+			{[]byte("1"), []byte("2")},
+		},
+	},
+	{
+		Name:      "Scan Records Unterminated Quoted Field Error",
+		Input:     "a,\"b\n",
+		Delimiter: ',',
+		Error:     "unterminated quoted field at offset 5",
+	},
+}
+
+func TestIndexScanner(t *testing.T) {
+	for _, v := range indexScannerTests {
+		scanner := newIndexScanner(strings.NewReader(v.Input), v.Delimiter)
+		if v.SkipBOM {
+			if err := scanner.skipBOM(); err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+		}
+
+		var offsets []int64
+		var records [][][]byte
+		var err error
+		for {
+			var offset int64
+			var fields [][]byte
+			offset, fields, err = scanner.scanRecord()
+			if err != nil {
+				break
+			}
+			offsets = append(offsets, offset)
+			records = append(records, fields)
+		}
+		if err == io.EOF {
+			err = nil
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(offsets, v.Offsets) {
+			t.Errorf("%s: offsets = %v, want %v", v.Name, offsets, v.Offsets)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %q, want %q", v.Name, records, v.Records)
+		}
+	}
+}
+
+var buildTableIndexTests = []struct {
+	Name     string
+	FileInfo *FileInfo
+	Column   string
+	Result   []IndexKey
+	Error    string
+}{
+	{
+		Name: "BuildTableIndex",
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+		},
+		Column: "GRP",
+		Result: []IndexKey{
+			{Text: "a", Offsets: []int64{12, 33}},
+			{Text: "b", Offsets: []int64{21, 42}},
+		},
+	},
+	{
+		Name: "BuildTableIndex with Null",
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+		},
+		Column: "name",
+		Result: []IndexKey{
+			{Null: true, Offsets: []int64{42}},
+			{Text: "str,2", Offsets: []int64{21}},
+			{Text: "str1", Offsets: []int64{12}},
+			{Text: "str3", Offsets: []int64{33}},
+		},
+	},
+	{
+		Name: "BuildTableIndex with NoHeader",
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			NoHeader:  true,
+		},
+		Column: "c1",
+		Result: []IndexKey{
+			{Text: "1", Offsets: []int64{12}},
+			{Text: "2", Offsets: []int64{21}},
+			{Text: "3", Offsets: []int64{33}},
+			{Text: "4", Offsets: []int64{42}},
+			{Text: "id", Offsets: []int64{0}},
+		},
+	},
+	{
+		Name: "BuildTableIndex Field Not Exist Error",
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+		},
+		Column: "notexist",
+		Error:  "field does not exist",
+	},
+	{
+		Name: "BuildTableIndex Not Supported Format Error",
+		FileInfo: &FileInfo{
+			Format:   cmd.JSON,
+			Encoding: text.UTF8,
+		},
+		Column: "id",
+		Error:  "format JSON is not supported",
+	},
+	{
+		Name: "BuildTableIndex Not Supported Encoding Error",
+		FileInfo: &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.SJIS,
+		},
+		Column: "id",
+		Error:  "encoding SJIS is not supported",
+	},
+}
+
+func TestBuildTableIndex(t *testing.T) {
+	fp, err := os.Open(GetTestFilePath("table_index.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	for _, v := range buildTableIndexTests {
+		result, err := BuildTableIndex(context.Background(), fp, v.FileInfo, "idx", v.Column)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.Name != "idx" || result.Column != v.Column {
+			t.Errorf("%s: name = %s, column = %s, want idx, %s", v.Name, result.Name, result.Column, v.Column)
+		}
+		if !result.IsValid(GetTestFilePath("table_index.csv")) {
+			t.Errorf("%s: index is not valid", v.Name)
+		}
+		if !reflect.DeepEqual(result.Keys, v.Result) {
+			t.Errorf("%s: keys = %v, want %v", v.Name, result.Keys, v.Result)
+		}
+	}
+}
+
+func TestTableIndex_Write(t *testing.T) {
+	fpath := GetTestFilePath("table_index.csv")
+	idx := &TableIndex{
+		Name:      "idx_write",
+		Column:    "id",
+		Delimiter: ',',
+		Keys: []IndexKey{
+			{Text: "1", Offsets: []int64{12}},
+		},
+	}
+	defer func() {
+		_ = RemoveTableIndex(fpath, idx.Name)
+	}()
+
+	if err := idx.Write(fpath); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	names, err := TableIndexNames(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(names, []string{"idx_write"}) {
+		t.Errorf("names = %v, want %v", names, []string{"idx_write"})
+	}
+
+	result, err := LoadTableIndex(fpath, idx.Name)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, idx) {
+		t.Errorf("result = %v, want %v", result, idx)
+	}
+}
+
+func TestTableIndex_Offsets(t *testing.T) {
+	idx := &TableIndex{
+		Column: "id",
+		Keys: []IndexKey{
+			{Null: true, Offsets: []int64{5}},
+			{Text: "10", Offsets: []int64{40, 1}},
+			{Text: "2", Offsets: []int64{20}},
+			{Text: "3", Offsets: []int64{30}},
+		},
+	}
+
+	var greaterThanTwo = func(p value.Primary) bool {
+		return value.Greater(p, value.NewInteger(2), nil) == ternary.TRUE
+	}
+
+	result := idx.Offsets(nil, false, nil, greaterThanTwo)
+	expect := []int64{1, 30, 40}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	result = idx.Offsets(TableSchema{{Name: "ID", Type: IntegerColumn}}, false, nil, func(p value.Primary) bool {
+		_, ok := p.(*value.Integer)
+		return ok
+	})
+	expect = []int64{1, 20, 30, 40}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	result = idx.Offsets(nil, true, nil, func(p value.Primary) bool {
+		return !value.IsNull(p) && p.(*value.String).Raw() == ""
+	})
+	expect = []int64{5}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
@@ -0,0 +1,259 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const SchemaFileExtension = ".schema.json"
+
+type ColumnType int
+
+const (
+	UntypedColumn ColumnType = iota
+	StringColumn
+	IntegerColumn
+	FloatColumn
+	BooleanColumn
+	DatetimeColumn
+)
+
+var columnTypeLiterals = map[ColumnType]string{
+	UntypedColumn:  "",
+	StringColumn:   "STRING",
+	IntegerColumn:  "INTEGER",
+	FloatColumn:    "FLOAT",
+	BooleanColumn:  "BOOLEAN",
+	DatetimeColumn: "DATETIME",
+}
+
+func (t ColumnType) String() string {
+	return columnTypeLiterals[t]
+}
+
+func ParseColumnType(s string) (ColumnType, bool) {
+	switch strings.ToUpper(cmd.TrimSpace(s)) {
+	case "STRING":
+		return StringColumn, true
+	case "INTEGER":
+		return IntegerColumn, true
+	case "FLOAT":
+		return FloatColumn, true
+	case "BOOLEAN":
+		return BooleanColumn, true
+	case "DATETIME":
+		return DatetimeColumn, true
+	}
+	return UntypedColumn, false
+}
+
+type ColumnSchema struct {
+	Name    string
+	Type    ColumnType
+	NotNull bool
+}
+
+func (c ColumnSchema) String() string {
+	s := make([]string, 0, 2)
+	if c.Type != UntypedColumn {
+		s = append(s, c.Type.String())
+	}
+	if c.NotNull {
+		s = append(s, "NOT NULL")
+	}
+	return strings.Join(s, " ")
+}
+
+// TableSchema holds the declared types and constraints of the columns in a table.
+// Columns that are not included in the schema are handled as untyped columns.
+type TableSchema []ColumnSchema
+
+func (s TableSchema) Column(name string) (ColumnSchema, bool) {
+	for _, c := range s {
+		if strings.EqualFold(c.Name, name) {
+			return c, true
+		}
+	}
+	return ColumnSchema{}, false
+}
+
+func (s TableSchema) Rename(oldName string, newName string) TableSchema {
+	ret := make(TableSchema, len(s))
+	for i, c := range s {
+		if strings.EqualFold(c.Name, oldName) {
+			c.Name = newName
+		}
+		ret[i] = c
+	}
+	return ret
+}
+
+func (s TableSchema) Drop(names []string) TableSchema {
+	ret := make(TableSchema, 0, len(s))
+	for _, c := range s {
+		dropped := false
+		for _, name := range names {
+			if strings.EqualFold(c.Name, name) {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+type schemaFile struct {
+	Columns []schemaFileColumn `json:"columns"`
+}
+
+type schemaFileColumn struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	NotNull bool   `json:"not_null,omitempty"`
+}
+
+func SchemaFilePath(fpath string) string {
+	return fpath + SchemaFileExtension
+}
+
+// LoadTableSchema reads the schema file placed next to the file.
+// If the schema file does not exist, then nil is returned.
+func LoadTableSchema(fpath string) (TableSchema, error) {
+	b, err := ioutil.ReadFile(SchemaFilePath(fpath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ParseTableSchema(b)
+}
+
+func ParseTableSchema(b []byte) (TableSchema, error) {
+	var f schemaFile
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&f); err != nil {
+		return nil, err
+	}
+
+	schema := make(TableSchema, 0, len(f.Columns))
+	for _, c := range f.Columns {
+		if len(c.Name) < 1 {
+			return nil, errors.New("column name is empty")
+		}
+		if _, ok := schema.Column(c.Name); ok {
+			return nil, errors.New(fmt.Sprintf("column %s is a duplicate", c.Name))
+		}
+
+		t := UntypedColumn
+		if 0 < len(c.Type) {
+			var ok bool
+			if t, ok = ParseColumnType(c.Type); !ok {
+				return nil, errors.New(fmt.Sprintf("%s is not a valid column type", c.Type))
+			}
+		}
+		schema = append(schema, ColumnSchema{Name: c.Name, Type: t, NotNull: c.NotNull})
+	}
+	return schema, nil
+}
+
+func (s TableSchema) MarshalJSON() ([]byte, error) {
+	f := schemaFile{Columns: make([]schemaFileColumn, len(s))}
+	for i, c := range s {
+		f.Columns[i] = schemaFileColumn{Name: c.Name, Type: c.Type.String(), NotNull: c.NotNull}
+	}
+	return json.MarshalIndent(f, "", "  ")
+}
+
+// WriteTableSchema writes the schema file placed next to the file.
+// If the schema is empty, then the schema file is removed.
+func WriteTableSchema(fpath string, schema TableSchema) error {
+	spath := SchemaFilePath(fpath)
+
+	if len(schema) < 1 {
+		if err := os.Remove(spath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	b, err := schema.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(spath, append(b, '\n'), 0644)
+}
+
+// ConvertToColumnType converts the value to the column type.
+// The second return value is false if the value cannot be converted.
+// Empty strings are handled as nulls in the columns other than STRING.
+func ConvertToColumnType(p value.Primary, t ColumnType, datetimeFormats []string) (value.Primary, bool) {
+	if value.IsNull(p) {
+		return p, true
+	}
+
+	var ret value.Primary
+	switch t {
+	case StringColumn:
+		switch p.(type) {
+		case *value.String:
+			return p, true
+		case *value.Boolean:
+			ret = value.NewString(strconv.FormatBool(p.(*value.Boolean).Raw()))
+		case *value.Ternary:
+			ret = value.NewString(p.(*value.Ternary).Ternary().String())
+		case *value.Datetime:
+			ret = value.NewString(p.(*value.Datetime).Format(time.RFC3339Nano))
+		default:
+			ret = value.ToString(p)
+		}
+	case IntegerColumn, FloatColumn, BooleanColumn, DatetimeColumn:
+		if s, ok := p.(*value.String); ok && len(cmd.TrimSpace(s.Raw())) < 1 {
+			return value.NewNull(), true
+		}
+
+		switch t {
+		case IntegerColumn:
+			if _, ok := p.(*value.Integer); ok {
+				return p, true
+			}
+			ret = value.ToInteger(p)
+		case FloatColumn:
+			if _, ok := p.(*value.Float); ok {
+				return p, true
+			}
+			ret = value.ToFloat(p)
+		case BooleanColumn:
+			if _, ok := p.(*value.Boolean); ok {
+				return p, true
+			}
+			ret = value.ToBoolean(p)
+		default:
+			if _, ok := p.(*value.Datetime); ok {
+				return p, true
+			}
+			ret = value.ToDatetime(p, datetimeFormats)
+		}
+	default:
+		return p, true
+	}
+
+	if value.IsNull(ret) {
+		return p, false
+	}
+	return ret, true
+}
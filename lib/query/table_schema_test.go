@@ -0,0 +1,168 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parseTableSchemaTests = []struct {
+	Name   string
+	Input  string
+	Result TableSchema
+	Error  string
+}{
+	{
+		Name:  "ParseTableSchema",
+		Input: `{"columns":[{"name":"id","type":"integer","not_null":true},{"name":"created","type":"DATETIME"},{"name":"name","not_null":true}]}`,
+		Result: TableSchema{
+			{Name: "id", Type: IntegerColumn, NotNull: true},
+			{Name: "created", Type: DatetimeColumn},
+			{Name: "name", Type: UntypedColumn, NotNull: true},
+		},
+	},
+	{
+		Name:  "ParseTableSchema Invalid Column Type Error",
+		Input: `{"columns":[{"name":"id","type":"int"}]}`,
+		Error: "int is not a valid column type",
+	},
+	{
+		Name:  "ParseTableSchema Duplicate Column Error",
+		Input: `{"columns":[{"name":"id","type":"INTEGER"},{"name":"ID","type":"FLOAT"}]}`,
+		Error: "column ID is a duplicate",
+	},
+	{
+		Name:  "ParseTableSchema Empty Column Name Error",
+		Input: `{"columns":[{"type":"INTEGER"}]}`,
+		Error: "column name is empty",
+	},
+}
+
+func TestParseTableSchema(t *testing.T) {
+	for _, v := range parseTableSchemaTests {
+		result, err := ParseTableSchema([]byte(v.Input))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestTableSchema_MarshalJSON(t *testing.T) {
+	schema := TableSchema{
+		{Name: "id", Type: IntegerColumn, NotNull: true},
+		{Name: "name"},
+	}
+	expect := "{\n" +
+		"  \"columns\": [\n" +
+		"    {\n" +
+		"      \"name\": \"id\",\n" +
+		"      \"type\": \"INTEGER\",\n" +
+		"      \"not_null\": true\n" +
+		"    },\n" +
+		"    {\n" +
+		"      \"name\": \"name\"\n" +
+		"    }\n" +
+		"  ]\n" +
+		"}"
+
+	b, err := schema.MarshalJSON()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if string(b) != expect {
+		t.Errorf("result = %q, want %q", string(b), expect)
+	}
+}
+
+var convertToColumnTypeTests = []struct {
+	Value  value.Primary
+	Type   ColumnType
+	Result value.Primary
+	OK     bool
+}{
+	{
+		Value:  value.NewString("1"),
+		Type:   IntegerColumn,
+		Result: value.NewInteger(1),
+		OK:     true,
+	},
+	{
+		Value:  value.NewFloat(1.5),
+		Type:   IntegerColumn,
+		Result: value.NewFloat(1.5),
+		OK:     false,
+	},
+	{
+		Value:  value.NewString(" "),
+		Type:   FloatColumn,
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Value:  value.NewInteger(2),
+		Type:   FloatColumn,
+		Result: value.NewFloat(2),
+		OK:     true,
+	},
+	{
+		Value:  value.NewString("true"),
+		Type:   BooleanColumn,
+		Result: value.NewBoolean(true),
+		OK:     true,
+	},
+	{
+		Value:  value.NewString("abc"),
+		Type:   DatetimeColumn,
+		Result: value.NewString("abc"),
+		OK:     false,
+	},
+	{
+		Value:  value.NewInteger(1),
+		Type:   StringColumn,
+		Result: value.NewString("1"),
+		OK:     true,
+	},
+	{
+		Value:  value.NewBoolean(false),
+		Type:   StringColumn,
+		Result: value.NewString("false"),
+		OK:     true,
+	},
+	{
+		Value:  value.NewNull(),
+		Type:   IntegerColumn,
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Value:  value.NewInteger(1),
+		Type:   UntypedColumn,
+		Result: value.NewInteger(1),
+		OK:     true,
+	},
+}
+
+func TestConvertToColumnType(t *testing.T) {
+	for _, v := range convertToColumnTypeTests {
+		result, ok := ConvertToColumnType(v.Value, v.Type, nil)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %s to %s", ok, v.OK, v.Value, v.Type)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %s, want %s for %s to %s", result, v.Result, v.Value, v.Type)
+		}
+	}
+}
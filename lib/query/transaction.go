@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +33,7 @@ type Transaction struct {
 
 	cachedViews      ViewMap
 	uncommittedViews UncommittedViews
+	savepoints       Savepoints
 
 	operationMutex   *sync.Mutex
 	viewLoadingMutex *sync.Mutex
@@ -44,7 +46,17 @@ type Transaction struct {
 	SelectedViews []*View
 	AffectedRows  int
 
+	caughtError Error
+
 	AutoCommit bool
+
+	// Sandboxed refuses the statements that execute external commands, read statements from files,
+	// or change the working directory or the environment variables of the process.
+	Sandboxed bool
+	// ReadOnly refuses the statements that create, update or lock files.
+	ReadOnly bool
+
+	sharedViews bool
 }
 
 func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retryDelay time.Duration, session *Session) (*Transaction, error) {
@@ -81,6 +93,57 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 	}, nil
 }
 
+// NewSessionTransaction returns a new transaction for the session.
+// The transaction shares the environment with tx and starts with a copy of the flags of tx.
+func (tx *Transaction) NewSessionTransaction(session *Session) (*Transaction, error) {
+	flags := tx.Flags.Copy()
+
+	palette, err := cmd.NewPalette(tx.Environment)
+	if err != nil {
+		return nil, ConvertLoadConfigurationError(err)
+	}
+	if !flags.Color {
+		palette.Disable()
+	}
+
+	return &Transaction{
+		Session:            session,
+		Environment:        tx.Environment,
+		Palette:            palette,
+		Flags:              flags,
+		WaitTimeout:        tx.WaitTimeout,
+		RetryDelay:         tx.RetryDelay,
+		FileContainer:      file.NewContainer(),
+		cachedViews:        NewViewMap(),
+		uncommittedViews:   NewUncommittedViews(),
+		operationMutex:     &sync.Mutex{},
+		viewLoadingMutex:   &sync.Mutex{},
+		stdinIsLocked:      false,
+		flagMutex:          &sync.RWMutex{},
+		PreparedStatements: NewPreparedStatementMap(),
+		SelectedViews:      nil,
+		AffectedRows:       0,
+		AutoCommit:         false,
+	}, nil
+}
+
+// NewReadOnlySessionTransaction returns a new sandboxed and read-only transaction for the session.
+// Views loaded from files are cached in tx and shared with the other read-only sessions,
+// and a cached view is loaded again when the file has been modified.
+func (tx *Transaction) NewReadOnlySessionTransaction(session *Session) (*Transaction, error) {
+	sessionTx, err := tx.NewSessionTransaction(session)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionTx.cachedViews = tx.cachedViews
+	sessionTx.viewLoadingMutex = tx.viewLoadingMutex
+	sessionTx.sharedViews = true
+	sessionTx.Sandboxed = true
+	sessionTx.ReadOnly = true
+	return sessionTx, nil
+}
+
 func (tx *Transaction) UpdateWaitTimeout(waitTimeout float64, retryDelay time.Duration) {
 	d, err := time.ParseDuration(strconv.FormatFloat(waitTimeout, 'f', -1, 64) + "s")
 	if err != nil {
@@ -122,8 +185,7 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			_, err := EncodeView(ctx, fp, view, fileinfo, tx)
-			if err != nil {
+			if err := encodeFile(ctx, fp, view, fileinfo, tx); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 			createFileInfo = append(createFileInfo, view.FileInfo)
@@ -142,7 +204,7 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileinfo, tx); err != nil {
+			if err := encodeFile(ctx, fp, view, fileinfo, tx); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
@@ -154,15 +216,36 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if err := WriteTableSchema(f.Path, f.Schema); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), tx.Flags.Quiet)
+		if err := tx.renameCompressedFile(f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		if err := tx.rebuildTableIndexes(ctx, f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 	}
 	for _, f := range updateFileInfo {
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if f.schemaUpdated {
+			if err := WriteTableSchema(f.Path, f.Schema); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+			f.schemaUpdated = false
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), tx.Flags.Quiet)
+		if err := tx.renameCompressedFile(f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		if err := tx.rebuildTableIndexes(ctx, f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 	}
 
 	msglist := scope.StoreTemporaryTable(tx.Session, tx.uncommittedViews.UncommittedTempViews())
@@ -170,6 +253,7 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		tx.LogNotice(strings.Join(msglist, "\n"), tx.quietForTemporaryViews(expr))
 	}
 	tx.uncommittedViews.Clean()
+	tx.savepoints = nil
 	tx.UnlockStdin()
 	if err := tx.ReleaseResources(); err != nil {
 		return NewCommitError(expr, err.Error())
@@ -177,6 +261,78 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	return nil
 }
 
+// rebuildTableIndexes rebuilds the indexes of the committed file.
+// Indexes that cannot be rebuilt due to changes of the file format or the fields are removed.
+func (tx *Transaction) rebuildTableIndexes(ctx context.Context, fileInfo *FileInfo) (err error) {
+	names, err := TableIndexNames(fileInfo.Path)
+	if err != nil || len(names) < 1 {
+		return err
+	}
+
+	h, err := file.NewHandlerForRead(ctx, tx.FileContainer, fileInfo.Path, tx.WaitTimeout, tx.RetryDelay)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = appendCompositeError(err, tx.FileContainer.Close(h))
+	}()
+
+	for _, name := range names {
+		var idx *TableIndex
+		old, e := LoadTableIndex(fileInfo.Path, name)
+		if e == nil && len(indexUnsupportedReason(fileInfo)) < 1 {
+			idx, e = BuildTableIndex(ctx, h.File(), fileInfo, name, old.Column)
+		}
+
+		if idx == nil || e != nil {
+			if err = RemoveTableIndex(fileInfo.Path, name); err != nil {
+				return err
+			}
+			tx.LogNotice(fmt.Sprintf("Commit: index %s on %q is dropped.", name, fileInfo.Path), tx.Flags.Quiet)
+			continue
+		}
+		if err = idx.Write(fileInfo.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameCompressedFile renames the committed file, its schema file and its index files so that the extension
+// indicates the compression format, because compressed files are recognized by their extensions.
+func (tx *Transaction) renameCompressedFile(fileInfo *FileInfo) error {
+	if !fileInfo.IsFile() {
+		return nil
+	}
+	fpath := cmd.PathWithCompression(fileInfo.Path, fileInfo.Compression)
+	if fpath == fileInfo.Path {
+		return nil
+	}
+
+	if err := os.Rename(fileInfo.Path, fpath); err != nil {
+		return err
+	}
+	spath := SchemaFilePath(fileInfo.Path)
+	if _, err := os.Stat(spath); err == nil {
+		if err = os.Rename(spath, SchemaFilePath(fpath)); err != nil {
+			return err
+		}
+	}
+	names, err := TableIndexNames(fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err = os.Rename(IndexFilePath(fileInfo.Path, name), IndexFilePath(fpath, name)); err != nil {
+			return err
+		}
+	}
+
+	tx.LogNotice(fmt.Sprintf("Commit: file %q is renamed to %q.", fileInfo.Path, fpath), tx.Flags.Quiet)
+	fileInfo.Path = fpath
+	return nil
+}
+
 func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) error {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
@@ -202,6 +358,7 @@ func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) e
 		}
 	}
 	tx.uncommittedViews.Clean()
+	tx.savepoints = nil
 	tx.UnlockStdin()
 	if err := tx.ReleaseResources(); err != nil {
 		return NewRollbackError(expr, err.Error())
@@ -209,13 +366,58 @@ func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) e
 	return nil
 }
 
+func (tx *Transaction) Savepoint(scope *ReferenceScope, expr parser.Savepoint) {
+	tx.operationMutex.Lock()
+	defer tx.operationMutex.Unlock()
+
+	if i := tx.savepoints.Index(expr.Name.Literal); -1 < i {
+		tx.savepoints = append(tx.savepoints[:i], tx.savepoints[i+1:]...)
+	}
+	sp := NewSavepoint(expr.Name.Literal, tx, scope)
+	tx.savepoints = append(tx.savepoints, sp)
+	tx.LogNotice(fmt.Sprintf("Savepoint: savepoint %s is created.", sp.Name), tx.Flags.Quiet)
+}
+
+func (tx *Transaction) RollbackToSavepoint(scope *ReferenceScope, expr parser.RollbackToSavepoint) error {
+	tx.operationMutex.Lock()
+	defer tx.operationMutex.Unlock()
+
+	i := tx.savepoints.Index(expr.Name.Literal)
+	if i < 0 {
+		return NewUndeclaredSavepointError(expr.Name)
+	}
+
+	sp := tx.savepoints[i]
+	cnt, err := sp.Restore(tx, scope)
+	if err != nil {
+		return NewRollbackError(expr, err.Error())
+	}
+	tx.savepoints = tx.savepoints[:i+1]
+	tx.LogNotice(fmt.Sprintf("Rollback: %s restored to savepoint %s.", FormatCount(cnt, "view"), sp.Name), tx.Flags.Quiet)
+	return nil
+}
+
+func (tx *Transaction) ReleaseSavepoint(expr parser.ReleaseSavepoint) error {
+	tx.operationMutex.Lock()
+	defer tx.operationMutex.Unlock()
+
+	i := tx.savepoints.Index(expr.Name.Literal)
+	if i < 0 {
+		return NewUndeclaredSavepointError(expr.Name)
+	}
+	tx.savepoints = tx.savepoints[:i]
+	return nil
+}
+
 func (tx *Transaction) quietForTemporaryViews(expr parser.Expression) bool {
 	return tx.Flags.Quiet || expr == nil
 }
 
 func (tx *Transaction) ReleaseResources() error {
-	if err := tx.cachedViews.Clean(tx.FileContainer); err != nil {
-		return err
+	if !tx.sharedViews {
+		if err := tx.cachedViews.Clean(tx.FileContainer); err != nil {
+			return err
+		}
 	}
 	if err := tx.FileContainer.CloseAll(); err != nil {
 		return err
@@ -226,8 +428,10 @@ func (tx *Transaction) ReleaseResources() error {
 
 func (tx *Transaction) ReleaseResourcesWithErrors() error {
 	var errs []error
-	if err := tx.cachedViews.CleanWithErrors(tx.FileContainer); err != nil {
-		errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
+	if !tx.sharedViews {
+		if err := tx.cachedViews.CleanWithErrors(tx.FileContainer); err != nil {
+			errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
+		}
 	}
 	if err := tx.FileContainer.CloseAllWithErrors(); err != nil {
 		errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
@@ -358,6 +562,12 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.NumericModeFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetNumericMode(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.WaitTimeoutFlag:
 		if f, ok := value.(float64); ok {
 			tx.UpdateWaitTimeout(f, file.DefaultRetryDelay)
@@ -539,6 +749,8 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(s)
 	case cmd.AnsiQuotesFlag:
 		val = value.NewBoolean(tx.Flags.AnsiQuotes)
+	case cmd.NumericModeFlag:
+		val = value.NewString(tx.Flags.NumericMode.String())
 	case cmd.WaitTimeoutFlag:
 		val = value.NewFloat(tx.Flags.WaitTimeout)
 	case cmd.ImportFormatFlag:
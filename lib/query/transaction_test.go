@@ -3,12 +3,15 @@ package query
 import (
 	"context"
 	"fmt"
+	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
 
 	"github.com/mithrandie/go-text"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -98,6 +101,95 @@ func TestTransaction_Commit(t *testing.T) {
 	}
 }
 
+func TestTransaction_CommitWithCompressionChange(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	fpath := GetTestFilePath("compression_change.csv")
+	if err := os.WriteFile(fpath, []byte("column1,column2\n1,str1\n2,str2\n"), 0644); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = os.Remove(fpath)
+		_ = os.Remove(fpath + cmd.GzipExt)
+		_ = RemoveTableIndex(fpath, "idx_column1")
+		_ = RemoveTableIndex(fpath+cmd.GzipExt, "idx_column1")
+	}()
+
+	if _, err := CreateIndex(ctx, scope, parser.CreateIndex{
+		Name:   parser.Identifier{Literal: "idx_column1"},
+		Table:  parser.Identifier{Literal: "compression_change"},
+		Column: parser.Identifier{Literal: "column1"},
+	}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+		NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+	}
+
+	for _, v := range []struct {
+		Compression string
+		Path        string
+		OldPath     string
+	}{
+		{Compression: "GZIP", Path: fpath + cmd.GzipExt, OldPath: fpath},
+		{Compression: "NONE", Path: fpath, OldPath: fpath + cmd.GzipExt},
+	} {
+		info, _, err := SetTableAttribute(ctx, scope, parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "compression_change"},
+			Attribute: parser.Identifier{Literal: "compression"},
+			Value:     parser.NewStringValue(v.Compression),
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Compression, err)
+		}
+		TestTx.uncommittedViews.SetForUpdatedView(info)
+		if err = TestTx.Commit(ctx, scope, parser.TransactionControl{Token: parser.COMMIT}); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Compression, err)
+		}
+
+		if _, err = os.Stat(v.Path); err != nil {
+			t.Errorf("%s: file %q does not exist", v.Compression, v.Path)
+		}
+		if _, err = os.Stat(v.OldPath); err == nil {
+			t.Errorf("%s: file %q still exists", v.Compression, v.OldPath)
+		}
+		for _, p := range []string{v.OldPath, v.Path} {
+			if names, _ := TableIndexNames(p); 0 < len(names) {
+				t.Errorf("%s: indexes %v of file %q exist, want to be dropped", v.Compression, names, p)
+			}
+		}
+
+		result, err := Select(ctx, scope, parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "compression_change"}}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Compression, err)
+		}
+		if !reflect.DeepEqual(result.RecordSet, expect) {
+			t.Errorf("%s: result = %v, want %v", v.Compression, result.RecordSet, expect)
+		}
+		_ = TestTx.ReleaseResources()
+	}
+}
+
 func TestTransaction_Rollback(t *testing.T) {
 	defer func() {
 		_ = TestTx.ReleaseResources()
@@ -137,3 +229,123 @@ func TestTransaction_Rollback(t *testing.T) {
 		t.Errorf("Rollback: log = %q, want %q", string(log), expect)
 	}
 }
+
+func TestTransaction_RollbackToSavepoint(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		TestTx.uncommittedViews.Clean()
+		TestTx.savepoints = nil
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(false)
+	tx := TestTx
+	scope := NewReferenceScope(tx)
+
+	table1 := &View{
+		Header:    NewHeader("table1", []string{"column1"}),
+		RecordSet: RecordSet{NewRecord([]value.Primary{value.NewInteger(1)})},
+		FileInfo:  &FileInfo{Path: "table1.csv", ForUpdate: true},
+	}
+	tempTable := &View{
+		Header:    NewHeader("tmp", []string{"column1"}),
+		RecordSet: RecordSet{},
+		FileInfo:  &FileInfo{Path: "tmp", ViewType: ViewTypeTemporaryTable},
+	}
+	tempTable.CreateRestorePoint()
+
+	tx.cachedViews = GenerateViewMap([]*View{table1})
+	tx.uncommittedViews = NewUncommittedViews()
+	tx.uncommittedViews.SetForUpdatedView(table1.FileInfo)
+	scope.SetTemporaryTable(tempTable)
+
+	tx.Session.SetStdout(NewOutput())
+	tx.Savepoint(scope, parser.Savepoint{Name: parser.Identifier{Literal: "sp1"}})
+
+	updated := table1.Copy()
+	updated.RecordSet = append(updated.RecordSet, NewRecord([]value.Primary{value.NewInteger(2)}))
+	updated.FileInfo.Format = cmd.JSON
+	tx.cachedViews.Set(updated)
+
+	table2 := &View{
+		Header:    NewHeader("table2", []string{"column1"}),
+		RecordSet: RecordSet{},
+		FileInfo:  &FileInfo{Path: "table2.csv"},
+	}
+	tx.cachedViews.Set(table2)
+	tx.uncommittedViews.SetForCreatedView(table2.FileInfo)
+
+	updatedTemp := tempTable.Copy()
+	updatedTemp.RecordSet = append(updatedTemp.RecordSet, NewRecord([]value.Primary{value.NewInteger(1)}))
+	scope.ReplaceTemporaryTable(updatedTemp)
+	tx.uncommittedViews.SetForUpdatedView(updatedTemp.FileInfo)
+
+	tx.Savepoint(scope, parser.Savepoint{Name: parser.Identifier{Literal: "sp2"}})
+
+	out := NewOutput()
+	tx.Session.SetStdout(out)
+	if err := tx.RollbackToSavepoint(scope, parser.RollbackToSavepoint{Name: parser.Identifier{Literal: "SP1"}}); err != nil {
+		t.Fatalf("RollbackToSavepoint: unexpected error %q", err)
+	}
+
+	expect := "Rollback: 3 views restored to savepoint sp1.\n"
+	if log := out.String(); log != expect {
+		t.Errorf("RollbackToSavepoint: log = %q, want %q", log, expect)
+	}
+	if v, _ := tx.cachedViews.Load("table1.csv"); v != table1 {
+		t.Errorf("RollbackToSavepoint: table1 is not restored")
+	}
+	if table1.FileInfo.Format != cmd.CSV {
+		t.Errorf("RollbackToSavepoint: format = %s, want %s", table1.FileInfo.Format, cmd.CSV)
+	}
+	if tx.cachedViews.Exists("table2.csv") {
+		t.Errorf("RollbackToSavepoint: table2 is not disposed")
+	}
+	if v, _ := scope.GetTemporaryTable(parser.Identifier{Literal: "tmp"}); v.RecordLen() != 0 {
+		t.Errorf("RollbackToSavepoint: temporary table is not restored")
+	}
+	if tx.uncommittedViews.CountCreatedTables() != 0 || tx.uncommittedViews.CountUpdatedTables() != 1 || tx.uncommittedViews.CountUpdatedViews() != 0 {
+		t.Errorf("RollbackToSavepoint: uncommitted views = %v, want only table1.csv", tx.uncommittedViews)
+	}
+	if len(tx.savepoints) != 1 {
+		t.Errorf("RollbackToSavepoint: %d savepoints remain, want 1", len(tx.savepoints))
+	}
+
+	if err := tx.ReleaseSavepoint(parser.ReleaseSavepoint{Name: parser.Identifier{Literal: "sp1"}}); err != nil {
+		t.Fatalf("ReleaseSavepoint: unexpected error %q", err)
+	}
+
+	expectErr := "savepoint sp1 is undeclared"
+	if err := tx.RollbackToSavepoint(scope, parser.RollbackToSavepoint{Name: parser.Identifier{Literal: "sp1"}}); err == nil {
+		t.Errorf("RollbackToSavepoint: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("RollbackToSavepoint: error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestTransaction_NewSessionTransaction(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
+	TestTx.Flags.Format = cmd.JSON
+	session := NewSession()
+
+	tx, err := TestTx.NewSessionTransaction(session)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if tx.Session != session {
+		t.Errorf("session is not set to the new transaction")
+	}
+	if tx.Environment != TestTx.Environment {
+		t.Errorf("environment is not shared with the new transaction")
+	}
+	if tx.FileContainer == TestTx.FileContainer {
+		t.Errorf("file container is shared with the new transaction")
+	}
+
+	tx.Flags.Format = cmd.CSV
+	if TestTx.Flags.Format != cmd.JSON {
+		t.Errorf("format = %s, want %s for the original transaction", TestTx.Flags.Format, cmd.JSON)
+	}
+}
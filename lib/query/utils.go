@@ -71,6 +71,15 @@ func InStrSliceWithCaseInsensitive(s string, list []string) bool {
 	return false
 }
 
+func InIntSlice(i int, list []int) bool {
+	for _, v := range list {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
 func Distinguish(list []value.Primary, flags *cmd.Flags) []value.Primary {
 	values := make(map[string]int, 40)
 	valueKeys := make([]string, 0, 40)
@@ -146,6 +155,8 @@ func SerializeKey(buf *bytes.Buffer, val value.Primary, flags *cmd.Flags) {
 	} else if dt := value.ToDatetime(val, flags.DatetimeFormat); !value.IsNull(dt) {
 		serializeDatetime(buf, dt.(*value.Datetime).Raw())
 		value.Discard(dt)
+	} else if iv, ok := val.(*value.Interval); ok {
+		serializeInterval(buf, iv)
 	} else if b := value.ToBoolean(val); !value.IsNull(b) {
 		if b.(*value.Boolean).Raw() {
 			serializeInteger(buf, 1)
@@ -188,6 +199,13 @@ func serializeDatetimeFromUnixNano(buf *bytes.Buffer, t int64) {
 	_ = binary.Write(buf, binary.LittleEndian, t)
 }
 
+func serializeInterval(buf *bytes.Buffer, iv *value.Interval) {
+	days, nanos := iv.Span()
+	buf.Write([]byte{91, 86, 93})
+	_ = binary.Write(buf, binary.LittleEndian, days)
+	_ = binary.Write(buf, binary.LittleEndian, nanos)
+}
+
 func serializeString(buf *bytes.Buffer, s string) {
 	buf.Write([]byte{91, 83, 93})
 	buf.WriteString(strings.ToUpper(cmd.TrimSpace(s)))
@@ -13,12 +13,15 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
@@ -77,10 +80,16 @@ func LoadView(ctx context.Context, scope *ReferenceScope, tables []parser.QueryE
 		tables = []parser.QueryExpression{parser.Table{Object: obj}}
 	}
 
+	var node *PlanNode
+	loadCtx := ctx
+	if 1 < len(tables) {
+		loadCtx, node = beginPlanNode(ctx)
+	}
+
 	views := make([]*View, len(tables))
 	tableNames := make(map[string]bool, len(tables))
 	for i, v := range tables {
-		loaded, err := loadView(ctx, scope, v, forUpdate, useInternalId)
+		loaded, err := loadView(loadCtx, scope, v, forUpdate, useInternalId)
 		if err != nil {
 			return nil, err
 		}
@@ -101,6 +110,7 @@ func LoadView(ctx context.Context, scope *ReferenceScope, tables []parser.QueryE
 			return nil, err
 		}
 	}
+	node.finish(view, planCrossJoin, nil)
 
 	return view, nil
 }
@@ -120,6 +130,17 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 
 	table := tableExpr.(parser.Table)
 
+	ctx, node := beginPlanNode(ctx)
+	defer func() {
+		if err == nil {
+			if _, ok := table.Object.(parser.Dual); !ok && isPlanOnly(ctx) && 0 < view.RecordLen() {
+				view = &View{Header: view.Header, RecordSet: RecordSet{}, FileInfo: view.FileInfo}
+			}
+			node.describeScan(scope, table, view)
+			node.finish(view, "", nil)
+		}
+	}()
+
 	switch table.Object.(type) {
 	case parser.Dual:
 		view = loadDualView()
@@ -134,6 +155,8 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encoding := scope.Tx.Flags.Encoding
 		noHeader := scope.Tx.Flags.NoHeader
 		withoutNull := scope.Tx.Flags.WithoutNull
+		sheet := ""
+		headerRow := 0
 
 		var felem value.Primary
 		if tableObject.FormatElement != nil {
@@ -148,6 +171,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		headerRowIdx := -1
 
 		switch strings.ToUpper(tableObject.Type.Literal) {
 		case cmd.CSV.String():
@@ -196,7 +220,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			delimiterPositions = positions
 			importFormat = cmd.FIXED
-		case cmd.JSON.String():
+		case cmd.JSON.String(), cmd.JSONL.String():
 			if felem == nil {
 				return nil, NewTableObjectInvalidArgumentError(tableObject, "json query is not specified")
 			}
@@ -208,6 +232,9 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			jsonQuery = felem.(*value.String).Raw()
 			importFormat = cmd.JSON
+			if strings.EqualFold(tableObject.Type.Literal, cmd.JSONL.String()) {
+				importFormat = cmd.JSONL
+			}
 			encoding = text.UTF8
 		case cmd.LTSV.String():
 			if 2 < len(tableObject.Args) {
@@ -215,6 +242,31 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			importFormat = cmd.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case cmd.XLSX.String():
+			if felem != nil {
+				if value.IsNull(felem) {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("invalid sheet: %s", tableObject.FormatElement.String()))
+				}
+				sheet = felem.(*value.String).Raw()
+			}
+			if 2 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 4)
+			}
+			importFormat = cmd.XLSX
+			encoding = text.UTF8
+			encodingIdx, noHeaderIdx = -1, -1
+			headerRowIdx, withoutNullIdx = 0, 1
+		case cmd.PARQUET.String():
+			if felem != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("unexpected argument: %s", tableObject.FormatElement.String()))
+			}
+			if 1 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 2)
+			}
+			importFormat = cmd.PARQUET
+			encoding = text.UTF8
+			encodingIdx, noHeaderIdx = -1, -1
+			withoutNullIdx = 0
 		default:
 			return nil, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
 		}
@@ -263,20 +315,31 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				} else {
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
 				}
+			case headerRowIdx:
+				v := value.ToInteger(p)
+				if !value.IsNull(v) && 0 <= v.(*value.Integer).Raw() {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a header-row value: %s", tableObject.Args[headerRowIdx].String()))
+				}
 			}
 		}
 
-		if args[encodingIdx] != nil {
-			if encoding, err = cmd.ParseEncoding(args[0].(*value.String).Raw()); err != nil {
+		if -1 < encodingIdx && args[encodingIdx] != nil {
+			if encoding, err = cmd.ParseEncoding(args[encodingIdx].(*value.String).Raw()); err != nil {
 				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
 			}
 		}
-		if args[noHeaderIdx] != nil {
+		if -1 < noHeaderIdx && args[noHeaderIdx] != nil {
 			noHeader = args[noHeaderIdx].(*value.Boolean).Raw()
 		}
 		if args[withoutNullIdx] != nil {
 			withoutNull = args[withoutNullIdx].(*value.Boolean).Raw()
 		}
+		if -1 < headerRowIdx && args[headerRowIdx] != nil {
+			headerRow = int(args[headerRowIdx].(*value.Integer).Raw())
+			noHeader = headerRow == 0
+		}
 
 		view, err = loadObject(
 			ctx,
@@ -290,6 +353,8 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			delimiterPositions,
 			singleLine,
 			jsonQuery,
+			sheet,
+			headerRow,
 			encoding,
 			scope.Tx.Flags.LineBreak,
 			noHeader,
@@ -314,6 +379,8 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			scope.Tx.Flags.DelimiterPositions,
 			scope.Tx.Flags.SingleLine,
 			scope.Tx.Flags.JsonQuery,
+			"",
+			0,
 			scope.Tx.Flags.Encoding,
 			scope.Tx.Flags.LineBreak,
 			scope.Tx.Flags.NoHeader,
@@ -330,38 +397,44 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		if err != nil {
 			return nil, err
 		}
-		joinView, err := loadView(ctx, scope, join.JoinTable, forUpdate, useInternalId)
-		if err != nil {
-			return nil, err
-		}
-
-		condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, joinView)
-		if err != nil {
-			return nil, err
-		}
-
-		joinType := join.JoinType.Token
-		if join.JoinType.IsEmpty() {
-			if join.Direction.IsEmpty() {
-				joinType = parser.INNER
-			} else {
-				joinType = parser.OUTER
-			}
-		}
 
-		switch joinType {
-		case parser.CROSS:
-			if err = CrossJoin(ctx, scope, view, joinView); err != nil {
+		var includeFields, excludeFields []parser.FieldReference
+		if join.IsLateral() {
+			node.describeJoin(join, nil, view, nil)
+			if includeFields, excludeFields, err = LateralJoin(ctx, scope, view, join); err != nil {
 				return nil, err
 			}
-		case parser.INNER:
-			if err = InnerJoin(ctx, scope, view, joinView, condition); err != nil {
+		} else {
+			joinView, err := loadJoinViewUsingIndex(ctx, scope, join, view, forUpdate || useInternalId)
+			if err == nil && joinView == nil {
+				joinView, err = loadView(ctx, scope, join.JoinTable, forUpdate, useInternalId)
+			}
+			if err != nil {
 				return nil, err
 			}
-		case parser.OUTER:
-			if err = OuterJoin(ctx, scope, view, joinView, condition, join.Direction.Token); err != nil {
+
+			var condition parser.QueryExpression
+			condition, includeFields, excludeFields, err = ParseJoinCondition(join, view, joinView)
+			if err != nil {
 				return nil, err
 			}
+
+			node.describeJoin(join, condition, view, joinView)
+
+			switch joinType(join) {
+			case parser.CROSS:
+				if err = CrossJoin(ctx, scope, view, joinView); err != nil {
+					return nil, err
+				}
+			case parser.INNER:
+				if err = InnerJoin(ctx, scope, view, joinView, condition); err != nil {
+					return nil, err
+				}
+			case parser.OUTER:
+				if err = OuterJoin(ctx, scope, view, joinView, condition, join.Direction.Token); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		includeIndices := NewUintPool(len(includeFields), LimitToUseUintSlicePool)
@@ -407,6 +480,31 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 		}
 
+	case parser.PivotTable, parser.UnpivotTable:
+		tableName := ""
+		if table.Alias != nil {
+			tableName = table.Alias.(parser.Identifier).Literal
+		}
+
+		if pivot, ok := table.Object.(parser.PivotTable); ok {
+			if view, err = loadView(ctx, scope, pivot.Table, false, false); err != nil {
+				return nil, err
+			}
+			view, err = Pivot(ctx, scope, view, pivot)
+		} else {
+			unpivot := table.Object.(parser.UnpivotTable)
+			if view, err = loadView(ctx, scope, unpivot.Table, false, false); err != nil {
+				return nil, err
+			}
+			view, err = Unpivot(ctx, view, unpivot)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err = view.Header.Update(tableName, nil); err != nil {
+			return nil, err
+		}
 	case parser.JsonQuery:
 		jsonQuery := table.Object.(parser.JsonQuery)
 		alias := table.Name().Literal
@@ -442,6 +540,12 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
 			}()
 			reader = h.File()
+
+			if compression, _ := cmd.CompressionOfPath(fpath); compression != cmd.NoCompression {
+				if reader, err = decompressFile(reader, compression); err != nil {
+					return nil, NewLoadJsonError(jsonQuery, err.Error())
+				}
+			}
 		} else {
 			jsonTextValue, err := Evaluate(ctx, scope, jsonQuery.JsonText)
 			if err != nil {
@@ -473,6 +577,19 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			return nil, err
 		}
+	case parser.TableFunction:
+		tableName := ""
+		if table.Alias != nil {
+			tableName = table.Alias.(parser.Identifier).Literal
+		}
+
+		if view, err = loadViewFromTableFunction(ctx, scope, table.Object.(parser.TableFunction), tableName); err != nil {
+			return nil, err
+		}
+	case parser.SchemaTable:
+		if view, err = loadViewFromSchemaTable(ctx, scope, table.Object.(parser.SchemaTable), table.Name().Literal); err != nil {
+			return nil, err
+		}
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
 		view, err = Select(ctx, scope, subquery.Query)
@@ -559,6 +676,8 @@ func loadObject(
 	delimiterPositions []int,
 	singleLine bool,
 	jsonQuery string,
+	sheet string,
+	headerRow int,
 	encoding text.Encoding,
 	lineBreak text.LineBreak,
 	noHeader bool,
@@ -578,6 +697,8 @@ func loadObject(
 			DelimiterPositions: delimiterPositions,
 			SingleLine:         singleLine,
 			JsonQuery:          jsonQuery,
+			Sheet:              sheet,
+			HeaderRow:          headerRow,
 			Encoding:           encoding,
 			LineBreak:          lineBreak,
 			NoHeader:           noHeader,
@@ -638,23 +759,65 @@ func loadObject(
 		return view, nil
 	}
 
-	filePath, err := cacheViewFromFile(
-		ctx,
-		scope,
-		tableIdentifier,
-		forUpdate,
-		importFormat,
-		delimiter,
-		delimiterPositions,
-		singleLine,
-		jsonQuery,
-		encoding,
-		lineBreak,
-		noHeader,
-		encloseAll,
-		jsonEscape,
-		withoutNull,
-	)
+	if forUpdate && scope.Tx.ReadOnly {
+		return nil, NewOperationNotAllowedError(tableIdentifier, fmt.Sprintf("updating %s", tableIdentifier))
+	}
+
+	var err error
+	if pattern, globFiles := SearchGlobFilePaths(tableIdentifier, scope.Tx.Flags.Repository); globFiles != nil {
+		filePath = pattern
+		err = cacheViewFromGlob(
+			ctx,
+			scope,
+			tableIdentifier,
+			pattern,
+			globFiles,
+			forUpdate,
+			importFormat,
+			delimiter,
+			delimiterPositions,
+			singleLine,
+			jsonQuery,
+			sheet,
+			headerRow,
+			encoding,
+			lineBreak,
+			noHeader,
+			encloseAll,
+			jsonEscape,
+			withoutNull,
+		)
+	} else {
+		if isPlanOnly(ctx) && !forUpdate {
+			if view := loadViewHeader(ctx, scope, tableIdentifier, importFormat, delimiter, encoding, noHeader); view != nil {
+				if !strings.EqualFold(parser.FormatTableName(view.FileInfo.Path), tableName.Literal) {
+					if err = view.Header.Update(tableName.Literal, nil); err != nil {
+						return nil, err
+					}
+				}
+				return view, nil
+			}
+		}
+		filePath, err = cacheViewFromFile(
+			ctx,
+			scope,
+			tableIdentifier,
+			forUpdate,
+			importFormat,
+			delimiter,
+			delimiterPositions,
+			singleLine,
+			jsonQuery,
+			sheet,
+			headerRow,
+			encoding,
+			lineBreak,
+			noHeader,
+			encloseAll,
+			jsonEscape,
+			withoutNull,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +851,46 @@ func loadObject(
 	return view, nil
 }
 
+// loadViewHeader returns a view that has only the header of a csv or tsv file that has not been loaded.
+// If the header cannot be read without loading the file, then it returns nil.
+func loadViewHeader(
+	ctx context.Context,
+	scope *ReferenceScope,
+	tableIdentifier parser.Identifier,
+	importFormat cmd.Format,
+	delimiter rune,
+	encoding text.Encoding,
+	noHeader bool,
+) *View {
+	if filePath, ok := scope.LoadFilePath(tableIdentifier.Literal); ok {
+		if _, ok := scope.Tx.cachedViews.Load(filePath); ok {
+			return nil
+		}
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, importFormat, delimiter, encoding, scope.Tx.Flags)
+	if err != nil {
+		return nil
+	}
+	if _, ok := scope.Tx.cachedViews.Load(fileInfo.Path); ok {
+		return nil
+	}
+	if (fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV) || fileInfo.Compression != cmd.NoCompression {
+		return nil
+	}
+
+	fileInfo.NoHeader = noHeader
+	columns, err := readCSVColumns(ctx, scope, fileInfo)
+	if err != nil {
+		return nil
+	}
+	return &View{
+		Header:    NewHeader(parser.FormatTableName(fileInfo.Path), columns),
+		RecordSet: RecordSet{},
+		FileInfo:  fileInfo,
+	}
+}
+
 func cacheViewFromFile(
 	ctx context.Context,
 	scope *ReferenceScope,
@@ -698,6 +901,8 @@ func cacheViewFromFile(
 	delimiterPositions []int,
 	singleLine bool,
 	jsonQuery string,
+	sheet string,
+	headerRow int,
 	encoding text.Encoding,
 	lineBreak text.LineBreak,
 	noHeader bool,
@@ -717,7 +922,15 @@ func cacheViewFromFile(
 		filePath = p
 	}
 
-	view, ok := scope.Tx.cachedViews.Load(filePath)
+	loadCachedView := func(fpath string) (*View, bool) {
+		view, ok := scope.Tx.cachedViews.Load(fpath)
+		if ok && scope.Tx.sharedViews && view.FileInfo.isModified() {
+			return nil, false
+		}
+		return view, ok
+	}
+
+	view, ok := loadCachedView(filePath)
 	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
 		fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, importFormat, delimiter, encoding, scope.Tx.Flags)
 		if err != nil {
@@ -725,11 +938,13 @@ func cacheViewFromFile(
 		}
 		filePath = fileInfo.Path
 
-		view, ok = scope.Tx.cachedViews.Load(filePath)
+		view, ok = loadCachedView(filePath)
 		if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
 			fileInfo.DelimiterPositions = delimiterPositions
 			fileInfo.SingleLine = singleLine
 			fileInfo.JsonQuery = cmd.TrimSpace(jsonQuery)
+			fileInfo.Sheet = sheet
+			fileInfo.HeaderRow = headerRow
 			fileInfo.LineBreak = lineBreak
 			fileInfo.NoHeader = noHeader
 			fileInfo.EncloseAll = encloseAll
@@ -763,6 +978,9 @@ func cacheViewFromFile(
 				}()
 				fp = h.File()
 			}
+			if scope.Tx.sharedViews {
+				fileInfo.setLoadedStat(fp)
+			}
 
 			loadView, err := loadViewFromFile(ctx, scope.Tx.Flags, fp, fileInfo, withoutNull, tableIdentifier)
 			if err != nil {
@@ -771,6 +989,11 @@ func cacheViewFromFile(
 				}
 				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
+			if loadView.FileInfo.Schema != nil {
+				if err = loadView.applyTableSchema(ctx, scope.Tx.Flags, tableIdentifier, nil); err != nil {
+					return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+				}
+			}
 			loadView.FileInfo.ForUpdate = forUpdate
 			scope.Tx.cachedViews.Set(loadView)
 		}
@@ -782,13 +1005,27 @@ func cacheViewFromFile(
 }
 
 func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	if fileInfo.Compression != cmd.NoCompression {
+		r, err := decompressFile(fp, fileInfo.Compression)
+		if err != nil {
+			return nil, err
+		}
+		fp = r
+	}
+
 	switch fileInfo.Format {
 	case cmd.FIXED:
 		return loadViewFromFixedLengthTextFile(ctx, fp, fileInfo, withoutNull, expr)
 	case cmd.LTSV:
 		return loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
+	case cmd.XLSX:
+		return loadViewFromXLSXFile(fp, fileInfo, withoutNull, expr)
+	case cmd.PARQUET:
+		return loadViewFromParquetFile(ctx, fp, fileInfo, withoutNull, expr)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo, expr)
+	case cmd.JSONL:
+		return loadViewFromJsonLinesFile(fp, fileInfo, expr)
 	}
 	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
 }
@@ -850,10 +1087,7 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 	}
 
 	if header == nil {
-		header = make([]string, len(fileInfo.DelimiterPositions))
-		for i := 0; i < len(fileInfo.DelimiterPositions); i++ {
-			header[i] = "c" + strconv.Itoa(i+1)
-		}
+		header = autofillHeader(len(fileInfo.DelimiterPositions))
 	}
 
 	if reader.DetectedLineBreak != "" {
@@ -868,26 +1102,10 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 }
 
 func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
-	if err != nil {
-		return nil, NewCannotDetectFileEncodingError(expr)
-	}
-	fileInfo.Encoding = enc
-
-	reader, err := csv.NewReader(fp, fileInfo.Encoding)
+	reader, header, err := newCSVReader(fp, fileInfo, withoutNull, expr)
 	if err != nil {
 		return nil, err
 	}
-	reader.Delimiter = fileInfo.Delimiter
-	reader.WithoutNull = withoutNull
-
-	var header []string
-	if !fileInfo.NoHeader {
-		header, err = reader.ReadHeader()
-		if err != nil && err != io.EOF {
-			return nil, err
-		}
-	}
 
 	records, err := readRecordSet(ctx, reader, fileSize(fp))
 	if err != nil {
@@ -895,10 +1113,7 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 	}
 
 	if header == nil {
-		header = make([]string, reader.FieldsPerRecord)
-		for i := 0; i < reader.FieldsPerRecord; i++ {
-			header[i] = "c" + strconv.Itoa(i+1)
-		}
+		header = autofillHeader(reader.FieldsPerRecord)
 	}
 
 	if reader.DetectedLineBreak != "" {
@@ -913,6 +1128,38 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 	return view, nil
 }
 
+func newCSVReader(fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*csv.Reader, []string, error) {
+	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, nil, NewCannotDetectFileEncodingError(expr)
+	}
+	fileInfo.Encoding = enc
+
+	reader, err := csv.NewReader(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader.Delimiter = fileInfo.Delimiter
+	reader.WithoutNull = withoutNull
+
+	var header []string
+	if !fileInfo.NoHeader {
+		header, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+	}
+	return reader, header, nil
+}
+
+func autofillHeader(fieldLen int) []string {
+	header := make([]string, fieldLen)
+	for i := 0; i < fieldLen; i++ {
+		header[i] = "c" + strconv.Itoa(i+1)
+	}
+	return header
+}
+
 func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
 	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
@@ -957,10 +1204,13 @@ func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeke
 }
 
 func fileSize(fp io.ReadSeeker) int64 {
-	if f, ok := fp.(*os.File); ok {
+	switch f := fp.(type) {
+	case *os.File:
 		if fi, err := f.Stat(); err == nil {
 			return fi.Size()
 		}
+	case *bytes.Reader:
+		return f.Size()
 	}
 	return 0
 }
@@ -980,14 +1230,7 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 			if !ok {
 				break
 			}
-			record := make(Record, len(row))
-			for i, v := range row {
-				if v == nil {
-					record[i] = NewCell(value.NewNull())
-				} else {
-					record[i] = NewCell(value.NewString(string(v)))
-				}
-			}
+			record := newRecordFromRawText(row)
 
 			if 0 < fileSize && len(recordSet) == fileLoadingPreparedRecordSetCap && int64(pos) < fileSize {
 				l := int((float64(fileSize) / float64(pos)) * fileLoadingPreparedRecordSetCap * 1.2)
@@ -1037,6 +1280,37 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 	return recordSet, err
 }
 
+func readRecords(ctx context.Context, reader RecordReader, limit int) (RecordSet, error) {
+	recordSet := make(RecordSet, 0, limit)
+	for i := 0; i < limit; i++ {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		recordSet = append(recordSet, newRecordFromRawText(row))
+	}
+	return recordSet, nil
+}
+
+func newRecordFromRawText(row []text.RawText) Record {
+	record := make(Record, len(row))
+	for i, v := range row {
+		if v == nil {
+			record[i] = NewCell(value.NewNull())
+		} else {
+			record[i] = NewCell(value.NewString(string(v)))
+		}
+	}
+	return record
+}
+
 func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
 	jsonText, err := ioutil.ReadAll(fp)
 	if err != nil {
@@ -1063,6 +1337,206 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExp
 	return view, nil
 }
 
+func loadViewFromJsonLinesFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	headerLabels, rows, escapeType, err := json.LoadTableFromJsonLines(fileInfo.JsonQuery, fp)
+	if err != nil {
+		return nil, NewLoadJsonError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+	fileInfo.JsonEscape = escapeType
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromXLSXFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	data, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	wb, err := xlsx.Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := wb.ReadSheet(fileInfo.Sheet, cmd.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+	if len(fileInfo.Sheet) < 1 {
+		fileInfo.Sheet = wb.SheetNames()[0]
+	}
+	fileInfo.Encoding = text.UTF8
+
+	start := 0
+	if 1 < fileInfo.HeaderRow {
+		start = fileInfo.HeaderRow - 1
+	}
+
+	var headerCells []interface{}
+	if !fileInfo.NoHeader {
+		if start < len(rows) {
+			headerCells = rows[start]
+		}
+		start++
+	}
+
+	fieldLen := len(headerCells)
+	dataRows := make([][]interface{}, 0, len(rows))
+	for i := start; i < len(rows); i++ {
+		if len(rows[i]) < 1 {
+			continue
+		}
+		dataRows = append(dataRows, rows[i])
+		if fieldLen < len(rows[i]) {
+			fieldLen = len(rows[i])
+		}
+	}
+
+	var header Header
+	if fileInfo.NoHeader {
+		header = NewHeader(parser.FormatTableName(fileInfo.Path), autofillHeader(fieldLen))
+	} else {
+		labels := make([]string, fieldLen)
+		for i := range headerCells {
+			if s := value.ToString(convertNativeValue(headerCells[i], false)); !value.IsNull(s) {
+				labels[i] = s.(*value.String).Raw()
+			}
+		}
+		header = NewHeaderWithAutofill(parser.FormatTableName(fileInfo.Path), labels)
+	}
+
+	records := make(RecordSet, len(dataRows))
+	for i, row := range dataRows {
+		record := make(Record, fieldLen)
+		for j := range record {
+			var cell interface{}
+			if j < len(row) {
+				cell = row[j]
+			}
+			record[j] = NewCell(convertNativeValue(cell, withoutNull))
+		}
+		records[i] = record
+	}
+
+	view := NewView()
+	view.Header = header
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func convertNativeValue(v interface{}, withoutNull bool) value.Primary {
+	switch v.(type) {
+	case string:
+		return value.NewString(v.(string))
+	case int64:
+		return value.NewInteger(v.(int64))
+	case float64:
+		return value.NewFloat(v.(float64))
+	case bool:
+		return value.NewBoolean(v.(bool))
+	case time.Time:
+		return value.NewDatetime(v.(time.Time))
+	}
+	if withoutNull {
+		return value.NewString("")
+	}
+	return value.NewNull()
+}
+
+func openParquetFile(fp io.Reader, expr parser.QueryExpression) (*parquet.File, error) {
+	if f, ok := fp.(*os.File); ok {
+		if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+			return parquet.Open(f, info.Size())
+		}
+	}
+
+	data, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	return parquet.Open(bytes.NewReader(data), int64(len(data)))
+}
+
+func loadViewFromParquetFile(ctx context.Context, fp io.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	pf, err := openParquetFile(fp, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]int, len(pf.ColumnNames()))
+	for i := range columns {
+		columns[i] = i
+	}
+	rowGroups := make([]int, pf.NumRowGroups())
+	for i := range rowGroups {
+		rowGroups[i] = i
+	}
+	return loadViewFromParquetRowGroups(ctx, pf, fileInfo, columns, rowGroups, withoutNull)
+}
+
+// loadViewFromParquetRowGroups reads the columns in the row groups of the parquet file.
+func loadViewFromParquetRowGroups(ctx context.Context, pf *parquet.File, fileInfo *FileInfo, columns []int, rowGroups []int, withoutNull bool) (*View, error) {
+	fileInfo.Encoding = text.UTF8
+	loc := cmd.GetLocation()
+
+	recordLen := 0
+	for _, rg := range rowGroups {
+		recordLen += int(pf.RowGroupNumRows(rg))
+	}
+
+	records := make(RecordSet, recordLen)
+	for i := range records {
+		records[i] = make(Record, len(columns))
+	}
+
+	offset := 0
+	for _, rg := range rowGroups {
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		numRows := int(pf.RowGroupNumRows(rg))
+		for j, col := range columns {
+			values, err := pf.ReadColumn(rg, col, loc)
+			if err != nil {
+				return nil, err
+			}
+			for k := 0; k < numRows; k++ {
+				var v interface{}
+				if k < len(values) {
+					v = values[k]
+				}
+				records[offset+k][j] = NewCell(convertNativeValue(v, withoutNull))
+			}
+		}
+		offset += numRows
+	}
+
+	fileHeader := NewHeaderWithAutofill(parser.FormatTableName(fileInfo.Path), pf.ColumnNames())
+	header := make(Header, len(columns), len(columns)+2)
+	for i, col := range columns {
+		header[i] = fileHeader[col]
+		header[i].Number = i + 1
+	}
+
+	view := NewView()
+	view.Header = header
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	return &View{
 		Header:    NewEmptyHeader(1),
@@ -1070,21 +1544,43 @@ func loadDualView() *View {
 	}
 }
 
+// groupLen returns the number of records in the group.
+// The first field of the record may have a null even if the group has no record when the field is rolled up.
+func (view *View) groupLen(recordIndex int) int {
+	record := view.RecordSet[recordIndex]
+	if idx, ok := view.Header.groupingColumnIndex(0); ok && idx < len(record) && record[idx][0].Ternary() == ternary.TRUE {
+		return len(record[idx]) - 1
+	}
+	return record.GroupLen()
+}
+
 func NewViewFromGroupedRecord(ctx context.Context, flags *cmd.Flags, referenceRecor ReferenceRecord) (*View, error) {
 	view := NewView()
 	view.Header = referenceRecor.view.Header
 	record := referenceRecor.view.RecordSet[referenceRecor.recordIndex]
 
-	view.RecordSet = make(RecordSet, record.GroupLen())
+	view.RecordSet = make(RecordSet, referenceRecor.view.groupLen(referenceRecor.recordIndex))
+
+	cells := make(Record, len(record))
+	copy(cells, record)
+	for j := range view.Header {
+		if !view.Header[j].IsGroupKey {
+			continue
+		}
+		if idx, ok := view.Header.groupingColumnIndex(j); ok && idx < len(record) && record[idx][0].Ternary() == ternary.TRUE {
+			cells[j] = record[idx][1:]
+			cells[idx] = record[idx][:1]
+		}
+	}
 
-	if err := NewGoroutineTaskManager(record.GroupLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+	if err := NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
 		view.RecordSet[index] = make(Record, view.FieldLen())
-		for j := range record {
+		for j := range cells {
 			grpIdx := index
-			if len(record[j]) < 2 {
+			if len(cells[j]) < 2 {
 				grpIdx = 0
 			}
-			view.RecordSet[index][j] = record[j][grpIdx : grpIdx+1]
+			view.RecordSet[index][j] = cells[j][grpIdx : grpIdx+1]
 		}
 		return nil
 	}); err != nil {
@@ -1137,10 +1633,15 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		return view.groupAll(ctx, scope.Tx.Flags)
 	}
 
+	keys, sets := expandGroupingSets(items)
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU)
 	groupsList := make([]map[string][]int, gm.Number)
 	groupKeyCnt := make(map[string]int, 20)
-	groupKeys := make([]string, 0, 20)
+	groupKeysInSets := make([][]string, len(sets))
+	for i := range groupKeysInSets {
+		groupKeysInSets[i] = make([]string, 0, 20)
+	}
 	mtx := &sync.Mutex{}
 
 	var grpFn = func(thIdx int) {
@@ -1159,31 +1660,42 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 
 			seqScope.Records[0].recordIndex = i
 
-			values := make([]value.Primary, len(items))
-			for i, item := range items {
+			keyValues := make([]value.Primary, len(keys))
+			for i, item := range keys {
 				p, e := Evaluate(ctx, seqScope, item)
 				if e != nil {
 					gm.SetError(e)
 					break GroupKeyLoop
 				}
-				values[i] = p
+				keyValues[i] = p
 			}
-			keyBuf := GetComparisonKeysBuf()
-			SerializeComparisonKeys(keyBuf, values, seqScope.Tx.Flags)
-			key := keyBuf.String()
-			PutComparisonkeysBuf(keyBuf)
 
-			if _, ok := groups[key]; ok {
-				groups[key] = append(groups[key], i)
-			} else {
-				groups[key] = make([]int, 0, view.RecordLen()/18)
-				groups[key] = append(groups[key], i)
-				mtx.Lock()
-				if _, ok := groupKeyCnt[key]; !ok {
-					groupKeyCnt[key] = 0
-					groupKeys = append(groupKeys, key)
+			for setIdx, set := range sets {
+				values := make([]value.Primary, len(set))
+				for i, keyIdx := range set {
+					values[i] = keyValues[keyIdx]
+				}
+				keyBuf := GetComparisonKeysBuf()
+				if 1 < len(sets) {
+					keyBuf.WriteString(strconv.Itoa(setIdx))
+					keyBuf.WriteByte(':')
+				}
+				SerializeComparisonKeys(keyBuf, values, seqScope.Tx.Flags)
+				key := keyBuf.String()
+				PutComparisonkeysBuf(keyBuf)
+
+				if _, ok := groups[key]; ok {
+					groups[key] = append(groups[key], i)
+				} else {
+					groups[key] = make([]int, 0, view.RecordLen()/18)
+					groups[key] = append(groups[key], i)
+					mtx.Lock()
+					if _, ok := groupKeyCnt[key]; !ok {
+						groupKeyCnt[key] = 0
+						groupKeysInSets[setIdx] = append(groupKeysInSets[setIdx], key)
+					}
+					mtx.Unlock()
 				}
-				mtx.Unlock()
 			}
 		}
 
@@ -1217,6 +1729,83 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		}
 	}
 
+	if view.RecordLen() < 1 {
+		for setIdx, set := range sets {
+			if len(set) < 1 {
+				key := ""
+				if 1 < len(sets) {
+					key = strconv.Itoa(setIdx) + ":"
+				}
+				groupKeysInSets[setIdx] = append(groupKeysInSets[setIdx], key)
+			}
+		}
+	}
+
+	groupKeys := groupKeysInSets[0]
+	groupSetIndices := make([]int, 0, len(groupKeys))
+	for setIdx := range groupKeysInSets {
+		if 0 < setIdx {
+			groupKeys = append(groupKeys, groupKeysInSets[setIdx]...)
+		}
+		for range groupKeysInSets[setIdx] {
+			groupSetIndices = append(groupSetIndices, setIdx)
+		}
+	}
+
+	keyFields := make([]int, 0, len(keys))
+	keyFieldIndices := make([]int, len(keys))
+	for i, key := range keys {
+		keyFieldIndices[i] = -1
+
+		switch key.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			idx, err := view.Header.SearchIndex(key)
+			if err != nil {
+				continue
+			}
+			keyFieldIndices[i] = idx
+			if !InIntSlice(idx, keyFields) {
+				keyFields = append(keyFields, idx)
+			}
+		}
+	}
+	for i, key := range keys {
+		if -1 < keyFieldIndices[i] {
+			continue
+		}
+		for j := range keys {
+			if -1 < keyFieldIndices[j] && strings.EqualFold(key.String(), keys[j].String()) {
+				keyFieldIndices[i] = keyFieldIndices[j]
+				break
+			}
+		}
+	}
+
+	var rolledUpFields [][]int
+	if 1 < len(sets) {
+		rolledUpFields = make([][]int, len(sets))
+		for setIdx, set := range sets {
+			for _, idx := range keyFields {
+				rolledUp := true
+				for _, keyIdx := range set {
+					if keyFieldIndices[keyIdx] == idx {
+						rolledUp = false
+						break
+					}
+				}
+				if rolledUp {
+					rolledUpFields[setIdx] = append(rolledUpFields[setIdx], idx)
+				}
+			}
+		}
+	}
+
+	fieldLen := view.FieldLen()
+	recordLen := fieldLen
+	if rolledUpFields != nil {
+		recordLen = fieldLen + len(keyFields)
+	}
+
 	records := make(RecordSet, len(groupKeys))
 	calcCnt := view.RecordLen() * len(groupKeys)
 	minReq := -1
@@ -1224,9 +1813,9 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		minReq = int(math.Ceil(float64(len(groupKeys)) / (math.Floor(float64(calcCnt) / MinimumRequiredPerCPUCore))))
 	}
 	if err := NewGoroutineTaskManager(len(groupKeys), minReq, scope.Tx.Flags.CPU).Run(ctx, func(gIdx int) error {
-		record := make(Record, view.FieldLen())
+		record := make(Record, recordLen)
 
-		for i := 0; i < view.FieldLen(); i++ {
+		for i := 0; i < fieldLen; i++ {
 			primaries := make(Cell, groupKeyCnt[groupKeys[gIdx]])
 			pos := 0
 			for j := range groupsList {
@@ -1240,6 +1829,25 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 			record[i] = primaries
 		}
 
+		if rolledUpFields != nil {
+			for i, idx := range keyFields {
+				if InIntSlice(idx, rolledUpFields[groupSetIndices[gIdx]]) {
+					record[fieldLen+i] = append(Cell{value.NewTernary(ternary.TRUE)}, record[idx]...)
+					nulls := make(Cell, len(record[idx]))
+					if len(nulls) < 1 {
+						// The field of the grand total of no records has a null to be referred.
+						nulls = make(Cell, 1)
+					}
+					for j := range nulls {
+						nulls[j] = value.NewNull()
+					}
+					record[idx] = nulls
+				} else {
+					record[fieldLen+i] = NewCell(value.NewTernary(ternary.FALSE))
+				}
+			}
+		}
+
 		records[gIdx] = record
 		return nil
 	}); err != nil {
@@ -1248,16 +1856,96 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 
 	view.RecordSet = records
 	view.isGrouped = true
-	for _, item := range items {
-		switch item.(type) {
-		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.Header.SearchIndex(item)
-			view.Header[idx].IsGroupKey = true
+	for _, idx := range keyFields {
+		view.Header[idx].IsGroupKey = true
+	}
+	if rolledUpFields != nil {
+		for _, idx := range keyFields {
+			view.Header = append(view.Header, HeaderField{Column: groupingColumnName(idx)})
 		}
 	}
 	return nil
 }
 
+// expandGroupingSets flattens the grouping elements into a list of key expressions
+// and returns the grouping sets as lists of indices of the key expressions.
+func expandGroupingSets(items []parser.QueryExpression) ([]parser.QueryExpression, [][]int) {
+	keys := make([]parser.QueryExpression, 0, len(items))
+
+	var addKeys = func(expr parser.QueryExpression) []int {
+		var exprs []parser.QueryExpression
+		if list, ok := expr.(parser.ValueList); ok {
+			exprs = list.Values
+		} else {
+			exprs = []parser.QueryExpression{expr}
+		}
+
+		indices := make([]int, 0, len(exprs))
+		for _, e := range exprs {
+			indices = append(indices, len(keys))
+			keys = append(keys, e)
+		}
+		return indices
+	}
+
+	sets := [][]int{{}}
+	for _, item := range items {
+		var itemSets [][]int
+
+		if gs, ok := item.(parser.GroupingSets); ok {
+			elements := make([][]int, len(gs.Values))
+			for i, v := range gs.Values {
+				for {
+					p, ok := v.(parser.Parentheses)
+					if !ok {
+						break
+					}
+					v = p.Expr
+				}
+				elements[i] = addKeys(v)
+			}
+
+			switch gs.Type {
+			case parser.ROLLUP:
+				for i := len(elements); 0 <= i; i-- {
+					set := make([]int, 0, len(keys))
+					for _, e := range elements[:i] {
+						set = append(set, e...)
+					}
+					itemSets = append(itemSets, set)
+				}
+			case parser.CUBE:
+				for mask := 1<<uint(len(elements)) - 1; 0 <= mask; mask-- {
+					set := make([]int, 0, len(keys))
+					for i, e := range elements {
+						if mask&(1<<uint(len(elements)-1-i)) != 0 {
+							set = append(set, e...)
+						}
+					}
+					itemSets = append(itemSets, set)
+				}
+			default: // GROUPING SETS
+				itemSets = elements
+			}
+		} else {
+			itemSets = [][]int{addKeys(item)}
+		}
+
+		combined := make([][]int, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				s := make([]int, 0, len(set)+len(itemSet))
+				s = append(s, set...)
+				s = append(s, itemSet...)
+				combined = append(combined, s)
+			}
+		}
+		sets = combined
+	}
+
+	return keys, sets
+}
+
 func (view *View) groupAll(ctx context.Context, flags *cmd.Flags) error {
 	if 0 < view.RecordLen() {
 		record := make(Record, view.FieldLen())
@@ -1290,9 +1978,11 @@ func (view *View) Having(ctx context.Context, scope *ReferenceScope, clause pars
 	err := view.filter(ctx, scope, clause.Filter)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
-			if err = view.group(ctx, scope, nil); err != nil {
+			groupCtx, node := beginPlanNode(ctx)
+			if err = view.group(groupCtx, scope, nil); err != nil {
 				return err
 			}
+			node.finish(view, planAggregate, nil)
 			if err = view.filter(ctx, scope, clause.Filter); err != nil {
 				return err
 			}
@@ -1385,9 +2075,11 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 				}
 			}
 
-			if err = view.group(ctx, scope, nil); err != nil {
+			groupCtx, node := beginPlanNode(ctx)
+			if err = view.group(groupCtx, scope, nil); err != nil {
 				return err
 			}
+			node.finish(view, planAggregate, nil)
 
 			if err = evalFields(view, fields); err != nil {
 				return err
@@ -1639,10 +2331,12 @@ func (view *View) evalColumn(ctx context.Context, scope *ReferenceScope, obj par
 		}
 	} else {
 		if analyticFunction, ok := obj.(parser.AnalyticFunction); ok {
-			err = view.evalAnalyticFunction(ctx, scope, analyticFunction)
+			windowCtx, node := beginPlanNode(ctx)
+			err = view.evalAnalyticFunction(windowCtx, scope, analyticFunction)
 			if err != nil {
 				return
 			}
+			node.finish(view, planWindow, analyticFunction)
 		} else if view.RecordLen() < 1 {
 			if view.tempRecord == nil {
 				view.tempRecord = NewEmptyRecord(view.FieldLen())
@@ -1725,20 +2419,11 @@ func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScop
 }
 
 func (view *View) Offset(ctx context.Context, scope *ReferenceScope, clause parser.OffsetClause) error {
-	val, err := Evaluate(ctx, scope, clause.Value)
+	offset, err := evalOffset(ctx, scope, clause)
 	if err != nil {
 		return err
 	}
-	number := value.ToInteger(val)
-	if value.IsNull(number) {
-		return NewInvalidOffsetNumberError(clause)
-	}
-	view.offset = int(number.(*value.Integer).Raw())
-	value.Discard(number)
-
-	if view.offset < 0 {
-		view.offset = 0
-	}
+	view.offset = offset
 
 	if view.RecordLen() <= view.offset {
 		view.RecordSet = RecordSet{}
@@ -1752,14 +2437,31 @@ func (view *View) Offset(ctx context.Context, scope *ReferenceScope, clause pars
 	return nil
 }
 
-func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parser.LimitClause) error {
+func evalOffset(ctx context.Context, scope *ReferenceScope, clause parser.OffsetClause) (int, error) {
 	val, err := Evaluate(ctx, scope, clause.Value)
 	if err != nil {
-		return err
+		return 0, err
 	}
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidOffsetNumberError(clause)
+	}
+	offset := int(number.(*value.Integer).Raw())
+	value.Discard(number)
 
+	if offset < 0 {
+		offset = 0
+	}
+	return offset, nil
+}
+
+func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parser.LimitClause) error {
 	var limit int
 	if clause.Percentage() {
+		val, err := Evaluate(ctx, scope, clause.Value)
+		if err != nil {
+			return err
+		}
 		number := value.ToFloat(val)
 		if value.IsNull(number) {
 			return NewInvalidLimitPercentageError(clause)
@@ -1775,15 +2477,9 @@ func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parse
 			limit = int(math.Ceil(float64(view.RecordLen()+view.offset) * percentage / 100))
 		}
 	} else {
-		number := value.ToInteger(val)
-		if value.IsNull(number) {
-			return NewInvalidLimitNumberError(clause)
-		}
-		limit = int(number.(*value.Integer).Raw())
-		value.Discard(number)
-
-		if limit < 0 {
-			limit = 0
+		var err error
+		if limit, err = evalLimit(ctx, scope, clause); err != nil {
+			return err
 		}
 	}
 
@@ -1805,12 +2501,30 @@ func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parse
 	return nil
 }
 
+func evalLimit(ctx context.Context, scope *ReferenceScope, clause parser.LimitClause) (int, error) {
+	val, err := Evaluate(ctx, scope, clause.Value)
+	if err != nil {
+		return 0, err
+	}
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidLimitNumberError(clause)
+	}
+	limit := int(number.(*value.Integer).Raw())
+	value.Discard(number)
+
+	if limit < 0 {
+		limit = 0
+	}
+	return limit, nil
+}
+
 func (view *View) InsertValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) (int, error) {
 	recordValues, err := view.convertListToRecordValues(ctx, scope, fields, list)
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	return view.insert(ctx, scope.Tx.Flags, fields, recordValues, func(i int) parser.QueryExpression { return list[i] })
 }
 
 func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery) (int, error) {
@@ -1818,7 +2532,8 @@ func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fi
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	var source parser.QueryExpression = query
+	return view.insert(ctx, scope.Tx.Flags, fields, recordValues, func(int) parser.QueryExpression { return source })
 }
 
 func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression, keys []parser.QueryExpression) (int, error) {
@@ -1826,7 +2541,7 @@ func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fiel
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys, func(i int) parser.QueryExpression { return list[i] })
 }
 
 func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery, keys []parser.QueryExpression) (int, error) {
@@ -1834,7 +2549,8 @@ func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, f
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	var source parser.QueryExpression = query
+	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys, func(int) parser.QueryExpression { return source })
 }
 
 func (view *View) convertListToRecordValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) ([][]value.Primary, error) {
@@ -1882,7 +2598,10 @@ func (view *View) convertResultSetToRecordValues(ctx context.Context, scope *Ref
 	return recordValues, nil
 }
 
-func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (RecordSet, error) {
+// convertRecordValuesToRecordSet converts the values to the records of the view.
+// The function source returns the row value or the select query that each record values were evaluated from,
+// and it is used to locate the value that does not conform to the table schema.
+func (view *View) convertRecordValuesToRecordSet(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, source func(int) parser.QueryExpression) (RecordSet, error) {
 	var valueIndex = func(i int, list []int) int {
 		for j, v := range list {
 			if i == v {
@@ -1910,19 +2629,109 @@ func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []p
 
 		record := make(Record, view.FieldLen())
 		for j := 0; j < view.FieldLen(); j++ {
+			var p value.Primary
 			if recordIndices[j] < 0 {
-				record[j] = NewCell(value.NewNull())
+				p = value.NewNull()
 			} else {
-				record[j] = NewCell(values[recordIndices[j]])
+				p = values[recordIndices[j]]
+			}
+
+			p, err = view.conformToSchema(valueExpression(source(i), recordIndices[j]), j, p, flags)
+			if err != nil {
+				return nil, err
 			}
+			record[j] = NewCell(p)
 		}
 		records[i] = record
 	}
 	return records, nil
 }
 
-func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (int, error) {
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+func (view *View) conformToSchema(expr parser.QueryExpression, fieldIdx int, p value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if view.FileInfo == nil || view.FileInfo.Schema == nil {
+		return p, nil
+	}
+
+	column, ok := view.FileInfo.Schema.Column(view.Header[fieldIdx].Column)
+	if !ok {
+		return p, nil
+	}
+	return conformToColumnSchema(expr, column, p, flags)
+}
+
+// applyTableSchema converts the values of the view to the types declared in the table schema.
+// If source is not nil, then it is used to locate the value that does not conform to the schema.
+func (view *View) applyTableSchema(ctx context.Context, flags *cmd.Flags, expr parser.QueryExpression, source parser.QueryExpression) error {
+	columns := make([]ColumnSchema, 0, len(view.FileInfo.Schema))
+	indices := make([]int, 0, len(view.FileInfo.Schema))
+	exprs := make([]parser.QueryExpression, 0, len(view.FileInfo.Schema))
+	for _, c := range view.FileInfo.Schema {
+		idx, err := view.Header.SearchIndex(parser.FieldReference{Column: parser.Identifier{Literal: c.Name}})
+		if err != nil {
+			return NewInvalidTableSchemaError(expr, SchemaFilePath(view.FileInfo.Path), fmt.Sprintf("field %s does not exist", c.Name))
+		}
+		columns = append(columns, c)
+		indices = append(indices, idx)
+		if source == nil {
+			exprs = append(exprs, expr)
+		} else {
+			exprs = append(exprs, valueExpression(source, idx))
+		}
+	}
+
+	return NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+		for i, idx := range indices {
+			p, err := conformToColumnSchema(exprs[i], columns[i], view.RecordSet[index][idx][0], flags)
+			if err != nil {
+				return err
+			}
+			view.RecordSet[index][idx] = NewCell(p)
+		}
+		return nil
+	})
+}
+
+// valueExpression returns the expression of the value at the index in a row value or in the select clause of a query.
+// If the expression cannot be determined, then source is returned.
+func valueExpression(source parser.QueryExpression, idx int) parser.QueryExpression {
+	if idx < 0 {
+		return source
+	}
+
+	switch source.(type) {
+	case parser.RowValue:
+		if list, ok := source.(parser.RowValue).Value.(parser.ValueList); ok && idx < len(list.Values) {
+			return list.Values[idx]
+		}
+	case parser.SelectQuery:
+		if entity, ok := source.(parser.SelectQuery).SelectEntity.(parser.SelectEntity); ok {
+			for i, f := range entity.SelectClause.(parser.SelectClause).Fields {
+				field := f.(parser.Field)
+				if _, ok := field.Object.(parser.AllColumns); ok {
+					break
+				}
+				if i == idx {
+					return field.Object
+				}
+			}
+		}
+	}
+	return source
+}
+
+func conformToColumnSchema(expr parser.QueryExpression, column ColumnSchema, p value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	ret, ok := ConvertToColumnType(p, column.Type, flags.DatetimeFormat)
+	if !ok {
+		return nil, NewColumnTypeMismatchError(expr, p, column.Name, column.Type)
+	}
+	if column.NotNull && value.IsNull(ret) {
+		return nil, NewColumnNotNullViolationError(expr, column.Name)
+	}
+	return ret, nil
+}
+
+func (view *View) insert(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, source func(int) parser.QueryExpression) (int, error) {
+	records, err := view.convertRecordValuesToRecordSet(ctx, flags, fields, recordValues, source)
 	if err != nil {
 		return 0, err
 	}
@@ -1931,7 +2740,7 @@ func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, r
 	return len(recordValues), nil
 }
 
-func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) (int, error) {
+func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression, source func(int) parser.QueryExpression) (int, error) {
 	fieldIndices, err := view.FieldIndices(fields)
 	if err != nil {
 		return 0, err
@@ -1962,7 +2771,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 		}
 	}
 
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+	records, err := view.convertRecordValuesToRecordSet(ctx, flags, fields, recordValues, source)
 	if err != nil {
 		return 0, err
 	}
@@ -58,26 +58,28 @@ func (m ViewMap) Get(fpath parser.Identifier) (*View, error) {
 func (m ViewMap) GetWithInternalId(ctx context.Context, fpath parser.Identifier, flags *cmd.Flags) (*View, error) {
 	if view, ok := m.Load(fpath.Literal); ok {
 		ret := view.Copy()
-
-		ret.Header = NewHeaderWithId(ret.Header[0].View, []string{}).Merge(ret.Header)
-
-		if err := NewGoroutineTaskManager(ret.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
-			record := make(Record, len(ret.RecordSet[index])+1)
-			record[0] = NewCell(value.NewInteger(int64(index)))
-			for i := 0; i < len(ret.RecordSet[index]); i++ {
-				record[i+1] = ret.RecordSet[index][i]
-			}
-			ret.RecordSet[index] = record
-			return nil
-		}); err != nil {
+		if err := addInternalId(ctx, ret, ret.Header[0].View, flags); err != nil {
 			return nil, err
 		}
-
 		return ret, nil
 	}
 	return nil, errTableNotLoaded
 }
 
+func addInternalId(ctx context.Context, view *View, viewName string, flags *cmd.Flags) error {
+	view.Header = NewHeaderWithId(viewName, []string{}).Merge(view.Header)
+
+	return NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+		record := make(Record, len(view.RecordSet[index])+1)
+		record[0] = NewCell(value.NewInteger(int64(index)))
+		for i := 0; i < len(view.RecordSet[index]); i++ {
+			record[i+1] = view.RecordSet[index][i]
+		}
+		view.RecordSet[index] = record
+		return nil
+	})
+}
+
 func (m ViewMap) Set(view *View) {
 	if view.FileInfo != nil {
 		m.Store(view.FileInfo.Path, view)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/json"
+	"github.com/mithrandie/ternary"
 )
 
 var viewLoadTests = []struct {
@@ -98,6 +99,77 @@ var viewLoadTests = []struct {
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView Compressed File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_gzip"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_gzip", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "table_gzip.csv.gz",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: cmd.GZIP,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File With Table Schema",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_schema"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_schema", []string{"id", "name", "created"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_schema.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				Schema: TableSchema{
+					{Name: "id", Type: IntegerColumn, NotNull: true},
+					{Name: "created", Type: DatetimeColumn},
+				},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
 	{
 		Name: "LoadView File ForUpdate",
 		From: parser.FromClause{
@@ -1104,6 +1176,41 @@ var viewLoadTests = []struct {
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView TableObject From JsonL File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "jsonl"},
+						FormatElement: parser.NewStringValue("{item2}"),
+						Path:          parser.Identifier{Literal: "table_l"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("jt", []string{"item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_l.jsonl",
+				Delimiter: ',',
+				JsonQuery: "{item2}",
+				Format:    cmd.JSONL,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
 	{
 		Name: "LoadView TableObject From JsonH File",
 		From: parser.FromClause{
@@ -1369,125 +1476,457 @@ var viewLoadTests = []struct {
 		Error: "table object ltsv takes exactly 3 arguments",
 	},
 	{
-		Name: "LoadView TableObject Invalid Object Type",
+		Name: "LoadView TableObject From XLSX File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
-						Type:          parser.Identifier{Literal: "invalid"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table"},
+						Type:          parser.Identifier{Literal: "xlsx"},
+						FormatElement: parser.NewStringValue("prices"),
+						Path:          parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("2"),
+						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid table object: invalid",
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "name", "price", "active", "updated"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("orange"),
+					value.NewNull(),
+					value.NewBoolean(false),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("grape"),
+					value.NewFloat(2.25),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				Sheet:     "prices",
+				HeaderRow: 2,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From Json File Arguments Length Error",
+		Name: "LoadView TableObject From XLSX File Without Header",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
-						Type:          parser.Identifier{Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table"},
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7.xlsx"},
 						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
+							parser.NewIntegerValueFromString("0"),
+							parser.NewTernaryValueFromString("true"),
 						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "table object json takes exactly 2 arguments",
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2", "c3", "c4", "c5"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("Price List"),
+					value.NewString(""),
+					value.NewString(""),
+					value.NewString(""),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("id"),
+					value.NewString("name"),
+					value.NewString("price"),
+					value.NewString("active"),
+					value.NewString("updated"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("orange"),
+					value.NewString(""),
+					value.NewBoolean(false),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("grape"),
+					value.NewFloat(2.25),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				Sheet:     "Prices",
+				NoHeader:  true,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView File Error",
+		Name: "LoadView TableObject From XLSX File Invalid Header Row Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "notexist"},
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("-1"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "file notexist does not exist",
+		Error: "invalid argument for xlsx: cannot be converted as a header-row value: -1",
 	},
 	{
-		Name: "LoadView From File Duplicate Table Name Error",
+		Name: "LoadView TableObject From XLSX File Sheet Not Exist Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
-				parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "xlsx"},
+						FormatElement: parser.NewStringValue("notexist"),
+						Path:          parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
 			},
 		},
-		Error: "table name t is a duplicate",
+		Error: "data parse error in file " + GetTestFilePath("table7.xlsx") + ": sheet notexist does not exist",
 	},
 	{
-		Name: "LoadView From File ForUpdate Duplicate Table Name Error",
+		Name: "LoadView TableObject From Parquet File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
-				parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table8"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
 			},
 		},
-		ForUpdate: true,
-		Error:     "table name t is a duplicate",
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "name", "score", "active", "updated"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("banana"),
+					value.NewNull(),
+					value.NewBoolean(false),
+					value.NewDatetime(time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("cherry"),
+					value.NewFloat(3.25),
+					value.NewNull(),
+					value.NewDatetime(time.Date(2021, 2, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewNull(),
+					value.NewFloat(4),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 2, 2, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+					value.NewString("elderberry"),
+					value.NewFloat(5.75),
+					value.NewBoolean(false),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(6),
+					value.NewString("fig"),
+					value.NewFloat(6.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table8.parquet",
+				Delimiter: ',',
+				Format:    cmd.PARQUET,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From File Inline Table",
+		Name: "LoadView TableObject From Parquet File Without Null",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "it"}, Alias: parser.Identifier{Literal: "t"}},
-			},
-		},
-		Scope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
-			{
-				scopeNameInlineTables: {
-					"IT": &View{
-						Header: NewHeader("it", []string{"c1", "c2", "num"}),
-						RecordSet: []Record{
-							NewRecord([]value.Primary{
-								value.NewString("1"),
-								value.NewString("str1"),
-								value.NewInteger(1),
-							}),
-							NewRecord([]value.Primary{
-								value.NewString("2"),
-								value.NewString("str2"),
-								value.NewInteger(1),
-							}),
-							NewRecord([]value.Primary{
-								value.NewString("3"),
-								value.NewString("str3"),
-								value.NewInteger(1),
-							}),
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table8.parquet"},
+						Args: []parser.QueryExpression{
+							parser.NewTernaryValueFromString("true"),
 						},
 					},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
-		}, time.Time{}, nil),
+		},
 		Result: &View{
-			Header: NewHeader("t", []string{"c1", "c2", "num"}),
+			Header: NewHeader("t", []string{"id", "name", "score", "active", "updated"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
 					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
-					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("banana"),
+					value.NewString(""),
+					value.NewBoolean(false),
+					value.NewDatetime(time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-					value.NewInteger(1),
+					value.NewInteger(3),
+					value.NewString("cherry"),
+					value.NewFloat(3.25),
+					value.NewString(""),
+					value.NewDatetime(time.Date(2021, 2, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
 				}),
-			},
-		},
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewString(""),
+					value.NewFloat(4),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 2, 2, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+					value.NewString("elderberry"),
+					value.NewFloat(5.75),
+					value.NewBoolean(false),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(6),
+					value.NewString("fig"),
+					value.NewFloat(6.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC).In(GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table8.parquet",
+				Delimiter: ',',
+				Format:    cmd.PARQUET,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From Parquet File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table8"},
+						Args: []parser.QueryExpression{
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object parquet takes at most 2 arguments",
+	},
+	{
+		Name: "LoadView TableObject From Parquet File Invalid Argument Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "parquet"},
+						FormatElement: parser.NewStringValue("x"),
+						Path:          parser.Identifier{Literal: "table8"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for parquet: unexpected argument: 'x'",
+	},
+	{
+		Name: "LoadView TableObject Invalid Object Type",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "invalid"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "invalid table object: invalid",
+	},
+	{
+		Name: "LoadView TableObject From Json File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "table object json takes exactly 2 arguments",
+	},
+	{
+		Name: "LoadView File Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "notexist"},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+	{
+		Name: "LoadView From File Duplicate Table Name Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Error: "table name t is a duplicate",
+	},
+	{
+		Name: "LoadView From File ForUpdate Duplicate Table Name Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{Object: parser.Identifier{Literal: "table2"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		ForUpdate: true,
+		Error:     "table name t is a duplicate",
+	},
+	{
+		Name: "LoadView From File Inline Table",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "it"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Scope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{
+				scopeNameInlineTables: {
+					"IT": &View{
+						Header: NewHeader("it", []string{"c1", "c2", "num"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewString("1"),
+								value.NewString("str1"),
+								value.NewInteger(1),
+							}),
+							NewRecord([]value.Primary{
+								value.NewString("2"),
+								value.NewString("str2"),
+								value.NewInteger(1),
+							}),
+							NewRecord([]value.Primary{
+								value.NewString("3"),
+								value.NewString("str3"),
+								value.NewInteger(1),
+							}),
+						},
+					},
+				},
+			},
+		}, time.Time{}, nil),
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2", "num"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewInteger(1),
+				}),
+			},
+		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{},
 			{
@@ -2321,6 +2760,69 @@ var viewLoadTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "LoadView Glob Pattern",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob/log_*.csv"},
+				},
+			},
+		},
+		Result: &View{
+			Header: append(NewHeader("log_*", []string{"column1", "column2"}), HeaderField{View: "log_*", Column: parser.FileColumn}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString(GetTestFilePath("glob/log_1.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString(GetTestFilePath("glob/log_1.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewString(GetTestFilePath("glob/log_1.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString(GetTestFilePath("glob/log_2.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString(GetTestFilePath("glob/log_2.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewString(GetTestFilePath("glob/log_2.csv")),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "log_*.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Glob Pattern Header Not Match Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob/*_1.csv"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("header of file %s does not match header of file %s", GetTestFilePath("glob/other_1.csv"), GetTestFilePath("glob/log_1.csv")),
+	},
 }
 
 func TestView_Load(t *testing.T) {
@@ -2408,6 +2910,12 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.PrettyPrint != v.Result.FileInfo.PrettyPrint {
 				t.Errorf("%s: FileInfo.PrettyPrint = %t, want %t", v.Name, view.FileInfo.PrettyPrint, v.Result.FileInfo.PrettyPrint)
 			}
+			if view.FileInfo.Compression != v.Result.FileInfo.Compression {
+				t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, view.FileInfo.Compression, v.Result.FileInfo.Compression)
+			}
+			if !reflect.DeepEqual(view.FileInfo.Schema, v.Result.FileInfo.Schema) {
+				t.Errorf("%s: FileInfo.Schema = %v, want %v", v.Name, view.FileInfo.Schema, v.Result.FileInfo.Schema)
+			}
 			if view.FileInfo.ForUpdate != v.Result.FileInfo.ForUpdate {
 				t.Errorf("%s: FileInfo.ForUpdate = %t, want %t", v.Name, view.FileInfo.ForUpdate, v.Result.FileInfo.ForUpdate)
 			}
@@ -2468,6 +2976,24 @@ func TestNewViewFromGroupedRecord(t *testing.T) {
 	if !reflect.DeepEqual(result, expect) {
 		t.Errorf("result = %v, want %v", result, expect)
 	}
+
+	fr = ReferenceRecord{
+		view:        groupingTestView,
+		recordIndex: 1,
+		cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+	}
+	expect = &View{
+		Header: groupingTestView.Header,
+		RecordSet: []Record{
+			{NewCell(value.NewInteger(1)), NewCell(value.NewString("a")), NewCell(value.NewTernary(ternary.TRUE))},
+			{NewCell(value.NewInteger(2)), NewCell(value.NewString("a")), NewCell(value.NewTernary(ternary.TRUE))},
+		},
+	}
+
+	result, _ = NewViewFromGroupedRecord(context.Background(), TestTx.Flags, fr)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
 }
 
 var viewWhereTests = []struct {
@@ -2689,6 +3215,414 @@ var viewGroupByTests = []struct {
 			isGrouped: true,
 		},
 	},
+	{
+		Name: "Group By Rollup",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewString("group1"),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.ROLLUP,
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					Column: groupingColumnName(3),
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str3")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("2"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str2"), value.NewString("str3")}),
+					NewGroupCell([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("group1"), value.NewString("group2"), value.NewString("group1")}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Empty Grouping Set Without Records",
+		View: &View{
+			Header:    NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.GROUPING,
+					Values: []parser.QueryExpression{
+						parser.ValueList{},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				{
+					Cell{},
+					Cell{},
+					Cell{},
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Grouping Sets With Parenthesized Set",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group1"),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.GROUPING,
+					Values: []parser.QueryExpression{
+						parser.ValueList{Values: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+						}},
+						parser.Parentheses{Expr: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					Column: groupingColumnName(1),
+				},
+				{
+					Column: groupingColumnName(3),
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("1")}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("1")}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("group1")}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Rollup With Function",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group1"),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.ROLLUP,
+					Values: []parser.QueryExpression{
+						parser.Function{
+							Name: "upper",
+							Args: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							},
+						},
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+				},
+				{
+					Column: groupingColumnName(1),
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("1")}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("2")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewNull(), value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("1"), value.NewString("2")}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Grouping Sets With Single Column Sets",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group1"),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.GROUPING,
+					Values: []parser.QueryExpression{
+						parser.Parentheses{Expr: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Parentheses{Expr: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					Column: groupingColumnName(1),
+				},
+				{
+					Column: groupingColumnName(3),
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("1")}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewNull(), value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+					NewGroupCell([]value.Primary{value.NewTernary(ternary.TRUE), value.NewString("1"), value.NewString("2")}),
+					NewCell(value.NewTernary(ternary.FALSE)),
+				},
+			},
+			isGrouped: true,
+		},
+	},
 	{
 		Name: "Group By With ColumnNumber",
 		View: &View{
@@ -0,0 +1,144 @@
+package query
+
+import (
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// walkQueryExpression calls fn for expr and all the expressions in it in depth-first order.
+// If fn returns false, then the expressions in the expression are not visited.
+func walkQueryExpression(expr parser.QueryExpression, fn func(parser.QueryExpression) bool) {
+	if expr == nil || !fn(expr) {
+		return
+	}
+
+	var walk = func(exprs ...parser.QueryExpression) {
+		for _, e := range exprs {
+			walkQueryExpression(e, fn)
+		}
+	}
+
+	switch e := expr.(type) {
+	case parser.Parentheses:
+		walk(e.Expr)
+	case parser.RowValue:
+		walk(e.Value)
+	case parser.ValueList:
+		walk(e.Values...)
+	case parser.RowValueList:
+		walk(e.RowValues...)
+	case parser.SelectQuery:
+		walk(e.WithClause, e.SelectEntity, e.OrderByClause, e.LimitClause)
+	case parser.SelectSet:
+		walk(e.LHS, e.RHS)
+	case parser.SelectEntity:
+		walk(e.SelectClause, e.IntoClause, e.FromClause, e.WhereClause, e.GroupByClause, e.HavingClause)
+	case parser.SelectClause:
+		walk(e.Fields...)
+	case parser.FromClause:
+		walk(e.Tables...)
+	case parser.WhereClause:
+		walk(e.Filter)
+	case parser.GroupByClause:
+		walk(e.Items...)
+	case parser.GroupingSets:
+		walk(e.Values...)
+	case parser.HavingClause:
+		walk(e.Filter)
+	case parser.OrderByClause:
+		walk(e.Items...)
+	case parser.LimitClause:
+		walk(e.Value, e.OffsetClause)
+	case parser.OffsetClause:
+		walk(e.Value)
+	case parser.WithClause:
+		walk(e.InlineTables...)
+	case parser.InlineTable:
+		walk(e.Fields...)
+		walk(e.Query)
+	case parser.Subquery:
+		walk(e.Query)
+	case parser.TableObject:
+		walk(e.FormatElement, e.Path)
+		walk(e.Args...)
+	case parser.JsonQuery:
+		walk(e.Query, e.JsonText)
+	case parser.TableFunction:
+		walk(e.Args...)
+	case parser.Table:
+		walk(e.Object)
+	case parser.Join:
+		walk(e.Table, e.JoinTable, e.Condition)
+	case parser.JoinCondition:
+		walk(e.On)
+		walk(e.Using...)
+	case parser.PivotTable:
+		walk(e.Table, e.Aggregate, e.Column)
+		walk(e.Values...)
+	case parser.UnpivotTable:
+		walk(e.Table, e.Value, e.Name)
+		walk(e.Columns...)
+	case parser.Field:
+		walk(e.Object)
+	case parser.OrderItem:
+		walk(e.Value)
+	case parser.Comparison:
+		walk(e.LHS, e.RHS)
+	case parser.Is:
+		walk(e.LHS, e.RHS)
+	case parser.Between:
+		walk(e.LHS, e.Low, e.High)
+	case parser.In:
+		walk(e.LHS, e.Values)
+	case parser.All:
+		walk(e.LHS, e.Values)
+	case parser.Any:
+		walk(e.LHS, e.Values)
+	case parser.Like:
+		walk(e.LHS, e.Pattern)
+	case parser.RegExp:
+		walk(e.LHS, e.Pattern)
+	case parser.Exists:
+		walk(e.Query)
+	case parser.Arithmetic:
+		walk(e.LHS, e.RHS)
+	case parser.UnaryArithmetic:
+		walk(e.Operand)
+	case parser.Logic:
+		walk(e.LHS, e.RHS)
+	case parser.UnaryLogic:
+		walk(e.Operand)
+	case parser.Concat:
+		walk(e.Items...)
+	case parser.Function:
+		walk(e.Args...)
+	case parser.AggregateFunction:
+		walk(e.Args...)
+		walk(e.Filter)
+	case parser.ListFunction:
+		walk(e.Args...)
+		walk(e.OrderBy, e.Filter)
+	case parser.AnalyticFunction:
+		walk(e.Args...)
+		walk(e.Filter, e.AnalyticClause)
+	case parser.FilterClause:
+		walk(e.Condition)
+	case parser.AnalyticClause:
+		walk(e.PartitionClause, e.OrderByClause, e.WindowingClause)
+	case parser.PartitionClause:
+		walk(e.Values...)
+	case parser.WindowingClause:
+		walk(e.FrameLow, e.FrameHigh, e.Exclusion)
+	case parser.WindowFramePosition:
+		walk(e.OffsetValue)
+	case parser.CaseExpr:
+		walk(e.Value)
+		walk(e.When...)
+		walk(e.Else)
+	case parser.CaseExprWhen:
+		walk(e.Condition, e.Result)
+	case parser.CaseExprElse:
+		walk(e.Result)
+	case parser.VariableSubstitution:
+		walk(e.Value)
+	}
+}
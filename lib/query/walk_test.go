@@ -0,0 +1,104 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var walkQueryExpressionTests = []struct {
+	Name             string
+	Expr             parser.QueryExpression
+	IgnoreSubqueries bool
+	Result           []string
+}{
+	{
+		Name: "Walk Query Expression",
+		Expr: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					parser.Field{Object: parser.AnalyticFunction{
+						Name: "rank",
+						AnalyticClause: parser.AnalyticClause{
+							PartitionClause: parser.PartitionClause{
+								Values: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+								},
+							},
+						},
+					}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+					RHS: parser.Subquery{
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+									},
+								},
+							},
+						},
+					},
+					Operator: "=",
+				},
+			},
+		},
+		Result: []string{"column1", "column2", "column3", "column4"},
+	},
+	{
+		Name: "Walk Query Expression Ignoring Subqueries",
+		Expr: parser.Logic{
+			LHS: parser.Function{
+				Name: "coalesce",
+				Args: []parser.QueryExpression{
+					parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				},
+			},
+			RHS: parser.Exists{
+				Query: parser.Subquery{
+					Query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+								},
+							},
+						},
+					},
+				},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		IgnoreSubqueries: true,
+		Result:           []string{"column1"},
+	},
+}
+
+func TestWalkQueryExpression(t *testing.T) {
+	for _, v := range walkQueryExpressionTests {
+		var result []string
+		walkQueryExpression(v.Expr, func(e parser.QueryExpression) bool {
+			switch e.(type) {
+			case parser.Subquery:
+				return !v.IgnoreSubqueries
+			case parser.FieldReference:
+				result = append(result, e.(parser.FieldReference).Column.Literal)
+			}
+			return true
+		})
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -76,7 +76,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "from_clause",
 						Group: []Grammar{
-							{Keyword("FROM"), ContinuousOption{Link("table")}},
+							{Keyword("FROM"), Link("table"), Option{Token(","), Option{Keyword("LATERAL")}, Link("table"), Token("...")}},
 						},
 					},
 					{
@@ -86,6 +86,9 @@ var CsvqSyntax = []Expression{
 							{Link("table_entity"), Identifier("alias")},
 							{Link("table_entity"), Keyword("AS"), Identifier("alias")},
 							{Link("join")},
+							{Link("pivot")},
+							{Link("pivot"), Identifier("alias")},
+							{Link("pivot"), Keyword("AS"), Identifier("alias")},
 							{Keyword("DUAL")},
 							{Parentheses{Link("table")}},
 						},
@@ -96,6 +99,8 @@ var CsvqSyntax = []Expression{
 							{Link("table_identifier")},
 							{Link("table_object")},
 							{Link("json_inline_table")},
+							{Link("table_function")},
+							{Link("schema_table")},
 							{Parentheses{Link("select_query")}},
 						},
 					},
@@ -115,6 +120,10 @@ var CsvqSyntax = []Expression{
 							{Link("table"), Keyword("FULL"), Option{Keyword("OUTER")}, Keyword("JOIN"), Link("table"), Keyword("ON"), Link("condition")},
 							{Link("table"), Keyword("NATURAL"), Option{Keyword("INNER")}, Keyword("JOIN"), Link("table")},
 							{Link("table"), Keyword("NATURAL"), AnyOne{Keyword("LEFT"), Keyword("RIGHT")}, Option{Keyword("OUTER")}, Keyword("JOIN"), Link("table")},
+							{Link("table"), Keyword("CROSS"), Keyword("JOIN"), Keyword("LATERAL"), Link("table")},
+							{Link("table"), Option{Keyword("INNER")}, Keyword("JOIN"), Keyword("LATERAL"), Link("table"), Link("join_condition")},
+							{Link("table"), Keyword("LEFT"), Option{Keyword("OUTER")}, Keyword("JOIN"), Keyword("LATERAL"), Link("table"), Link("join_condition")},
+							{Link("table"), AnyOne{Keyword("CROSS"), Keyword("OUTER")}, Keyword("APPLY"), Link("table")},
 						},
 					},
 					{
@@ -124,13 +133,36 @@ var CsvqSyntax = []Expression{
 							{Keyword("USING"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 						},
 					},
+					{
+						Name: "pivot",
+						Group: []Grammar{
+							{Link("table"), Keyword("PIVOT"), Parentheses{Token("aggregate_function"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{ContinuousOption{Link("pivot_value")}}}},
+							{Link("table"), Keyword("PIVOT"), Parentheses{Token("aggregate_function"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{Keyword("ANY")}}},
+							{Link("table"), Keyword("UNPIVOT"), Parentheses{Identifier("value_column"), Keyword("FOR"), Identifier("name_column"), Keyword("IN"), Parentheses{ContinuousOption{Link("unpivot_column")}}}},
+						},
+					},
+					{
+						Name: "pivot_value",
+						Group: []Grammar{
+							{Link("value"), Option{Keyword("AS"), Identifier("alias")}},
+						},
+					},
+					{
+						Name: "unpivot_column",
+						Group: []Grammar{
+							{Identifier("column_name"), Option{Keyword("AS"), Identifier("alias")}},
+						},
+					},
 					{
 						Name: "table_object",
 						Group: []Grammar{
 							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
+							{Function{Name: "JSONL", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "XLSX", Args: []Element{Option{String("sheet")}, Link("table_identifier"), Option{Integer("header_row"), Boolean("without_null")}}}},
+							{Function{Name: "PARQUET", Args: []Element{Link("table_identifier"), Option{Boolean("without_null")}}}},
 						},
 					},
 					{
@@ -140,6 +172,23 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "JSON_TABLE", Args: []Element{String("json_query"), String("json_data")}}},
 						},
 					},
+					{
+						Name: "table_function",
+						Group: []Grammar{
+							{Function{Name: "STRING_SPLIT", Args: []Element{String("str"), String("separator")}}},
+						},
+					},
+					{
+						Name: "schema_table",
+						Group: []Grammar{
+							{Keyword("INFORMATION_SCHEMA.TABLES")},
+							{Keyword("INFORMATION_SCHEMA.COLUMNS")},
+							{Keyword("INFORMATION_SCHEMA.VIEWS")},
+							{Keyword("INFORMATION_SCHEMA.CURSORS")},
+							{Keyword("INFORMATION_SCHEMA.FUNCTIONS")},
+							{Keyword("INFORMATION_SCHEMA.FLAGS")},
+						},
+					},
 				},
 			},
 			{
@@ -165,7 +214,30 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "group_by_clause",
 						Group: []Grammar{
-							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("field")}},
+							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("grouping_element")}},
+						},
+					},
+					{
+						Name: "grouping_element",
+						Group: []Grammar{
+							{Link("field")},
+							{Keyword("ROLLUP"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+							{Keyword("CUBE"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+							{Keyword("GROUPING"), Keyword("SETS"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+						},
+						Description: Description{
+							Template: "%s groups records by each prefix of the grouping sets and the empty set, " +
+								"%s groups records by every combination of the grouping sets, " +
+								"and %s groups records by each of the listed grouping sets. " +
+								"In the subtotal records, fields that are not in the grouping set are %s.",
+							Values: []Element{Keyword("ROLLUP"), Keyword("CUBE"), Keyword("GROUPING SETS"), Null("NULL")},
+						},
+					},
+					{
+						Name: "grouping_set",
+						Group: []Grammar{
+							{Link("field")},
+							{Parentheses{Option{ContinuousOption{Link("field")}}}},
 						},
 					},
 				},
@@ -305,6 +377,33 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "MERGE Statement",
+		Grammar: []Definition{
+			{
+				Name: "merge_statement",
+				Group: []Grammar{
+					{Option{Link("with_clause")}, Link("merge_query")},
+				},
+			},
+			{
+				Name: "merge_query",
+				Group: []Grammar{
+					{Keyword("MERGE"), Keyword("INTO"), Identifier("table_name"), Option{Keyword("AS")}, Option{Identifier("alias")}, Keyword("USING"), Link("table"), Keyword("ON"), Link("condition"), ContinuousOption{Link("merge_when_clause")}},
+				},
+			},
+			{
+				Name: "merge_when_clause",
+				Group: []Grammar{
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Option{Keyword("BY"), Keyword("TARGET")}, Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("INSERT"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), Link("row_value")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Keyword("BY"), Keyword("SOURCE"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Keyword("BY"), Keyword("SOURCE"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+				},
+			},
+		},
+	},
 	{
 		Label: "DELETE Statement",
 		Grammar: []Definition{
@@ -329,8 +428,24 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("column_schema")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("column_schema")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "column_schema",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("NOT"), Keyword("NULL")}},
+				},
+				Description: Description{
+					Template: "If any column type or constraint is specified, a schema file with the extension %s is created next to the file.",
+					Values:   []Element{String(".schema.json")},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("STRING"), Keyword("INTEGER"), Keyword("FLOAT"), Keyword("BOOLEAN"), Keyword("DATETIME")}},
 				},
 			},
 		},
@@ -392,6 +507,27 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "CREATE INDEX Statement",
+		Grammar: []Definition{
+			{
+				Name: "create_index_statement",
+				Group: []Grammar{
+					{Keyword("CREATE"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("table_name"), Parentheses{Identifier("column_name")}},
+				},
+				Description: Description{
+					Template: "An index file with the extension %s is created next to the file. Indexes are available for CSV and TSV files.",
+					Values:   []Element{String(".idx")},
+				},
+			},
+			{
+				Name: "drop_index_statement",
+				Group: []Grammar{
+					{Keyword("DROP"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("table_name")},
+				},
+			},
+		},
+	},
 	{
 		Label: "Prepared Statement",
 		Grammar: []Definition{
@@ -666,6 +802,25 @@ var CsvqSyntax = []Expression{
 					Values:   []Element{Token("1"), Integer("exit_code")},
 				},
 			},
+			{
+				Name: "exception_block",
+				Group: []Grammar{
+					{Keyword("BEGIN"), Token("statements"), Keyword("EXCEPTION"), Keyword("WHEN"), Link("error_numbers"), Keyword("THEN"), Token("statements"), Option{Keyword("WHEN"), Link("error_numbers"), Keyword("THEN"), Token("statements"), Token("...")}, Keyword("END")},
+				},
+				Description: Description{
+					Template: "Executes the statements of the first WHEN expression matching the error number of the occurred error, " +
+						"or the exit code if the error is raised by TRIGGER ERROR. " +
+						"In the statements, %s, %s and %s return the exit code, the error number and the message of the caught error.",
+					Values: []Element{Variable("@#ERROR_CODE"), Variable("@#ERROR_NUMBER"), Variable("@#ERROR_MESSAGE")},
+				},
+			},
+			{
+				Name: "error_numbers",
+				Group: []Grammar{
+					{Keyword("ANY")},
+					{ContinuousOption{Integer("error_number")}},
+				},
+			},
 		},
 	},
 	{
@@ -683,6 +838,24 @@ var CsvqSyntax = []Expression{
 					{Keyword("ROLLBACK")},
 				},
 			},
+			{
+				Name: "savepoint_statement",
+				Group: []Grammar{
+					{Keyword("SAVEPOINT"), Identifier("savepoint_name")},
+				},
+			},
+			{
+				Name: "rollback_to_savepoint_statement",
+				Group: []Grammar{
+					{Keyword("ROLLBACK"), Keyword("TO"), Keyword("SAVEPOINT"), Identifier("savepoint_name")},
+				},
+			},
+			{
+				Name: "release_savepoint_statement",
+				Group: []Grammar{
+					{Keyword("RELEASE"), Keyword("SAVEPOINT"), Identifier("savepoint_name")},
+				},
+			},
 		},
 	},
 	{
@@ -753,6 +926,16 @@ var CsvqSyntax = []Expression{
 					Template: "Show fields in a table or a view.",
 				},
 			},
+			{
+				Name: "explain",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_query")},
+				},
+				Description: Description{
+					Template: "Show the execution plan of a select query. " +
+						"If ANALYZE is specified, the query is executed and the number of records, loops and execution time of each step are shown.",
+				},
+			},
 			{
 				Name: "chdir",
 				Group: []Grammar{
@@ -825,6 +1008,8 @@ var CsvqSyntax = []Expression{
 						"%s\n" +
 						"  > Values of Date and time with nano seconds.\n" +
 						"%s\n" +
+						"  > Spans of time that consist of months, days and nano seconds.\n" +
+						"%s\n" +
 						"  > Representations of missing values." +
 						"",
 					Values: []Element{
@@ -834,6 +1019,7 @@ var CsvqSyntax = []Expression{
 						Boolean("Boolean"),
 						Ternary("Ternary"),
 						Datetime("Datetime"),
+						Datetime("Interval"),
 						Null("Null"),
 					},
 				},
@@ -993,6 +1179,8 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Use double quotation mark(U+0022 \") as identifier enclosure.\n" +
 				"%s  <type::%s>\n" +
+				"  > Numeric type of numbers with fractional parts. FLOAT or DECIMAL.\n" +
+				"%s  <type::%s>\n" +
 				"  > Limit of the waiting time in seconds to wait for locked files to be released.\n" +
 				"%s  <type::%s>\n" +
 				"  > Default format to load files.\n" +
@@ -1046,6 +1234,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@TIMEZONE"), String("string"), Link("Timezone"),
 				Flag("@@DATETIME_FORMAT"), String("string"),
 				Flag("@@ANSI_QUOTES"), String("boolean"),
+				Flag("@@NUMERIC_MODE"), String("string"),
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
 				Flag("@@IMPORT_FORMAT"), String("string"),
 				Flag("@@DELIMITER"), String("string"),
@@ -1137,6 +1326,12 @@ var CsvqSyntax = []Expression{
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
+				"%s  <type::%s>\n" +
+				"  > Exit code of the error caught by an exception block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Error number of the error caught by an exception block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Message of the error caught by an exception block.\n" +
 				"",
 			Values: []Element{
 				Variable("@#UNCOMMITTED"), Boolean("boolean"),
@@ -1146,6 +1341,9 @@ var CsvqSyntax = []Expression{
 				Variable("@#LOADED_TABLES"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#ERROR_CODE"), Integer("integer"),
+				Variable("@#ERROR_NUMBER"), Integer("integer"),
+				Variable("@#ERROR_MESSAGE"), String("string"),
 			},
 		},
 	},
@@ -1250,6 +1448,7 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
+						"  |            | REGEXP              | n/a           |\n" +
 						"  |          6 | NOT                 | Right-to-Left |\n" +
 						"  |          7 | AND                 | Left-to-Right |\n" +
 						"  |          8 | OR                  | Left-to-Right |\n" +
@@ -1372,6 +1571,16 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("str"), String("pattern"), String("str"), Ternary("UNKNOWN"), String("pattern"), Token("%")},
 						},
 					},
+					{
+						Name: "regexp",
+						Group: []Grammar{
+							{String("str"), Option{Keyword("NOT")}, Keyword("REGEXP"), String("pattern")},
+						},
+						Description: Description{
+							Template: "Check if %s matches the regular expression %s. If %s or %s is null, then returns %s.",
+							Values:   []Element{String("str"), String("pattern"), String("str"), String("pattern"), Ternary("UNKNOWN")},
+						},
+					},
 					{
 						Name: "in",
 						Group: []Grammar{
@@ -2154,6 +2363,41 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "regexp_match",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_MATCH", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns whether %s matches the regular expression %s.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the first substring of %s that matches the regular expression %s. If there is no match, returns null.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find_all",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a JSON array of all substrings of %s that match the regular expression %s.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_replace",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_REPLACE", Args: []Element{String("str"), String("pattern"), String("replacement"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that is replaced all matches of the regular expression %s with %s in %s.", Values: []Element{String("pattern"), String("replacement"), String("str")}},
+					},
+					{
+						Name: "regexp_split",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_SPLIT", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a JSON array of the substrings generated by splitting %s with the regular expression %s.", Values: []Element{String("str"), String("pattern")}},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2262,6 +2506,16 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a float.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "decimal",
+						Group: []Grammar{
+							{Function{Name: "DECIMAL", Args: []Element{Link("value"), Option{Integer("scale")}}, Return: Return("decimal")}},
+						},
+						Description: Description{
+							Template: "Converts %s to a decimal. If %s is specified, then the result has exactly %s digits after the decimal point.",
+							Values:   []Element{Link("value"), Integer("scale"), Integer("scale")},
+						},
+					},
 					{
 						Name: "datetime",
 						Group: []Grammar{
@@ -2307,10 +2561,11 @@ var CsvqSyntax = []Expression{
 					Template: "" +
 						"Aggregate functions calculate groupd records retrieved by a select query. " +
 						"If records are not grouped, all records are dealt with as one group. " +
-						"If %s keyword is specified, aggregate functions calculate only unique values.\n" +
+						"If %s keyword is specified, aggregate functions calculate only unique values. " +
+						"If %s is specified after a function, only the records for which %s is true are calculated.\n" +
 						"\n" +
 						"Analytic Functions can be used only in %s, %s and %s",
-					Values: []Element{Keyword("DISTINCT"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
+					Values: []Element{Keyword("DISTINCT"), Link("filter_clause"), Link("condition"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
 					{
@@ -2452,6 +2707,69 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER BY"), Link("value"), Option{Link("order_direction")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at the %s position of float values of %s sorted by the order clause, interpolating between adjacent values. " +
+								"%s must be a number between 0 and 1. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Float("fraction"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER BY"), Link("value"), Option{Link("order_direction")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value of %s sorted by the order clause whose cumulative distribution is greater than or equal to %s. " +
+								"%s must be a number between 0 and 1. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Float("fraction"), Float("fraction"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER BY"), Link("value"), Option{Link("order_direction")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of %s. " +
+								"If there are multiple most frequent values, then returns the first one sorted by the order clause. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "grouping",
+						Group: []Grammar{
+							{Function{Name: "GROUPING", Args: []Element{Identifier("field_name")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns 1 if %s is aggregated in the subtotal record created by %s, %s or %s, otherwise returns 0.",
+							Values:   []Element{Identifier("field_name"), Keyword("ROLLUP"), Keyword("CUBE"), Keyword("GROUPING SETS")},
+						},
+					},
+				},
+				Children: []Expression{
+					{
+						Label: "Filter Clause",
+						Grammar: []Definition{
+							{
+								Name: "filter_clause",
+								Group: []Grammar{
+									{Keyword("FILTER"), Parentheses{Keyword("WHERE"), Link("condition")}},
+								},
+								Description: Description{
+									Template: "A filter clause can be specified after aggregate functions, %s and %s, and also before %s in analytic functions.",
+									Values:   []Element{Keyword("LISTAGG"), Keyword("JSON_AGG"), Keyword("OVER")},
+								},
+							},
+						},
+					},
 				},
 			},
 			{
@@ -2716,15 +3034,23 @@ var CsvqSyntax = []Expression{
 							{
 								Name: "windowing_clause",
 								Group: []Grammar{
-									{Keyword("ROWS"), Link("window_position")},
-									{Keyword("ROWS"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high")},
+									{AnyOne{Keyword("ROWS"), Keyword("RANGE"), Keyword("GROUPS")}, Link("window_position"), Option{Link("window_exclusion")}},
+									{AnyOne{Keyword("ROWS"), Keyword("RANGE"), Keyword("GROUPS")}, Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high"), Option{Link("window_exclusion")}},
+								},
+								Description: Description{
+									Template: "" +
+										"%s counts offsets in records, %s counts offsets in groups of records that have the same values in the order by clause, " +
+										"and %s compares offsets with the differences of the values in the order by clause. " +
+										"%s with offsets requires exactly one numeric or datetime value in the order by clause, and offsets for datetime values are in seconds or intervals.",
+									Values: []Element{Keyword("ROWS"), Keyword("GROUPS"), Keyword("RANGE"), Keyword("RANGE")},
 								},
 							},
 							{
 								Name: "window_position",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("PRECEDING")},
+									{Keyword("INTERVAL"), String("interval"), Keyword("PRECEDING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2732,8 +3058,10 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_low",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Float("offset"), Keyword("PRECEDING")},
+									{Keyword("INTERVAL"), String("interval"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("FOLLOWING")},
+									{Keyword("INTERVAL"), String("interval"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2741,11 +3069,21 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_high",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("FOLLOWING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Float("offset"), Keyword("PRECEDING")},
+									{Keyword("INTERVAL"), String("interval"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("FOLLOWING")},
+									{Keyword("INTERVAL"), String("interval"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
+							{
+								Name: "window_exclusion",
+								Group: []Grammar{
+									{Keyword("EXCLUDE"), Keyword("CURRENT"), Keyword("ROW")},
+									{Keyword("EXCLUDE"), Keyword("GROUP")},
+									{Keyword("EXCLUDE"), Keyword("TIES")},
+								},
+							},
 						},
 					},
 				},
@@ -2795,6 +3133,10 @@ var CsvqSyntax = []Expression{
 						"  > A datetime is a string formatted as datetime.\n" +
 						"\n" +
 						"%s\n" +
+						"  > An interval is a keyword INTERVAL followed by a string such as" +
+						"    '3 days 4 hours' or '1 month 02:30:00'.\n" +
+						"\n" +
+						"%s\n" +
 						"  > A null is represented by a keyword NULL.\n" +
 						"\n" +
 						"%s\n" +
@@ -2826,6 +3168,7 @@ var CsvqSyntax = []Expression{
 						Float("Float"),
 						Ternary("Ternary"),
 						Datetime("Datetime"),
+						Datetime("Interval"),
 						Null("Null"),
 						Variable("Variable"),
 						Flag("Flag"),
@@ -2877,17 +3220,17 @@ var CsvqSyntax = []Expression{
 						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
 						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
-						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
-						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
-						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LEAD " +
-						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
+						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXCEPTION EXECUTE EXISTS " +
+						"EXIT EXPLAIN FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"GROUP GROUPING HAVING IF IGNORE IN INNER INSERT INTERSECT INTERVAL INTO IS JOIN " +
+						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
+						"LEFT LIKE LIMIT LISTAGG MATCHED MAX MEDIAN MERGE MIN MODE NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON ONLY OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
-						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
-						"SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN SUM SYNTAX TABLE " +
-						"THEN TO TRIGGER TRUE " +
-						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
+						"PERCENT_RANK PERCENTILE_CONT PERCENTILE_DISC PIVOT PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE REGEXP " +
+						"RELATIVE RELEASE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"SAVEPOINT SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN STRING_SPLIT SUM SYNTAX TABLE " +
+						"TARGET THEN TO TRIGGER TRUE " +
+						"UNBOUNDED UNION UNKNOWN UNPIVOT UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
 						"WHILE WITH WITHIN",
 				},
 			},
@@ -3088,18 +3431,21 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-mode            |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+---------+------------------------------------------+\n" +
+						"|  Value  |                  Format                  |\n" +
+						"+---------+------------------------------------------+\n" +
+						"| CSV     | Character separated values               |\n" +
+						"| TSV     | Tab separated values                     |\n" +
+						"| FIXED   | Fixed-Length Format                      |\n" +
+						"| JSON    | JSON Format                              |\n" +
+						"| JSONL   | JSON Lines Format                        |\n" +
+						"| LTSV    | Labeled Tab-separated Values             |\n" +
+						"| XLSX    | Excel Workbook                           |\n" +
+						"| PARQUET | Apache Parquet                           |\n" +
+						"| GFM     | Text Table for GitHub Flavored Markdown  |\n" +
+						"| ORG     | Text Table for Emacs Org-mode            |\n" +
+						"| TEXT    | Text Table for console                   |\n" +
+						"+---------+------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -55,6 +55,20 @@ func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string) Compari
 		Discard(i1)
 	}
 
+	if isDecimalValue(p1) || isDecimalValue(p2) {
+		if d1 := ToDecimal(p1); !IsNull(d1) {
+			if d2 := ToDecimal(p2); !IsNull(d2) {
+				switch d1.(*Decimal).Cmp(d2.(*Decimal)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				}
+				return IsGreater
+			}
+		}
+	}
+
 	if f1 := ToFloat(p1); !IsNull(f1) {
 		if f2 := ToFloat(p2); !IsNull(f2) {
 			v1 := f1.(*Float).Raw()
@@ -89,6 +103,21 @@ func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string) Compari
 		Discard(d1)
 	}
 
+	if isIntervalValue(p1) || isIntervalValue(p2) {
+		if iv1 := ToInterval(p1); !IsNull(iv1) {
+			if iv2 := ToInterval(p2); !IsNull(iv2) {
+				switch iv1.(*Interval).Cmp(iv2.(*Interval)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				}
+				return IsGreater
+			}
+		}
+		return IsIncommensurable
+	}
+
 	if b1 := ToBoolean(p1); !IsNull(b1) {
 		if b2 := ToBoolean(p2); !IsNull(b2) {
 			if b1.(*Boolean).Raw() == b2.(*Boolean).Raw() {
@@ -115,6 +144,16 @@ func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string) Compari
 	return IsIncommensurable
 }
 
+func isDecimalValue(p Primary) bool {
+	_, ok := p.(*Decimal)
+	return ok
+}
+
+func isIntervalValue(p Primary) bool {
+	_, ok := p.(*Interval)
+	return ok
+}
+
 func Identical(p1 Primary, p2 Primary) ternary.Value {
 	if t, ok := p1.(*Ternary); (ok && t.value == ternary.UNKNOWN) || IsNull(p1) {
 		return ternary.UNKNOWN
@@ -135,12 +174,24 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 		}
 	}
 
+	if v1, ok := p1.(*Decimal); ok {
+		if v2, ok := p2.(*Decimal); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
 	if v1, ok := p1.(*Datetime); ok {
 		if v2, ok := p2.(*Datetime); ok {
 			return ternary.ConvertFromBool(v1.value.Equal(v2.value))
 		}
 	}
 
+	if v1, ok := p1.(*Interval); ok {
+		if v2, ok := p2.(*Interval); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
 	if v1, ok := p1.(*Boolean); ok {
 		if v2, ok := p2.(*Boolean); ok {
 			return ternary.ConvertFromBool(v1.value == v2.value)
@@ -37,6 +37,36 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewInteger(1),
 		Result: IsGreater,
 	},
+	{
+		LHS:    NewDecimalFromString("0.3"),
+		RHS:    NewString("0.30"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewDecimalFromString("0.3"),
+		RHS:    NewString("0.30000000000000001"),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewFloat(1.5),
+		RHS:    NewDecimalFromString("1.25"),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewString("24 hours"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewInterval(1, 0, 0),
+		RHS:    NewInterval(0, 31, 0),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
 	{
 		LHS:    NewFloatFromString("1.5"),
 		RHS:    NewFloat(1.5),
@@ -123,6 +153,21 @@ var identicalTests = []struct {
 	RHS    Primary
 	Result ternary.Value
 }{
+	{
+		LHS:    NewDecimalFromString("1.50"),
+		RHS:    NewDecimalFromString("1.5"),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewDecimalFromString("1.5"),
+		RHS:    NewFloat(1.5),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInterval(0, 0, 86400000000000),
+		Result: ternary.TRUE,
+	},
 	{
 		LHS:    NewNull(),
 		RHS:    NewString("R"),
@@ -256,6 +256,10 @@ func ToInteger(p Primary) Primary {
 		if math.Remainder(f, 1) == 0 {
 			return NewInteger(int64(f))
 		}
+	case *Decimal:
+		if i, ok := p.(*Decimal).Int64(); ok {
+			return NewInteger(i)
+		}
 	case *String:
 		s := cmd.TrimSpace(p.(*String).Raw())
 		if MaybeInteger(s) {
@@ -281,6 +285,8 @@ func ToFloat(p Primary) Primary {
 		return NewFloat(float64(p.(*Integer).Raw()))
 	case *Float:
 		return NewFloat(p.(*Float).Raw())
+	case *Decimal:
+		return NewFloat(p.(*Decimal).Float64())
 	case *String:
 		s := cmd.TrimSpace(p.(*String).Raw())
 		if MaybeNumber(s) {
@@ -293,6 +299,31 @@ func ToFloat(p Primary) Primary {
 	return NewNull()
 }
 
+func ToDecimal(p Primary) Primary {
+	switch p.(type) {
+	case *Integer:
+		return NewDecimalFromInt64(p.(*Integer).Raw())
+	case *Float:
+		f := p.(*Float).Raw()
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			if d, ok := parseDecimal(strconv.FormatFloat(f, 'g', -1, 64)); ok {
+				return d
+			}
+		}
+	case *Decimal:
+		return p
+	case *String:
+		s := cmd.TrimSpace(p.(*String).Raw())
+		if MaybeNumber(s) {
+			if d, ok := parseDecimal(s); ok {
+				return d
+			}
+		}
+	}
+
+	return NewNull()
+}
+
 func MaybeInteger(s string) bool {
 	if len(s) < 1 {
 		return false
@@ -374,6 +405,19 @@ func ToDatetime(p Primary, formats []string) Primary {
 	return NewNull()
 }
 
+func ToInterval(p Primary) Primary {
+	switch p.(type) {
+	case *Interval:
+		return p
+	case *String:
+		if iv, ok := StrToInterval(p.(*String).Raw()); ok {
+			return iv
+		}
+	}
+
+	return NewNull()
+}
+
 func TimeFromUnixTime(sec int64, nano int64) time.Time {
 	return time.Unix(sec, nano).In(cmd.GetLocation())
 }
@@ -382,7 +426,7 @@ func ToBoolean(p Primary) Primary {
 	switch p.(type) {
 	case *Boolean:
 		return NewBoolean(p.(*Boolean).Raw())
-	case *String, *Integer, *Float, *Ternary:
+	case *String, *Integer, *Float, *Decimal, *Ternary:
 		if p.Ternary() != ternary.UNKNOWN {
 			return NewBoolean(p.Ternary().ParseBool())
 		}
@@ -398,6 +442,10 @@ func ToString(p Primary) Primary {
 		return NewString(Int64ToStr(p.(*Integer).Raw()))
 	case *Float:
 		return NewString(Float64ToStr(p.(*Float).Raw()))
+	case *Decimal:
+		return NewString(p.(*Decimal).String())
+	case *Interval:
+		return NewString(p.(*Interval).Literal())
 	}
 	return NewNull()
 }
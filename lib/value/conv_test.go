@@ -406,6 +406,64 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestToDecimal(t *testing.T) {
+	var p Primary
+	var d Primary
+
+	p = NewInteger(1)
+	d = ToDecimal(p)
+	if _, ok := d.(*Decimal); !ok {
+		t.Errorf("primary type = %T, want Decimal for %#v", d, p)
+	}
+
+	p = NewFloat(0.1)
+	d = ToDecimal(p)
+	if _, ok := d.(*Decimal); !ok {
+		t.Errorf("primary type = %T, want Decimal for %#v", d, p)
+	} else if d.(*Decimal).String() != "0.1" {
+		t.Errorf("decimal = %s, want %s for %#v", d, "0.1", p)
+	}
+
+	p = NewString(" 1.50 ")
+	d = ToDecimal(p)
+	if _, ok := d.(*Decimal); !ok {
+		t.Errorf("primary type = %T, want Decimal for %#v", d, p)
+	} else if d.(*Decimal).String() != "1.50" {
+		t.Errorf("decimal = %s, want %s for %#v", d, "1.50", p)
+	}
+
+	p = NewString("error")
+	d = ToDecimal(p)
+	if _, ok := d.(*Null); !ok {
+		t.Errorf("primary type = %T, want Null for %#v", d, p)
+	}
+}
+
+func TestToInterval(t *testing.T) {
+	var p Primary
+	var iv Primary
+
+	p = NewString("2 days 03:00:00")
+	iv = ToInterval(p)
+	if _, ok := iv.(*Interval); !ok {
+		t.Errorf("primary type = %T, want Interval for %#v", iv, p)
+	} else if iv.(*Interval).Literal() != "2 days 03:00:00" {
+		t.Errorf("interval = %s, want %s for %#v", iv, "2 days 03:00:00", p)
+	}
+
+	p = NewInterval(1, 0, 0)
+	iv = ToInterval(p)
+	if _, ok := iv.(*Interval); !ok {
+		t.Errorf("primary type = %T, want Interval for %#v", iv, p)
+	}
+
+	p = NewInteger(1)
+	iv = ToInterval(p)
+	if _, ok := iv.(*Null); !ok {
+		t.Errorf("primary type = %T, want Null for %#v", iv, p)
+	}
+}
+
 func TestToDatetime(t *testing.T) {
 	var p Primary
 	var dt Primary
@@ -515,6 +573,14 @@ func TestToString(t *testing.T) {
 	if _, ok := s.(*Null); !ok {
 		t.Errorf("primary type = %T, want Null for %#v", s, p)
 	}
+
+	p = NewInterval(0, 1, 0)
+	s = ToString(p)
+	if _, ok := s.(*String); !ok {
+		t.Errorf("primary type = %T, want String for %#v", s, p)
+	} else if s.(*String).Raw() != "1 day" {
+		t.Errorf("string = %q, want %q for %#v", s.(*String).Raw(), "1 day", p)
+	}
 }
 
 var maybeNumberTests = []struct {
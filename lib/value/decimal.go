@@ -0,0 +1,308 @@
+package value
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/ternary"
+)
+
+// DecimalDivisionScale is the minimum number of digits after the decimal point in the result of a division.
+const DecimalDivisionScale = 16
+
+const maxDecimalExponent = 4096
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact decimal number that consists of an unscaled integer and a scale.
+// Its value is unscaled * 10^(-scale).
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+func NewDecimalFromString(s string) *Decimal {
+	if d, ok := parseDecimal(s); ok {
+		return d
+	}
+	return NewDecimalFromInt64(0)
+}
+
+func NewDecimalFromInt64(i int64) *Decimal {
+	return newDecimal(big.NewInt(i), 0)
+}
+
+func newDecimal(unscaled *big.Int, scale int) *Decimal {
+	return &Decimal{
+		unscaled: unscaled,
+		scale:    scale,
+	}
+}
+
+func parseDecimal(s string) (*Decimal, bool) {
+	if len(s) < 1 {
+		return nil, false
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); -1 < i {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -maxDecimalExponent || maxDecimalExponent < e {
+			return nil, false
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	scale := 0
+	if i := strings.IndexByte(s, '.'); -1 < i {
+		scale = len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+	if len(s) < 1 {
+		return nil, false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDecimal(s[i]) {
+			return nil, false
+		}
+	}
+
+	unscaled, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, false
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+
+	scale = scale - exp
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	return newDecimal(unscaled, scale), true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.unscaled).String()
+	if 0 < d.scale {
+		if len(s) <= d.scale {
+			s = strings.Repeat("0", d.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+func (d Decimal) Ternary() ternary.Value {
+	if d.unscaled.Sign() == 0 {
+		return ternary.FALSE
+	}
+	if d.Cmp(NewDecimalFromInt64(1)) == 0 {
+		return ternary.TRUE
+	}
+	return ternary.UNKNOWN
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.unscaled.Sign()
+}
+
+func (d Decimal) Float64() float64 {
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		if d.Sign() < 0 {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	}
+	return f
+}
+
+// Int64 returns the value as an int64 if the value is an integer within the range of int64.
+func (d Decimal) Int64() (int64, bool) {
+	q, r := new(big.Int).QuoRem(d.unscaled, pow10(d.scale), new(big.Int))
+	if r.Sign() != 0 || !q.IsInt64() {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+func alignDecimals(d1 *Decimal, d2 *Decimal) (*big.Int, *big.Int, int) {
+	switch {
+	case d1.scale < d2.scale:
+		return new(big.Int).Mul(d1.unscaled, pow10(d2.scale-d1.scale)), d2.unscaled, d2.scale
+	case d2.scale < d1.scale:
+		return d1.unscaled, new(big.Int).Mul(d2.unscaled, pow10(d1.scale-d2.scale)), d1.scale
+	}
+	return d1.unscaled, d2.unscaled, d1.scale
+}
+
+func (d Decimal) Cmp(d2 *Decimal) int {
+	x1, x2, _ := alignDecimals(&d, d2)
+	return x1.Cmp(x2)
+}
+
+func (d Decimal) Neg() *Decimal {
+	return newDecimal(new(big.Int).Neg(d.unscaled), d.scale)
+}
+
+func (d Decimal) Add(d2 *Decimal) *Decimal {
+	x1, x2, scale := alignDecimals(&d, d2)
+	return newDecimal(new(big.Int).Add(x1, x2), scale)
+}
+
+func (d Decimal) Sub(d2 *Decimal) *Decimal {
+	x1, x2, scale := alignDecimals(&d, d2)
+	return newDecimal(new(big.Int).Sub(x1, x2), scale)
+}
+
+func (d Decimal) Mul(d2 *Decimal) *Decimal {
+	return newDecimal(new(big.Int).Mul(d.unscaled, d2.unscaled), d.scale+d2.scale)
+}
+
+// Quo returns the quotient rounded half away from zero at DecimalDivisionScale or the larger scale of the operands.
+// Trailing zeros beyond the larger scale of the operands are removed.
+// If the divisor is zero, then it returns false.
+func (d Decimal) Quo(d2 *Decimal) (*Decimal, bool) {
+	if d2.unscaled.Sign() == 0 {
+		return nil, false
+	}
+
+	minScale := d.scale
+	if minScale < d2.scale {
+		minScale = d2.scale
+	}
+	scale := minScale
+	if scale < DecimalDivisionScale {
+		scale = DecimalDivisionScale
+	}
+
+	n := new(big.Int).Mul(d.unscaled, pow10(d2.scale+scale-d.scale))
+	q := roundQuo(n, d2.unscaled, roundHalfUp)
+	return newDecimal(q, scale).trim(minScale), true
+}
+
+// Rem returns the remainder of the truncated division that has the same sign as the dividend.
+// If the divisor is zero, then it returns false.
+func (d Decimal) Rem(d2 *Decimal) (*Decimal, bool) {
+	if d2.unscaled.Sign() == 0 {
+		return nil, false
+	}
+	x1, x2, scale := alignDecimals(&d, d2)
+	return newDecimal(new(big.Int).Rem(x1, x2), scale), true
+}
+
+// Round rounds the value half away from zero to the place after the decimal point.
+// A negative place rounds the value to the left of the decimal point.
+func (d Decimal) Round(place int) *Decimal {
+	return d.roundToPlace(place, roundHalfUp)
+}
+
+func (d Decimal) Ceil(place int) *Decimal {
+	return d.roundToPlace(place, roundCeil)
+}
+
+func (d Decimal) Floor(place int) *Decimal {
+	return d.roundToPlace(place, roundFloor)
+}
+
+// Rescale returns the value with exactly the scale digits after the decimal point.
+// The value is rounded half away from zero if the scale is smaller than the current scale.
+func (d Decimal) Rescale(scale int) *Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if d.scale <= scale {
+		return newDecimal(new(big.Int).Mul(d.unscaled, pow10(scale-d.scale)), scale)
+	}
+	return newDecimal(roundQuo(d.unscaled, pow10(d.scale-scale), roundHalfUp), scale)
+}
+
+func (d Decimal) roundToPlace(place int, mode roundingMode) *Decimal {
+	if d.scale <= place {
+		return newDecimal(d.unscaled, d.scale)
+	}
+
+	q := roundQuo(d.unscaled, pow10(d.scale-place), mode)
+	if place < 0 {
+		return newDecimal(q.Mul(q, pow10(-place)), 0)
+	}
+	return newDecimal(q, place)
+}
+
+func (d Decimal) trim(minScale int) *Decimal {
+	unscaled := d.unscaled
+	scale := d.scale
+	r := new(big.Int)
+	for minScale < scale {
+		q, m := new(big.Int).QuoRem(unscaled, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		unscaled = q
+		scale--
+	}
+	return newDecimal(unscaled, scale)
+}
+
+type roundingMode int
+
+const (
+	roundHalfUp roundingMode = iota
+	roundCeil
+	roundFloor
+)
+
+func roundQuo(n *big.Int, d *big.Int, mode roundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	positive := (n.Sign() < 0) == (d.Sign() < 0)
+	switch mode {
+	case roundCeil:
+		if positive {
+			q.Add(q, big.NewInt(1))
+		}
+	case roundFloor:
+		if !positive {
+			q.Sub(q, big.NewInt(1))
+		}
+	default:
+		r2 := new(big.Int).Abs(r)
+		r2.Lsh(r2, 1)
+		if 0 <= r2.Cmp(new(big.Int).Abs(d)) {
+			if positive {
+				q.Add(q, big.NewInt(1))
+			} else {
+				q.Sub(q, big.NewInt(1))
+			}
+		}
+	}
+	return q
+}
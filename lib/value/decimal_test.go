@@ -0,0 +1,188 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/mithrandie/ternary"
+)
+
+var decimalStringTests = []struct {
+	Input  string
+	Result string
+	OK     bool
+}{
+	{Input: "1.50", Result: "1.50", OK: true},
+	{Input: "-0.05", Result: "-0.05", OK: true},
+	{Input: "+12", Result: "12", OK: true},
+	{Input: ".5", Result: "0.5", OK: true},
+	{Input: "1.25e+2", Result: "125", OK: true},
+	{Input: "1.25e-3", Result: "0.00125", OK: true},
+	{Input: "1e+5000", OK: false},
+	{Input: "1.2.3", OK: false},
+	{Input: "abc", OK: false},
+	{Input: "", OK: false},
+}
+
+func TestDecimal_String(t *testing.T) {
+	for _, v := range decimalStringTests {
+		d, ok := parseDecimal(v.Input)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Input)
+			continue
+		}
+		if ok && d.String() != v.Result {
+			t.Errorf("string = %q, want %q for %q", d.String(), v.Result, v.Input)
+		}
+	}
+}
+
+func TestDecimal_Ternary(t *testing.T) {
+	d := NewDecimalFromString("1.00")
+	if d.Ternary() != ternary.TRUE {
+		t.Errorf("ternary = %s, want %s for %s", d.Ternary(), ternary.TRUE, d)
+	}
+	d = NewDecimalFromString("0.0")
+	if d.Ternary() != ternary.FALSE {
+		t.Errorf("ternary = %s, want %s for %s", d.Ternary(), ternary.FALSE, d)
+	}
+	d = NewDecimalFromString("1.5")
+	if d.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %s", d.Ternary(), ternary.UNKNOWN, d)
+	}
+}
+
+func TestDecimal_Int64(t *testing.T) {
+	d := NewDecimalFromString("-12.00")
+	if i, ok := d.Int64(); !ok || i != -12 {
+		t.Errorf("int64 = %d, %t, want %d, %t for %s", i, ok, -12, true, d)
+	}
+	d = NewDecimalFromString("12.5")
+	if i, ok := d.Int64(); ok {
+		t.Errorf("int64 = %d, %t, want %t for %s", i, ok, false, d)
+	}
+}
+
+var decimalArithmeticTests = []struct {
+	Name   string
+	Func   func(*Decimal, *Decimal) (*Decimal, bool)
+	LHS    string
+	RHS    string
+	Result string
+	OK     bool
+}{
+	{
+		Name:   "Add",
+		Func:   func(d1 *Decimal, d2 *Decimal) (*Decimal, bool) { return d1.Add(d2), true },
+		LHS:    "0.1",
+		RHS:    "0.20",
+		Result: "0.30",
+		OK:     true,
+	},
+	{
+		Name:   "Sub",
+		Func:   func(d1 *Decimal, d2 *Decimal) (*Decimal, bool) { return d1.Sub(d2), true },
+		LHS:    "1",
+		RHS:    "1.25",
+		Result: "-0.25",
+		OK:     true,
+	},
+	{
+		Name:   "Mul",
+		Func:   func(d1 *Decimal, d2 *Decimal) (*Decimal, bool) { return d1.Mul(d2), true },
+		LHS:    "1.5",
+		RHS:    "-0.3",
+		Result: "-0.45",
+		OK:     true,
+	},
+	{
+		Name:   "Quo",
+		Func:   (*Decimal).Quo,
+		LHS:    "2",
+		RHS:    "3",
+		Result: "0.6666666666666667",
+		OK:     true,
+	},
+	{
+		Name:   "Quo Trailing Zeros",
+		Func:   (*Decimal).Quo,
+		LHS:    "10.00",
+		RHS:    "4",
+		Result: "2.50",
+		OK:     true,
+	},
+	{
+		Name:   "Quo Negative",
+		Func:   (*Decimal).Quo,
+		LHS:    "-2",
+		RHS:    "3",
+		Result: "-0.6666666666666667",
+		OK:     true,
+	},
+	{
+		Name: "Quo Division by Zero",
+		Func: (*Decimal).Quo,
+		LHS:  "1",
+		RHS:  "0.0",
+		OK:   false,
+	},
+	{
+		Name:   "Rem",
+		Func:   (*Decimal).Rem,
+		LHS:    "-7.5",
+		RHS:    "2",
+		Result: "-1.5",
+		OK:     true,
+	},
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	for _, v := range decimalArithmeticTests {
+		result, ok := v.Func(NewDecimalFromString(v.LHS), NewDecimalFromString(v.RHS))
+		if ok != v.OK {
+			t.Errorf("%s: ok = %t, want %t", v.Name, ok, v.OK)
+			continue
+		}
+		if ok && result.String() != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var decimalRoundTests = []struct {
+	Name   string
+	Func   func(*Decimal, int) *Decimal
+	Input  string
+	Place  int
+	Result string
+}{
+	{Name: "Round", Func: (*Decimal).Round, Input: "2.345", Place: 2, Result: "2.35"},
+	{Name: "Round Negative", Func: (*Decimal).Round, Input: "-2.345", Place: 2, Result: "-2.35"},
+	{Name: "Round Place Over Scale", Func: (*Decimal).Round, Input: "2.5", Place: 3, Result: "2.5"},
+	{Name: "Round Negative Place", Func: (*Decimal).Round, Input: "1250.5", Place: -2, Result: "1300"},
+	{Name: "Ceil", Func: (*Decimal).Ceil, Input: "-2.341", Place: 2, Result: "-2.34"},
+	{Name: "Floor", Func: (*Decimal).Floor, Input: "-2.341", Place: 2, Result: "-2.35"},
+	{Name: "Rescale Up", Func: (*Decimal).Rescale, Input: "2.5", Place: 3, Result: "2.500"},
+	{Name: "Rescale Down", Func: (*Decimal).Rescale, Input: "2.555", Place: 2, Result: "2.56"},
+}
+
+func TestDecimal_Round(t *testing.T) {
+	for _, v := range decimalRoundTests {
+		result := v.Func(NewDecimalFromString(v.Input), v.Place)
+		if result.String() != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	d1 := NewDecimalFromString("0.30")
+	d2 := NewDecimalFromString("0.3")
+	if r := d1.Cmp(d2); r != 0 {
+		t.Errorf("result = %d, want %d for %s and %s", r, 0, d1, d2)
+	}
+
+	d2 = NewDecimalFromString("0.30000000000000001")
+	if r := d1.Cmp(d2); r != -1 {
+		t.Errorf("result = %d, want %d for %s and %s", r, -1, d1, d2)
+	}
+}
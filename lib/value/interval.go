@@ -0,0 +1,357 @@
+package value
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	daysPerMonth   = 30
+	nanosPerSecond = int64(time.Second)
+	nanosPerDay    = 24 * int64(time.Hour)
+)
+
+type intervalUnit struct {
+	months int64
+	days   int64
+	nanos  int64
+}
+
+var intervalUnits = map[string]intervalUnit{
+	"YEAR":        {months: 12},
+	"MONTH":       {months: 1},
+	"MON":         {months: 1},
+	"WEEK":        {days: 7},
+	"DAY":         {days: 1},
+	"HOUR":        {nanos: int64(time.Hour)},
+	"MINUTE":      {nanos: int64(time.Minute)},
+	"MIN":         {nanos: int64(time.Minute)},
+	"SECOND":      {nanos: int64(time.Second)},
+	"SEC":         {nanos: int64(time.Second)},
+	"MILLISECOND": {nanos: int64(time.Millisecond)},
+	"MICROSECOND": {nanos: int64(time.Microsecond)},
+	"NANOSECOND":  {nanos: 1},
+}
+
+// Interval is a span of time that consists of months, days and nanoseconds.
+// Months and days are added to datetimes according to the calendar.
+type Interval struct {
+	months int64
+	days   int64
+	nanos  int64
+}
+
+func NewInterval(months int64, days int64, nanos int64) *Interval {
+	return &Interval{
+		months: months,
+		days:   days,
+		nanos:  nanos,
+	}
+}
+
+func NewIntervalFromString(s string) *Interval {
+	if iv, ok := StrToInterval(s); ok {
+		return iv
+	}
+	return NewInterval(0, 0, 0)
+}
+
+// NewIntervalBetween returns the interval from t2 to t1 as days and nanoseconds.
+func NewIntervalBetween(t1 time.Time, t2 time.Time) *Interval {
+	secs := t1.Unix() - t2.Unix()
+	nanos := (secs%86400)*nanosPerSecond + int64(t1.Nanosecond()-t2.Nanosecond())
+	days := secs / 86400
+
+	switch {
+	case 0 < days && nanos < 0:
+		days--
+		nanos += nanosPerDay
+	case days < 0 && 0 < nanos:
+		days++
+		nanos -= nanosPerDay
+	}
+	return NewInterval(0, days, nanos)
+}
+
+// StrToInterval parses a string such as "1 year 2 months 3 days 04:05:06.5" or "3 days 4 hours".
+func StrToInterval(s string) (*Interval, bool) {
+	words := strings.Fields(s)
+	if len(words) < 1 {
+		return nil, false
+	}
+
+	months := new(big.Int)
+	days := new(big.Int)
+	nanos := new(big.Int)
+
+	for i := 0; i < len(words); i++ {
+		if strings.IndexByte(words[i], ':') != -1 {
+			n, ok := parseClock(words[i])
+			if !ok {
+				return nil, false
+			}
+			nanos.Add(nanos, n)
+			continue
+		}
+
+		if !isIntervalAmount(words[i]) || len(words) <= i+1 {
+			return nil, false
+		}
+		amount, ok := new(big.Rat).SetString(words[i])
+		if !ok {
+			return nil, false
+		}
+		i++
+		unit, ok := intervalUnits[strings.TrimSuffix(strings.ToUpper(words[i]), "S")]
+		if !ok {
+			return nil, false
+		}
+
+		switch {
+		case unit.months != 0:
+			m := new(big.Rat).Mul(amount, new(big.Rat).SetInt64(unit.months))
+			if !m.IsInt() {
+				return nil, false
+			}
+			months.Add(months, m.Num())
+		case unit.days != 0:
+			d := new(big.Rat).Mul(amount, new(big.Rat).SetInt64(unit.days))
+			q, r := new(big.Int).QuoRem(d.Num(), d.Denom(), new(big.Int))
+			days.Add(days, q)
+			frac := new(big.Rat).SetFrac(r.Mul(r, big.NewInt(nanosPerDay)), d.Denom())
+			nanos.Add(nanos, new(big.Int).Quo(frac.Num(), frac.Denom()))
+		default:
+			n := new(big.Rat).Mul(amount, new(big.Rat).SetInt64(unit.nanos))
+			nanos.Add(nanos, new(big.Int).Quo(n.Num(), n.Denom()))
+		}
+	}
+
+	if !months.IsInt64() || !days.IsInt64() || !nanos.IsInt64() {
+		return nil, false
+	}
+	return NewInterval(months.Int64(), days.Int64(), nanos.Int64()), true
+}
+
+func isIntervalAmount(s string) bool {
+	if s[0] == '-' || s[0] == '+' {
+		s = s[1:]
+	}
+	if i := strings.IndexByte(s, '.'); -1 < i {
+		s = s[:i] + s[i+1:]
+	}
+	if len(s) < 1 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDecimal(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseClock(s string) (*big.Int, bool) {
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || 3 < len(parts) {
+		return nil, false
+	}
+
+	var sec string
+	if len(parts) == 3 {
+		sec = parts[2]
+	}
+	frac := ""
+	if i := strings.IndexByte(sec, '.'); -1 < i {
+		frac = sec[i+1:]
+		sec = sec[:i]
+		if len(frac) < 1 || 9 < len(frac) {
+			return nil, false
+		}
+	}
+
+	n := new(big.Int)
+	for i, p := range []string{parts[0], parts[1], sec, frac} {
+		if i == 2 && len(parts) < 3 {
+			break
+		}
+		if len(p) < 1 && i < 3 {
+			return nil, false
+		}
+		for j := 0; j < len(p); j++ {
+			if !isDecimal(p[j]) {
+				return nil, false
+			}
+		}
+		if len(p) < 1 {
+			continue
+		}
+
+		v, ok := new(big.Int).SetString(p, 10)
+		if !ok {
+			return nil, false
+		}
+		switch i {
+		case 0:
+			v.Mul(v, big.NewInt(int64(time.Hour)))
+		case 1:
+			v.Mul(v, big.NewInt(int64(time.Minute)))
+		case 2:
+			v.Mul(v, big.NewInt(nanosPerSecond))
+		default:
+			v.Mul(v, pow10(9-len(p)))
+		}
+		n.Add(n, v)
+	}
+
+	if neg {
+		n.Neg(n)
+	}
+	return n, true
+}
+
+func (iv Interval) String() string {
+	return "INTERVAL " + cmd.QuoteString(iv.Literal())
+}
+
+// Literal returns the interval in the form such as "1 year 2 months 3 days 04:05:06.5".
+func (iv Interval) Literal() string {
+	s := make([]string, 0, 4)
+
+	years := iv.months / 12
+	months := iv.months % 12
+	if years != 0 {
+		s = append(s, formatIntervalUnit(years, "year"))
+	}
+	if months != 0 {
+		s = append(s, formatIntervalUnit(months, "month"))
+	}
+	if iv.days != 0 {
+		s = append(s, formatIntervalUnit(iv.days, "day"))
+	}
+	if iv.nanos != 0 || len(s) < 1 {
+		s = append(s, formatClock(iv.nanos))
+	}
+	return strings.Join(s, " ")
+}
+
+func formatIntervalUnit(n int64, unit string) string {
+	s := strconv.FormatInt(n, 10) + " " + unit
+	if n != 1 && n != -1 {
+		s = s + "s"
+	}
+	return s
+}
+
+func formatClock(nanos int64) string {
+	sign := ""
+	n := new(big.Int).SetInt64(nanos)
+	if nanos < 0 {
+		sign = "-"
+		n.Neg(n)
+	}
+
+	secs, frac := new(big.Int).QuoRem(n, big.NewInt(nanosPerSecond), new(big.Int))
+	h := new(big.Int).Quo(secs, big.NewInt(3600)).Int64()
+	m := new(big.Int).Rem(new(big.Int).Quo(secs, big.NewInt(60)), big.NewInt(60)).Int64()
+	sc := new(big.Int).Rem(secs, big.NewInt(60)).Int64()
+
+	s := sign + pad2(h) + ":" + pad2(m) + ":" + pad2(sc)
+	if frac.Sign() != 0 {
+		f := strconv.FormatInt(frac.Int64()+nanosPerSecond, 10)[1:]
+		s = s + "." + strings.TrimRight(f, "0")
+	}
+	return s
+}
+
+func pad2(i int64) string {
+	s := strconv.FormatInt(i, 10)
+	if len(s) < 2 {
+		s = "0" + s
+	}
+	return s
+}
+
+func (iv Interval) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+func (iv Interval) Months() int64 {
+	return iv.months
+}
+
+func (iv Interval) Days() int64 {
+	return iv.days
+}
+
+func (iv Interval) Nanoseconds() int64 {
+	return iv.nanos
+}
+
+// Span returns the length of the interval as days and nanoseconds less than a day,
+// assuming that a month has 30 days and a day has 24 hours.
+func (iv Interval) Span() (int64, int64) {
+	days := iv.months*daysPerMonth + iv.days + iv.nanos/nanosPerDay
+	nanos := iv.nanos % nanosPerDay
+	if nanos < 0 {
+		days--
+		nanos += nanosPerDay
+	}
+	return days, nanos
+}
+
+func (iv Interval) Cmp(iv2 *Interval) int {
+	d1, n1 := iv.Span()
+	d2, n2 := iv2.Span()
+	switch {
+	case d1 < d2 || (d1 == d2 && n1 < n2):
+		return -1
+	case d1 > d2 || (d1 == d2 && n1 > n2):
+		return 1
+	}
+	return 0
+}
+
+func (iv Interval) Neg() *Interval {
+	return NewInterval(-iv.months, -iv.days, -iv.nanos)
+}
+
+func (iv Interval) Add(iv2 *Interval) *Interval {
+	return NewInterval(iv.months+iv2.months, iv.days+iv2.days, iv.nanos+iv2.nanos)
+}
+
+func (iv Interval) Sub(iv2 *Interval) *Interval {
+	return NewInterval(iv.months-iv2.months, iv.days-iv2.days, iv.nanos-iv2.nanos)
+}
+
+func (iv Interval) Mul(i int64) *Interval {
+	return NewInterval(iv.months*i, iv.days*i, iv.nanos*i)
+}
+
+// AddTo adds the interval to t. Months, days and the time are added in that order.
+// If the day of t does not exist in the month after adding months, the day is adjusted to the last day of the month.
+func (iv Interval) AddTo(t time.Time) time.Time {
+	if iv.months != 0 {
+		year, month, day := t.Date()
+		year, month, _ = time.Date(year, month+time.Month(iv.months), 1, 0, 0, 0, 0, time.UTC).Date()
+		if lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); lastDay < day {
+			day = lastDay
+		}
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return t.AddDate(0, 0, int(iv.days)).Add(time.Duration(iv.nanos))
+}
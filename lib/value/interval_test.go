@@ -0,0 +1,149 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+var strToIntervalTests = []struct {
+	Input   string
+	Months  int64
+	Days    int64
+	Nanos   int64
+	Literal string
+	OK      bool
+}{
+	{Input: "3 days 4 hours", Days: 3, Nanos: int64(4 * time.Hour), Literal: "3 days 04:00:00", OK: true},
+	{Input: "1 Year 14 MONTHS 1 week", Months: 26, Days: 7, Literal: "2 years 2 months 7 days", OK: true},
+	{Input: "1.5 days", Days: 1, Nanos: int64(12 * time.Hour), Literal: "1 day 12:00:00", OK: true},
+	{Input: "-1.5 days", Days: -1, Nanos: -int64(12 * time.Hour), Literal: "-1 day -12:00:00", OK: true},
+	{Input: "90 minutes 0.25 sec", Nanos: int64(90*time.Minute + 250*time.Millisecond), Literal: "01:30:00.25", OK: true},
+	{Input: "1 day -01:02:03.5", Days: 1, Nanos: -int64(time.Hour + 2*time.Minute + 3500*time.Millisecond), Literal: "1 day -01:02:03.5", OK: true},
+	{Input: "36:00", Nanos: int64(36 * time.Hour), Literal: "36:00:00", OK: true},
+	{Input: "0 days", Literal: "00:00:00", OK: true},
+	{Input: "1.5 months", OK: false},
+	{Input: "3 dayz", OK: false},
+	{Input: "3", OK: false},
+	{Input: "1e3 days", OK: false},
+	{Input: "1:2:3:4", OK: false},
+	{Input: "1:xx", OK: false},
+	{Input: "", OK: false},
+}
+
+func TestStrToInterval(t *testing.T) {
+	for _, v := range strToIntervalTests {
+		iv, ok := StrToInterval(v.Input)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Input)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if iv.Months() != v.Months || iv.Days() != v.Days || iv.Nanoseconds() != v.Nanos {
+			t.Errorf("result = (%d, %d, %d), want (%d, %d, %d) for %q", iv.Months(), iv.Days(), iv.Nanoseconds(), v.Months, v.Days, v.Nanos, v.Input)
+		}
+		if iv.Literal() != v.Literal {
+			t.Errorf("literal = %q, want %q for %q", iv.Literal(), v.Literal, v.Input)
+		}
+	}
+}
+
+func TestInterval_String(t *testing.T) {
+	iv := NewInterval(0, 3, int64(4*time.Hour))
+	expect := "INTERVAL '3 days 04:00:00'"
+	if iv.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", iv.String(), expect, iv)
+	}
+}
+
+var intervalCmpTests = []struct {
+	LHS    *Interval
+	RHS    *Interval
+	Result int
+}{
+	{LHS: NewInterval(0, 1, 0), RHS: NewInterval(0, 0, int64(24*time.Hour)), Result: 0},
+	{LHS: NewInterval(1, 0, 0), RHS: NewInterval(0, 29, 0), Result: 1},
+	{LHS: NewInterval(0, 1, -1), RHS: NewInterval(0, 1, 0), Result: -1},
+	{LHS: NewInterval(0, -1, 0), RHS: NewInterval(0, 0, -int64(time.Hour)), Result: -1},
+}
+
+func TestInterval_Cmp(t *testing.T) {
+	for _, v := range intervalCmpTests {
+		r := v.LHS.Cmp(v.RHS)
+		if r != v.Result {
+			t.Errorf("result = %d, want %d for %s and %s", r, v.Result, v.LHS, v.RHS)
+		}
+	}
+}
+
+var intervalAddToTests = []struct {
+	Interval *Interval
+	Time     time.Time
+	Result   time.Time
+}{
+	{
+		Interval: NewInterval(1, 1, int64(time.Hour)),
+		Time:     time.Date(2012, 1, 31, 23, 30, 0, 0, time.UTC),
+		Result:   time.Date(2012, 3, 2, 0, 30, 0, 0, time.UTC),
+	},
+	{
+		Interval: NewInterval(1, 0, 0),
+		Time:     time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+		Result:   time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: NewInterval(-1, 0, 0),
+		Time:     time.Date(2021, 3, 31, 12, 0, 0, 0, time.UTC),
+		Result:   time.Date(2021, 2, 28, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: NewInterval(13, 0, 0),
+		Time:     time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		Result:   time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Interval: NewInterval(-12, 0, 0),
+		Time:     time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+		Result:   time.Date(2019, 2, 28, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestInterval_AddTo(t *testing.T) {
+	for _, v := range intervalAddToTests {
+		if r := v.Interval.AddTo(v.Time); !r.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for %s + %s", r, v.Result, v.Time, v.Interval)
+		}
+	}
+}
+
+var newIntervalBetweenTests = []struct {
+	T1     time.Time
+	T2     time.Time
+	Result *Interval
+}{
+	{
+		T1:     time.Date(2012, 3, 4, 12, 0, 0, 0, time.UTC),
+		T2:     time.Date(2012, 3, 1, 18, 0, 0, 500, time.UTC),
+		Result: NewInterval(0, 2, int64(18*time.Hour)-500),
+	},
+	{
+		T1:     time.Date(2012, 3, 1, 18, 0, 0, 0, time.UTC),
+		T2:     time.Date(2012, 3, 4, 12, 0, 0, 0, time.UTC),
+		Result: NewInterval(0, -2, -int64(18*time.Hour)),
+	},
+	{
+		T1:     time.Date(2012, 3, 1, 0, 0, 0, 0, time.UTC),
+		T2:     time.Date(2012, 3, 1, 0, 0, 1, 0, time.UTC),
+		Result: NewInterval(0, 0, -int64(time.Second)),
+	},
+}
+
+func TestNewIntervalBetween(t *testing.T) {
+	for _, v := range newIntervalBetweenTests {
+		r := NewIntervalBetween(v.T1, v.T2)
+		if *r != *v.Result {
+			t.Errorf("result = %s, want %s for %s - %s", r, v.Result, v.T1, v.T2)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// Package xlsx implements reading and writing of worksheets in the Office Open XML spreadsheet format.
+//
+// Cell values are represented as nil, string, int64, float64, bool or time.Time.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	relTypeOfficeDocument = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	relTypeSharedStrings  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings"
+	relTypeStyles         = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
+)
+
+var ErrNoSheet = errors.New("workbook has no sheets")
+
+type relationships struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type workbook struct {
+	WorkbookPr struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		Id   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type text struct {
+	Text string `xml:",chardata"`
+}
+
+type stringItem struct {
+	T    *text  `xml:"t"`
+	Runs []text `xml:"r>t"`
+}
+
+func (si stringItem) String() string {
+	if si.T != nil {
+		return si.T.Text
+	}
+	var b strings.Builder
+	for _, r := range si.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+type sharedStrings struct {
+	Items []stringItem `xml:"si"`
+}
+
+type styleSheet struct {
+	NumFmts []struct {
+		Id   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtId int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type worksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R  string      `xml:"r,attr"`
+			T  string      `xml:"t,attr"`
+			S  int         `xml:"s,attr"`
+			V  *string     `xml:"v"`
+			Is *stringItem `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// Workbook is a spreadsheet file opened for reading.
+type Workbook struct {
+	zr *zip.Reader
+
+	sheetNames []string
+	sheetPaths []string
+
+	sharedStrings []string
+	dateStyles    []bool
+	date1904      bool
+}
+
+func Open(r io.ReaderAt, size int64) (*Workbook, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	wb := &Workbook{zr: zr}
+
+	workbookPath := "xl/workbook.xml"
+	rels := relationships{}
+	if err = wb.decode("_rels/.rels", &rels); err != nil {
+		return nil, err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.Type == relTypeOfficeDocument {
+			workbookPath = resolvePath("", rel.Target)
+			break
+		}
+	}
+
+	book := workbook{}
+	if err = wb.decode(workbookPath, &book); err != nil {
+		return nil, err
+	}
+	wb.date1904 = book.WorkbookPr.Date1904 == "1" || book.WorkbookPr.Date1904 == "true"
+
+	dir := path.Dir(workbookPath)
+	rels = relationships{}
+	if err = wb.decode(path.Join(dir, "_rels", path.Base(workbookPath)+".rels"), &rels); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		target := resolvePath(dir, rel.Target)
+		targets[rel.Id] = target
+
+		switch rel.Type {
+		case relTypeSharedStrings:
+			sst := sharedStrings{}
+			if err = wb.decode(target, &sst); err != nil {
+				return nil, err
+			}
+			wb.sharedStrings = make([]string, len(sst.Items))
+			for i := range sst.Items {
+				wb.sharedStrings[i] = sst.Items[i].String()
+			}
+		case relTypeStyles:
+			styles := styleSheet{}
+			if err = wb.decode(target, &styles); err != nil {
+				return nil, err
+			}
+			customFormats := make(map[int]string, len(styles.NumFmts))
+			for _, f := range styles.NumFmts {
+				customFormats[f.Id] = f.Code
+			}
+			wb.dateStyles = make([]bool, len(styles.CellXfs))
+			for i, xf := range styles.CellXfs {
+				if code, ok := customFormats[xf.NumFmtId]; ok {
+					wb.dateStyles[i] = IsDateFormat(code)
+				} else {
+					wb.dateStyles[i] = isBuiltInDateFormat(xf.NumFmtId)
+				}
+			}
+		}
+	}
+
+	for _, sheet := range book.Sheets {
+		target, ok := targets[sheet.Id]
+		if !ok {
+			return nil, fmt.Errorf("relationship %s of sheet %s does not exist", sheet.Id, sheet.Name)
+		}
+		wb.sheetNames = append(wb.sheetNames, sheet.Name)
+		wb.sheetPaths = append(wb.sheetPaths, target)
+	}
+	return wb, nil
+}
+
+func (wb *Workbook) SheetNames() []string {
+	return wb.sheetNames
+}
+
+// ReadSheet returns the rows of the sheet. If name is empty, the first sheet is read.
+//
+// The index of the returned slice corresponds to the row number minus one,
+// so rows missing in the file are returned as empty rows.
+// Datetime values are returned in loc because spreadsheets do not hold time zones.
+func (wb *Workbook) ReadSheet(name string, loc *time.Location) ([][]interface{}, error) {
+	if len(wb.sheetNames) < 1 {
+		return nil, ErrNoSheet
+	}
+
+	idx := 0
+	if 0 < len(name) {
+		idx = -1
+		for i := range wb.sheetNames {
+			if strings.EqualFold(wb.sheetNames[i], name) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("sheet %s does not exist", name)
+		}
+	}
+
+	ws := worksheet{}
+	if err := wb.decode(wb.sheetPaths[idx], &ws); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]interface{}, 0, len(ws.Rows))
+	for _, row := range ws.Rows {
+		rowIdx := len(rows)
+		if 0 < row.R {
+			rowIdx = row.R - 1
+		}
+		for len(rows) < rowIdx {
+			rows = append(rows, nil)
+		}
+
+		var cells []interface{}
+		for _, c := range row.Cells {
+			colIdx := len(cells)
+			if 0 < len(c.R) {
+				i, err := ColumnIndex(c.R)
+				if err != nil {
+					return nil, err
+				}
+				colIdx = i
+			}
+			for len(cells) <= colIdx {
+				cells = append(cells, nil)
+			}
+
+			v, err := wb.cellValue(c.T, c.S, c.V, c.Is, loc)
+			if err != nil {
+				return nil, fmt.Errorf("cell %s: %s", c.R, err.Error())
+			}
+			cells[colIdx] = v
+		}
+		for 0 < len(cells) && cells[len(cells)-1] == nil {
+			cells = cells[:len(cells)-1]
+		}
+
+		if rowIdx < len(rows) {
+			rows[rowIdx] = cells
+		} else {
+			rows = append(rows, cells)
+		}
+	}
+	return rows, nil
+}
+
+func (wb *Workbook) cellValue(cellType string, style int, v *string, is *stringItem, loc *time.Location) (interface{}, error) {
+	if cellType == "inlineStr" {
+		if is == nil {
+			return nil, nil
+		}
+		return is.String(), nil
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	switch cellType {
+	case "s":
+		i, err := strconv.Atoi(*v)
+		if err != nil || i < 0 || len(wb.sharedStrings) <= i {
+			return nil, errors.New("invalid shared string index")
+		}
+		return wb.sharedStrings[i], nil
+	case "str", "e":
+		return *v, nil
+	case "b":
+		return *v == "1" || *v == "true", nil
+	case "d":
+		t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", strings.TrimSuffix(*v, "Z"), loc)
+		if err != nil {
+			if t, err = time.ParseInLocation("2006-01-02", *v, loc); err != nil {
+				return nil, errors.New("invalid date value")
+			}
+		}
+		return t, nil
+	}
+
+	if 0 <= style && style < len(wb.dateStyles) && wb.dateStyles[style] {
+		f, err := strconv.ParseFloat(*v, 64)
+		if err != nil {
+			return nil, errors.New("invalid numeric value")
+		}
+		return SerialToTime(f, wb.date1904, loc), nil
+	}
+
+	if i, err := strconv.ParseInt(*v, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(*v, 64)
+	if err != nil {
+		return nil, errors.New("invalid numeric value")
+	}
+	return f, nil
+}
+
+func (wb *Workbook) decode(name string, v interface{}) error {
+	for _, f := range wb.zr.File {
+		if f.Name != name {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		b, err := ioutil.ReadAll(rc)
+		if cerr := rc.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		if err = xml.Unmarshal(b, v); err != nil {
+			return fmt.Errorf("%s: %s", name, err.Error())
+		}
+		return nil
+	}
+	return fmt.Errorf("%s does not exist in the workbook", name)
+}
+
+func resolvePath(dir string, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return target[1:]
+	}
+	return path.Join(dir, target)
+}
+
+// ColumnIndex returns the zero-based column index of a cell reference such as "B3".
+func ColumnIndex(ref string) (int, error) {
+	idx := 0
+	n := 0
+	for _, c := range ref {
+		switch {
+		case 'A' <= c && c <= 'Z':
+			idx = idx*26 + int(c-'A') + 1
+		case 'a' <= c && c <= 'z':
+			idx = idx*26 + int(c-'a') + 1
+		default:
+			if n < 1 {
+				return 0, fmt.Errorf("invalid cell reference %s", ref)
+			}
+			return idx - 1, nil
+		}
+		n++
+	}
+	if n < 1 {
+		return 0, fmt.Errorf("invalid cell reference %s", ref)
+	}
+	return idx - 1, nil
+}
+
+func isBuiltInDateFormat(id int) bool {
+	return (14 <= id && id <= 22) || (27 <= id && id <= 36) || (45 <= id && id <= 47) || (50 <= id && id <= 58)
+}
+
+// IsDateFormat reports whether a number format code displays numbers as dates or times.
+func IsDateFormat(code string) bool {
+	section := code
+	if i := strings.IndexByte(section, ';'); -1 < i {
+		section = section[:i]
+	}
+
+	runes := []rune(section)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+			}
+		case '\\', '_', '*':
+			i++
+		case '[':
+			start := i
+			for i++; i < len(runes) && runes[i] != ']'; i++ {
+			}
+			if elapsed := strings.ToLower(string(runes[start+1 : i])); 0 < len(elapsed) && strings.Trim(elapsed, "hms") == "" {
+				return true
+			}
+		case 'y', 'Y', 'm', 'M', 'd', 'D', 'h', 'H', 's', 'S':
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	epoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	epoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+const nanosecondsPerDay = float64(24 * time.Hour)
+
+// SerialToTime converts a serial date number to a time in loc.
+func SerialToTime(serial float64, date1904 bool, loc *time.Location) time.Time {
+	epoch := epoch1900
+	if date1904 {
+		epoch = epoch1904
+	}
+
+	days := math.Floor(serial)
+	ns := math.Round((serial-days)*nanosecondsPerDay/float64(time.Millisecond)) * float64(time.Millisecond)
+	t := epoch.AddDate(0, 0, int(days)).Add(time.Duration(ns))
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// TimeToSerial converts the wall clock of t to a serial date number in the 1900 date system.
+func TimeToSerial(t time.Time) float64 {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	days := (date.Unix() - epoch1900.Unix()) / 86400
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	return float64(days) + float64(clock)/nanosecondsPerDay
+}
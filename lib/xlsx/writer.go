@@ -0,0 +1,217 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const MaxSheetNameLength = 31
+
+const (
+	styleDefault = iota
+	styleHeader
+	styleDate
+	styleDatetime
+)
+
+const contentTypesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const packageRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="` + relTypeOfficeDocument + `" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="` + relTypeStyles + `" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const stylesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="2"><numFmt numFmtId="164" formatCode="yyyy\-mm\-dd"/><numFmt numFmtId="165" formatCode="yyyy\-mm\-dd\ hh:mm:ss"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+// Write writes a workbook that consists of one sheet.
+// If header is not nil, it is written to the first row in bold.
+func Write(w io.Writer, sheet string, header []string, rows [][]interface{}) error {
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: contentTypesXml},
+		{name: "_rels/.rels", content: packageRelsXml},
+		{name: "xl/workbook.xml", content: workbookXml(sheet)},
+		{name: "xl/_rels/workbook.xml.rels", content: workbookRelsXml},
+		{name: "xl/styles.xml", content: stylesXml},
+	}
+	for _, part := range parts {
+		pw, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(pw, part.content); err != nil {
+			return err
+		}
+	}
+
+	pw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if err = writeWorksheet(pw, header, rows); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func workbookXml(sheet string) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`)
+	b.WriteString(`<sheets><sheet name="`)
+	_ = xml.EscapeText(&b, []byte(SheetName(sheet)))
+	b.WriteString(`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	return b.String()
+}
+
+func writeWorksheet(w io.Writer, header []string, rows [][]interface{}) error {
+	bw := bufio.NewWriter(w)
+	_, _ = bw.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	_, _ = bw.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	rowNumber := 0
+	if header != nil {
+		rowNumber++
+		_, _ = bw.WriteString(`<row r="1">`)
+		for i, label := range header {
+			writeCell(bw, ColumnName(i)+"1", label, styleHeader)
+		}
+		_, _ = bw.WriteString(`</row>`)
+	}
+
+	for _, row := range rows {
+		rowNumber++
+		rn := strconv.Itoa(rowNumber)
+		_, _ = bw.WriteString(`<row r="` + rn + `">`)
+		for i, v := range row {
+			writeCell(bw, ColumnName(i)+rn, v, styleDefault)
+		}
+		_, _ = bw.WriteString(`</row>`)
+	}
+
+	_, _ = bw.WriteString(`</sheetData></worksheet>`)
+	return bw.Flush()
+}
+
+func writeCell(w *bufio.Writer, ref string, v interface{}, style int) {
+	switch v.(type) {
+	case nil:
+		return
+	case string:
+		writeStringCell(w, ref, v.(string), style)
+	case int64:
+		writeValueCell(w, ref, "", style, strconv.FormatInt(v.(int64), 10))
+	case float64:
+		f := v.(float64)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			writeStringCell(w, ref, strconv.FormatFloat(f, 'f', -1, 64), style)
+		} else {
+			writeValueCell(w, ref, "", style, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case bool:
+		b := "0"
+		if v.(bool) {
+			b = "1"
+		}
+		writeValueCell(w, ref, "b", style, b)
+	case time.Time:
+		t := v.(time.Time)
+		if style == styleDefault {
+			style = styleDatetime
+			if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+				style = styleDate
+			}
+		}
+		writeValueCell(w, ref, "", style, strconv.FormatFloat(TimeToSerial(t), 'f', -1, 64))
+	default:
+		writeStringCell(w, ref, fmt.Sprint(v), style)
+	}
+}
+
+func writeCellStart(w *bufio.Writer, ref string, cellType string, style int) {
+	_, _ = w.WriteString(`<c r="` + ref + `"`)
+	if 0 < len(cellType) {
+		_, _ = w.WriteString(` t="` + cellType + `"`)
+	}
+	if style != styleDefault {
+		_, _ = w.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+	_ = w.WriteByte('>')
+}
+
+func writeValueCell(w *bufio.Writer, ref string, cellType string, style int, v string) {
+	writeCellStart(w, ref, cellType, style)
+	_, _ = w.WriteString(`<v>` + v + `</v></c>`)
+}
+
+func writeStringCell(w *bufio.Writer, ref string, s string, style int) {
+	writeCellStart(w, ref, "inlineStr", style)
+	_, _ = w.WriteString(`<is><t xml:space="preserve">`)
+	_ = xml.EscapeText(w, []byte(s))
+	_, _ = w.WriteString(`</t></is></c>`)
+}
+
+// ColumnName returns the column name such as "A" or "AB" of a zero-based column index.
+func ColumnName(idx int) string {
+	name := make([]byte, 0, 3)
+	for idx++; 0 < idx; idx = (idx - 1) / 26 {
+		name = append([]byte{byte('A' + (idx-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+// SheetName returns a valid sheet name by replacing prohibited characters and truncating the length.
+func SheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, strings.Trim(name, "'"))
+
+	if runes := []rune(name); MaxSheetNameLength < len(runes) {
+		name = string(runes[:MaxSheetNameLength])
+	}
+	if len(name) < 1 {
+		name = "Sheet1"
+	}
+	return name
+}
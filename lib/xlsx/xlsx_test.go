@@ -0,0 +1,155 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWriteAndReadSheet(t *testing.T) {
+	header := []string{"id", "name", "price", "active", "created"}
+	rows := [][]interface{}{
+		{int64(1), "apple & <pear>", 1.5, true, time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+		{int64(2), " spaced ", nil, false, time.Date(2012, 2, 4, 0, 0, 0, 0, time.UTC)},
+		{},
+		{nil, nil, nil, nil, "last"},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := Write(buf, "Sheet/1", header, rows); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	wb, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(wb.SheetNames(), []string{"Sheet_1"}) {
+		t.Errorf("sheet names = %q, want %q", wb.SheetNames(), []string{"Sheet_1"})
+	}
+
+	result, err := wb.ReadSheet("", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := [][]interface{}{
+		{"id", "name", "price", "active", "created"},
+		{int64(1), "apple & <pear>", 1.5, true, time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+		{int64(2), " spaced ", nil, false, time.Date(2012, 2, 4, 0, 0, 0, 0, time.UTC)},
+		nil,
+		{nil, nil, nil, nil, "last"},
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	if _, err = wb.ReadSheet("notexist", time.UTC); err == nil || err.Error() != "sheet notexist does not exist" {
+		t.Errorf("error = %v, want error %q", err, "sheet notexist does not exist")
+	}
+}
+
+func TestWorkbook_ReadSheet(t *testing.T) {
+	parts := map[string]string{
+		"_rels/.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="/xl/workbook.xml"/>` +
+			`</Relationships>`,
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<workbookPr date1904="1"/>` +
+			`<sheets><sheet name="First" sheetId="1" r:id="rId3"/><sheet name="Second" sheetId="2" r:id="rId4"/></sheets>` +
+			`</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId4" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>` +
+			`</Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>plain</t></si><si><r><t>rich </t></r><r><rPr><b/></rPr><t>text</t></r></si>` +
+			`</sst>`,
+		"xl/styles.xml": `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts count="1"><numFmt numFmtId="170" formatCode="&quot;Day&quot; d"/></numFmts>` +
+			`<cellXfs count="3"><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="170"/></cellXfs>` +
+			`</styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="2"><c r="B2" t="s"><v>0</v></c><c r="C2" t="s"><v>1</v></c></row>` +
+			`<row r="3"><c r="A3" s="1"><v>0</v></c><c r="B3" s="2"><v>1.5</v></c><c r="C3"><v>1E+3</v></c><c r="D3" t="str"><f>A1</f><v>calc</v></c><c r="E3" t="e"><v>#DIV/0!</v></c></row>` +
+			`</sheetData></worksheet>`,
+	}
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range parts {
+		w, _ := zw.Create(name)
+		_, _ = w.Write([]byte(content))
+	}
+	_ = zw.Close()
+
+	wb, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err := wb.ReadSheet("second", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := [][]interface{}{
+		nil,
+		{nil, "plain", "rich text"},
+		{time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1904, 1, 2, 12, 0, 0, 0, time.UTC), float64(1000), "calc", "#DIV/0!"},
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+var isDateFormatTests = []struct {
+	Code   string
+	Expect bool
+}{
+	{Code: "General", Expect: false},
+	{Code: "#,##0.00", Expect: false},
+	{Code: "0.00E+00", Expect: false},
+	{Code: "yyyy-mm-dd", Expect: true},
+	{Code: "[h]:mm", Expect: true},
+	{Code: "[Red]#,##0", Expect: false},
+	{Code: "\"Days\" 0", Expect: false},
+	{Code: "0\\d", Expect: false},
+	{Code: "0;[Red]-0;\"y\"", Expect: false},
+}
+
+func TestIsDateFormat(t *testing.T) {
+	for _, v := range isDateFormatTests {
+		if result := IsDateFormat(v.Code); result != v.Expect {
+			t.Errorf("result = %t, want %t for %q", result, v.Expect, v.Code)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for idx, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if result := ColumnName(idx); result != name {
+			t.Errorf("column name = %s, want %s for %d", result, name, idx)
+		}
+		if result, err := ColumnIndex(name + "12"); err != nil || result != idx {
+			t.Errorf("column index = %d, %v, want %d for %s", result, err, idx, name)
+		}
+	}
+
+	if _, err := ColumnIndex("12"); err == nil {
+		t.Errorf("no error, want error for %q", "12")
+	}
+}
+
+func TestTimeToSerial(t *testing.T) {
+	tm := time.Date(2012, 2, 3, 18, 0, 0, 0, time.FixedZone("", 9*3600))
+	if result := TimeToSerial(tm); result != 40942.75 {
+		t.Errorf("result = %v, want %v", result, 40942.75)
+	}
+	if result := SerialToTime(40942.75, false, time.UTC); !result.Equal(time.Date(2012, 2, 3, 18, 0, 0, 0, time.UTC)) {
+		t.Errorf("result = %v, want %v", result, time.Date(2012, 2, 3, 18, 0, 0, 0, time.UTC))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/action"
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -49,6 +50,11 @@ func main() {
 			Name:  "ansi-quotes, k",
 			Usage: "use double quotation mark as identifier enclosure",
 		},
+		cli.StringFlag{
+			Name:  "numeric-mode",
+			Value: "FLOAT",
+			Usage: "numeric type of numbers with fractional parts",
+		},
 		cli.Float64Flag{
 			Name:  "wait-timeout, w",
 			Value: 10,
@@ -207,6 +213,62 @@ func main() {
 				return action.Syntax(ctx, proc, words)
 			}),
 		},
+		{
+			Name:      "serve",
+			Usage:     "Run an HTTP server that executes queries",
+			ArgsUsage: " ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "listen",
+					Value: action.DefaultServerListen,
+					Usage: "address to listen on",
+				},
+				cli.Float64Flag{
+					Name:  "timeout",
+					Value: action.DefaultServerTimeout.Seconds(),
+					Usage: "limit of the execution time of each request in seconds. 0 means no limit",
+				},
+				cli.BoolFlag{
+					Name:  "allow-write",
+					Usage: "allow statements that create, update or lock files",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 0 < c.NArg() {
+					return query.NewIncorrectCommandUsageError("serve subcommand takes no argument")
+				}
+
+				if !c.GlobalIsSet("format") {
+					_ = proc.Tx.SetFormatFlag(cmd.JSON.String(), "")
+				}
+				timeout := time.Duration(c.Float64("timeout") * float64(time.Second))
+				return action.Serve(ctx, proc, c.String("listen"), timeout, c.Bool("allow-write"))
+			}),
+		},
+		{
+			Name:      "pgserver",
+			Usage:     "Run a server that accepts connections of PostgreSQL clients",
+			ArgsUsage: " ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "listen",
+					Value: action.DefaultPGServerListen,
+					Usage: "address to listen on",
+				},
+				cli.StringFlag{
+					Name:   "password",
+					Usage:  "password to authenticate clients. required to listen on addresses other than loopback addresses",
+					EnvVar: "CSVQ_PGSERVER_PASSWORD",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 0 < c.NArg() {
+					return query.NewIncorrectCommandUsageError("pgserver subcommand takes no argument")
+				}
+
+				return action.ServePG(ctx, proc, c.String("listen"), c.String("password"))
+			}),
+		},
 		{
 			Name:      "check-update",
 			Usage:     "Check for updates",
@@ -342,6 +404,11 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("ansi-quotes") {
 		_ = tx.SetFlag(cmd.AnsiQuotesFlag, c.GlobalBool("ansi-quotes"))
 	}
+	if c.GlobalIsSet("numeric-mode") {
+		if err := tx.SetFlag(cmd.NumericModeFlag, c.GlobalString("numeric-mode")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 
 	if c.GlobalIsSet("wait-timeout") {
 		_ = tx.SetFlag(cmd.WaitTimeoutFlag, c.GlobalFloat64("wait-timeout"))